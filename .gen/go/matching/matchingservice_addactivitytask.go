@@ -255,6 +255,8 @@ func init() {
 			return true
 		case *shared.DomainNotActiveError:
 			return true
+		case *RemoteSyncMatchFailedError:
+			return true
 		default:
 			return false
 		}
@@ -291,6 +293,11 @@ func init() {
 				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.DomainNotActiveError")
 			}
 			return &MatchingService_AddActivityTask_Result{DomainNotActiveError: e}, nil
+		case *RemoteSyncMatchFailedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.RemoteSyncMatchFailedError")
+			}
+			return &MatchingService_AddActivityTask_Result{RemoteSyncMatchFailedError: e}, nil
 		}
 
 		return nil, err
@@ -316,6 +323,10 @@ func init() {
 			err = result.DomainNotActiveError
 			return
 		}
+		if result.RemoteSyncMatchFailedError != nil {
+			err = result.RemoteSyncMatchFailedError
+			return
+		}
 		return
 	}
 
@@ -325,11 +336,12 @@ func init() {
 //
 // The result of a AddActivityTask execution is sent and received over the wire as this struct.
 type MatchingService_AddActivityTask_Result struct {
-	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
-	LimitExceededError   *shared.LimitExceededError   `json:"limitExceededError,omitempty"`
-	DomainNotActiveError *shared.DomainNotActiveError `json:"domainNotActiveError,omitempty"`
+	BadRequestError            *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError       *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	ServiceBusyError           *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+	LimitExceededError         *shared.LimitExceededError   `json:"limitExceededError,omitempty"`
+	DomainNotActiveError       *shared.DomainNotActiveError `json:"domainNotActiveError,omitempty"`
+	RemoteSyncMatchFailedError *RemoteSyncMatchFailedError  `json:"remoteSyncMatchFailedError,omitempty"`
 }
 
 // ToWire translates a MatchingService_AddActivityTask_Result struct into a Thrift-level intermediate
@@ -349,7 +361,7 @@ type MatchingService_AddActivityTask_Result struct {
 //   }
 func (v *MatchingService_AddActivityTask_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -395,6 +407,14 @@ func (v *MatchingService_AddActivityTask_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
+	if v.RemoteSyncMatchFailedError != nil {
+		w, err = v.RemoteSyncMatchFailedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
 
 	if i > 1 {
 		return wire.Value{}, fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", i)
@@ -433,6 +453,12 @@ func _DomainNotActiveError_Read(w wire.Value) (*shared.DomainNotActiveError, err
 	return &v, err
 }
 
+func _RemoteSyncMatchFailedError_Read(w wire.Value) (*RemoteSyncMatchFailedError, error) {
+	var v RemoteSyncMatchFailedError
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a MatchingService_AddActivityTask_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -494,6 +520,14 @@ func (v *MatchingService_AddActivityTask_Result) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.RemoteSyncMatchFailedError, err = _RemoteSyncMatchFailedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -514,6 +548,9 @@ func (v *MatchingService_AddActivityTask_Result) FromWire(w wire.Value) error {
 	if v.DomainNotActiveError != nil {
 		count++
 	}
+	if v.RemoteSyncMatchFailedError != nil {
+		count++
+	}
 	if count > 1 {
 		return fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", count)
 	}
@@ -528,7 +565,7 @@ func (v *MatchingService_AddActivityTask_Result) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
@@ -550,6 +587,10 @@ func (v *MatchingService_AddActivityTask_Result) String() string {
 		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
 		i++
 	}
+	if v.RemoteSyncMatchFailedError != nil {
+		fields[i] = fmt.Sprintf("RemoteSyncMatchFailedError: %v", v.RemoteSyncMatchFailedError)
+		i++
+	}
 
 	return fmt.Sprintf("MatchingService_AddActivityTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
@@ -579,6 +620,9 @@ func (v *MatchingService_AddActivityTask_Result) Equals(rhs *MatchingService_Add
 	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
 		return false
 	}
+	if !((v.RemoteSyncMatchFailedError == nil && rhs.RemoteSyncMatchFailedError == nil) || (v.RemoteSyncMatchFailedError != nil && rhs.RemoteSyncMatchFailedError != nil && v.RemoteSyncMatchFailedError.Equals(rhs.RemoteSyncMatchFailedError))) {
+		return false
+	}
 
 	return true
 }
@@ -604,6 +648,9 @@ func (v *MatchingService_AddActivityTask_Result) MarshalLogObject(enc zapcore.Ob
 	if v.DomainNotActiveError != nil {
 		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
 	}
+	if v.RemoteSyncMatchFailedError != nil {
+		err = multierr.Append(err, enc.AddObject("remoteSyncMatchFailedError", v.RemoteSyncMatchFailedError))
+	}
 	return err
 }
 
@@ -657,6 +704,16 @@ func (v *MatchingService_AddActivityTask_Result) GetDomainNotActiveError() (o *s
 	return
 }
 
+// GetRemoteSyncMatchFailedError returns the value of RemoteSyncMatchFailedError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetRemoteSyncMatchFailedError() (o *RemoteSyncMatchFailedError) {
+	if v.RemoteSyncMatchFailedError != nil {
+		return v.RemoteSyncMatchFailedError
+	}
+
+	return
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/backoff"
 	"go.uber.org/yarpc"
@@ -93,3 +94,171 @@ func (c *retryableClient) GetWorkflowExecutionRawHistory(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) ReadHistoryBranch(
+	ctx context.Context,
+	request *admin.ReadHistoryBranchRequest,
+	opts ...yarpc.CallOption,
+) (*admin.ReadHistoryBranchResponse, error) {
+
+	var resp *admin.ReadHistoryBranchResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ReadHistoryBranch(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *admin.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.DeleteWorkflowExecution(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) GetDomainIDOrName(
+	ctx context.Context,
+	request *admin.GetDomainIDOrNameRequest,
+	opts ...yarpc.CallOption,
+) (*admin.GetDomainIDOrNameResponse, error) {
+
+	var resp *admin.GetDomainIDOrNameResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetDomainIDOrName(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteDomain(
+	ctx context.Context,
+	request *admin.DeleteDomainRequest,
+	opts ...yarpc.CallOption,
+) (*admin.DeleteDomainResponse, error) {
+
+	var resp *admin.DeleteDomainResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DeleteDomain(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartBatch(
+	ctx context.Context,
+	request *admin.StartBatchRequest,
+	opts ...yarpc.CallOption,
+) (*admin.StartBatchResponse, error) {
+
+	var resp *admin.StartBatchResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.StartBatch(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.ReplicationMessages, error) {
+
+	var resp *replicator.ReplicationMessages
+	op := func() error {
+		var err error
+		resp, err = c.client.GetReplicationMessages(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetDomainReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetDomainReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.ReplicationMessages, error) {
+
+	var resp *replicator.ReplicationMessages
+	op := func() error {
+		var err error
+		resp, err = c.client.GetDomainReplicationMessages(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ReadDLQMessages(
+	ctx context.Context,
+	request *replicator.ReadDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.ReadDLQMessagesResponse, error) {
+
+	var resp *replicator.ReadDLQMessagesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ReadDLQMessages(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) MergeDLQMessages(
+	ctx context.Context,
+	request *replicator.MergeDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.MergeDLQMessagesResponse, error) {
+
+	var resp *replicator.MergeDLQMessagesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.MergeDLQMessages(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PurgeDLQMessages(
+	ctx context.Context,
+	request *replicator.PurgeDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PurgeDLQMessages(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) VerifyWorkflowExecution(
+	ctx context.Context,
+	request *admin.VerifyWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*admin.VerifyWorkflowExecutionResponse, error) {
+
+	var resp *admin.VerifyWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.VerifyWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
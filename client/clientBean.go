@@ -25,14 +25,15 @@ import (
 	"fmt"
 	"regexp"
 
+	"github.com/uber-common/bark"
 	"go.uber.org/yarpc"
-	"go.uber.org/yarpc/transport/tchannel"
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/service/config"
 )
 
 const (
@@ -51,7 +52,7 @@ type (
 
 	// DispatcherProvider provides a diapatcher to a given address
 	DispatcherProvider interface {
-		Get(name string, address string) (*yarpc.Dispatcher, error)
+		Get(name string, address string, tlsConfig *config.TLS) (*yarpc.Dispatcher, error)
 	}
 
 	clientBeanImpl struct {
@@ -87,7 +88,7 @@ func NewClientBean(factory Factory, dispatcherProvider DispatcherProvider, clust
 	remoteAdminClients := map[string]admin.Client{}
 	remoteFrontendClients := map[string]frontend.Client{}
 	for cluster, address := range clusterMetadata.GetAllClientAddress() {
-		dispatcher, err := dispatcherProvider.Get(address.RPCName, address.RPCAddress)
+		dispatcher, err := dispatcherProvider.Get(address.RPCName, address.RPCAddress, &address.TLS)
 		if err != nil {
 			return nil, err
 		}
@@ -165,7 +166,7 @@ func NewIPYarpcDispatcherProvider() DispatcherProvider {
 	return &ipDispatcherProvider{}
 }
 
-func (p *ipDispatcherProvider) Get(name string, address string) (*yarpc.Dispatcher, error) {
+func (p *ipDispatcherProvider) Get(name string, address string, tlsConfig *config.TLS) (*yarpc.Dispatcher, error) {
 	match, err := regexp.MatchString(ipPortRegex, address)
 	if err != nil {
 		return nil, err
@@ -174,11 +175,8 @@ func (p *ipDispatcherProvider) Get(name string, address string) (*yarpc.Dispatch
 		return nil, errors.New("invalid ip:port address")
 	}
 
-	channel, err := tchannel.NewChannelTransport(
-		tchannel.ServiceName(crossDCCaller),
-		// this aim to get rid of the annoying popup about accepting incoming network connections
-		tchannel.ListenAddr("127.0.0.1:0"),
-	)
+	// this aim to get rid of the annoying popup about accepting incoming network connections
+	channel, err := tlsConfig.NewChannelTransport(crossDCCaller, "127.0.0.1:0", bark.NewNopLogger())
 	if err != nil {
 		return nil, err
 	}
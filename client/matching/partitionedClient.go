@@ -0,0 +1,264 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+
+	m "github.com/uber/cadence/.gen/go/matching"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/yarpc"
+)
+
+var _ Client = (*partitionedClient)(nil)
+
+type partitionedClient struct {
+	client             Client
+	domainCache        cache.DomainCache
+	numWritePartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+	numReadPartitions  dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+}
+
+// NewPartitionedClient creates a new instance of Client which spreads the tasks and the pollers of
+// a task list across its partitions. Each partition is a task list of its own, so the partitions are
+// placed on different matching hosts by the service resolver. The pollers are spread across the read
+// partitions, which keep being polled after the tasks are no longer added to them so that their backlog
+// is drained.
+func NewPartitionedClient(
+	client Client,
+	domainCache cache.DomainCache,
+	numWritePartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters,
+	numReadPartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters,
+) Client {
+	return &partitionedClient{
+		client:             client,
+		domainCache:        domainCache,
+		numWritePartitions: numWritePartitions,
+		numReadPartitions:  numReadPartitions,
+	}
+}
+
+func (c *partitionedClient) AddActivityTask(
+	ctx context.Context,
+	addRequest *m.AddActivityTaskRequest,
+	opts ...yarpc.CallOption) error {
+	request := *addRequest
+	request.TaskList = c.pickPartition(
+		addRequest.GetDomainUUID(), addRequest.TaskList, persistence.TaskListTypeActivity, addRequest.GetForwardedFrom(),
+		false,
+	)
+	return c.client.AddActivityTask(ctx, &request, opts...)
+}
+
+func (c *partitionedClient) AddDecisionTask(
+	ctx context.Context,
+	addRequest *m.AddDecisionTaskRequest,
+	opts ...yarpc.CallOption) error {
+	request := *addRequest
+	request.TaskList = c.pickPartition(
+		addRequest.GetDomainUUID(), addRequest.TaskList, persistence.TaskListTypeDecision, addRequest.GetForwardedFrom(),
+		false,
+	)
+	return c.client.AddDecisionTask(ctx, &request, opts...)
+}
+
+func (c *partitionedClient) PollForActivityTask(
+	ctx context.Context,
+	pollRequest *m.PollForActivityTaskRequest,
+	opts ...yarpc.CallOption) (*workflow.PollForActivityTaskResponse, error) {
+	if pollRequest.PollRequest == nil {
+		return c.client.PollForActivityTask(ctx, pollRequest, opts...)
+	}
+	request := *pollRequest
+	innerRequest := *pollRequest.PollRequest
+	innerRequest.TaskList = c.pickPartition(
+		pollRequest.GetDomainUUID(), innerRequest.TaskList, persistence.TaskListTypeActivity, pollRequest.GetForwardedFrom(),
+		true,
+	)
+	request.PollRequest = &innerRequest
+	return c.client.PollForActivityTask(ctx, &request, opts...)
+}
+
+func (c *partitionedClient) PollForDecisionTask(
+	ctx context.Context,
+	pollRequest *m.PollForDecisionTaskRequest,
+	opts ...yarpc.CallOption) (*m.PollForDecisionTaskResponse, error) {
+	if pollRequest.PollRequest == nil {
+		return c.client.PollForDecisionTask(ctx, pollRequest, opts...)
+	}
+	request := *pollRequest
+	innerRequest := *pollRequest.PollRequest
+	innerRequest.TaskList = c.pickPartition(
+		pollRequest.GetDomainUUID(), innerRequest.TaskList, persistence.TaskListTypeDecision, pollRequest.GetForwardedFrom(),
+		true,
+	)
+	request.PollRequest = &innerRequest
+	return c.client.PollForDecisionTask(ctx, &request, opts...)
+}
+
+func (c *partitionedClient) QueryWorkflow(
+	ctx context.Context,
+	queryRequest *m.QueryWorkflowRequest,
+	opts ...yarpc.CallOption) (*workflow.QueryWorkflowResponse, error) {
+	// queries are always dispatched by the root partition, pollers of the other partitions
+	// reach it through forwarding
+	return c.client.QueryWorkflow(ctx, queryRequest, opts...)
+}
+
+func (c *partitionedClient) RespondQueryTaskCompleted(
+	ctx context.Context,
+	request *m.RespondQueryTaskCompletedRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.RespondQueryTaskCompleted(ctx, request, opts...)
+}
+
+// CancelOutstandingPoll is sent to every partition as the poll could have been routed to any of them
+func (c *partitionedClient) CancelOutstandingPoll(
+	ctx context.Context,
+	request *m.CancelOutstandingPollRequest,
+	opts ...yarpc.CallOption) error {
+	var firstErr error
+	for _, taskList := range c.allPartitions(request.GetDomainUUID(), request.TaskList, int(request.GetTaskListType())) {
+		partitionRequest := *request
+		partitionRequest.TaskList = taskList
+		if err := c.client.CancelOutstandingPoll(ctx, &partitionRequest, opts...); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// DescribeTaskList returns the pollers of all the partitions of the task list
+func (c *partitionedClient) DescribeTaskList(
+	ctx context.Context,
+	request *m.DescribeTaskListRequest,
+	opts ...yarpc.CallOption) (*workflow.DescribeTaskListResponse, error) {
+	if request.DescRequest == nil {
+		return c.client.DescribeTaskList(ctx, request, opts...)
+	}
+	taskListType := persistence.TaskListTypeDecision
+	if request.DescRequest.GetTaskListType() == workflow.TaskListTypeActivity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+	partitions := c.allPartitions(request.GetDomainUUID(), request.DescRequest.TaskList, taskListType)
+	if len(partitions) == 1 {
+		return c.client.DescribeTaskList(ctx, request, opts...)
+	}
+
+	pollers := make(map[string]*workflow.PollerInfo)
+	for _, taskList := range partitions {
+		partitionRequest := *request
+		descRequest := *request.DescRequest
+		descRequest.TaskList = taskList
+		partitionRequest.DescRequest = &descRequest
+		resp, err := c.client.DescribeTaskList(ctx, &partitionRequest, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, poller := range resp.Pollers {
+			existing, ok := pollers[poller.GetIdentity()]
+			if !ok || existing.GetLastAccessTime() < poller.GetLastAccessTime() {
+				pollers[poller.GetIdentity()] = poller
+			}
+		}
+	}
+	response := &workflow.DescribeTaskListResponse{Pollers: []*workflow.PollerInfo{}}
+	for _, poller := range pollers {
+		response.Pollers = append(response.Pollers, poller)
+	}
+	return response, nil
+}
+
+// pickPartition returns a random partition of the task list, a read partition for polls and a write partition
+// otherwise. Requests which were forwarded, target sticky task lists or already name a partition are left as
+// they are.
+func (c *partitionedClient) pickPartition(
+	domainID string,
+	taskList *workflow.TaskList,
+	taskListType int,
+	forwardedFrom string,
+	isPoll bool,
+) *workflow.TaskList {
+	if forwardedFrom != "" {
+		return taskList
+	}
+	numPartitions, numReadPartitions := c.getNumPartitions(domainID, taskList, taskListType)
+	if isPoll {
+		numPartitions = numReadPartitions
+	}
+	if numPartitions <= 1 {
+		return taskList
+	}
+	return &workflow.TaskList{
+		Name: common.StringPtr(TaskListPartitionName(taskList.GetName(), rand.Intn(numPartitions))),
+		Kind: taskList.Kind,
+	}
+}
+
+func (c *partitionedClient) allPartitions(
+	domainID string,
+	taskList *workflow.TaskList,
+	taskListType int,
+) []*workflow.TaskList {
+	_, numPartitions := c.getNumPartitions(domainID, taskList, taskListType)
+	if numPartitions <= 1 {
+		return []*workflow.TaskList{taskList}
+	}
+	partitions := make([]*workflow.TaskList, 0, numPartitions)
+	for i := 0; i < numPartitions; i++ {
+		partitions = append(partitions, &workflow.TaskList{
+			Name: common.StringPtr(TaskListPartitionName(taskList.GetName(), i)),
+			Kind: taskList.Kind,
+		})
+	}
+	return partitions
+}
+
+// getNumPartitions returns the number of write and read partitions of the task list, the read partitions
+// always include the write partitions
+func (c *partitionedClient) getNumPartitions(
+	domainID string,
+	taskList *workflow.TaskList,
+	taskListType int,
+) (numWritePartitions int, numReadPartitions int) {
+	if taskList == nil || taskList.GetKind() == workflow.TaskListKindSticky ||
+		strings.HasPrefix(taskList.GetName(), common.ReservedTaskListPrefix) {
+		return 1, 1
+	}
+	domainEntry, err := c.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		// let the matching service report the error
+		return 1, 1
+	}
+	domain := domainEntry.GetInfo().Name
+	numWritePartitions = c.numWritePartitions(domain, taskList.GetName(), taskListType)
+	numReadPartitions = c.numReadPartitions(domain, taskList.GetName(), taskListType)
+	if numReadPartitions < numWritePartitions {
+		numReadPartitions = numWritePartitions
+	}
+	return numWritePartitions, numReadPartitions
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	m "github.com/uber/cadence/.gen/go/matching"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	partitionedClientSuite struct {
+		suite.Suite
+		mockClient        *mocks.MatchingClient
+		domainCache       *cache.DomainCacheMock
+		numPartitions     int
+		numReadPartitions int
+		client            Client
+	}
+)
+
+func TestPartitionedClientSuite(t *testing.T) {
+	s := new(partitionedClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *partitionedClientSuite) SetupTest() {
+	s.mockClient = &mocks.MatchingClient{}
+	s.domainCache = &cache.DomainCacheMock{}
+	s.domainCache.On("GetDomainByID", mock.Anything).Return(cache.CreateDomainCacheEntry("domainName"), nil)
+	s.numPartitions = 3
+	s.numReadPartitions = 1
+	s.client = NewPartitionedClient(s.mockClient, s.domainCache, func(domain string, taskList string, taskType int) int {
+		return s.numPartitions
+	}, func(domain string, taskList string, taskType int) int {
+		return s.numReadPartitions
+	})
+}
+
+func (s *partitionedClientSuite) TearDownTest() {
+	s.mockClient.AssertExpectations(s.T())
+}
+
+func (s *partitionedClientSuite) TestTaskListPartitionName() {
+	for _, name := range []string{"tl", "some/task/list", "/tl/1"} {
+		s.Equal(name, TaskListPartitionName(name, 0))
+		for partition := 0; partition < 3; partition++ {
+			root, parsed := ParseTaskListPartition(TaskListPartitionName(name, partition))
+			s.Equal(name, root)
+			s.Equal(partition, parsed)
+		}
+	}
+
+	root, partition := ParseTaskListPartition(common.ReservedTaskListPrefix + "tl")
+	s.Equal(common.ReservedTaskListPrefix+"tl", root)
+	s.Equal(0, partition)
+}
+
+func (s *partitionedClientSuite) TestAddDecisionTask_SpreadAcrossPartitions() {
+	picked := make(map[int]bool)
+	s.mockClient.On("AddDecisionTask", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, request *m.AddDecisionTaskRequest) error {
+			root, partition := ParseTaskListPartition(request.TaskList.GetName())
+			s.Equal("tl", root)
+			s.True(partition < s.numPartitions)
+			picked[partition] = true
+			return nil
+		})
+
+	request := &m.AddDecisionTaskRequest{
+		DomainUUID: common.StringPtr("domainID"),
+		TaskList:   &workflow.TaskList{Name: common.StringPtr("tl")},
+	}
+	for i := 0; i < 100; i++ {
+		s.NoError(s.client.AddDecisionTask(context.Background(), request))
+	}
+	s.Equal("tl", request.TaskList.GetName())
+	s.Len(picked, s.numPartitions)
+}
+
+func (s *partitionedClientSuite) TestPollForDecisionTask_SpreadAcrossReadPartitions() {
+	s.numPartitions = 1
+	s.numReadPartitions = 3
+	s.mockClient.On("AddDecisionTask", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, request *m.AddDecisionTaskRequest) error {
+			s.Equal("tl", request.TaskList.GetName())
+			return nil
+		}).Once()
+	picked := make(map[int]bool)
+	s.mockClient.On("PollForDecisionTask", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, request *m.PollForDecisionTaskRequest) *m.PollForDecisionTaskResponse {
+			root, partition := ParseTaskListPartition(request.PollRequest.TaskList.GetName())
+			s.Equal("tl", root)
+			s.True(partition < s.numReadPartitions)
+			picked[partition] = true
+			return &m.PollForDecisionTaskResponse{}
+		}, nil)
+
+	s.NoError(s.client.AddDecisionTask(context.Background(), &m.AddDecisionTaskRequest{
+		DomainUUID: common.StringPtr("domainID"),
+		TaskList:   &workflow.TaskList{Name: common.StringPtr("tl")},
+	}))
+	request := &m.PollForDecisionTaskRequest{
+		DomainUUID: common.StringPtr("domainID"),
+		PollRequest: &workflow.PollForDecisionTaskRequest{
+			TaskList: &workflow.TaskList{Name: common.StringPtr("tl")},
+		},
+	}
+	for i := 0; i < 100; i++ {
+		_, err := s.client.PollForDecisionTask(context.Background(), request)
+		s.NoError(err)
+	}
+	s.Len(picked, s.numReadPartitions)
+}
+
+func (s *partitionedClientSuite) TestPollForActivityTask_StickyAndForwardedNotPartitioned() {
+	sticky := &m.PollForActivityTaskRequest{
+		DomainUUID: common.StringPtr("domainID"),
+		PollRequest: &workflow.PollForActivityTaskRequest{
+			TaskList: &workflow.TaskList{Name: common.StringPtr("tl"), Kind: workflow.TaskListKindSticky.Ptr()},
+		},
+	}
+	forwarded := &m.PollForActivityTaskRequest{
+		DomainUUID: common.StringPtr("domainID"),
+		PollRequest: &workflow.PollForActivityTaskRequest{
+			TaskList: &workflow.TaskList{Name: common.StringPtr("tl")},
+		},
+		ForwardedFrom: common.StringPtr(TaskListPartitionName("tl", 1)),
+	}
+	s.mockClient.On("PollForActivityTask", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, request *m.PollForActivityTaskRequest) *workflow.PollForActivityTaskResponse {
+			s.Equal("tl", request.PollRequest.TaskList.GetName())
+			return &workflow.PollForActivityTaskResponse{}
+		}, nil).Twice()
+
+	_, err := s.client.PollForActivityTask(context.Background(), sticky)
+	s.NoError(err)
+	_, err = s.client.PollForActivityTask(context.Background(), forwarded)
+	s.NoError(err)
+}
+
+func (s *partitionedClientSuite) TestCancelOutstandingPoll_AllPartitions() {
+	cancelled := make(map[string]bool)
+	s.mockClient.On("CancelOutstandingPoll", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, request *m.CancelOutstandingPollRequest) error {
+			s.Equal("pollerID", request.GetPollerID())
+			cancelled[request.TaskList.GetName()] = true
+			return nil
+		}).Times(s.numPartitions)
+
+	err := s.client.CancelOutstandingPoll(context.Background(), &m.CancelOutstandingPollRequest{
+		DomainUUID:   common.StringPtr("domainID"),
+		TaskListType: common.Int32Ptr(persistence.TaskListTypeDecision),
+		TaskList:     &workflow.TaskList{Name: common.StringPtr("tl")},
+		PollerID:     common.StringPtr("pollerID"),
+	})
+	s.NoError(err)
+	s.Len(cancelled, s.numPartitions)
+	s.True(cancelled["tl"])
+}
+
+func (s *partitionedClientSuite) TestDescribeTaskList_MergesPollers() {
+	s.mockClient.On("DescribeTaskList", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, request *m.DescribeTaskListRequest) *workflow.DescribeTaskListResponse {
+			_, partition := ParseTaskListPartition(request.DescRequest.TaskList.GetName())
+			return &workflow.DescribeTaskListResponse{
+				Pollers: []*workflow.PollerInfo{
+					{Identity: common.StringPtr("shared"), LastAccessTime: common.Int64Ptr(int64(partition))},
+					{Identity: common.StringPtr(TaskListPartitionName("poller", partition)), LastAccessTime: common.Int64Ptr(0)},
+				},
+			}
+		}, nil).Times(s.numPartitions)
+
+	resp, err := s.client.DescribeTaskList(context.Background(), &m.DescribeTaskListRequest{
+		DomainUUID: common.StringPtr("domainID"),
+		DescRequest: &workflow.DescribeTaskListRequest{
+			TaskList:     &workflow.TaskList{Name: common.StringPtr("tl")},
+			TaskListType: workflow.TaskListTypeDecision.Ptr(),
+		},
+	})
+	s.NoError(err)
+	s.Len(resp.Pollers, s.numPartitions+1)
+	for _, poller := range resp.Pollers {
+		if poller.GetIdentity() == "shared" {
+			s.EqualValues(s.numPartitions-1, poller.GetLastAccessTime())
+		}
+	}
+}
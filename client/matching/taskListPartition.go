@@ -0,0 +1,55 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/uber/cadence/common"
+)
+
+// TaskListPartitionName returns the name of the given partition of a task list. Partition 0 is the
+// task list itself, so a task list which is not split keeps its own name.
+func TaskListPartitionName(taskList string, partition int) string {
+	if partition <= 0 {
+		return taskList
+	}
+	return common.ReservedTaskListPrefix + taskList + "/" + strconv.Itoa(partition)
+}
+
+// ParseTaskListPartition returns the name of the task list a partition belongs to along with the
+// partition number, names which are not partition names are returned as partition 0
+func ParseTaskListPartition(name string) (string, int) {
+	if !strings.HasPrefix(name, common.ReservedTaskListPrefix) {
+		return name, 0
+	}
+	suffix := strings.TrimPrefix(name, common.ReservedTaskListPrefix)
+	idx := strings.LastIndex(suffix, "/")
+	if idx <= 0 {
+		return name, 0
+	}
+	partition, err := strconv.Atoi(suffix[idx+1:])
+	if err != nil || partition <= 0 {
+		return name, 0
+	}
+	return suffix[:idx], partition
+}
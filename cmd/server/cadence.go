@@ -25,8 +25,13 @@ import (
 	"os"
 	"strings"
 
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	p "github.com/uber/cadence/common/persistence"
+	pfactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/service/worker/sysworkflow"
 	"github.com/uber/cadence/tools/cassandra"
+	"github.com/uber/cadence/tools/sql"
 
 	"github.com/urfave/cli"
 )
@@ -34,6 +39,13 @@ import (
 // validServices is the list of all valid cadence services
 var validServices = []string{historyService, matchingService, frontendService, workerService}
 
+const (
+	// devStoreName is the name of the in-memory datastore used by the dev command
+	devStoreName = "memory"
+	// systemDomainID is the id of the cadence system domain, it matches the id used by the cassandra schema
+	systemDomainID = "32049b68-7872-4094-8e63-d0dd59896a83"
+)
+
 // main entry point for the cadence server
 func main() {
 	app := buildCLI()
@@ -42,6 +54,50 @@ func main() {
 
 // startHandler is the handler for the cli start command
 func startHandler(c *cli.Context) {
+	cfg := loadConfig(c)
+
+	dir, err := os.Getwd()
+	if err != nil {
+		log.Fatal("Unable to get current directory")
+	}
+	if err := cassandra.VerifyCompatibleVersion(cfg.Persistence, dir); err != nil {
+		log.Fatal("Incompatible versions", err)
+	}
+	if err := sql.VerifyCompatibleVersion(cfg.Persistence, dir); err != nil {
+		log.Fatal("Incompatible versions", err)
+	}
+
+	startServices(&cfg, getServices(c))
+}
+
+// devHandler is the handler for the cli dev command, it starts all the services in
+// this process on top of an in-memory datastore, all data is lost when the process exits
+func devHandler(c *cli.Context) {
+	cfg := loadConfig(c)
+
+	cfg.Persistence = config.Persistence{
+		DefaultStore:     devStoreName,
+		VisibilityStore:  devStoreName,
+		NumHistoryShards: cfg.Persistence.NumHistoryShards,
+		DataStores: map[string]config.DataStore{
+			devStoreName: {Memory: &config.Memory{Name: "cadence"}},
+		},
+	}
+	// replication to other clusters requires kafka and a shared datastore
+	cfg.ClustersInfo.EnableGlobalDomain = false
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("config validation failed: %v", err)
+	}
+
+	if err := registerSystemDomain(&cfg); err != nil {
+		log.Fatalf("failed to register the system domain: %v", err)
+	}
+
+	startServices(&cfg, validServices)
+}
+
+// loadConfig loads and validates the config selected by the global flags
+func loadConfig(c *cli.Context) config.Config {
 	env := getEnvironment(c)
 	zone := getZone(c)
 	configDir := getConfigDir(c)
@@ -58,27 +114,57 @@ func startHandler(c *cli.Context) {
 	if err := cfg.Validate(); err != nil {
 		log.Fatalf("config validation failed: %v", err)
 	}
+	return cfg
+}
 
-	dir, err := os.Getwd()
-	if err != nil {
-		log.Fatal("Unable to get current directory")
-	}
-	if err := cassandra.VerifyCompatibleVersion(cfg.Persistence, dir); err != nil {
-		log.Fatal("Incompatible versions", err)
-	}
-
-	services := getServices(c)
+// startServices starts the given services in this process and blocks forever
+func startServices(cfg *config.Config, services []string) {
 	for _, svc := range services {
 		if _, ok := cfg.Services[svc]; !ok {
 			log.Fatalf("`%v` service missing config", svc)
 		}
-		server := newServer(svc, &cfg)
+		server := newServer(svc, cfg)
 		server.Start()
 	}
 
 	select {}
 }
 
+// registerSystemDomain creates the domain used by the system workflows, the schema of
+// the other datastores creates it as part of the setup
+func registerSystemDomain(cfg *config.Config) error {
+	logger := cfg.Log.NewBarkLogger()
+	factory := pfactory.New(&cfg.Persistence, cfg.ClustersInfo.CurrentClusterName, nil, logger)
+	defer factory.Close()
+
+	metadataMgr, err := factory.NewMetadataManager(pfactory.MetadataV2)
+	if err != nil {
+		return err
+	}
+	_, err = metadataMgr.CreateDomain(&p.CreateDomainRequest{
+		Info: &p.DomainInfo{
+			ID:          systemDomainID,
+			Name:        sysworkflow.Domain,
+			Status:      p.DomainStatusRegistered,
+			Description: "cadence system workflow domain",
+			OwnerEmail:  "cadence-dev-group@uber.com",
+		},
+		Config: &p.DomainConfig{
+			Retention: 3,
+		},
+		ReplicationConfig: &p.DomainReplicationConfig{
+			ActiveClusterName: cfg.ClustersInfo.CurrentClusterName,
+			Clusters: []*p.ClusterReplicationConfig{
+				{ClusterName: cfg.ClustersInfo.CurrentClusterName},
+			},
+		},
+	})
+	if _, ok := err.(*workflow.DomainAlreadyExistsError); ok {
+		return nil
+	}
+	return err
+}
+
 func getEnvironment(c *cli.Context) string {
 	return strings.TrimSpace(c.GlobalString("env"))
 }
@@ -186,6 +272,13 @@ func buildCLI() *cli.App {
 				startHandler(c)
 			},
 		},
+		{
+			Name:  "dev",
+			Usage: "start all cadence services in one process with an in-memory datastore",
+			Action: func(c *cli.Context) {
+				devHandler(c)
+			},
+		},
 	}
 
 	return app
@@ -27,8 +27,10 @@ import (
 
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/search/httpjson"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
@@ -43,10 +45,11 @@ import (
 
 type (
 	server struct {
-		name   string
-		cfg    *config.Config
-		doneC  chan struct{}
-		daemon common.Daemon
+		name               string
+		cfg                *config.Config
+		doneC              chan struct{}
+		dynamicConfigDoneC chan struct{}
+		daemon             common.Daemon
 	}
 )
 
@@ -61,9 +64,10 @@ const (
 // that represents a cadence service
 func newServer(service string, cfg *config.Config) common.Daemon {
 	return &server{
-		cfg:   cfg,
-		name:  service,
-		doneC: make(chan struct{}),
+		cfg:                cfg,
+		name:               service,
+		doneC:              make(chan struct{}),
+		dynamicConfigDoneC: make(chan struct{}),
 	}
 }
 
@@ -81,6 +85,7 @@ func (s *server) Stop() {
 	if s.daemon == nil {
 		return
 	}
+	close(s.dynamicConfigDoneC)
 
 	select {
 	case <-s.doneC:
@@ -109,7 +114,11 @@ func (s *server) startService() common.Daemon {
 		log.Fatalf("error creating ringpop factory: %v", err)
 	}
 
-	params.DynamicConfig = dynamicconfig.NewNopClient()
+	params.DynamicConfig, err = dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfigClient, params.Logger, s.dynamicConfigDoneC)
+	if err != nil {
+		log.Printf("error creating file based dynamic config client, use no-op config client instead. error: %v", err)
+		params.DynamicConfig = dynamicconfig.NewNopClient()
+	}
 	dc := dynamicconfig.NewCollection(params.DynamicConfig, params.Logger)
 
 	svcCfg := s.cfg.Services[s.name]
@@ -126,6 +135,7 @@ func (s *server) startService() common.Daemon {
 		s.cfg.ClustersInfo.CurrentClusterName,
 		s.cfg.ClustersInfo.ClusterInitialFailoverVersions,
 		s.cfg.ClustersInfo.ClusterAddress,
+		s.cfg.ClustersInfo.ReplicationMode,
 		enableArchival,
 		s.cfg.Archival.Filestore.DefaultBucket.Name,
 	)
@@ -149,6 +159,22 @@ func (s *server) startService() common.Daemon {
 		}
 	}
 
+	if s.cfg.Search != nil {
+		params.SearchClient, err = httpjson.NewClient(s.cfg.Search)
+		if err != nil {
+			log.Fatalf("error creating search client: %v", err)
+		}
+	}
+
+	if s.cfg.Authorization != nil {
+		params.Authorizer, err = authorization.NewRoleAuthorizer(s.cfg.Authorization)
+		if err != nil {
+			log.Fatalf("error creating authorizer: %v", err)
+		}
+	} else {
+		params.Authorizer = authorization.NewNopAuthorizer()
+	}
+
 	params.Logger.Info("Starting service " + s.name)
 
 	var daemon common.Daemon
@@ -0,0 +1,103 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common"
+)
+
+const (
+	// PermissionRead is required by the APIs which only read the state of a domain
+	PermissionRead Permission = iota + 1
+	// PermissionWrite is required by the APIs which change the workflows of a domain
+	PermissionWrite
+	// PermissionAdmin is required by the APIs which change a domain itself or the cluster
+	PermissionAdmin
+)
+
+const (
+	// DecisionDeny means the call is rejected
+	DecisionDeny Decision = iota
+	// DecisionAllow means the call is allowed
+	DecisionAllow
+)
+
+type (
+	// Permission is the level of access an API requires on a domain
+	Permission int
+
+	// Decision is the result of authorizing a call
+	Decision int
+
+	// Attributes describes the call to be authorized
+	Attributes struct {
+		// Actor is the identity of the caller, empty if the caller did not present a client certificate
+		Actor string
+		// APIName is the name of the API which is called
+		APIName string
+		// DomainName is the domain the API acts on, empty for the APIs which are not scoped to a domain
+		DomainName string
+		// Permission is the permission the API requires on the domain
+		Permission Permission
+		// Request is the request of the call
+		Request interface{}
+	}
+
+	// Authorizer decides whether the caller is allowed to call an API
+	Authorizer interface {
+		Authorize(ctx context.Context, attributes *Attributes) (Decision, error)
+	}
+)
+
+// GetCallerIdentity returns the identity of the caller of the inbound call, which is the common name of the
+// verified client certificate of its TLS connection. The identity header is only trusted on the calls of the
+// internal services, which make calls on behalf of their own callers. The identity is empty if the connection
+// did not present a client certificate.
+func GetCallerIdentity(ctx context.Context) string {
+	p, ok := getPeer(ctx)
+	if !ok {
+		return ""
+	}
+	if p.internal {
+		if identity := yarpc.CallFromContext(ctx).Header(common.CallerIdentityHeaderName); identity != "" {
+			return identity
+		}
+	}
+	return p.identity
+}
+
+// String returns the name of the permission
+func (p Permission) String() string {
+	switch p {
+	case PermissionRead:
+		return "read"
+	case PermissionWrite:
+		return "write"
+	case PermissionAdmin:
+		return "admin"
+	default:
+		return "unknown"
+	}
+}
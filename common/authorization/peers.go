@@ -0,0 +1,111 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uber/tchannel-go"
+)
+
+type (
+	// peer is the identity in the verified client certificate of an inbound connection
+	peer struct {
+		identity string
+		internal bool
+	}
+
+	// peerRegistry keeps the peers of the open inbound connections by the tchannel host port
+	// of the remote end, which is the address of the connection for the ephemeral peers
+	peerRegistry struct {
+		sync.RWMutex
+		peers map[string]map[peer]int
+	}
+)
+
+var (
+	peers = &peerRegistry{peers: make(map[string]map[peer]int)}
+
+	// getRemoteHostPort returns the tchannel host port of the peer which made the inbound call
+	getRemoteHostPort = func(ctx context.Context) string {
+		call := tchannel.CurrentCall(ctx)
+		if call == nil {
+			return ""
+		}
+		return call.RemotePeer().HostPort
+	}
+)
+
+// AddPeer records the identity in the verified client certificate of an inbound connection from
+// the tchannel host port, internal is true if the peer is a cadence service. It returns the
+// function which removes the peer once the connection is closed.
+func AddPeer(hostPort string, identity string, internal bool) func() {
+	p := peer{identity: identity, internal: internal}
+	peers.add(hostPort, p)
+	var once sync.Once
+	return func() {
+		once.Do(func() { peers.remove(hostPort, p) })
+	}
+}
+
+// getPeer returns the peer which made the inbound call, false if the connection of the call did not present
+// a verified client certificate or if connections with different certificates share its host port
+func getPeer(ctx context.Context) (peer, bool) {
+	hostPort := getRemoteHostPort(ctx)
+	if hostPort == "" {
+		return peer{}, false
+	}
+	return peers.get(hostPort)
+}
+
+func (r *peerRegistry) add(hostPort string, p peer) {
+	r.Lock()
+	defer r.Unlock()
+	if _, ok := r.peers[hostPort]; !ok {
+		r.peers[hostPort] = make(map[peer]int)
+	}
+	r.peers[hostPort][p]++
+}
+
+func (r *peerRegistry) remove(hostPort string, p peer) {
+	r.Lock()
+	defer r.Unlock()
+	r.peers[hostPort][p]--
+	if r.peers[hostPort][p] <= 0 {
+		delete(r.peers[hostPort], p)
+	}
+	if len(r.peers[hostPort]) == 0 {
+		delete(r.peers, hostPort)
+	}
+}
+
+func (r *peerRegistry) get(hostPort string) (peer, bool) {
+	r.RLock()
+	defer r.RUnlock()
+	if len(r.peers[hostPort]) != 1 {
+		return peer{}, false
+	}
+	for p := range r.peers[hostPort] {
+		return p, true
+	}
+	return peer{}, false
+}
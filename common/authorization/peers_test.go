@@ -0,0 +1,121 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+
+	"github.com/uber/cadence/common"
+)
+
+type (
+	peersSuite struct {
+		suite.Suite
+		*require.Assertions
+		hostPort          string
+		getRemoteHostPort func(ctx context.Context) string
+	}
+)
+
+func TestPeersSuite(t *testing.T) {
+	suite.Run(t, new(peersSuite))
+}
+
+func (s *peersSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.hostPort = "10.0.0.1:52011"
+	s.getRemoteHostPort = getRemoteHostPort
+	getRemoteHostPort = func(ctx context.Context) string { return s.hostPort }
+}
+
+func (s *peersSuite) TearDownTest() {
+	getRemoteHostPort = s.getRemoteHostPort
+}
+
+func (s *peersSuite) TestNoClientCertificate() {
+	s.Equal("", GetCallerIdentity(s.newContext("")))
+	s.Equal("", GetCallerIdentity(s.newContext("ops")))
+}
+
+func (s *peersSuite) TestClientCertificate() {
+	remove := AddPeer(s.hostPort, "alice", false)
+	s.Equal("alice", GetCallerIdentity(s.newContext("")))
+
+	remove()
+	s.Equal("", GetCallerIdentity(s.newContext("")))
+}
+
+func (s *peersSuite) TestSpoofedIdentityHeader() {
+	remove := AddPeer(s.hostPort, "alice", false)
+	defer remove()
+
+	s.Equal("alice", GetCallerIdentity(s.newContext("ops")))
+}
+
+func (s *peersSuite) TestInternalServiceIdentityHeader() {
+	remove := AddPeer(s.hostPort, "cadence-worker", true)
+	defer remove()
+
+	s.Equal("ops", GetCallerIdentity(s.newContext("ops")))
+	s.Equal("cadence-worker", GetCallerIdentity(s.newContext("")))
+}
+
+func (s *peersSuite) TestAmbiguousHostPort() {
+	removeInternal := AddPeer(s.hostPort, "cadence-worker", true)
+	defer removeInternal()
+	removeExternal := AddPeer(s.hostPort, "alice", false)
+
+	s.Equal("", GetCallerIdentity(s.newContext("ops")))
+
+	removeExternal()
+	removeExternal()
+	s.Equal("ops", GetCallerIdentity(s.newContext("ops")))
+}
+
+func (s *peersSuite) TestMultipleConnections() {
+	removeFirst := AddPeer(s.hostPort, "alice", false)
+	removeSecond := AddPeer(s.hostPort, "alice", false)
+	defer removeSecond()
+
+	removeFirst()
+	s.Equal("alice", GetCallerIdentity(s.newContext("")))
+}
+
+func (s *peersSuite) newContext(identityHeader string) context.Context {
+	ctx, call := encoding.NewInboundCall(context.Background())
+	headers := transport.NewHeaders()
+	if identityHeader != "" {
+		headers = headers.With(common.CallerIdentityHeaderName, identityHeader)
+	}
+	s.NoError(call.ReadFromRequest(&transport.Request{
+		Caller:    "cadence-cli",
+		Service:   "cadence-frontend",
+		Procedure: "WorkflowService::StartWorkflowExecution",
+		Headers:   headers,
+	}))
+	return ctx
+}
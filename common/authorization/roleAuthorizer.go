@@ -0,0 +1,109 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	// RoleRead allows the APIs which require PermissionRead
+	RoleRead Role = "read"
+	// RoleWrite allows the APIs which require PermissionWrite or PermissionRead
+	RoleWrite Role = "write"
+	// RoleAdmin allows all APIs
+	RoleAdmin Role = "admin"
+
+	// Wildcard matches all callers when used as an identity and all domains when used as a domain
+	Wildcard = "*"
+)
+
+type (
+	// Role is the set of permissions a caller has on a domain
+	Role string
+
+	// Config is the config of the role based authorizer
+	Config struct {
+		// Roles maps the identity of a caller to its role in each domain. The role in the wildcard
+		// domain applies to the domains without a role of their own and to the APIs which are
+		// not scoped to a domain, the wildcard identity applies to all callers
+		Roles map[string]map[string]Role `yaml:"roles"`
+	}
+
+	roleAuthorizer struct {
+		roles map[string]map[string]Role
+	}
+)
+
+// Validate validates the authorization config
+func (c *Config) Validate() error {
+	for identity, domains := range c.Roles {
+		for domain, role := range domains {
+			if role.permission() == 0 {
+				return fmt.Errorf("authorization: unknown role %v of %v in domain %v", role, identity, domain)
+			}
+		}
+	}
+	return nil
+}
+
+// NewRoleAuthorizer creates an authorizer which allows a call if the role of the caller
+// in the domain grants the permission required by the API
+func NewRoleAuthorizer(config *Config) (Authorizer, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &roleAuthorizer{roles: config.Roles}, nil
+}
+
+func (a *roleAuthorizer) Authorize(ctx context.Context, attributes *Attributes) (Decision, error) {
+	for _, identity := range []string{attributes.Actor, Wildcard} {
+		if a.getRole(identity, attributes.DomainName).permission() >= attributes.Permission {
+			return DecisionAllow, nil
+		}
+	}
+	return DecisionDeny, nil
+}
+
+func (a *roleAuthorizer) getRole(identity string, domain string) Role {
+	domains, ok := a.roles[identity]
+	if !ok {
+		return ""
+	}
+	if role, ok := domains[domain]; ok && domain != "" {
+		return role
+	}
+	return domains[Wildcard]
+}
+
+func (r Role) permission() Permission {
+	switch r {
+	case RoleRead:
+		return PermissionRead
+	case RoleWrite:
+		return PermissionWrite
+	case RoleAdmin:
+		return PermissionAdmin
+	default:
+		return 0
+	}
+}
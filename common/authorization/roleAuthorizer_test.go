@@ -0,0 +1,123 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	roleAuthorizerSuite struct {
+		suite.Suite
+		*require.Assertions
+		authorizer Authorizer
+	}
+)
+
+func TestRoleAuthorizerSuite(t *testing.T) {
+	suite.Run(t, new(roleAuthorizerSuite))
+}
+
+func (s *roleAuthorizerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	var err error
+	s.authorizer, err = NewRoleAuthorizer(&Config{
+		Roles: map[string]map[string]Role{
+			"ops": {
+				Wildcard: RoleAdmin,
+			},
+			"worker": {
+				"orders":  RoleWrite,
+				"billing": RoleRead,
+			},
+			"auditor": {
+				Wildcard: RoleRead,
+				"secret": RoleRead,
+			},
+			Wildcard: {
+				"public": RoleRead,
+			},
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *roleAuthorizerSuite) TestInvalidRole() {
+	_, err := NewRoleAuthorizer(&Config{
+		Roles: map[string]map[string]Role{
+			"worker": {"orders": "owner"},
+		},
+	})
+	s.Error(err)
+}
+
+func (s *roleAuthorizerSuite) TestAuthorize() {
+	testCases := []struct {
+		actor      string
+		domain     string
+		permission Permission
+		decision   Decision
+	}{
+		{"ops", "orders", PermissionAdmin, DecisionAllow},
+		{"ops", "", PermissionAdmin, DecisionAllow},
+		{"worker", "orders", PermissionWrite, DecisionAllow},
+		{"worker", "orders", PermissionRead, DecisionAllow},
+		{"worker", "orders", PermissionAdmin, DecisionDeny},
+		{"worker", "billing", PermissionRead, DecisionAllow},
+		{"worker", "billing", PermissionWrite, DecisionDeny},
+		{"worker", "shipping", PermissionRead, DecisionDeny},
+		{"worker", "", PermissionRead, DecisionDeny},
+		{"auditor", "shipping", PermissionRead, DecisionAllow},
+		{"auditor", "", PermissionRead, DecisionAllow},
+		{"auditor", "shipping", PermissionWrite, DecisionDeny},
+		{"worker", "public", PermissionRead, DecisionAllow},
+		{"", "public", PermissionRead, DecisionAllow},
+		{"", "public", PermissionWrite, DecisionDeny},
+		{"unknown", "orders", PermissionRead, DecisionDeny},
+	}
+
+	for _, tc := range testCases {
+		decision, err := s.authorizer.Authorize(context.Background(), &Attributes{
+			Actor:      tc.actor,
+			APIName:    "TestAPI",
+			DomainName: tc.domain,
+			Permission: tc.permission,
+		})
+		s.NoError(err)
+		s.Equal(tc.decision, decision, "actor: %v, domain: %v, permission: %v", tc.actor, tc.domain, tc.permission)
+	}
+}
+
+func (s *roleAuthorizerSuite) TestNopAuthorizer() {
+	decision, err := NewNopAuthorizer().Authorize(context.Background(), &Attributes{
+		Actor:      "unknown",
+		APIName:    "TestAPI",
+		DomainName: "orders",
+		Permission: PermissionAdmin,
+	})
+	s.NoError(err)
+	s.Equal(DecisionAllow, decision)
+}
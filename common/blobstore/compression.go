@@ -0,0 +1,98 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/golang/snappy"
+)
+
+var compressionTypeNames = map[CompressionType]string{
+	NoCompression:     "none",
+	GzipCompression:   "gzip",
+	SnappyCompression: "snappy",
+}
+
+// String returns the name of the compression type as used in configs
+func (c CompressionType) String() string {
+	if name, ok := compressionTypeNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", int(c))
+}
+
+// ParseCompressionType returns the compression type with the given name, an empty name means NoCompression
+func ParseCompressionType(name string) (CompressionType, error) {
+	if len(name) == 0 {
+		return NoCompression, nil
+	}
+	for compressionType, compressionName := range compressionTypeNames {
+		if compressionName == name {
+			return compressionType, nil
+		}
+	}
+	return NoCompression, fmt.Errorf("unknown compression type: %v", name)
+}
+
+// Compress returns the data compressed with the given compression type
+func Compress(compressionType CompressionType, data []byte) ([]byte, error) {
+	switch compressionType {
+	case NoCompression:
+		return data, nil
+	case GzipCompression:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case SnappyCompression:
+		return snappy.Encode(nil, data), nil
+	default:
+		return nil, fmt.Errorf("unknown compression type: %v", compressionType)
+	}
+}
+
+// Decompress returns the data decompressed with the given compression type
+func Decompress(compressionType CompressionType, data []byte) ([]byte, error) {
+	switch compressionType {
+	case NoCompression:
+		return data, nil
+	case GzipCompression:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return ioutil.ReadAll(r)
+	case SnappyCompression:
+		return snappy.Decode(nil, data)
+	default:
+		return nil, fmt.Errorf("unknown compression type: %v", compressionType)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type CompressionSuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestCompressionSuite(t *testing.T) {
+	suite.Run(t, new(CompressionSuite))
+}
+
+func (s *CompressionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *CompressionSuite) TestCompressDecompress() {
+	data := bytes.Repeat([]byte("repetitive history event "), 100)
+	for _, compressionType := range []CompressionType{NoCompression, GzipCompression, SnappyCompression} {
+		compressed, err := Compress(compressionType, data)
+		s.NoError(err)
+		if compressionType != NoCompression {
+			s.True(len(compressed) < len(data))
+		}
+
+		decompressed, err := Decompress(compressionType, compressed)
+		s.NoError(err)
+		s.Equal(data, decompressed)
+	}
+}
+
+func (s *CompressionSuite) TestUnknownCompressionType() {
+	_, err := Compress(CompressionType(-1), []byte("data"))
+	s.Error(err)
+	_, err = Decompress(CompressionType(-1), []byte("data"))
+	s.Error(err)
+}
+
+func (s *CompressionSuite) TestDecompressCorruptedData() {
+	_, err := Decompress(GzipCompression, []byte("data"))
+	s.Error(err)
+	_, err = Decompress(SnappyCompression, []byte("data"))
+	s.Error(err)
+}
+
+func (s *CompressionSuite) TestParseCompressionType() {
+	for _, compressionType := range []CompressionType{NoCompression, GzipCompression, SnappyCompression} {
+		parsed, err := ParseCompressionType(compressionType.String())
+		s.NoError(err)
+		s.Equal(compressionType, parsed)
+	}
+
+	parsed, err := ParseCompressionType("")
+	s.NoError(err)
+	s.Equal(NoCompression, parsed)
+
+	_, err = ParseCompressionType("zip")
+	s.Error(err)
+}
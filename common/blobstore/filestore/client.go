@@ -36,7 +36,8 @@ const (
 
 type client struct {
 	sync.Mutex
-	storeDirectory string
+	storeDirectory     string
+	defaultCompression map[string]blobstore.CompressionType
 }
 
 // NewClient returns a new Client backed by file system
@@ -50,8 +51,14 @@ func NewClient(cfg *Config) (blobstore.Client, error) {
 	if err := writeMetadataFiles(cfg); err != nil {
 		return nil, err
 	}
+	defaultCompression := make(map[string]blobstore.CompressionType)
+	for _, b := range append([]BucketConfig{cfg.DefaultBucket}, cfg.CustomBuckets...) {
+		// the compression types are already validated
+		defaultCompression[b.Name], _ = blobstore.ParseCompressionType(b.DefaultCompression)
+	}
 	return &client{
-		storeDirectory: cfg.StoreDirectory,
+		storeDirectory:     cfg.StoreDirectory,
+		defaultCompression: defaultCompression,
 	}, nil
 }
 
@@ -66,7 +73,15 @@ func (c *client) UploadBlob(_ context.Context, bucket string, filename string, b
 	if !exists {
 		return blobstore.ErrBucketNotExists
 	}
-	data, err := serializeBlob(blob)
+	compressionType := blob.CompressionType
+	if compressionType == blobstore.NoCompression {
+		compressionType = c.defaultCompression[bucket]
+	}
+	data, err := serializeBlob(&blobstore.Blob{
+		Body:            blob.Body,
+		Tags:            blob.Tags,
+		CompressionType: compressionType,
+	})
 	if err != nil {
 		return err
 	}
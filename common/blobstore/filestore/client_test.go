@@ -23,6 +23,7 @@ package filestore
 import (
 	"bytes"
 	"context"
+	"encoding/gob"
 	"fmt"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -210,6 +211,56 @@ func (s *ClientSuite) TestUploadDownloadBlobCustomBucket() {
 	s.assertBlobEquals(map[string]string{}, "blob body", downloadBlob)
 }
 
+func (s *ClientSuite) TestUploadDownloadBlobCompressed() {
+	dir, err := ioutil.TempDir("", "test.upload.download.blob.compressed")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	cfg := s.constructConfig(dir)
+	cfg.DefaultBucket.DefaultCompression = "gzip"
+	client, err := NewClient(cfg)
+	s.NoError(err)
+
+	blob := s.constructBlob("blob body", map[string]string{"key": "value"})
+	blobFilename := "blob.blob"
+	s.NoError(client.UploadBlob(context.Background(), defaultBucketName, blobFilename, blob))
+	downloadBlob, err := client.DownloadBlob(context.Background(), defaultBucketName, blobFilename)
+	s.NoError(err)
+	s.Equal(blobstore.GzipCompression, downloadBlob.CompressionType)
+	s.assertBodyEquals("blob body", downloadBlob)
+
+	blob = s.constructBlob("blob body", map[string]string{"key": "value"})
+	blob.CompressionType = blobstore.SnappyCompression
+	s.NoError(client.UploadBlob(context.Background(), defaultBucketName, blobFilename, blob))
+	downloadBlob, err = client.DownloadBlob(context.Background(), defaultBucketName, blobFilename)
+	s.NoError(err)
+	s.Equal(blobstore.SnappyCompression, downloadBlob.CompressionType)
+	s.assertBodyEquals("blob body", downloadBlob)
+}
+
+func (s *ClientSuite) TestDownloadBlobWrittenWithoutCompressionType() {
+	dir, err := ioutil.TempDir("", "test.download.blob.written.without.compression.type")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	client := s.constructClient(dir)
+
+	// blobs written before compression was supported have no compression type
+	var buf bytes.Buffer
+	s.NoError(gob.NewEncoder(&buf).Encode(struct {
+		Body []byte
+		Tags map[string]string
+	}{
+		Body: []byte("blob body"),
+		Tags: map[string]string{"key": "value"},
+	}))
+	blobFilename := "blob.blob"
+	s.NoError(writeFile(bucketItemPath(dir, defaultBucketName, blobFilename), buf.Bytes()))
+
+	downloadBlob, err := client.DownloadBlob(context.Background(), defaultBucketName, blobFilename)
+	s.NoError(err)
+	s.assertBlobEquals(map[string]string{"key": "value"}, "blob body", downloadBlob)
+}
+
 func (s *ClientSuite) TestBucketMetadataBucketNotExists() {
 	dir, err := ioutil.TempDir("", "test.bucket.metadata.bucket.not.exists")
 	s.NoError(err)
@@ -309,6 +360,10 @@ func (s *ClientSuite) constructConfig(storeDir string) *Config {
 func (s *ClientSuite) assertBlobEquals(expectedTags map[string]string, expectedBody string, actual *blobstore.Blob) {
 	s.Equal(blobstore.NoCompression, actual.CompressionType)
 	s.Equal(expectedTags, actual.Tags)
+	s.assertBodyEquals(expectedBody, actual)
+}
+
+func (s *ClientSuite) assertBodyEquals(expectedBody string, actual *blobstore.Blob) {
 	actualBody, err := ioutil.ReadAll(actual.Body)
 	s.NoError(err)
 	s.Equal(expectedBody, string(actualBody))
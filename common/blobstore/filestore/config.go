@@ -22,6 +22,8 @@ package filestore
 
 import (
 	"errors"
+
+	"github.com/uber/cadence/common/blobstore"
 )
 
 type (
@@ -37,6 +39,8 @@ type (
 		Name          string `yaml:"name"`
 		Owner         string `yaml:"owner"`
 		RetentionDays int    `yaml:"retentionDays"`
+		// DefaultCompression is the compression of blobs which are uploaded without one, either none, gzip or snappy
+		DefaultCompression string `yaml:"defaultCompression"`
 	}
 )
 
@@ -52,6 +56,9 @@ func (c *Config) Validate() error {
 		if b.RetentionDays < 0 {
 			return errors.New("negative retention days")
 		}
+		if _, err := blobstore.ParseCompressionType(b.DefaultCompression); err != nil {
+			return err
+		}
 		return nil
 	}
 
@@ -130,6 +130,35 @@ func (s *ConfigSuite) TestValidate() {
 			},
 			isValid: true,
 		},
+		{
+			config: &Config{
+				StoreDirectory: "test-store-directory",
+				DefaultBucket: BucketConfig{
+					Name:               "test-default-bucket-name",
+					Owner:              "test-default-bucket-owner",
+					DefaultCompression: "gzip",
+				},
+				CustomBuckets: []BucketConfig{
+					{
+						Name:               "test-custom-bucket-name",
+						Owner:              "test-custom-bucket-owner",
+						DefaultCompression: "snappy",
+					},
+				},
+			},
+			isValid: true,
+		},
+		{
+			config: &Config{
+				StoreDirectory: "test-store-directory",
+				DefaultBucket: BucketConfig{
+					Name:               "test-default-bucket-name",
+					Owner:              "test-default-bucket-owner",
+					DefaultCompression: "zip",
+				},
+			},
+			isValid: false,
+		},
 	}
 
 	for _, tc := range testCases {
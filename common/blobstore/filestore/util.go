@@ -106,6 +106,8 @@ func deserializeBucketConfig(data []byte) (*BucketConfig, error) {
 type serializedBlob struct {
 	Body []byte
 	Tags map[string]string
+	// CompressionType of the body, blobs written before compression was supported have none
+	CompressionType blobstore.CompressionType
 }
 
 func serializeBlob(blob *blobstore.Blob) ([]byte, error) {
@@ -115,9 +117,14 @@ func serializeBlob(blob *blobstore.Blob) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	body, err = blobstore.Compress(blob.CompressionType, body)
+	if err != nil {
+		return nil, err
+	}
 	serBlob := serializedBlob{
-		Body: body,
-		Tags: blob.Tags,
+		Body:            body,
+		Tags:            blob.Tags,
+		CompressionType: blob.CompressionType,
 	}
 	if err := encoder.Encode(serBlob); err != nil {
 		return nil, err
@@ -132,10 +139,14 @@ func deserializeBlob(data []byte) (*blobstore.Blob, error) {
 	if err := decoder.Decode(serBlob); err != nil {
 		return nil, err
 	}
+	body, err := blobstore.Decompress(serBlob.CompressionType, serBlob.Body)
+	if err != nil {
+		return nil, err
+	}
 
 	return &blobstore.Blob{
-		Body:            bytes.NewReader(serBlob.Body),
+		Body:            bytes.NewReader(body),
 		Tags:            serBlob.Tags,
-		CompressionType: blobstore.NoCompression,
+		CompressionType: serBlob.CompressionType,
 	}, nil
 }
@@ -32,6 +32,10 @@ type CompressionType int
 const (
 	// NoCompression indicates that blob is not compressed
 	NoCompression CompressionType = iota
+	// GzipCompression indicates that blob is compressed with gzip
+	GzipCompression
+	// SnappyCompression indicates that blob is compressed with snappy
+	SnappyCompression
 )
 
 var (
@@ -41,7 +45,9 @@ var (
 	ErrBucketNotExists = errors.New("requested bucket does not exist")
 )
 
-// Blob defines a blob
+// Blob defines a blob, the body is always uncompressed. When uploading, the blob is compressed
+// with its CompressionType or with the default compression of the bucket if it is NoCompression.
+// When downloading, the CompressionType is the one the blob was stored with.
 type Blob struct {
 	Body            io.Reader
 	CompressionType CompressionType
@@ -154,6 +154,14 @@ func NewDomainCacheEntryWithInfo(info *persistence.DomainInfo) *DomainCacheEntry
 	}
 }
 
+// NewDomainCacheEntryWithConfig returns an entry with domainInfo and domainConfig
+func NewDomainCacheEntryWithConfig(info *persistence.DomainInfo, config *persistence.DomainConfig) *DomainCacheEntry {
+	return &DomainCacheEntry{
+		info:   info,
+		config: config,
+	}
+}
+
 func (c *domainCache) GetCacheSize() (sizeOfCacheByName int64, sizeOfCacheByID int64) {
 	return int64(c.cacheByID.Load().(Cache).Size()), int64(c.cacheNameToID.Load().(Cache).Size())
 }
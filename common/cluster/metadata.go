@@ -49,6 +49,9 @@ type (
 		ClusterNameForFailoverVersion(failoverVersion int64) string
 		// GetAllClientAddress return the frontend address for each cluster name
 		GetAllClientAddress() map[string]config.Address
+		// IsPullReplicationEnabled whether the history and domain replication tasks are pulled by the other clusters,
+		// instead of being published to kafka
+		IsPullReplicationEnabled() bool
 
 		// IsArchivalEnabled whether archival is enabled
 		IsArchivalEnabled() bool
@@ -73,6 +76,8 @@ type (
 		initialFailoverVersionClusters map[int64]string
 		// clusterToAddress contains the cluster name to corresponding frontend client
 		clusterToAddress map[string]config.Address
+		// replicationMode is how the history replication tasks are shipped between clusters
+		replicationMode string
 
 		// enableArchival whether archival is enabled
 		enableArchival dynamicconfig.BoolPropertyFn
@@ -89,6 +94,7 @@ func NewMetadata(
 	currentClusterName string,
 	clusterInitialFailoverVersions map[string]int64,
 	clusterToAddress map[string]config.Address,
+	replicationMode string,
 	enableArchival dynamicconfig.BoolPropertyFn,
 	defaultArchivalBucket string,
 ) Metadata {
@@ -127,6 +133,13 @@ func NewMetadata(
 	if len(initialFailoverVersionClusters) != len(clusterToAddress) {
 		panic("Cluster to address size is different than Cluster to initial failover versions")
 	}
+	switch replicationMode {
+	case "":
+		replicationMode = config.ReplicationModeKafka
+	case config.ReplicationModeKafka, config.ReplicationModePull:
+	default:
+		panic(fmt.Sprintf("Unknown replication mode: %v.", replicationMode))
+	}
 
 	defaultArchivalBucketSet := len(defaultArchivalBucket) != 0
 	if enableArchival() && !defaultArchivalBucketSet {
@@ -143,6 +156,7 @@ func NewMetadata(
 		clusterInitialFailoverVersions: clusterInitialFailoverVersions,
 		initialFailoverVersionClusters: initialFailoverVersionClusters,
 		clusterToAddress:               clusterToAddress,
+		replicationMode:                replicationMode,
 		enableArchival:                 enableArchival,
 		defaultArchivalBucket:          defaultArchivalBucket,
 	}
@@ -215,6 +229,12 @@ func (metadata *metadataImpl) GetAllClientAddress() map[string]config.Address {
 	return metadata.clusterToAddress
 }
 
+// IsPullReplicationEnabled whether the history and domain replication tasks are pulled by the other clusters,
+// instead of being published to kafka
+func (metadata *metadataImpl) IsPullReplicationEnabled() bool {
+	return metadata.replicationMode == config.ReplicationModePull
+}
+
 // IsArchivalEnabled whether archival is enabled
 func (metadata *metadataImpl) IsArchivalEnabled() bool {
 	return metadata.enableArchival()
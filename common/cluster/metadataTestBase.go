@@ -84,6 +84,7 @@ func GetTestClusterMetadata(enableGlobalDomain bool, isMasterCluster bool) Metad
 			TestCurrentClusterName,
 			TestAllClusterFailoverVersions,
 			TestAllClusterAddress,
+			config.ReplicationModeKafka,
 			dynamicconfig.GetBoolPropertyFn(false),
 			"",
 		)
@@ -96,6 +97,7 @@ func GetTestClusterMetadata(enableGlobalDomain bool, isMasterCluster bool) Metad
 		TestCurrentClusterName,
 		TestSingleDCAllClusterFailoverVersions,
 		TestSingleDCAllClusterAddress,
+		config.ReplicationModeKafka,
 		dynamicconfig.GetBoolPropertyFn(false),
 		"",
 	)
@@ -73,3 +73,7 @@ const (
 	// GetHistoryMaxPageSize is the max page size for get history
 	GetHistoryMaxPageSize = 1000
 )
+
+// ReservedTaskListPrefix is the prefix of task list names reserved for internal use, such as the
+// names of task list partitions
+const ReservedTaskListPrefix = "/__cadence_sys/"
@@ -0,0 +1,123 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package definition
+
+import (
+	"fmt"
+	"strings"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+// valid indexed fields on the visibility store
+const (
+	WorkflowID    = "WorkflowID"
+	RunID         = "RunID"
+	WorkflowType  = "WorkflowType"
+	StartTime     = "StartTime"
+	CloseTime     = "CloseTime"
+	CloseStatus   = "CloseStatus"
+	HistoryLength = "HistoryLength"
+
+	CustomStringField   = "CustomStringField"
+	CustomKeywordField  = "CustomKeywordField"
+	CustomIntField      = "CustomIntField"
+	CustomDoubleField   = "CustomDoubleField"
+	CustomBoolField     = "CustomBoolField"
+	CustomDatetimeField = "CustomDatetimeField"
+)
+
+// systemIndexedKeys are the attributes every execution has, they can be used in queries
+// but can not be set as custom search attributes
+var systemIndexedKeys = map[string]workflow.IndexedValueType{
+	WorkflowID:    workflow.IndexedValueTypeKeyword,
+	RunID:         workflow.IndexedValueTypeKeyword,
+	WorkflowType:  workflow.IndexedValueTypeKeyword,
+	StartTime:     workflow.IndexedValueTypeInt,
+	CloseTime:     workflow.IndexedValueTypeInt,
+	CloseStatus:   workflow.IndexedValueTypeInt,
+	HistoryLength: workflow.IndexedValueTypeInt,
+}
+
+// defaultIndexedKeys are the custom search attributes available when the cluster does not
+// configure its own whitelist through dynamic config
+var defaultIndexedKeys = map[string]interface{}{
+	CustomStringField:   workflow.IndexedValueTypeString,
+	CustomKeywordField:  workflow.IndexedValueTypeKeyword,
+	CustomIntField:      workflow.IndexedValueTypeInt,
+	CustomDoubleField:   workflow.IndexedValueTypeDouble,
+	CustomBoolField:     workflow.IndexedValueTypeBool,
+	CustomDatetimeField: workflow.IndexedValueTypeDatetime,
+}
+
+// IsSystemIndexedKey returns true if the key is a system indexed key
+func IsSystemIndexedKey(key string) bool {
+	_, ok := systemIndexedKeys[key]
+	return ok
+}
+
+// GetDefaultIndexedKeys returns the default valid search attributes, used as the default
+// value of the system.validSearchAttributes dynamic config
+func GetDefaultIndexedKeys() map[string]interface{} {
+	return defaultIndexedKeys
+}
+
+// GetIndexedKeys returns the system indexed keys together with the given custom search
+// attributes, converted to their IndexedValueType
+func GetIndexedKeys(validSearchAttributes map[string]interface{}) (map[string]workflow.IndexedValueType, error) {
+	result := make(map[string]workflow.IndexedValueType, len(systemIndexedKeys)+len(validSearchAttributes))
+	for key, valueType := range validSearchAttributes {
+		t, err := ConvertIndexedValueType(valueType)
+		if err != nil {
+			return nil, fmt.Errorf("invalid type for search attribute %v: %v", key, err)
+		}
+		result[key] = t
+	}
+	for key, valueType := range systemIndexedKeys {
+		result[key] = valueType
+	}
+	return result, nil
+}
+
+// ConvertIndexedValueType converts a value read from dynamic config, which can be either
+// the enum value or its name, to IndexedValueType
+func ConvertIndexedValueType(valueType interface{}) (workflow.IndexedValueType, error) {
+	var result workflow.IndexedValueType
+	switch t := valueType.(type) {
+	case workflow.IndexedValueType:
+		result = t
+	case int:
+		result = workflow.IndexedValueType(t)
+	case float64:
+		result = workflow.IndexedValueType(t)
+	case string:
+		if err := result.UnmarshalText([]byte(strings.ToUpper(t))); err != nil {
+			return result, fmt.Errorf("unknown indexed value type %v", t)
+		}
+	default:
+		return result, fmt.Errorf("unknown indexed value type %v", valueType)
+	}
+
+	if result < workflow.IndexedValueTypeString || result > workflow.IndexedValueTypeDatetime {
+		return result, fmt.Errorf("unknown indexed value type %v", valueType)
+	}
+	return result, nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package definition
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+func TestConvertIndexedValueType(t *testing.T) {
+	for _, input := range []interface{}{workflow.IndexedValueTypeInt, 2, 2.0, "INT", "int", "2"} {
+		result, err := ConvertIndexedValueType(input)
+		assert.NoError(t, err)
+		assert.Equal(t, workflow.IndexedValueTypeInt, result)
+	}
+
+	for _, input := range []interface{}{-1, 6, "unknown", "7", true, nil} {
+		_, err := ConvertIndexedValueType(input)
+		assert.Error(t, err)
+	}
+}
+
+func TestGetIndexedKeys(t *testing.T) {
+	keys, err := GetIndexedKeys(GetDefaultIndexedKeys())
+	assert.NoError(t, err)
+	assert.Equal(t, workflow.IndexedValueTypeKeyword, keys[CustomKeywordField])
+	assert.Equal(t, workflow.IndexedValueTypeDatetime, keys[CustomDatetimeField])
+	assert.Equal(t, workflow.IndexedValueTypeInt, keys[StartTime])
+	assert.Equal(t, len(systemIndexedKeys)+len(defaultIndexedKeys), len(keys))
+
+	// system keys can not be redefined
+	keys, err = GetIndexedKeys(map[string]interface{}{WorkflowID: "INT", "CustomerID": "KEYWORD"})
+	assert.NoError(t, err)
+	assert.Equal(t, workflow.IndexedValueTypeKeyword, keys[WorkflowID])
+	assert.Equal(t, workflow.IndexedValueTypeKeyword, keys["CustomerID"])
+
+	_, err = GetIndexedKeys(map[string]interface{}{"CustomerID": "OBJECT"})
+	assert.Error(t, err)
+}
+
+func TestIsSystemIndexedKey(t *testing.T) {
+	assert.True(t, IsSystemIndexedKey(CloseStatus))
+	assert.False(t, IsSystemIndexedKey(CustomIntField))
+}
@@ -92,7 +92,12 @@ const (
 	TagValueMatchingEngineComponent           = "matching-engine"
 	TagValueReplicatorComponent               = "replicator"
 	TagValueReplicationTaskProcessorComponent = "replication-task-processor"
+	TagValueReplicationTaskPullerComponent    = "replication-task-puller"
+	TagValueDomainReplicationPullerComponent  = "domain-replication-puller"
+	TagValueReplicationDLQComponent           = "replication-dlq"
 	TagValueHistoryReplicatorComponent        = "history-replicator"
+	TagValueIndexerComponent                  = "indexer"
+	TagValueReplicationVerifierComponent      = "replication-verifier"
 
 	// TagHistoryBuilderAction values
 	TagValueActionWorkflowStarted                 = "add-workflowexecution-started-event"
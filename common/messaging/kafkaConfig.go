@@ -53,6 +53,11 @@ type (
 // VisibilityTopicName for visibility data to kafka
 const VisibilityTopicName = "visibility-topic"
 
+// VisibilityTopicsKey is the key of the topics consumed by the visibility indexer in cadence-cluster-topics.
+// It is passed as both the current and the source cluster to NewConsumer, the topic of the entry has to be
+// the visibility topic and its dlq topic receives the messages which could not be indexed.
+const VisibilityTopicsKey = "visibility"
+
 // Validate will validate config for kafka
 func (k *KafkaConfig) Validate(checkCluster bool) {
 	if len(k.Clusters) == 0 {
@@ -85,6 +90,10 @@ func (k *KafkaConfig) Validate(checkCluster bool) {
 		}
 	} else {
 		validateTopicsFn(VisibilityTopicName)
+		if topics, ok := k.ClusterToTopic[VisibilityTopicsKey]; ok {
+			validateTopicsFn(topics.Topic)
+			validateTopicsFn(topics.DLQTopic)
+		}
 	}
 }
 
@@ -154,15 +154,16 @@ func (p *kafkaProducer) getProducerMessage(message interface{}) (*sarama.Produce
 			Value: sarama.ByteEncoder(payload),
 		}
 		return msg, nil
-	case *OpenWorkflowMsg:
-		openRecord := message.(*OpenWorkflowMsg)
-		payload, err := p.gobEncoder.Encode(openRecord)
+	case *VisibilityMsg:
+		visibilityRecord := message.(*VisibilityMsg)
+		payload, err := p.gobEncoder.Encode(visibilityRecord)
 		if err != nil {
 			return nil, err
 		}
+		// Use workflowID as the partition key so all messages of a workflow are published to the same partition
 		msg := &sarama.ProducerMessage{
 			Topic: VisibilityTopicName,
-			Key:   sarama.StringEncoder(openRecord.WorkflowID),
+			Key:   sarama.StringEncoder(visibilityRecord.WorkflowID),
 			Value: sarama.ByteEncoder(payload),
 		}
 		return msg, nil
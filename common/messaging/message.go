@@ -20,10 +20,34 @@
 
 package messaging
 
-// OpenWorkflowMsg is visibility data for open workflow
-type OpenWorkflowMsg struct {
-	Domain     string
-	WorkflowID string
-	RunID      string
-	StartTime  int64
+// VisibilityMsgType is the type of a visibility message
+type VisibilityMsgType int
+
+const (
+	// VisibilityMsgTypeOpen is published when a workflow execution is started, it is the zero value so
+	// that messages published before the type was added are decoded as open records
+	VisibilityMsgTypeOpen VisibilityMsgType = iota
+	// VisibilityMsgTypeUpsert is published when the search attributes of an open workflow execution change
+	VisibilityMsgTypeUpsert
+	// VisibilityMsgTypeClosed is published when a workflow execution is closed
+	VisibilityMsgTypeClosed
+)
+
+// VisibilityMsg is visibility data for a workflow execution, published to the visibility topic.
+// Messages of a workflow execution are ordered by their Version, a message is stale if a message
+// with a higher Version was already indexed.
+type VisibilityMsg struct {
+	MsgType          VisibilityMsgType
+	DomainID         string
+	Domain           string
+	WorkflowID       string
+	RunID            string
+	WorkflowTypeName string
+	StartTime        int64
+	CloseTime        int64
+	CloseStatus      int32
+	HistoryLength    int64
+	Memo             map[string][]byte
+	SearchAttributes map[string][]byte
+	Version          int64
 }
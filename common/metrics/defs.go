@@ -69,6 +69,9 @@ const (
 	ShardTagName       = "shard"
 	CadenceRoleTagName = "cadence-role"
 	StatsTypeTagName   = "stats-type"
+	DomainTagName      = "domain"
+	// SourceClusterTagName is the cluster replication tasks are received from
+	SourceClusterTagName = "source-cluster"
 )
 
 // This package should hold all the metrics and tags for cadence
@@ -136,10 +139,18 @@ const (
 	PersistenceUpdateWorkflowExecutionScope
 	// PersistenceResetMutableStateScope tracks ResetMutableState calls made by service to persistence layer
 	PersistenceResetMutableStateScope
+	// PersistenceResetWorkflowExecutionScope tracks ResetWorkflowExecution calls made by service to persistence layer
+	PersistenceResetWorkflowExecutionScope
 	// PersistenceDeleteWorkflowExecutionScope tracks DeleteWorkflowExecution calls made by service to persistence layer
 	PersistenceDeleteWorkflowExecutionScope
+	// PersistenceDeleteCurrentWorkflowExecutionScope tracks DeleteCurrentWorkflowExecution calls made by service to persistence layer
+	PersistenceDeleteCurrentWorkflowExecutionScope
 	// PersistenceGetCurrentExecutionScope tracks GetCurrentExecution calls made by service to persistence layer
 	PersistenceGetCurrentExecutionScope
+	// PersistenceListConcreteExecutionsScope tracks ListConcreteExecutions calls made by service to persistence layer
+	PersistenceListConcreteExecutionsScope
+	// PersistenceListCurrentExecutionsScope tracks ListCurrentExecutions calls made by service to persistence layer
+	PersistenceListCurrentExecutionsScope
 	// PersistenceGetTransferTasksScope tracks GetTransferTasks calls made by service to persistence layer
 	PersistenceGetTransferTasksScope
 	// PersistenceGetReplicationTasksScope tracks GetReplicationTasks calls made by service to persistence layer
@@ -150,6 +161,14 @@ const (
 	PersistenceRangeCompleteTransferTaskScope
 	// PersistenceCompleteReplicationTaskScope tracks CompleteReplicationTasks calls made by service to persistence layer
 	PersistenceCompleteReplicationTaskScope
+	// PersistencePutReplicationTaskToDLQScope tracks PutReplicationTaskToDLQ calls made by service to persistence layer
+	PersistencePutReplicationTaskToDLQScope
+	// PersistenceGetReplicationTasksFromDLQScope tracks GetReplicationTasksFromDLQ calls made by service to persistence layer
+	PersistenceGetReplicationTasksFromDLQScope
+	// PersistenceDeleteReplicationTaskFromDLQScope tracks DeleteReplicationTaskFromDLQ calls made by service to persistence layer
+	PersistenceDeleteReplicationTaskFromDLQScope
+	// PersistenceGetReplicationDLQSizeScope tracks GetReplicationDLQSize calls made by service to persistence layer
+	PersistenceGetReplicationDLQSizeScope
 	// PersistenceGetTimerIndexTasksScope tracks GetTimerIndexTasks calls made by service to persistence layer
 	PersistenceGetTimerIndexTasksScope
 	// PersistenceCompleteTimerTaskScope tracks CompleteTimerTasks calls made by service to persistence layer
@@ -166,6 +185,12 @@ const (
 	PersistenceLeaseTaskListScope
 	// PersistenceUpdateTaskListScope tracks PersistenceUpdateTaskListScope calls made by service to persistence layer
 	PersistenceUpdateTaskListScope
+	// PersistenceListTaskListScope tracks ListTaskList calls made by service to persistence layer
+	PersistenceListTaskListScope
+	// PersistenceDeleteTaskListScope tracks DeleteTaskList calls made by service to persistence layer
+	PersistenceDeleteTaskListScope
+	// PersistenceCompleteTasksLessThanScope tracks CompleteTasksLessThan calls made by service to persistence layer
+	PersistenceCompleteTasksLessThanScope
 	// PersistenceAppendHistoryEventsScope tracks AppendHistoryEvents calls made by service to persistence layer
 	PersistenceAppendHistoryEventsScope
 	// PersistenceGetWorkflowExecutionHistoryScope tracks GetWorkflowExecutionHistory calls made by service to persistence layer
@@ -206,6 +231,12 @@ const (
 	PersistenceListClosedWorkflowExecutionsByStatusScope
 	// PersistenceGetClosedWorkflowExecutionScope tracks GetClosedWorkflowExecution calls made by service to persistence layer
 	PersistenceGetClosedWorkflowExecutionScope
+	// PersistenceUpsertWorkflowExecutionScope tracks UpsertWorkflowExecution calls made by service to persistence layer
+	PersistenceUpsertWorkflowExecutionScope
+	// PersistenceListWorkflowExecutionsScope tracks ListWorkflowExecutions calls made by service to persistence layer
+	PersistenceListWorkflowExecutionsScope
+	// PersistenceVisibilityDeleteWorkflowExecutionScope tracks the visibility DeleteWorkflowExecution calls made by service to persistence layer
+	PersistenceVisibilityDeleteWorkflowExecutionScope
 	// HistoryClientStartWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientStartWorkflowExecutionScope
 	// HistoryClientRecordActivityTaskHeartbeatScope tracks RPC calls to history service
@@ -240,6 +271,10 @@ const (
 	HistoryClientRemoveSignalMutableStateScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope
+	// HistoryClientResetWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientResetWorkflowExecutionScope
+	// HistoryClientTriggerCronWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientTriggerCronWorkflowExecutionScope
 	// HistoryClientScheduleDecisionTaskScope tracks RPC calls to history service
 	HistoryClientScheduleDecisionTaskScope
 	// HistoryClientRecordChildExecutionCompletedScope tracks RPC calls to history service
@@ -252,6 +287,14 @@ const (
 	HistoryClientSyncShardStatusScope
 	// HistoryClientSyncActivityScope tracks RPC calls to history service
 	HistoryClientSyncActivityScope
+	// HistoryClientGetReplicationMessagesScope tracks RPC calls to history service
+	HistoryClientGetReplicationMessagesScope
+	// HistoryClientReadDLQMessagesScope tracks RPC calls to history service
+	HistoryClientReadDLQMessagesScope
+	// HistoryClientMergeDLQMessagesScope tracks RPC calls to history service
+	HistoryClientMergeDLQMessagesScope
+	// HistoryClientPurgeDLQMessagesScope tracks RPC calls to history service
+	HistoryClientPurgeDLQMessagesScope
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
 	// MatchingClientPollForActivityTaskScope tracks RPC calls to matching service
@@ -280,6 +323,10 @@ const (
 	FrontendClientGetWorkflowExecutionHistoryScope
 	// FrontendClientListClosedWorkflowExecutionsScope tracks RPC calls to frontend service
 	FrontendClientListClosedWorkflowExecutionsScope
+	// FrontendClientListWorkflowExecutionsScope tracks RPC calls to frontend service
+	FrontendClientListWorkflowExecutionsScope
+	// FrontendClientGetSearchAttributesScope tracks RPC calls to frontend service
+	FrontendClientGetSearchAttributesScope
 	// FrontendClientListDomainsScope tracks RPC calls to frontend service
 	FrontendClientListDomainsScope
 	// FrontendClientListOpenWorkflowExecutionsScope tracks RPC calls to frontend service
@@ -326,6 +373,10 @@ const (
 	FrontendClientStartWorkflowExecutionScope
 	// FrontendClientTerminateWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientTerminateWorkflowExecutionScope
+	// FrontendClientResetWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientResetWorkflowExecutionScope
+	// FrontendClientTriggerCronWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientTriggerCronWorkflowExecutionScope
 	// FrontendClientUpdateDomainScope tracks RPC calls to frontend service
 	FrontendClientUpdateDomainScope
 	// AdminClientDescribeHistoryHostScope tracks RPC calls to admin service
@@ -334,6 +385,28 @@ const (
 	AdminClientDescribeWorkflowExecutionScope
 	// AdminClientGetWorkflowExecutionRawHistoryScope tracks RPC calls to admin service
 	AdminClientGetWorkflowExecutionRawHistoryScope
+	// AdminClientReadHistoryBranchScope tracks RPC calls to admin service
+	AdminClientReadHistoryBranchScope
+	// AdminClientDeleteWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientDeleteWorkflowExecutionScope
+	// AdminClientGetDomainIDOrNameScope tracks RPC calls to admin service
+	AdminClientGetDomainIDOrNameScope
+	// AdminClientDeleteDomainScope tracks RPC calls to admin service
+	AdminClientDeleteDomainScope
+	// AdminClientStartBatchScope tracks RPC calls to admin service
+	AdminClientStartBatchScope
+	// AdminClientGetReplicationMessagesScope tracks RPC calls to admin service
+	AdminClientGetReplicationMessagesScope
+	// AdminClientGetDomainReplicationMessagesScope tracks RPC calls to admin service
+	AdminClientGetDomainReplicationMessagesScope
+	// AdminClientReadDLQMessagesScope tracks RPC calls to admin service
+	AdminClientReadDLQMessagesScope
+	// AdminClientMergeDLQMessagesScope tracks RPC calls to admin service
+	AdminClientMergeDLQMessagesScope
+	// AdminClientPurgeDLQMessagesScope tracks RPC calls to admin service
+	AdminClientPurgeDLQMessagesScope
+	// AdminClientVerifyWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientVerifyWorkflowExecutionScope
 
 	// MessagingPublishScope tracks Publish calls made by service to messaging layer
 	MessagingClientPublishScope
@@ -352,6 +425,8 @@ const (
 	PersistenceDeleteHistoryBranchScope
 	// PersistenceGetHistoryTreeScope tracks GetHistoryTree calls made by service to persistence layer
 	PersistenceGetHistoryTreeScope
+	// PersistenceGetAllHistoryTreeBranchesScope tracks GetAllHistoryTreeBranches calls made by service to persistence layer
+	PersistenceGetAllHistoryTreeBranchesScope
 
 	NumCommonScopes
 )
@@ -364,6 +439,28 @@ const (
 	AdminDescribeWorkflowExecutionScope
 	// AdminGetWorkflowExecutionRawHistoryScope is the metric scope for admin.GetWorkflowExecutionRawHistoryScope
 	AdminGetWorkflowExecutionRawHistoryScope
+	// AdminReadHistoryBranchScope is the metric scope for admin.ReadHistoryBranch
+	AdminReadHistoryBranchScope
+	// AdminDeleteWorkflowExecutionScope is the metric scope for admin.DeleteWorkflowExecution
+	AdminDeleteWorkflowExecutionScope
+	// AdminGetDomainIDOrNameScope is the metric scope for admin.GetDomainIDOrName
+	AdminGetDomainIDOrNameScope
+	// AdminDeleteDomainScope is the metric scope for admin.DeleteDomain
+	AdminDeleteDomainScope
+	// AdminStartBatchScope is the metric scope for admin.StartBatch
+	AdminStartBatchScope
+	// AdminGetReplicationMessagesScope is the metric scope for admin.GetReplicationMessages
+	AdminGetReplicationMessagesScope
+	// AdminGetDomainReplicationMessagesScope is the metric scope for admin.GetDomainReplicationMessages
+	AdminGetDomainReplicationMessagesScope
+	// AdminReadDLQMessagesScope is the metric scope for admin.ReadDLQMessages
+	AdminReadDLQMessagesScope
+	// AdminMergeDLQMessagesScope is the metric scope for admin.MergeDLQMessages
+	AdminMergeDLQMessagesScope
+	// AdminPurgeDLQMessagesScope is the metric scope for admin.PurgeDLQMessages
+	AdminPurgeDLQMessagesScope
+	// AdminVerifyWorkflowExecutionScope is the metric scope for admin.VerifyWorkflowExecution
+	AdminVerifyWorkflowExecutionScope
 
 	NumAdminScopes
 )
@@ -406,12 +503,20 @@ const (
 	FrontendSignalWithStartWorkflowExecutionScope
 	// FrontendTerminateWorkflowExecutionScope is the metric scope for frontend.TerminateWorkflowExecution
 	FrontendTerminateWorkflowExecutionScope
+	// FrontendResetWorkflowExecutionScope is the metric scope for frontend.ResetWorkflowExecution
+	FrontendResetWorkflowExecutionScope
+	// FrontendTriggerCronWorkflowExecutionScope is the metric scope for frontend.TriggerCronWorkflowExecution
+	FrontendTriggerCronWorkflowExecutionScope
 	// FrontendRequestCancelWorkflowExecutionScope is the metric scope for frontend.RequestCancelWorkflowExecution
 	FrontendRequestCancelWorkflowExecutionScope
 	// FrontendListOpenWorkflowExecutionsScope is the metric scope for frontend.ListOpenWorkflowExecutions
 	FrontendListOpenWorkflowExecutionsScope
 	// FrontendListClosedWorkflowExecutionsScope is the metric scope for frontend.ListClosedWorkflowExecutions
 	FrontendListClosedWorkflowExecutionsScope
+	// FrontendListWorkflowExecutionsScope is the metric scope for frontend.ListWorkflowExecutions
+	FrontendListWorkflowExecutionsScope
+	// FrontendGetSearchAttributesScope is the metric scope for frontend.GetSearchAttributes
+	FrontendGetSearchAttributesScope
 	// FrontendRegisterDomainScope is the metric scope for frontend.RegisterDomain
 	FrontendRegisterDomainScope
 	// FrontendDescribeDomainScope is the metric scope for frontend.DescribeDomain
@@ -468,6 +573,10 @@ const (
 	HistoryRemoveSignalMutableStateScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope
+	// HistoryResetWorkflowExecutionScope tracks ResetWorkflowExecution API calls received by service
+	HistoryResetWorkflowExecutionScope
+	// HistoryTriggerCronWorkflowExecutionScope tracks TriggerCronWorkflowExecution API calls received by service
+	HistoryTriggerCronWorkflowExecutionScope
 	// HistoryScheduleDecisionTaskScope tracks ScheduleDecisionTask API calls received by service
 	HistoryScheduleDecisionTaskScope
 	// HistoryRecordChildExecutionCompletedScope tracks CompleteChildExecution API calls received by service
@@ -484,6 +593,14 @@ const (
 	HistorySyncActivityScope
 	// HistoryDescribeMutableStateScope tracks HistoryActivity API calls received by service
 	HistoryDescribeMutableStateScope
+	// HistoryGetReplicationMessagesScope tracks GetReplicationMessages API calls received by service
+	HistoryGetReplicationMessagesScope
+	// HistoryReadDLQMessagesScope tracks ReadDLQMessages API calls received by service
+	HistoryReadDLQMessagesScope
+	// HistoryMergeDLQMessagesScope tracks MergeDLQMessages API calls received by service
+	HistoryMergeDLQMessagesScope
+	// HistoryPurgeDLQMessagesScope tracks PurgeDLQMessages API calls received by service
+	HistoryPurgeDLQMessagesScope
 	// HistoryShardControllerScope is the scope used by shard controller
 	HistoryShardControllerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -504,6 +621,10 @@ const (
 	TransferActiveTaskSignalExecutionScope
 	// TransferActiveTaskStartChildExecutionScope is the scope used for start child execution task processing by transfer queue processor
 	TransferActiveTaskStartChildExecutionScope
+	// TransferActiveTaskUpsertWorkflowSearchAttributesScope is the scope used for upsert search attributes processing by transfer queue processor
+	TransferActiveTaskUpsertWorkflowSearchAttributesScope
+	// TransferActiveTaskRecordWorkflowStartedScope is the scope used for record workflow started processing by transfer queue processor
+	TransferActiveTaskRecordWorkflowStartedScope
 	// TransferStandbyTaskActivityScope is the scope used for activity task processing by transfer queue processor
 	TransferStandbyTaskActivityScope
 	// TransferStandbyTaskDecisionScope is the scope used for decision task processing by transfer queue processor
@@ -516,6 +637,10 @@ const (
 	TransferStandbyTaskSignalExecutionScope
 	// TransferStandbyTaskStartChildExecutionScope is the scope used for start child execution task processing by transfer queue processor
 	TransferStandbyTaskStartChildExecutionScope
+	// TransferStandbyTaskUpsertWorkflowSearchAttributesScope is the scope used for upsert search attributes processing by transfer queue processor
+	TransferStandbyTaskUpsertWorkflowSearchAttributesScope
+	// TransferStandbyTaskRecordWorkflowStartedScope is the scope used for record workflow started processing by transfer queue processor
+	TransferStandbyTaskRecordWorkflowStartedScope
 	// TimerQueueProcessorScope is the scope used by all metric emitted by timer queue processor
 	TimerQueueProcessorScope
 	// TimerActiveQueueProcessorScope is the scope used by all metric emitted by timer queue processor
@@ -560,6 +685,10 @@ const (
 	ReplicatorTaskSyncActivityScope
 	// ReplicateHistoryEventsScope is the scope used by historyReplicator API for applying events
 	ReplicateHistoryEventsScope
+	// ReplicationTaskPullerScope is the scope used by the replication task puller for applying pulled tasks
+	ReplicationTaskPullerScope
+	// ReplicationDLQScope is the scope used by the replication dead letter queue of a shard
+	ReplicationDLQScope
 	// ShardInfoScope is the scope used when updating shard info
 	ShardInfoScope
 	// WorkflowContextScope is the scope used by WorkflowContext component
@@ -618,6 +747,10 @@ const (
 	SyncShardTaskScope
 	// SyncActivityTaskScope is the scope used by sync activity information processing
 	SyncActivityTaskScope
+	// IndexerScope is the scope used by the visibility indexer
+	IndexerScope
+	// ReplicationVerifierScope is the scope used by the cross cluster replication verifier
+	ReplicationVerifierScope
 
 	NumWorkerScopes
 )
@@ -633,13 +766,21 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceGetWorkflowExecutionScope:                     {operation: "GetWorkflowExecution"},
 		PersistenceUpdateWorkflowExecutionScope:                  {operation: "UpdateWorkflowExecution"},
 		PersistenceResetMutableStateScope:                        {operation: "ResetMutableState"},
+		PersistenceResetWorkflowExecutionScope:                   {operation: "ResetWorkflowExecution"},
 		PersistenceDeleteWorkflowExecutionScope:                  {operation: "DeleteWorkflowExecution"},
+		PersistenceDeleteCurrentWorkflowExecutionScope:           {operation: "DeleteCurrentWorkflowExecution"},
 		PersistenceGetCurrentExecutionScope:                      {operation: "GetCurrentExecution"},
+		PersistenceListConcreteExecutionsScope:                   {operation: "ListConcreteExecutions"},
+		PersistenceListCurrentExecutionsScope:                    {operation: "ListCurrentExecutions"},
 		PersistenceGetTransferTasksScope:                         {operation: "GetTransferTasks"},
 		PersistenceGetReplicationTasksScope:                      {operation: "GetReplicationTasks"},
 		PersistenceCompleteTransferTaskScope:                     {operation: "CompleteTransferTask"},
 		PersistenceRangeCompleteTransferTaskScope:                {operation: "RangeCompleteTransferTask"},
 		PersistenceCompleteReplicationTaskScope:                  {operation: "CompleteReplicationTask"},
+		PersistencePutReplicationTaskToDLQScope:                  {operation: "PutReplicationTaskToDLQ"},
+		PersistenceGetReplicationTasksFromDLQScope:               {operation: "GetReplicationTasksFromDLQ"},
+		PersistenceDeleteReplicationTaskFromDLQScope:             {operation: "DeleteReplicationTaskFromDLQ"},
+		PersistenceGetReplicationDLQSizeScope:                    {operation: "GetReplicationDLQSize"},
 		PersistenceGetTimerIndexTasksScope:                       {operation: "GetTimerIndexTasks"},
 		PersistenceCompleteTimerTaskScope:                        {operation: "CompleteTimerTask"},
 		PersistenceRangeCompleteTimerTaskScope:                   {operation: "RangeCompleteTimerTask"},
@@ -648,6 +789,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceCompleteTaskScope:                             {operation: "CompleteTask", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceLeaseTaskListScope:                            {operation: "LeaseTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceUpdateTaskListScope:                           {operation: "UpdateTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceListTaskListScope:                             {operation: "ListTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceDeleteTaskListScope:                           {operation: "DeleteTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceCompleteTasksLessThanScope:                    {operation: "CompleteTasksLessThan", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceAppendHistoryEventsScope:                      {operation: "AppendHistoryEvents", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceGetWorkflowExecutionHistoryScope:              {operation: "GetWorkflowExecutionHistory", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceDeleteWorkflowExecutionHistoryScope:           {operation: "DeleteWorkflowExecutionHistory", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
@@ -668,11 +812,15 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceListClosedWorkflowExecutionsByWorkflowIDScope: {operation: "ListClosedWorkflowExecutionsByWorkflowID"},
 		PersistenceListClosedWorkflowExecutionsByStatusScope:     {operation: "ListClosedWorkflowExecutionsByStatus"},
 		PersistenceGetClosedWorkflowExecutionScope:               {operation: "GetClosedWorkflowExecution"},
+		PersistenceUpsertWorkflowExecutionScope:                  {operation: "UpsertWorkflowExecution"},
+		PersistenceListWorkflowExecutionsScope:                   {operation: "ListWorkflowExecutions"},
+		PersistenceVisibilityDeleteWorkflowExecutionScope:        {operation: "VisibilityDeleteWorkflowExecution"},
 		PersistenceAppendHistoryNodesScope:                       {operation: "AppendHistoryNodes", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceReadHistoryBranchScope:                        {operation: "ReadHistoryBranch", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceForkHistoryBranchScope:                        {operation: "ForkHistoryBranch", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceDeleteHistoryBranchScope:                      {operation: "DeleteHistoryBranch", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceGetHistoryTreeScope:                           {operation: "GetHistoryTree", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceGetAllHistoryTreeBranchesScope:                {operation: "GetAllHistoryTreeBranches", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 
 		HistoryClientStartWorkflowExecutionScope:            {operation: "HistoryClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRecordActivityTaskHeartbeatScope:       {operation: "HistoryClientRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
@@ -691,12 +839,18 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientSignalWithStartWorkflowExecutionScope:  {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRemoveSignalMutableStateScope:          {operation: "HistoryClientRemoveSignalMutableStateScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientTerminateWorkflowExecutionScope:        {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientResetWorkflowExecutionScope:            {operation: "HistoryClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientTriggerCronWorkflowExecutionScope:      {operation: "HistoryClientTriggerCronWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientScheduleDecisionTaskScope:              {operation: "HistoryClientScheduleDecisionTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRecordChildExecutionCompletedScope:     {operation: "HistoryClientRecordChildExecutionCompleted", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientReplicateEventsScope:                   {operation: "HistoryClientReplicateEvents", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientReplicateRawEventsScope:                {operation: "HistoryClientReplicateRawEvents", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSyncShardStatusScope:                   {operation: "HistoryClientSyncShardStatusScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSyncActivityScope:                      {operation: "HistoryClientSyncActivityScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGetReplicationMessagesScope:            {operation: "HistoryClientGetReplicationMessages", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientReadDLQMessagesScope:                   {operation: "HistoryClientReadDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientMergeDLQMessagesScope:                  {operation: "HistoryClientMergeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientPurgeDLQMessagesScope:                  {operation: "HistoryClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		MatchingClientPollForDecisionTaskScope:              {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientPollForActivityTaskScope:              {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                  {operation: "MatchingClientAddActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
@@ -711,6 +865,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientDescribeWorkflowExecutionScope:        {operation: "FrontendClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientGetWorkflowExecutionHistoryScope:      {operation: "FrontendClientGetWorkflowExecutionHistory", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientListClosedWorkflowExecutionsScope:     {operation: "FrontendClientListClosedWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientListWorkflowExecutionsScope:           {operation: "FrontendClientListWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientGetSearchAttributesScope:              {operation: "FrontendClientGetSearchAttributes", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientListDomainsScope:                      {operation: "FrontendClientListDomains", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientListOpenWorkflowExecutionsScope:       {operation: "FrontendClientListOpenWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientPollForActivityTaskScope:              {operation: "FrontendClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
@@ -734,10 +890,23 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientSignalWorkflowExecutionScope:          {operation: "FrontendClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientStartWorkflowExecutionScope:           {operation: "FrontendClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientTerminateWorkflowExecutionScope:       {operation: "FrontendClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientResetWorkflowExecutionScope:           {operation: "FrontendClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientTriggerCronWorkflowExecutionScope:     {operation: "FrontendClientTriggerCronWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientUpdateDomainScope:                     {operation: "FrontendClientUpdateDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		AdminClientDescribeHistoryHostScope:                 {operation: "AdminClientDescribeHistoryHost", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeWorkflowExecutionScope:           {operation: "AdminClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetWorkflowExecutionRawHistoryScope:      {operation: "AdminClientGetWorkflowExecutionRawHistory", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientReadHistoryBranchScope:                   {operation: "AdminClientReadHistoryBranch", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteWorkflowExecutionScope:             {operation: "AdminClientDeleteWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetDomainIDOrNameScope:                   {operation: "AdminClientGetDomainIDOrName", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteDomainScope:                        {operation: "AdminClientDeleteDomain", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientStartBatchScope:                          {operation: "AdminClientStartBatch", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetReplicationMessagesScope:              {operation: "AdminClientGetReplicationMessages", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetDomainReplicationMessagesScope:        {operation: "AdminClientGetDomainReplicationMessages", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientReadDLQMessagesScope:                     {operation: "AdminClientReadDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientMergeDLQMessagesScope:                    {operation: "AdminClientMergeDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientPurgeDLQMessagesScope:                    {operation: "AdminClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientVerifyWorkflowExecutionScope:             {operation: "AdminClientVerifyWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 
 		MessagingClientPublishScope:      {operation: "MessagingClientPublish"},
 		MessagingClientPublishBatchScope: {operation: "MessagingClientPublishBatch"},
@@ -750,6 +919,17 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminDescribeHistoryHostScope:            {operation: "DescribeHistoryHost"},
 		AdminDescribeWorkflowExecutionScope:      {operation: "DescribeWorkflowExecution"},
 		AdminGetWorkflowExecutionRawHistoryScope: {operation: "GetWorkflowExecutionRawHistory"},
+		AdminReadHistoryBranchScope:              {operation: "ReadHistoryBranch"},
+		AdminDeleteWorkflowExecutionScope:        {operation: "DeleteWorkflowExecution"},
+		AdminGetDomainIDOrNameScope:              {operation: "GetDomainIDOrName"},
+		AdminDeleteDomainScope:                   {operation: "DeleteDomain"},
+		AdminStartBatchScope:                     {operation: "StartBatch"},
+		AdminGetReplicationMessagesScope:         {operation: "GetReplicationMessages"},
+		AdminGetDomainReplicationMessagesScope:   {operation: "GetDomainReplicationMessages"},
+		AdminReadDLQMessagesScope:                {operation: "ReadDLQMessages"},
+		AdminMergeDLQMessagesScope:               {operation: "MergeDLQMessages"},
+		AdminPurgeDLQMessagesScope:               {operation: "PurgeDLQMessages"},
+		AdminVerifyWorkflowExecutionScope:        {operation: "VerifyWorkflowExecution"},
 
 		FrontendStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:              {operation: "PollForDecisionTask"},
@@ -769,9 +949,13 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendSignalWorkflowExecutionScope:          {operation: "SignalWorkflowExecution"},
 		FrontendSignalWithStartWorkflowExecutionScope: {operation: "SignalWithStartWorkflowExecution"},
 		FrontendTerminateWorkflowExecutionScope:       {operation: "TerminateWorkflowExecution"},
+		FrontendResetWorkflowExecutionScope:           {operation: "ResetWorkflowExecution"},
+		FrontendTriggerCronWorkflowExecutionScope:     {operation: "TriggerCronWorkflowExecution"},
 		FrontendRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
 		FrontendListOpenWorkflowExecutionsScope:       {operation: "ListOpenWorkflowExecutions"},
 		FrontendListClosedWorkflowExecutionsScope:     {operation: "ListClosedWorkflowExecutions"},
+		FrontendListWorkflowExecutionsScope:           {operation: "ListWorkflowExecutions"},
+		FrontendGetSearchAttributesScope:              {operation: "GetSearchAttributes"},
 		FrontendRegisterDomainScope:                   {operation: "RegisterDomain"},
 		FrontendDescribeDomainScope:                   {operation: "DescribeDomain"},
 		FrontendListDomainsScope:                      {operation: "ListDomain"},
@@ -784,77 +968,89 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 	},
 	// History Scope Names
 	History: {
-		HistoryStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
-		HistoryRecordActivityTaskHeartbeatScope:      {operation: "RecordActivityTaskHeartbeat"},
-		HistoryRespondDecisionTaskCompletedScope:     {operation: "RespondDecisionTaskCompleted"},
-		HistoryRespondDecisionTaskFailedScope:        {operation: "RespondDecisionTaskFailed"},
-		HistoryRespondActivityTaskCompletedScope:     {operation: "RespondActivityTaskCompleted"},
-		HistoryRespondActivityTaskFailedScope:        {operation: "RespondActivityTaskFailed"},
-		HistoryRespondActivityTaskCanceledScope:      {operation: "RespondActivityTaskCanceled"},
-		HistoryGetMutableStateScope:                  {operation: "GetMutableState"},
-		HistoryResetStickyTaskListScope:              {operation: "ResetStickyTaskListScope"},
-		HistoryDescribeWorkflowExecutionScope:        {operation: "DescribeWorkflowExecution"},
-		HistoryRecordDecisionTaskStartedScope:        {operation: "RecordDecisionTaskStarted"},
-		HistoryRecordActivityTaskStartedScope:        {operation: "RecordActivityTaskStarted"},
-		HistorySignalWorkflowExecutionScope:          {operation: "SignalWorkflowExecution"},
-		HistorySignalWithStartWorkflowExecutionScope: {operation: "SignalWithStartWorkflowExecution"},
-		HistoryRemoveSignalMutableStateScope:         {operation: "RemoveSignalMutableState"},
-		HistoryTerminateWorkflowExecutionScope:       {operation: "TerminateWorkflowExecution"},
-		HistoryScheduleDecisionTaskScope:             {operation: "ScheduleDecisionTask"},
-		HistoryRecordChildExecutionCompletedScope:    {operation: "RecordChildExecutionCompleted"},
-		HistoryRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
-		HistoryReplicateEventsScope:                  {operation: "ReplicateEvents"},
-		HistoryReplicateRawEventsScope:               {operation: "ReplicateRawEvents"},
-		HistorySyncShardStatusScope:                  {operation: "SyncShardStatus"},
-		HistorySyncActivityScope:                     {operation: "SyncActivity"},
-		HistoryDescribeMutableStateScope:             {operation: "DescribeMutableState"},
-		HistoryShardControllerScope:                  {operation: "ShardController"},
-		TransferQueueProcessorScope:                  {operation: "TransferQueueProcessor"},
-		TransferActiveQueueProcessorScope:            {operation: "TransferActiveQueueProcessor"},
-		TransferStandbyQueueProcessorScope:           {operation: "TransferStandbyQueueProcessor"},
-		TransferActiveTaskActivityScope:              {operation: "TransferActiveTaskActivity"},
-		TransferActiveTaskDecisionScope:              {operation: "TransferActiveTaskDecision"},
-		TransferActiveTaskCloseExecutionScope:        {operation: "TransferActiveTaskCloseExecution"},
-		TransferActiveTaskCancelExecutionScope:       {operation: "TransferActiveTaskCancelExecution"},
-		TransferActiveTaskSignalExecutionScope:       {operation: "TransferActiveTaskSignalExecution"},
-		TransferActiveTaskStartChildExecutionScope:   {operation: "TransferActiveTaskStartChildExecution"},
-		TransferStandbyTaskActivityScope:             {operation: "TransferStandbyTaskActivity"},
-		TransferStandbyTaskDecisionScope:             {operation: "TransferStandbyTaskDecision"},
-		TransferStandbyTaskCloseExecutionScope:       {operation: "TransferStandbyTaskCloseExecution"},
-		TransferStandbyTaskCancelExecutionScope:      {operation: "TransferStandbyTaskCancelExecution"},
-		TransferStandbyTaskSignalExecutionScope:      {operation: "TransferStandbyTaskSignalExecution"},
-		TransferStandbyTaskStartChildExecutionScope:  {operation: "TransferStandbyTaskStartChildExecution"},
-		TimerQueueProcessorScope:                     {operation: "TimerQueueProcessor"},
-		TimerActiveQueueProcessorScope:               {operation: "TimerActiveQueueProcessor"},
-		TimerStandbyQueueProcessorScope:              {operation: "TimerStandbyQueueProcessor"},
-		TimerActiveTaskActivityTimeoutScope:          {operation: "TimerActiveTaskActivityTimeout"},
-		TimerActiveTaskDecisionTimeoutScope:          {operation: "TimerActiveTaskDecisionTimeout"},
-		TimerActiveTaskUserTimerScope:                {operation: "TimerActiveTaskUserTimer"},
-		TimerActiveTaskWorkflowTimeoutScope:          {operation: "TimerActiveTaskWorkflowTimeout"},
-		TimerActiveTaskActivityRetryTimerScope:       {operation: "TimerActiveTaskActivityRetryTimer"},
-		TimerActiveTaskWorkflowBackoffTimerScope:     {operation: "TimerActiveTaskWorkflowBackoffTimer"},
-		TimerActiveTaskDeleteHistoryEventScope:       {operation: "TimerActiveTaskDeleteHistoryEvent"},
-		TimerStandbyTaskActivityTimeoutScope:         {operation: "TimerStandbyTaskActivityTimeout"},
-		TimerStandbyTaskDecisionTimeoutScope:         {operation: "TimerStandbyTaskDecisionTimeout"},
-		TimerStandbyTaskUserTimerScope:               {operation: "TimerStandbyTaskUserTimer"},
-		TimerStandbyTaskWorkflowTimeoutScope:         {operation: "TimerStandbyTaskWorkflowTimeout"},
-		TimerStandbyTaskActivityRetryTimerScope:      {operation: "TimerStandbyTaskActivityRetryTimer"},
-		TimerStandbyTaskWorkflowBackoffTimerScope:    {operation: "TimerStandbyTaskWorkflowBackoffTimer"},
-		TimerStandbyTaskDeleteHistoryEventScope:      {operation: "TimerStandbyTaskDeleteHistoryEvent"},
-		HistoryEventNotificationScope:                {operation: "HistoryEventNotification"},
-		ReplicatorQueueProcessorScope:                {operation: "ReplicatorQueueProcessor"},
-		ReplicatorTaskHistoryScope:                   {operation: "ReplicatorTaskHistory"},
-		ReplicatorTaskSyncActivityScope:              {operation: "ReplicatorTaskSyncActivity"},
-		ReplicateHistoryEventsScope:                  {operation: "ReplicateHistoryEvents"},
-		ShardInfoScope:                               {operation: "ShardInfo"},
-		WorkflowContextScope:                         {operation: "WorkflowContext"},
-		HistoryCacheGetAndCreateScope:                {operation: "HistoryCacheGetAndCreate"},
-		HistoryCacheGetOrCreateScope:                 {operation: "HistoryCacheGetOrCreate"},
-		HistoryCacheGetCurrentExecutionScope:         {operation: "HistoryCacheGetCurrentExecution"},
-		ExecutionSizeStatsScope:                      {operation: "ExecutionStats", tags: map[string]string{StatsTypeTagName: SizeStatsTypeTagValue}},
-		ExecutionCountStatsScope:                     {operation: "ExecutionStats", tags: map[string]string{StatsTypeTagName: CountStatsTypeTagValue}},
-		SessionSizeStatsScope:                        {operation: "SessionStats", tags: map[string]string{StatsTypeTagName: SizeStatsTypeTagValue}},
-		SessionCountStatsScope:                       {operation: "SessionStats", tags: map[string]string{StatsTypeTagName: CountStatsTypeTagValue}},
+		HistoryStartWorkflowExecutionScope:                     {operation: "StartWorkflowExecution"},
+		HistoryRecordActivityTaskHeartbeatScope:                {operation: "RecordActivityTaskHeartbeat"},
+		HistoryRespondDecisionTaskCompletedScope:               {operation: "RespondDecisionTaskCompleted"},
+		HistoryRespondDecisionTaskFailedScope:                  {operation: "RespondDecisionTaskFailed"},
+		HistoryRespondActivityTaskCompletedScope:               {operation: "RespondActivityTaskCompleted"},
+		HistoryRespondActivityTaskFailedScope:                  {operation: "RespondActivityTaskFailed"},
+		HistoryRespondActivityTaskCanceledScope:                {operation: "RespondActivityTaskCanceled"},
+		HistoryGetMutableStateScope:                            {operation: "GetMutableState"},
+		HistoryResetStickyTaskListScope:                        {operation: "ResetStickyTaskListScope"},
+		HistoryDescribeWorkflowExecutionScope:                  {operation: "DescribeWorkflowExecution"},
+		HistoryRecordDecisionTaskStartedScope:                  {operation: "RecordDecisionTaskStarted"},
+		HistoryRecordActivityTaskStartedScope:                  {operation: "RecordActivityTaskStarted"},
+		HistorySignalWorkflowExecutionScope:                    {operation: "SignalWorkflowExecution"},
+		HistorySignalWithStartWorkflowExecutionScope:           {operation: "SignalWithStartWorkflowExecution"},
+		HistoryRemoveSignalMutableStateScope:                   {operation: "RemoveSignalMutableState"},
+		HistoryTerminateWorkflowExecutionScope:                 {operation: "TerminateWorkflowExecution"},
+		HistoryResetWorkflowExecutionScope:                     {operation: "ResetWorkflowExecution"},
+		HistoryTriggerCronWorkflowExecutionScope:               {operation: "TriggerCronWorkflowExecution"},
+		HistoryScheduleDecisionTaskScope:                       {operation: "ScheduleDecisionTask"},
+		HistoryRecordChildExecutionCompletedScope:              {operation: "RecordChildExecutionCompleted"},
+		HistoryRequestCancelWorkflowExecutionScope:             {operation: "RequestCancelWorkflowExecution"},
+		HistoryReplicateEventsScope:                            {operation: "ReplicateEvents"},
+		HistoryReplicateRawEventsScope:                         {operation: "ReplicateRawEvents"},
+		HistorySyncShardStatusScope:                            {operation: "SyncShardStatus"},
+		HistorySyncActivityScope:                               {operation: "SyncActivity"},
+		HistoryDescribeMutableStateScope:                       {operation: "DescribeMutableState"},
+		HistoryGetReplicationMessagesScope:                     {operation: "GetReplicationMessages"},
+		HistoryReadDLQMessagesScope:                            {operation: "ReadDLQMessages"},
+		HistoryMergeDLQMessagesScope:                           {operation: "MergeDLQMessages"},
+		HistoryPurgeDLQMessagesScope:                           {operation: "PurgeDLQMessages"},
+		HistoryShardControllerScope:                            {operation: "ShardController"},
+		TransferQueueProcessorScope:                            {operation: "TransferQueueProcessor"},
+		TransferActiveQueueProcessorScope:                      {operation: "TransferActiveQueueProcessor"},
+		TransferStandbyQueueProcessorScope:                     {operation: "TransferStandbyQueueProcessor"},
+		TransferActiveTaskActivityScope:                        {operation: "TransferActiveTaskActivity"},
+		TransferActiveTaskDecisionScope:                        {operation: "TransferActiveTaskDecision"},
+		TransferActiveTaskCloseExecutionScope:                  {operation: "TransferActiveTaskCloseExecution"},
+		TransferActiveTaskCancelExecutionScope:                 {operation: "TransferActiveTaskCancelExecution"},
+		TransferActiveTaskSignalExecutionScope:                 {operation: "TransferActiveTaskSignalExecution"},
+		TransferActiveTaskStartChildExecutionScope:             {operation: "TransferActiveTaskStartChildExecution"},
+		TransferActiveTaskUpsertWorkflowSearchAttributesScope:  {operation: "TransferActiveTaskUpsertWorkflowSearchAttributes"},
+		TransferActiveTaskRecordWorkflowStartedScope:           {operation: "TransferActiveTaskRecordWorkflowStarted"},
+		TransferStandbyTaskActivityScope:                       {operation: "TransferStandbyTaskActivity"},
+		TransferStandbyTaskDecisionScope:                       {operation: "TransferStandbyTaskDecision"},
+		TransferStandbyTaskCloseExecutionScope:                 {operation: "TransferStandbyTaskCloseExecution"},
+		TransferStandbyTaskCancelExecutionScope:                {operation: "TransferStandbyTaskCancelExecution"},
+		TransferStandbyTaskSignalExecutionScope:                {operation: "TransferStandbyTaskSignalExecution"},
+		TransferStandbyTaskStartChildExecutionScope:            {operation: "TransferStandbyTaskStartChildExecution"},
+		TransferStandbyTaskUpsertWorkflowSearchAttributesScope: {operation: "TransferStandbyTaskUpsertWorkflowSearchAttributes"},
+		TransferStandbyTaskRecordWorkflowStartedScope:          {operation: "TransferStandbyTaskRecordWorkflowStarted"},
+		TimerQueueProcessorScope:                               {operation: "TimerQueueProcessor"},
+		TimerActiveQueueProcessorScope:                         {operation: "TimerActiveQueueProcessor"},
+		TimerStandbyQueueProcessorScope:                        {operation: "TimerStandbyQueueProcessor"},
+		TimerActiveTaskActivityTimeoutScope:                    {operation: "TimerActiveTaskActivityTimeout"},
+		TimerActiveTaskDecisionTimeoutScope:                    {operation: "TimerActiveTaskDecisionTimeout"},
+		TimerActiveTaskUserTimerScope:                          {operation: "TimerActiveTaskUserTimer"},
+		TimerActiveTaskWorkflowTimeoutScope:                    {operation: "TimerActiveTaskWorkflowTimeout"},
+		TimerActiveTaskActivityRetryTimerScope:                 {operation: "TimerActiveTaskActivityRetryTimer"},
+		TimerActiveTaskWorkflowBackoffTimerScope:               {operation: "TimerActiveTaskWorkflowBackoffTimer"},
+		TimerActiveTaskDeleteHistoryEventScope:                 {operation: "TimerActiveTaskDeleteHistoryEvent"},
+		TimerStandbyTaskActivityTimeoutScope:                   {operation: "TimerStandbyTaskActivityTimeout"},
+		TimerStandbyTaskDecisionTimeoutScope:                   {operation: "TimerStandbyTaskDecisionTimeout"},
+		TimerStandbyTaskUserTimerScope:                         {operation: "TimerStandbyTaskUserTimer"},
+		TimerStandbyTaskWorkflowTimeoutScope:                   {operation: "TimerStandbyTaskWorkflowTimeout"},
+		TimerStandbyTaskActivityRetryTimerScope:                {operation: "TimerStandbyTaskActivityRetryTimer"},
+		TimerStandbyTaskWorkflowBackoffTimerScope:              {operation: "TimerStandbyTaskWorkflowBackoffTimer"},
+		TimerStandbyTaskDeleteHistoryEventScope:                {operation: "TimerStandbyTaskDeleteHistoryEvent"},
+		HistoryEventNotificationScope:                          {operation: "HistoryEventNotification"},
+		ReplicatorQueueProcessorScope:                          {operation: "ReplicatorQueueProcessor"},
+		ReplicatorTaskHistoryScope:                             {operation: "ReplicatorTaskHistory"},
+		ReplicatorTaskSyncActivityScope:                        {operation: "ReplicatorTaskSyncActivity"},
+		ReplicateHistoryEventsScope:                            {operation: "ReplicateHistoryEvents"},
+		ReplicationTaskPullerScope:                             {operation: "ReplicationTaskPuller"},
+		ReplicationDLQScope:                                    {operation: "ReplicationDLQ"},
+		ShardInfoScope:                                         {operation: "ShardInfo"},
+		WorkflowContextScope:                                   {operation: "WorkflowContext"},
+		HistoryCacheGetAndCreateScope:                          {operation: "HistoryCacheGetAndCreate"},
+		HistoryCacheGetOrCreateScope:                           {operation: "HistoryCacheGetOrCreate"},
+		HistoryCacheGetCurrentExecutionScope:                   {operation: "HistoryCacheGetCurrentExecution"},
+		ExecutionSizeStatsScope:                                {operation: "ExecutionStats", tags: map[string]string{StatsTypeTagName: SizeStatsTypeTagValue}},
+		ExecutionCountStatsScope:                               {operation: "ExecutionStats", tags: map[string]string{StatsTypeTagName: CountStatsTypeTagValue}},
+		SessionSizeStatsScope:                                  {operation: "SessionStats", tags: map[string]string{StatsTypeTagName: SizeStatsTypeTagValue}},
+		SessionCountStatsScope:                                 {operation: "SessionStats", tags: map[string]string{StatsTypeTagName: CountStatsTypeTagValue}},
 	},
 	// Matching Scope Names
 	Matching: {
@@ -875,6 +1071,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryReplicationTaskScope: {operation: "HistoryReplicationTask"},
 		SyncShardTaskScope:          {operation: "SyncShardTask"},
 		SyncActivityTaskScope:       {operation: "SyncActivityTask"},
+		IndexerScope:                {operation: "Indexer"},
+		ReplicationVerifierScope:    {operation: "ReplicationVerifier"},
 	},
 }
 
@@ -887,12 +1085,14 @@ const (
 	CadenceErrBadRequestCounter
 	CadenceErrDomainNotActiveCounter
 	CadenceErrServiceBusyCounter
+	CadenceErrDomainServiceBusyCounter
 	CadenceErrEntityNotExistsCounter
 	CadenceErrExecutionAlreadyStartedCounter
 	CadenceErrDomainAlreadyExistsCounter
 	CadenceErrCancellationAlreadyRequestedCounter
 	CadenceErrQueryFailedCounter
 	CadenceErrLimitExceededCounter
+	CadenceErrAccessDeniedCounter
 	CadenceErrContextTimeoutCounter
 	CadenceErrRetryTaskCounter
 	PersistenceRequests
@@ -952,6 +1152,7 @@ const (
 	DecisionTypeChildWorkflowCounter
 	DecisionTypeContinueAsNewCounter
 	DecisionTypeSignalExternalWorkflowCounter
+	DecisionTypeUpsertWorkflowSearchAttributesCounter
 	MultipleCompletionDecisionsCounter
 	FailedDecisionsCounter
 	StaleMutableStateCounter
@@ -1030,6 +1231,8 @@ const (
 	DeleteRequestCancelInfoCount
 	WorkflowRetryBackoffTimerCount
 	WorkflowCronBackoffTimerCount
+	ReplicationDLQSizeGauge
+	ReplicationDLQEnqueuedCounter
 
 	NumHistoryMetrics
 )
@@ -1046,6 +1249,8 @@ const (
 	SyncThrottleCounter
 	BufferThrottleCounter
 	SyncMatchLatency
+	ForwardedTaskCounter
+	ForwardedPollCounter
 
 	NumMatchingMetrics
 )
@@ -1055,6 +1260,14 @@ const (
 	ReplicatorMessages = iota + NumCommonMetrics
 	ReplicatorFailures
 	ReplicatorLatency
+	IndexerMessages
+	IndexerFailures
+	IndexerBulkLatency
+	ReplicatorDLQEnqueued
+	VerifierWorkflowsVerified
+	VerifierWorkflowsDiverged
+	VerifierWorkflowsRepaired
+	VerifierFailures
 
 	NumWorkerMetrics
 )
@@ -1069,12 +1282,14 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CadenceErrBadRequestCounter:                         {metricName: "cadence.errors.bad-request", metricType: Counter},
 		CadenceErrDomainNotActiveCounter:                    {metricName: "cadence.errors.domain-not-active", metricType: Counter},
 		CadenceErrServiceBusyCounter:                        {metricName: "cadence.errors.service-busy", metricType: Counter},
+		CadenceErrDomainServiceBusyCounter:                  {metricName: "cadence.errors.domain-service-busy", metricType: Counter},
 		CadenceErrEntityNotExistsCounter:                    {metricName: "cadence.errors.entity-not-exists", metricType: Counter},
 		CadenceErrExecutionAlreadyStartedCounter:            {metricName: "cadence.errors.execution-already-started", metricType: Counter},
 		CadenceErrDomainAlreadyExistsCounter:                {metricName: "cadence.errors.domain-already-exists", metricType: Counter},
 		CadenceErrCancellationAlreadyRequestedCounter:       {metricName: "cadence.errors.cancellation-already-requested", metricType: Counter},
 		CadenceErrQueryFailedCounter:                        {metricName: "cadence.errors.query-failed", metricType: Counter},
 		CadenceErrLimitExceededCounter:                      {metricName: "cadence.errors.limit-exceeded", metricType: Counter},
+		CadenceErrAccessDeniedCounter:                       {metricName: "cadence.errors.access-denied", metricType: Counter},
 		CadenceErrContextTimeoutCounter:                     {metricName: "cadence.errors.context-timeout", metricType: Counter},
 		CadenceErrRetryTaskCounter:                          {metricName: "cadence.errors.retry-task", metricType: Counter},
 		PersistenceRequests:                                 {metricName: "persistence.requests", metricType: Counter},
@@ -1102,109 +1317,112 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 	},
 	Frontend: {},
 	History: {
-		TaskRequests:                                 {metricName: "task.requests", metricType: Counter},
-		TaskLatency:                                  {metricName: "task.latency", metricType: Timer},
-		TaskAttemptTimer:                             {metricName: "task.attempt", metricType: Timer},
-		TaskFailures:                                 {metricName: "task.errors", metricType: Counter},
-		TaskDiscarded:                                {metricName: "task.errors.discarded", metricType: Counter},
-		TaskStandbyRetryCounter:                      {metricName: "task.errors.standby-retry-counter", metricType: Counter},
-		TaskNotActiveCounter:                         {metricName: "task.errors.not-active-counter", metricType: Counter},
-		TaskLimitExceededCounter:                     {metricName: "task.errors.limit-exceeded-counter", metricType: Counter},
-		TaskProcessingLatency:                        {metricName: "task.latency.processing", metricType: Timer},
-		TaskQueueLatency:                             {metricName: "task.latency.queue", metricType: Timer},
-		TaskBatchCompleteCounter:                     {metricName: "task.batch-complete-counter", metricType: Counter},
-		AckLevelUpdateCounter:                        {metricName: "ack-level-update", metricType: Counter},
-		AckLevelUpdateFailedCounter:                  {metricName: "ack-level-update-failed", metricType: Counter},
-		DecisionTypeScheduleActivityCounter:          {metricName: "schedule-activity-decision", metricType: Counter},
-		DecisionTypeCompleteWorkflowCounter:          {metricName: "complete-workflow-decision", metricType: Counter},
-		DecisionTypeFailWorkflowCounter:              {metricName: "fail-workflow-decision", metricType: Counter},
-		DecisionTypeCancelWorkflowCounter:            {metricName: "cancel-workflow-decision", metricType: Counter},
-		DecisionTypeStartTimerCounter:                {metricName: "start-timer-decision", metricType: Counter},
-		DecisionTypeCancelActivityCounter:            {metricName: "cancel-activity-decision", metricType: Counter},
-		DecisionTypeCancelTimerCounter:               {metricName: "cancel-timer-decision", metricType: Counter},
-		DecisionTypeRecordMarkerCounter:              {metricName: "record-marker-decision", metricType: Counter},
-		DecisionTypeCancelExternalWorkflowCounter:    {metricName: "cancel-external-workflow-decision", metricType: Counter},
-		DecisionTypeContinueAsNewCounter:             {metricName: "continue-as-new-decision", metricType: Counter},
-		DecisionTypeSignalExternalWorkflowCounter:    {metricName: "signal-external-workflow-decision", metricType: Counter},
-		DecisionTypeChildWorkflowCounter:             {metricName: "child-workflow-decision", metricType: Counter},
-		MultipleCompletionDecisionsCounter:           {metricName: "multiple-completion-decisions", metricType: Counter},
-		FailedDecisionsCounter:                       {metricName: "failed-decisions", metricType: Counter},
-		StaleMutableStateCounter:                     {metricName: "stale-mutable-state", metricType: Counter},
-		ConcurrencyUpdateFailureCounter:              {metricName: "concurrency-update-failure", metricType: Counter},
-		CadenceErrShardOwnershipLostCounter:          {metricName: "cadence.errors.shard-ownership-lost", metricType: Counter},
-		CadenceErrEventAlreadyStartedCounter:         {metricName: "cadence.errors.event-already-started", metricType: Counter},
-		HeartbeatTimeoutCounter:                      {metricName: "heartbeat-timeout", metricType: Counter},
-		ScheduleToStartTimeoutCounter:                {metricName: "schedule-to-start-timeout", metricType: Counter},
-		StartToCloseTimeoutCounter:                   {metricName: "start-to-close-timeout", metricType: Counter},
-		ScheduleToCloseTimeoutCounter:                {metricName: "schedule-to-close-timeout", metricType: Counter},
-		NewTimerCounter:                              {metricName: "new-timer", metricType: Counter},
-		NewTimerNotifyCounter:                        {metricName: "new-timer-notifications", metricType: Counter},
-		AcquireShardsCounter:                         {metricName: "acquire-shards-count", metricType: Counter},
-		AcquireShardsLatency:                         {metricName: "acquire-shards-latency", metricType: Timer},
-		ShardClosedCounter:                           {metricName: "shard-closed-count", metricType: Counter},
-		ShardItemCreatedCounter:                      {metricName: "sharditem-created-count", metricType: Counter},
-		ShardItemRemovedCounter:                      {metricName: "sharditem-removed-count", metricType: Counter},
-		ShardInfoReplicationPendingTasksTimer:        {metricName: "shardinfo-replication-pending-task", metricType: Timer},
-		ShardInfoTransferActivePendingTasksTimer:     {metricName: "shardinfo-transfer-active-pending-task", metricType: Timer},
-		ShardInfoTransferStandbyPendingTasksTimer:    {metricName: "shardinfo-transfer-standby-pending-task", metricType: Timer},
-		ShardInfoTimerActivePendingTasksTimer:        {metricName: "shardinfo-timer-active-pending-task", metricType: Timer},
-		ShardInfoTimerStandbyPendingTasksTimer:       {metricName: "shardinfo-timer-standby-pending-task", metricType: Timer},
-		ShardInfoReplicationLagTimer:                 {metricName: "shardinfo-replication-lag", metricType: Timer},
-		ShardInfoTransferLagTimer:                    {metricName: "shardinfo-transfer-lag", metricType: Timer},
-		ShardInfoTimerLagTimer:                       {metricName: "shardinfo-timer-lag", metricType: Timer},
-		ShardInfoTransferDiffTimer:                   {metricName: "shardinfo-transfer-diff", metricType: Timer},
-		ShardInfoTimerDiffTimer:                      {metricName: "shardinfo-timer-diff", metricType: Timer},
-		ShardInfoTransferFailoverInProgressTimer:     {metricName: "shardinfo-transfer-failover-in-progress", metricType: Timer},
-		ShardInfoTimerFailoverInProgressTimer:        {metricName: "shardinfo-timer-failover-in-progress", metricType: Timer},
-		ShardInfoTransferFailoverLatencyTimer:        {metricName: "shardinfo-transfer-failover-latency", metricType: Timer},
-		ShardInfoTimerFailoverLatencyTimer:           {metricName: "shardinfo-timer-failover-latency", metricType: Timer},
-		MembershipChangedCounter:                     {metricName: "membership-changed-count", metricType: Counter},
-		NumShardsGauge:                               {metricName: "numshards-gauge", metricType: Gauge},
-		GetEngineForShardErrorCounter:                {metricName: "get-engine-for-shard-errors", metricType: Counter},
-		GetEngineForShardLatency:                     {metricName: "get-engine-for-shard-latency", metricType: Timer},
-		RemoveEngineForShardLatency:                  {metricName: "remove-engine-for-shard-latency", metricType: Timer},
-		CompleteDecisionWithStickyEnabledCounter:     {metricName: "complete-decision-sticky-enabled-count", metricType: Counter},
-		CompleteDecisionWithStickyDisabledCounter:    {metricName: "complete-decision-sticky-disabled-count", metricType: Counter},
-		HistoryEventNotificationQueueingLatency:      {metricName: "history-event-notification-queueing-latency", metricType: Timer},
-		HistoryEventNotificationFanoutLatency:        {metricName: "history-event-notification-fanout-latency", metricType: Timer},
-		HistoryEventNotificationInFlightMessageGauge: {metricName: "history-event-notification-inflight-message-gauge", metricType: Gauge},
-		HistoryEventNotificationFailDeliveryCount:    {metricName: "history-event-notification-fail-delivery-count", metricType: Counter},
-		EmptyReplicationEventsCounter:                {metricName: "empty-replication-events", metricType: Counter},
-		DuplicateReplicationEventsCounter:            {metricName: "duplicate-replication-events", metricType: Counter},
-		StaleReplicationEventsCounter:                {metricName: "stale-replication-events", metricType: Counter},
-		ReplicationEventsSizeTimer:                   {metricName: "replication-events-size", metricType: Timer},
-		BufferReplicationTaskTimer:                   {metricName: "buffer-replication-tasks", metricType: Timer},
-		UnbufferReplicationTaskTimer:                 {metricName: "unbuffer-replication-tasks", metricType: Timer},
-		HistoryConflictsCounter:                      {metricName: "history-conflicts", metricType: Counter},
-		CompleteTaskFailedCounter:                    {metricName: "complete-task-fail-count", metricType: Counter},
-		HistoryCacheRequests:                         {metricName: "history-cache.requests", metricType: Counter},
-		HistoryCacheFailures:                         {metricName: "history-cache.errors", metricType: Counter},
-		HistoryCacheLatency:                          {metricName: "history-cache.latency", metricType: Timer},
-		CacheMissCounter:                             {metricName: "cache-miss", metricType: Counter},
-		AcquireLockFailedCounter:                     {metricName: "acquire-lock-failed", metricType: Counter},
-		WorkflowContextCleared:                       {metricName: "workflow-context-cleared", metricType: Counter},
-		MutableStateSize:                             {metricName: "mutable-state-size", metricType: Timer},
-		ExecutionInfoSize:                            {metricName: "execution-info-size", metricType: Timer},
-		ActivityInfoSize:                             {metricName: "activity-info-size", metricType: Timer},
-		TimerInfoSize:                                {metricName: "timer-info-size", metricType: Timer},
-		ChildInfoSize:                                {metricName: "child-info-size", metricType: Timer},
-		SignalInfoSize:                               {metricName: "signal-info", metricType: Timer},
-		BufferedEventsSize:                           {metricName: "buffered-events-size", metricType: Timer},
-		BufferedReplicationTasksSize:                 {metricName: "buffered-replication-tasks-size", metricType: Timer},
-		ActivityInfoCount:                            {metricName: "activity-info-count", metricType: Timer},
-		TimerInfoCount:                               {metricName: "timer-info-count", metricType: Timer},
-		ChildInfoCount:                               {metricName: "child-info-count", metricType: Timer},
-		SignalInfoCount:                              {metricName: "signal-info-count", metricType: Timer},
-		RequestCancelInfoCount:                       {metricName: "request-cancel-info-count", metricType: Timer},
-		BufferedEventsCount:                          {metricName: "buffered-events-count", metricType: Timer},
-		BufferedReplicationTasksCount:                {metricName: "buffered-replication-tasks-count", metricType: Timer},
-		DeleteActivityInfoCount:                      {metricName: "delete-activity-info", metricType: Timer},
-		DeleteTimerInfoCount:                         {metricName: "delete-timer-info", metricType: Timer},
-		DeleteChildInfoCount:                         {metricName: "delete-child-info", metricType: Timer},
-		DeleteSignalInfoCount:                        {metricName: "delete-signal-info", metricType: Timer},
-		DeleteRequestCancelInfoCount:                 {metricName: "delete-request-cancel-info", metricType: Timer},
-		WorkflowRetryBackoffTimerCount:               {metricName: "workflow-retry-backoff-timer", metricType: Counter},
-		WorkflowCronBackoffTimerCount:                {metricName: "workflow-cron-backoff-timer", metricType: Counter},
+		TaskRequests:                                      {metricName: "task.requests", metricType: Counter},
+		TaskLatency:                                       {metricName: "task.latency", metricType: Timer},
+		TaskAttemptTimer:                                  {metricName: "task.attempt", metricType: Timer},
+		TaskFailures:                                      {metricName: "task.errors", metricType: Counter},
+		TaskDiscarded:                                     {metricName: "task.errors.discarded", metricType: Counter},
+		TaskStandbyRetryCounter:                           {metricName: "task.errors.standby-retry-counter", metricType: Counter},
+		TaskNotActiveCounter:                              {metricName: "task.errors.not-active-counter", metricType: Counter},
+		TaskLimitExceededCounter:                          {metricName: "task.errors.limit-exceeded-counter", metricType: Counter},
+		TaskProcessingLatency:                             {metricName: "task.latency.processing", metricType: Timer},
+		TaskQueueLatency:                                  {metricName: "task.latency.queue", metricType: Timer},
+		TaskBatchCompleteCounter:                          {metricName: "task.batch-complete-counter", metricType: Counter},
+		AckLevelUpdateCounter:                             {metricName: "ack-level-update", metricType: Counter},
+		AckLevelUpdateFailedCounter:                       {metricName: "ack-level-update-failed", metricType: Counter},
+		DecisionTypeScheduleActivityCounter:               {metricName: "schedule-activity-decision", metricType: Counter},
+		DecisionTypeCompleteWorkflowCounter:               {metricName: "complete-workflow-decision", metricType: Counter},
+		DecisionTypeFailWorkflowCounter:                   {metricName: "fail-workflow-decision", metricType: Counter},
+		DecisionTypeCancelWorkflowCounter:                 {metricName: "cancel-workflow-decision", metricType: Counter},
+		DecisionTypeStartTimerCounter:                     {metricName: "start-timer-decision", metricType: Counter},
+		DecisionTypeCancelActivityCounter:                 {metricName: "cancel-activity-decision", metricType: Counter},
+		DecisionTypeCancelTimerCounter:                    {metricName: "cancel-timer-decision", metricType: Counter},
+		DecisionTypeRecordMarkerCounter:                   {metricName: "record-marker-decision", metricType: Counter},
+		DecisionTypeCancelExternalWorkflowCounter:         {metricName: "cancel-external-workflow-decision", metricType: Counter},
+		DecisionTypeContinueAsNewCounter:                  {metricName: "continue-as-new-decision", metricType: Counter},
+		DecisionTypeSignalExternalWorkflowCounter:         {metricName: "signal-external-workflow-decision", metricType: Counter},
+		DecisionTypeUpsertWorkflowSearchAttributesCounter: {metricName: "upsert-workflow-search-attributes-decision", metricType: Counter},
+		DecisionTypeChildWorkflowCounter:                  {metricName: "child-workflow-decision", metricType: Counter},
+		MultipleCompletionDecisionsCounter:                {metricName: "multiple-completion-decisions", metricType: Counter},
+		FailedDecisionsCounter:                            {metricName: "failed-decisions", metricType: Counter},
+		StaleMutableStateCounter:                          {metricName: "stale-mutable-state", metricType: Counter},
+		ConcurrencyUpdateFailureCounter:                   {metricName: "concurrency-update-failure", metricType: Counter},
+		CadenceErrShardOwnershipLostCounter:               {metricName: "cadence.errors.shard-ownership-lost", metricType: Counter},
+		CadenceErrEventAlreadyStartedCounter:              {metricName: "cadence.errors.event-already-started", metricType: Counter},
+		HeartbeatTimeoutCounter:                           {metricName: "heartbeat-timeout", metricType: Counter},
+		ScheduleToStartTimeoutCounter:                     {metricName: "schedule-to-start-timeout", metricType: Counter},
+		StartToCloseTimeoutCounter:                        {metricName: "start-to-close-timeout", metricType: Counter},
+		ScheduleToCloseTimeoutCounter:                     {metricName: "schedule-to-close-timeout", metricType: Counter},
+		NewTimerCounter:                                   {metricName: "new-timer", metricType: Counter},
+		NewTimerNotifyCounter:                             {metricName: "new-timer-notifications", metricType: Counter},
+		AcquireShardsCounter:                              {metricName: "acquire-shards-count", metricType: Counter},
+		AcquireShardsLatency:                              {metricName: "acquire-shards-latency", metricType: Timer},
+		ShardClosedCounter:                                {metricName: "shard-closed-count", metricType: Counter},
+		ShardItemCreatedCounter:                           {metricName: "sharditem-created-count", metricType: Counter},
+		ShardItemRemovedCounter:                           {metricName: "sharditem-removed-count", metricType: Counter},
+		ShardInfoReplicationPendingTasksTimer:             {metricName: "shardinfo-replication-pending-task", metricType: Timer},
+		ShardInfoTransferActivePendingTasksTimer:          {metricName: "shardinfo-transfer-active-pending-task", metricType: Timer},
+		ShardInfoTransferStandbyPendingTasksTimer:         {metricName: "shardinfo-transfer-standby-pending-task", metricType: Timer},
+		ShardInfoTimerActivePendingTasksTimer:             {metricName: "shardinfo-timer-active-pending-task", metricType: Timer},
+		ShardInfoTimerStandbyPendingTasksTimer:            {metricName: "shardinfo-timer-standby-pending-task", metricType: Timer},
+		ShardInfoReplicationLagTimer:                      {metricName: "shardinfo-replication-lag", metricType: Timer},
+		ShardInfoTransferLagTimer:                         {metricName: "shardinfo-transfer-lag", metricType: Timer},
+		ShardInfoTimerLagTimer:                            {metricName: "shardinfo-timer-lag", metricType: Timer},
+		ShardInfoTransferDiffTimer:                        {metricName: "shardinfo-transfer-diff", metricType: Timer},
+		ShardInfoTimerDiffTimer:                           {metricName: "shardinfo-timer-diff", metricType: Timer},
+		ShardInfoTransferFailoverInProgressTimer:          {metricName: "shardinfo-transfer-failover-in-progress", metricType: Timer},
+		ShardInfoTimerFailoverInProgressTimer:             {metricName: "shardinfo-timer-failover-in-progress", metricType: Timer},
+		ShardInfoTransferFailoverLatencyTimer:             {metricName: "shardinfo-transfer-failover-latency", metricType: Timer},
+		ShardInfoTimerFailoverLatencyTimer:                {metricName: "shardinfo-timer-failover-latency", metricType: Timer},
+		MembershipChangedCounter:                          {metricName: "membership-changed-count", metricType: Counter},
+		NumShardsGauge:                                    {metricName: "numshards-gauge", metricType: Gauge},
+		GetEngineForShardErrorCounter:                     {metricName: "get-engine-for-shard-errors", metricType: Counter},
+		GetEngineForShardLatency:                          {metricName: "get-engine-for-shard-latency", metricType: Timer},
+		RemoveEngineForShardLatency:                       {metricName: "remove-engine-for-shard-latency", metricType: Timer},
+		CompleteDecisionWithStickyEnabledCounter:          {metricName: "complete-decision-sticky-enabled-count", metricType: Counter},
+		CompleteDecisionWithStickyDisabledCounter:         {metricName: "complete-decision-sticky-disabled-count", metricType: Counter},
+		HistoryEventNotificationQueueingLatency:           {metricName: "history-event-notification-queueing-latency", metricType: Timer},
+		HistoryEventNotificationFanoutLatency:             {metricName: "history-event-notification-fanout-latency", metricType: Timer},
+		HistoryEventNotificationInFlightMessageGauge:      {metricName: "history-event-notification-inflight-message-gauge", metricType: Gauge},
+		HistoryEventNotificationFailDeliveryCount:         {metricName: "history-event-notification-fail-delivery-count", metricType: Counter},
+		EmptyReplicationEventsCounter:                     {metricName: "empty-replication-events", metricType: Counter},
+		DuplicateReplicationEventsCounter:                 {metricName: "duplicate-replication-events", metricType: Counter},
+		StaleReplicationEventsCounter:                     {metricName: "stale-replication-events", metricType: Counter},
+		ReplicationEventsSizeTimer:                        {metricName: "replication-events-size", metricType: Timer},
+		BufferReplicationTaskTimer:                        {metricName: "buffer-replication-tasks", metricType: Timer},
+		UnbufferReplicationTaskTimer:                      {metricName: "unbuffer-replication-tasks", metricType: Timer},
+		HistoryConflictsCounter:                           {metricName: "history-conflicts", metricType: Counter},
+		CompleteTaskFailedCounter:                         {metricName: "complete-task-fail-count", metricType: Counter},
+		HistoryCacheRequests:                              {metricName: "history-cache.requests", metricType: Counter},
+		HistoryCacheFailures:                              {metricName: "history-cache.errors", metricType: Counter},
+		HistoryCacheLatency:                               {metricName: "history-cache.latency", metricType: Timer},
+		CacheMissCounter:                                  {metricName: "cache-miss", metricType: Counter},
+		AcquireLockFailedCounter:                          {metricName: "acquire-lock-failed", metricType: Counter},
+		WorkflowContextCleared:                            {metricName: "workflow-context-cleared", metricType: Counter},
+		MutableStateSize:                                  {metricName: "mutable-state-size", metricType: Timer},
+		ExecutionInfoSize:                                 {metricName: "execution-info-size", metricType: Timer},
+		ActivityInfoSize:                                  {metricName: "activity-info-size", metricType: Timer},
+		TimerInfoSize:                                     {metricName: "timer-info-size", metricType: Timer},
+		ChildInfoSize:                                     {metricName: "child-info-size", metricType: Timer},
+		SignalInfoSize:                                    {metricName: "signal-info", metricType: Timer},
+		BufferedEventsSize:                                {metricName: "buffered-events-size", metricType: Timer},
+		BufferedReplicationTasksSize:                      {metricName: "buffered-replication-tasks-size", metricType: Timer},
+		ActivityInfoCount:                                 {metricName: "activity-info-count", metricType: Timer},
+		TimerInfoCount:                                    {metricName: "timer-info-count", metricType: Timer},
+		ChildInfoCount:                                    {metricName: "child-info-count", metricType: Timer},
+		SignalInfoCount:                                   {metricName: "signal-info-count", metricType: Timer},
+		RequestCancelInfoCount:                            {metricName: "request-cancel-info-count", metricType: Timer},
+		BufferedEventsCount:                               {metricName: "buffered-events-count", metricType: Timer},
+		BufferedReplicationTasksCount:                     {metricName: "buffered-replication-tasks-count", metricType: Timer},
+		DeleteActivityInfoCount:                           {metricName: "delete-activity-info", metricType: Timer},
+		DeleteTimerInfoCount:                              {metricName: "delete-timer-info", metricType: Timer},
+		DeleteChildInfoCount:                              {metricName: "delete-child-info", metricType: Timer},
+		DeleteSignalInfoCount:                             {metricName: "delete-signal-info", metricType: Timer},
+		DeleteRequestCancelInfoCount:                      {metricName: "delete-request-cancel-info", metricType: Timer},
+		WorkflowRetryBackoffTimerCount:                    {metricName: "workflow-retry-backoff-timer", metricType: Counter},
+		WorkflowCronBackoffTimerCount:                     {metricName: "workflow-cron-backoff-timer", metricType: Counter},
+		ReplicationDLQSizeGauge:                           {metricName: "replication-dlq-size", metricType: Gauge},
+		ReplicationDLQEnqueuedCounter:                     {metricName: "replication-dlq-enqueued", metricType: Counter},
 	},
 	Matching: {
 		PollSuccessCounter:            {metricName: "poll.success"},
@@ -1217,11 +1435,21 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		SyncThrottleCounter:           {metricName: "sync.throttle.count"},
 		BufferThrottleCounter:         {metricName: "buffer.throttle.count"},
 		SyncMatchLatency:              {metricName: "syncmatch.latency", metricType: Timer},
+		ForwardedTaskCounter:          {metricName: "forwarded.tasks"},
+		ForwardedPollCounter:          {metricName: "forwarded.polls"},
 	},
 	Worker: {
-		ReplicatorMessages: {metricName: "replicator.messages"},
-		ReplicatorFailures: {metricName: "replicator.errors"},
-		ReplicatorLatency:  {metricName: "replicator.latency"},
+		ReplicatorMessages:        {metricName: "replicator.messages"},
+		ReplicatorFailures:        {metricName: "replicator.errors"},
+		ReplicatorLatency:         {metricName: "replicator.latency"},
+		IndexerMessages:           {metricName: "indexer.messages"},
+		IndexerFailures:           {metricName: "indexer.errors"},
+		IndexerBulkLatency:        {metricName: "indexer.bulk-latency"},
+		ReplicatorDLQEnqueued:     {metricName: "replicator.dlq-enqueued"},
+		VerifierWorkflowsVerified: {metricName: "verifier.workflows-verified"},
+		VerifierWorkflowsDiverged: {metricName: "verifier.workflows-diverged"},
+		VerifierWorkflowsRepaired: {metricName: "verifier.workflows-repaired"},
+		VerifierFailures:          {metricName: "verifier.errors"},
 	},
 }
 
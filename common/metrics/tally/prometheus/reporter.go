@@ -0,0 +1,103 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package prometheus
+
+import (
+	"github.com/uber-go/tally"
+	tallyprometheus "github.com/uber-go/tally/prometheus"
+	"github.com/uber/cadence/common/metrics"
+)
+
+// noneTagValue is reported for the tags a metric is not tagged with
+const noneTagValue = "none"
+
+// tagKeys are the tags cadence scopes can carry, on top of the common tags of the service
+var tagKeys = []string{
+	metrics.OperationTagName,
+	metrics.ShardTagName,
+	metrics.CadenceRoleTagName,
+	metrics.StatsTypeTagName,
+	metrics.DomainTagName,
+	metrics.SourceClusterTagName,
+}
+
+type cadenceTallyPrometheusReporter struct {
+	//Wrapper on top of "github.com/uber-go/tally/prometheus"
+	tallyprometheus tally.CachedStatsReporter
+	tagKeys         []string
+}
+
+// NewReporter is a wrapper on top of "github.com/uber-go/tally/prometheus"
+// The purpose is to report every metric with the same set of labels
+// Prometheus refuses a metric whose label names differ from the ones it was
+// first registered with, while the cadence scopes of a single metric carry
+// different tags, e.g. only some persistence scopes are tagged with a shard.
+// The implementation is to add the missing tags with a value of "none"
+func NewReporter(reporter tally.CachedStatsReporter) tally.CachedStatsReporter {
+	sanitizer := tally.NewSanitizer(tallyprometheus.DefaultSanitizerOpts)
+	keys := make([]string, 0, len(tagKeys))
+	for _, key := range tagKeys {
+		keys = append(keys, sanitizer.Key(key))
+	}
+	return &cadenceTallyPrometheusReporter{
+		tallyprometheus: reporter,
+		tagKeys:         keys,
+	}
+}
+
+func (r *cadenceTallyPrometheusReporter) tagsWithDefaults(tags map[string]string) map[string]string {
+	result := make(map[string]string, len(tags)+len(r.tagKeys))
+	for _, key := range r.tagKeys {
+		result[key] = noneTagValue
+	}
+	for key, value := range tags {
+		result[key] = value
+	}
+	return result
+}
+
+func (r *cadenceTallyPrometheusReporter) AllocateCounter(name string, tags map[string]string) tally.CachedCount {
+	return r.tallyprometheus.AllocateCounter(name, r.tagsWithDefaults(tags))
+}
+
+func (r *cadenceTallyPrometheusReporter) AllocateGauge(name string, tags map[string]string) tally.CachedGauge {
+	return r.tallyprometheus.AllocateGauge(name, r.tagsWithDefaults(tags))
+}
+
+func (r *cadenceTallyPrometheusReporter) AllocateTimer(name string, tags map[string]string) tally.CachedTimer {
+	return r.tallyprometheus.AllocateTimer(name, r.tagsWithDefaults(tags))
+}
+
+func (r *cadenceTallyPrometheusReporter) AllocateHistogram(
+	name string,
+	tags map[string]string,
+	buckets tally.Buckets,
+) tally.CachedHistogram {
+	return r.tallyprometheus.AllocateHistogram(name, r.tagsWithDefaults(tags), buckets)
+}
+
+func (r *cadenceTallyPrometheusReporter) Capabilities() tally.Capabilities {
+	return r.tallyprometheus.Capabilities()
+}
+
+func (r *cadenceTallyPrometheusReporter) Flush() {
+	r.tallyprometheus.Flush()
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package prometheus
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+	tallyprometheus "github.com/uber-go/tally/prometheus"
+	"github.com/uber/cadence/common/metrics"
+)
+
+func TestTagsWithDefaults(t *testing.T) {
+	r := NewReporter(nil).(*cadenceTallyPrometheusReporter)
+	tags := map[string]string{
+		"operation": "GetShard",
+		"service":   "history",
+	}
+
+	assert.Equal(t, map[string]string{
+		"operation":      "GetShard",
+		"service":        "history",
+		"shard":          noneTagValue,
+		"cadence_role":   noneTagValue,
+		"stats_type":     noneTagValue,
+		"domain":         noneTagValue,
+		"source_cluster": noneTagValue,
+	}, r.tagsWithDefaults(tags))
+}
+
+func TestReportDifferentlyTaggedScopes(t *testing.T) {
+	var errs []error
+	reporter := tallyprometheus.NewReporter(tallyprometheus.Options{
+		Registerer: prom.NewRegistry(),
+		OnRegisterError: func(err error) {
+			errs = append(errs, err)
+		},
+	})
+	scope, closer := tally.NewRootScope(tally.ScopeOptions{
+		CachedReporter:  NewReporter(reporter),
+		Separator:       tallyprometheus.DefaultSeparator,
+		SanitizeOptions: &tallyprometheus.DefaultSanitizerOpts,
+	}, time.Second)
+
+	scope.Tagged(map[string]string{
+		metrics.OperationTagName: "GetShard",
+		metrics.ShardTagName:     metrics.NoneShardsTagValue,
+	}).Counter("cadence.requests").Inc(1)
+	scope.Tagged(map[string]string{
+		metrics.OperationTagName: "CreateWorkflowExecution",
+	}).Counter("cadence.requests").Inc(2)
+	scope.Tagged(map[string]string{
+		metrics.OperationTagName:     "ReplicationDLQ",
+		metrics.SourceClusterTagName: "standby",
+	}).Counter("cadence.requests").Inc(3)
+	closer.Close()
+
+	assert.Empty(t, errs)
+
+	recorder := httptest.NewRecorder()
+	reporter.HTTPHandler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	body := recorder.Body.String()
+	assert.Contains(t, body,
+		`cadence_requests{cadence_role="none",domain="none",operation="GetShard",shard="NONE",source_cluster="none",stats_type="none"} 1`)
+	assert.Contains(t, body,
+		`cadence_requests{cadence_role="none",domain="none",operation="CreateWorkflowExecution",shard="none",source_cluster="none",stats_type="none"} 2`)
+	assert.Contains(t, body,
+		`cadence_requests{cadence_role="none",domain="none",operation="ReplicationDLQ",shard="none",source_cluster="standby",stats_type="none"} 3`)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"go.uber.org/yarpc"
 )
@@ -105,3 +106,238 @@ func (_m *AdminClient) GetWorkflowExecutionRawHistory(ctx context.Context, reque
 
 	return r0, r1
 }
+
+// ReadHistoryBranch provides a mock function with given fields: ctx, request
+func (_m *AdminClient) ReadHistoryBranch(ctx context.Context, request *admin.ReadHistoryBranchRequest, opts ...yarpc.CallOption) (*admin.ReadHistoryBranchResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *admin.ReadHistoryBranchResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ReadHistoryBranchRequest) *admin.ReadHistoryBranchResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.ReadHistoryBranchResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.ReadHistoryBranchRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDomainIDOrName provides a mock function with given fields: ctx, request
+func (_m *AdminClient) GetDomainIDOrName(ctx context.Context, request *admin.GetDomainIDOrNameRequest, opts ...yarpc.CallOption) (*admin.GetDomainIDOrNameResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *admin.GetDomainIDOrNameResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.GetDomainIDOrNameRequest) *admin.GetDomainIDOrNameResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.GetDomainIDOrNameResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.GetDomainIDOrNameRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDomain provides a mock function with given fields: ctx, request
+func (_m *AdminClient) DeleteDomain(ctx context.Context, request *admin.DeleteDomainRequest, opts ...yarpc.CallOption) (*admin.DeleteDomainResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *admin.DeleteDomainResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.DeleteDomainRequest) *admin.DeleteDomainResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.DeleteDomainResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.DeleteDomainRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartBatch provides a mock function with given fields: ctx, request
+func (_m *AdminClient) StartBatch(ctx context.Context, request *admin.StartBatchRequest, opts ...yarpc.CallOption) (*admin.StartBatchResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *admin.StartBatchResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.StartBatchRequest) *admin.StartBatchResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.StartBatchResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.StartBatchRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *AdminClient) DeleteWorkflowExecution(ctx context.Context, request *admin.DeleteWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.DeleteWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetReplicationMessages provides a mock function with given fields: ctx, request
+func (_m *AdminClient) GetReplicationMessages(ctx context.Context, request *replicator.GetReplicationMessagesRequest, opts ...yarpc.CallOption) (*replicator.ReplicationMessages, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.ReplicationMessages
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.GetReplicationMessagesRequest) *replicator.ReplicationMessages); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.ReplicationMessages)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *replicator.GetReplicationMessagesRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDomainReplicationMessages provides a mock function with given fields: ctx, request
+func (_m *AdminClient) GetDomainReplicationMessages(ctx context.Context, request *replicator.GetDomainReplicationMessagesRequest, opts ...yarpc.CallOption) (*replicator.ReplicationMessages, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.ReplicationMessages
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.GetDomainReplicationMessagesRequest) *replicator.ReplicationMessages); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.ReplicationMessages)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *replicator.GetDomainReplicationMessagesRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadDLQMessages provides a mock function with given fields: ctx, request
+func (_m *AdminClient) ReadDLQMessages(ctx context.Context, request *replicator.ReadDLQMessagesRequest, opts ...yarpc.CallOption) (*replicator.ReadDLQMessagesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.ReadDLQMessagesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.ReadDLQMessagesRequest) *replicator.ReadDLQMessagesResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.ReadDLQMessagesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *replicator.ReadDLQMessagesRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeDLQMessages provides a mock function with given fields: ctx, request
+func (_m *AdminClient) MergeDLQMessages(ctx context.Context, request *replicator.MergeDLQMessagesRequest, opts ...yarpc.CallOption) (*replicator.MergeDLQMessagesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.MergeDLQMessagesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.MergeDLQMessagesRequest) *replicator.MergeDLQMessagesResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.MergeDLQMessagesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *replicator.MergeDLQMessagesRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeDLQMessages provides a mock function with given fields: ctx, request
+func (_m *AdminClient) PurgeDLQMessages(ctx context.Context, request *replicator.PurgeDLQMessagesRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.PurgeDLQMessagesRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VerifyWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *AdminClient) VerifyWorkflowExecution(ctx context.Context, request *admin.VerifyWorkflowExecutionRequest, opts ...yarpc.CallOption) (*admin.VerifyWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *admin.VerifyWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.VerifyWorkflowExecutionRequest) *admin.VerifyWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.VerifyWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.VerifyWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
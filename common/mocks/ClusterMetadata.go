@@ -172,6 +172,20 @@ func (_m *ClusterMetadata) GetAllClientAddress() map[string]config.Address {
 	return r0
 }
 
+// IsPullReplicationEnabled provides a mock function with given fields:
+func (_m *ClusterMetadata) IsPullReplicationEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // IsArchivalEnabled provides a mock function with given fields:
 func (_m *ClusterMetadata) IsArchivalEnabled() bool {
 	ret := _m.Called()
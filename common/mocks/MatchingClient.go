@@ -156,10 +156,10 @@ func (_m *MatchingClient) CancelOutstandingPoll(ctx context.Context,
 	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(1).(func(context.Context, *matching.CancelOutstandingPollRequest) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *matching.CancelOutstandingPollRequest) error); ok {
 		r0 = rf(ctx, request)
 	} else {
-		r0 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
 	return r0
@@ -23,6 +23,7 @@ package cassandra
 import (
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/uber-common/bark"
@@ -45,11 +46,13 @@ const (
 
 	// below are templates for history_tree table
 	v2templateInsertTree = `INSERT INTO history_tree (` +
-		`tree_id, branch_id, ancestors, in_progress) ` +
-		`VALUES (?, ?, ?, ?) `
+		`tree_id, branch_id, ancestors, in_progress, fork_time, info) ` +
+		`VALUES (?, ?, ?, ?, ?, ?) `
 
 	v2templateReadAllBranches = `SELECT branch_id, ancestors, in_progress FROM history_tree WHERE tree_id = ? `
 
+	v2templateScanAllTreeBranches = `SELECT tree_id, branch_id, fork_time, info FROM history_tree `
+
 	v2templateDeleteBranch = `DELETE FROM history_tree WHERE tree_id = ? AND branch_id = ? `
 )
 
@@ -125,7 +128,7 @@ func (h *cassandraHistoryV2Persistence) AppendHistoryNodes(request *p.InternalAp
 
 		batch := h.session.NewBatch(gocql.LoggedBatch)
 		batch.Query(v2templateInsertTree,
-			branchInfo.TreeID, branchInfo.BranchID, ancs, false)
+			branchInfo.TreeID, branchInfo.BranchID, ancs, false, time.Now(), request.Info)
 		batch.Query(v2templateUpsertData,
 			branchInfo.TreeID, branchInfo.BranchID, request.NodeID, request.TransactionID, request.Events.Data, request.Events.Encoding)
 		err = h.session.ExecuteBatch(batch)
@@ -281,7 +284,7 @@ func (h *cassandraHistoryV2Persistence) ForkHistoryBranch(request *p.InternalFor
 	// NOTE: To prevent leaking event data caused by forking, we introduce this in_progress flag.
 	// Insert nil as ancestor here, we assume append will insert the actual ancestors along with setting in_progress to false
 	query := h.session.Query(v2templateInsertTree,
-		treeID, request.NewBranchID, nil, true)
+		treeID, request.NewBranchID, nil, true, time.Now(), request.Info)
 
 	err := query.Exec()
 	if err != nil {
@@ -411,6 +414,52 @@ func (h *cassandraHistoryV2Persistence) GetHistoryTree(request *p.GetHistoryTree
 	}, nil
 }
 
+// GetAllHistoryTreeBranches returns the branches of all the history trees
+func (h *cassandraHistoryV2Persistence) GetAllHistoryTreeBranches(request *p.GetAllHistoryTreeBranchesRequest) (*p.GetAllHistoryTreeBranchesResponse, error) {
+	query := h.session.Query(v2templateScanAllTreeBranches)
+
+	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "GetAllHistoryTreeBranches operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	pagingToken := iter.PageState()
+	branches := make([]p.HistoryBranchDetail, 0, request.PageSize)
+	treeUUID := gocql.UUID{}
+	branchUUID := gocql.UUID{}
+	forkTime := time.Time{}
+	info := ""
+
+	for iter.Scan(&treeUUID, &branchUUID, &forkTime, &info) {
+		branches = append(branches, p.HistoryBranchDetail{
+			TreeID:   treeUUID.String(),
+			BranchID: branchUUID.String(),
+			ForkTime: forkTime,
+			Info:     info,
+		})
+
+		treeUUID = gocql.UUID{}
+		branchUUID = gocql.UUID{}
+		forkTime = time.Time{}
+		info = ""
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, convertCommonErrors("GetAllHistoryTreeBranches", err)
+	}
+
+	response := &p.GetAllHistoryTreeBranchesResponse{
+		Branches: branches,
+	}
+	if len(pagingToken) > 0 {
+		response.NextPageToken = make([]byte, len(pagingToken))
+		copy(response.NextPageToken, pagingToken)
+	}
+	return response, nil
+}
+
 func (h *cassandraHistoryV2Persistence) parseBranchAncestors(ancestors []map[string]interface{}) []*workflow.HistoryBranchRange {
 	ans := make([]*workflow.HistoryBranchRange, 0, len(ancestors))
 	for _, e := range ancestors {
@@ -64,6 +64,9 @@ const (
 	rowTypeReplicationDomainID   = "10000000-5000-f000-f000-000000000000"
 	rowTypeReplicationWorkflowID = "20000000-5000-f000-f000-000000000000"
 	rowTypeReplicationRunID      = "30000000-5000-f000-f000-000000000000"
+	// Row Constants for Replication DLQ Row, the workflow ID of the row is the source cluster name
+	rowTypeDLQDomainID = "10000000-6000-f000-f000-000000000000"
+	rowTypeDLQRunID    = "30000000-6000-f000-f000-000000000000"
 	// Special TaskId constants
 	rowTypeExecutionTaskID  = int64(-10)
 	rowTypeShardTaskID      = int64(-11)
@@ -83,6 +86,7 @@ const (
 	rowTypeTransferTask
 	rowTypeTimerTask
 	rowTypeReplicationTask
+	rowTypeDLQ
 )
 
 const (
@@ -108,6 +112,7 @@ const (
 		`timer_ack_level: ?, ` +
 		`cluster_transfer_ack_level: ?, ` +
 		`cluster_timer_ack_level: ?, ` +
+		`cluster_replication_level: ?, ` +
 		`domain_notification_version: ? ` +
 		`}`
 
@@ -143,6 +148,8 @@ const (
 		`decision_timeout: ?, ` +
 		`decision_attempt: ?, ` +
 		`decision_timestamp: ?, ` +
+		`decision_scheduled_timestamp: ?, ` +
+		`decision_started_timestamp: ?, ` +
 		`cancel_requested: ?, ` +
 		`cancel_request_id: ?, ` +
 		`sticky_task_list: ?, ` +
@@ -161,7 +168,9 @@ const (
 		`event_store_version: ?, ` +
 		`branch_token: ?, ` +
 		`cron_schedule: ?, ` +
-		`expiration_seconds: ? ` +
+		`expiration_seconds: ?, ` +
+		`memo: ?, ` +
+		`search_attributes: ? ` +
 		`}`
 
 	templateReplicationStateType = `{` +
@@ -205,6 +214,15 @@ const (
 		`new_run_branch_token: ? ` +
 		`}`
 
+	templateReplicationDLQTaskType = `{` +
+		`domain_id: ?, ` +
+		`workflow_id: ?, ` +
+		`run_id: ?, ` +
+		`task_id: ?, ` +
+		`data: ?, ` +
+		`data_encoding: ? ` +
+		`}`
+
 	templateTimerTaskType = `{` +
 		`domain_id: ?, ` +
 		`workflow_id: ?, ` +
@@ -247,6 +265,7 @@ const (
 		`expiration_time: ?, ` +
 		`max_attempts: ?, ` +
 		`non_retriable_errors: ?, ` +
+		`last_failure_reason: ?, ` +
 		`event_data_encoding: ?` +
 		`}`
 
@@ -382,6 +401,14 @@ workflow_state = ? ` +
 		`shard_id, domain_id, workflow_id, run_id, type, execution, replication_state, next_event_id, visibility_ts, task_id) ` +
 		`VALUES(?, ?, ?, ?, ?, ` + templateWorkflowExecutionType + `, ` + templateReplicationStateType + `, ?, ?, ?) `
 
+	templateResetWorkflowExecutionQuery = `INSERT INTO executions (` +
+		`shard_id, domain_id, workflow_id, run_id, type, execution, activity_map, timer_map, signal_requested, next_event_id, visibility_ts, task_id) ` +
+		`VALUES(?, ?, ?, ?, ?, ` + templateWorkflowExecutionType + `, ?, ?, ?, ?, ?, ?) `
+
+	templateResetWorkflowExecutionWithReplicationQuery = `INSERT INTO executions (` +
+		`shard_id, domain_id, workflow_id, run_id, type, execution, replication_state, activity_map, timer_map, signal_requested, next_event_id, visibility_ts, task_id) ` +
+		`VALUES(?, ?, ?, ?, ?, ` + templateWorkflowExecutionType + `, ` + templateReplicationStateType + `, ?, ?, ?, ?, ?, ?) `
+
 	templateCreateTransferTaskQuery = `INSERT INTO executions (` +
 		`shard_id, type, domain_id, workflow_id, run_id, transfer, visibility_ts, task_id) ` +
 		`VALUES(?, ?, ?, ?, ?, ` + templateTransferTaskType + `, ?, ?)`
@@ -390,6 +417,10 @@ workflow_state = ? ` +
 		`shard_id, type, domain_id, workflow_id, run_id, replication, visibility_ts, task_id) ` +
 		`VALUES(?, ?, ?, ?, ?, ` + templateReplicationTaskType + `, ?, ?)`
 
+	templateCreateReplicationDLQTaskQuery = `INSERT INTO executions (` +
+		`shard_id, type, domain_id, workflow_id, run_id, replication_dlq, visibility_ts, task_id) ` +
+		`VALUES(?, ?, ?, ?, ?, ` + templateReplicationDLQTaskType + `, ?, ?)`
+
 	templateCreateTimerTaskQuery = `INSERT INTO executions (` +
 		`shard_id, type, domain_id, workflow_id, run_id, timer, visibility_ts, task_id) ` +
 		`VALUES(?, ?, ?, ?, ?, ` + templateTimerTaskType + `, ?, ?)`
@@ -425,6 +456,16 @@ workflow_state = ? ` +
 		`and visibility_ts = ? ` +
 		`and task_id = ?`
 
+	templateListConcreteExecutionsQuery = `SELECT domain_id, workflow_id, run_id ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ?`
+
+	templateListCurrentExecutionsQuery = `SELECT domain_id, workflow_id, run_id, current_run_id, execution ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ?`
+
 	templateUpdateWorkflowExecutionQuery = `UPDATE executions ` +
 		`SET execution = ` + templateWorkflowExecutionType + `, next_event_id = ? ` +
 		`WHERE shard_id = ? ` +
@@ -709,6 +750,9 @@ workflow_state = ? ` +
 		`and visibility_ts = ? ` +
 		`and task_id = ? `
 
+	templateDeleteCurrentWorkflowExecutionQuery = templateDeleteWorkflowExecutionMutableStateQuery +
+		`IF current_run_id = ? `
+
 	templateDeleteWorkflowExecutionSignalRequestedQuery = `UPDATE executions ` +
 		`SET signal_requested = signal_requested - ? ` +
 		`WHERE shard_id = ? ` +
@@ -742,6 +786,24 @@ workflow_state = ? ` +
 		`and task_id > ? ` +
 		`and task_id <= ?`
 
+	templateGetReplicationDLQTasksQuery = `SELECT replication_dlq ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ?`
+
+	templateGetReplicationDLQSizeQuery = `SELECT count(1) as count ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ?`
+
 	templateCompleteTransferTaskQuery = `DELETE FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -797,7 +859,8 @@ workflow_state = ? ` +
 		`domain_id, task_list_name, task_list_type, type, task_id, task) ` +
 		`VALUES(?, ?, ?, ?, ?, ` + templateTaskType + `) USING TTL ?`
 
-	templateGetTasksQuery = `SELECT task_id, task ` +
+	// the tasks are not updated once they are created, so the write time of a task is its creation time
+	templateGetTasksQuery = `SELECT task_id, task, writetime(task) as created_time ` +
 		`FROM tasks ` +
 		`WHERE domain_id = ? ` +
 		`and task_list_name = ? ` +
@@ -813,6 +876,21 @@ workflow_state = ? ` +
 		`and type = ? ` +
 		`and task_id = ?`
 
+	templateGetTaskIDsLessThanQuery = `SELECT task_id ` +
+		`FROM tasks ` +
+		`WHERE domain_id = ? ` +
+		`and task_list_name = ? ` +
+		`and task_list_type = ? ` +
+		`and type = ? ` +
+		`and task_id < ?`
+
+	templateCompleteTasksLessThanQuery = `DELETE FROM tasks ` +
+		`WHERE domain_id = ? ` +
+		`and task_list_name = ? ` +
+		`and task_list_type = ? ` +
+		`and type = ? ` +
+		`and task_id < ?`
+
 	templateGetTaskList = `SELECT ` +
 		`range_id, ` +
 		`task_list ` +
@@ -843,6 +921,32 @@ workflow_state = ? ` +
 		`and task_id = ? ` +
 		`IF range_id = ?`
 
+	// the task lists are not indexed by domain, so listing them scans the whole table
+	templateListTaskListQuery = `SELECT ` +
+		`domain_id, ` +
+		`task_list_name, ` +
+		`task_list_type, ` +
+		`range_id, ` +
+		`task_list, ` +
+		`writetime(task_list) ` +
+		`FROM tasks ` +
+		`WHERE type = ? ` +
+		`ALLOW FILTERING`
+
+	templateDeleteTaskListTasksQuery = `DELETE FROM tasks ` +
+		`WHERE domain_id = ? ` +
+		`and task_list_name = ? ` +
+		`and task_list_type = ? ` +
+		`and type = ?`
+
+	templateDeleteTaskListQuery = `DELETE FROM tasks ` +
+		`WHERE domain_id = ? ` +
+		`and task_list_name = ? ` +
+		`and task_list_type = ? ` +
+		`and type = ? ` +
+		`and task_id = ? ` +
+		`IF range_id = ?`
+
 	templateUpdateTaskListQueryWithTTL = `INSERT INTO tasks (` +
 		`domain_id, ` +
 		`task_list_name, ` +
@@ -956,6 +1060,7 @@ func (d *cassandraPersistence) CreateShard(request *p.CreateShardRequest) error
 		shardInfo.TimerAckLevel,
 		shardInfo.ClusterTransferAckLevel,
 		shardInfo.ClusterTimerAckLevel,
+		shardInfo.ClusterReplicationLevel,
 		shardInfo.DomainNotificationVersion,
 		shardInfo.RangeID)
 
@@ -1031,6 +1136,7 @@ func (d *cassandraPersistence) UpdateShard(request *p.UpdateShardRequest) error
 		shardInfo.TimerAckLevel,
 		shardInfo.ClusterTransferAckLevel,
 		shardInfo.ClusterTimerAckLevel,
+		shardInfo.ClusterReplicationLevel,
 		shardInfo.DomainNotificationVersion,
 		shardInfo.RangeID,
 		shardInfo.ShardID,
@@ -1344,6 +1450,8 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *p.Cre
 			request.DecisionStartToCloseTimeout,
 			0,
 			0,
+			request.DecisionScheduledTimestamp,
+			0,
 			false,
 			"",
 			"", // sticky_task_list (no sticky tasklist for new workflow execution)
@@ -1363,6 +1471,8 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *p.Cre
 			request.BranchToken,
 			request.CronSchedule,
 			request.ExpirationSeconds,
+			request.Memo,
+			request.SearchAttributes,
 			request.NextEventID,
 			defaultVisibilityTimestamp,
 			rowTypeExecutionTaskID)
@@ -1409,6 +1519,8 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *p.Cre
 			request.DecisionStartToCloseTimeout,
 			0,
 			0,
+			request.DecisionScheduledTimestamp,
+			0,
 			false,
 			"",
 			"", // sticky_task_list (no sticky tasklist for new workflow execution)
@@ -1428,6 +1540,8 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *p.Cre
 			request.BranchToken,
 			request.CronSchedule,
 			request.ExpirationSeconds,
+			request.Memo,
+			request.SearchAttributes,
 			request.ReplicationState.CurrentVersion,
 			request.ReplicationState.StartVersion,
 			request.ReplicationState.LastWriteVersion,
@@ -1548,141 +1662,7 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *p.InternalUpdate
 	executionInfo := request.ExecutionInfo
 	replicationState := request.ReplicationState
 
-	completionData, completionEncoding := p.FromDataBlob(executionInfo.CompletionEvent)
-	if replicationState == nil {
-		// Updates will be called with null ReplicationState while the feature is disabled
-		batch.Query(templateUpdateWorkflowExecutionQuery,
-			executionInfo.DomainID,
-			executionInfo.WorkflowID,
-			executionInfo.RunID,
-			executionInfo.ParentDomainID,
-			executionInfo.ParentWorkflowID,
-			executionInfo.ParentRunID,
-			executionInfo.InitiatedID,
-			completionData,
-			completionEncoding,
-			executionInfo.TaskList,
-			executionInfo.WorkflowTypeName,
-			executionInfo.WorkflowTimeout,
-			executionInfo.DecisionTimeoutValue,
-			executionInfo.ExecutionContext,
-			executionInfo.State,
-			executionInfo.CloseStatus,
-			executionInfo.LastFirstEventID,
-			executionInfo.NextEventID,
-			executionInfo.LastProcessedEvent,
-			executionInfo.StartTimestamp,
-			cqlNowTimestamp,
-			executionInfo.CreateRequestID,
-			executionInfo.SignalCount,
-			executionInfo.HistorySize,
-			executionInfo.DecisionVersion,
-			executionInfo.DecisionScheduleID,
-			executionInfo.DecisionStartedID,
-			executionInfo.DecisionRequestID,
-			executionInfo.DecisionTimeout,
-			executionInfo.DecisionAttempt,
-			executionInfo.DecisionTimestamp,
-			executionInfo.CancelRequested,
-			executionInfo.CancelRequestID,
-			executionInfo.StickyTaskList,
-			executionInfo.StickyScheduleToStartTimeout,
-			executionInfo.ClientLibraryVersion,
-			executionInfo.ClientFeatureVersion,
-			executionInfo.ClientImpl,
-			executionInfo.Attempt,
-			executionInfo.HasRetryPolicy,
-			executionInfo.InitialInterval,
-			executionInfo.BackoffCoefficient,
-			executionInfo.MaximumInterval,
-			executionInfo.ExpirationTime,
-			executionInfo.MaximumAttempts,
-			executionInfo.NonRetriableErrors,
-			executionInfo.EventStoreVersion,
-			executionInfo.BranchToken,
-			executionInfo.CronSchedule,
-			executionInfo.ExpirationSeconds,
-			executionInfo.NextEventID,
-			d.shardID,
-			rowTypeExecution,
-			executionInfo.DomainID,
-			executionInfo.WorkflowID,
-			executionInfo.RunID,
-			defaultVisibilityTimestamp,
-			rowTypeExecutionTaskID,
-			request.Condition)
-	} else {
-		lastReplicationInfo := make(map[string]map[string]interface{})
-		for k, v := range replicationState.LastReplicationInfo {
-			lastReplicationInfo[k] = createReplicationInfoMap(v)
-		}
-
-		batch.Query(templateUpdateWorkflowExecutionWithReplicationQuery,
-			executionInfo.DomainID,
-			executionInfo.WorkflowID,
-			executionInfo.RunID,
-			executionInfo.ParentDomainID,
-			executionInfo.ParentWorkflowID,
-			executionInfo.ParentRunID,
-			executionInfo.InitiatedID,
-			completionData,
-			completionEncoding,
-			executionInfo.TaskList,
-			executionInfo.WorkflowTypeName,
-			executionInfo.WorkflowTimeout,
-			executionInfo.DecisionTimeoutValue,
-			executionInfo.ExecutionContext,
-			executionInfo.State,
-			executionInfo.CloseStatus,
-			executionInfo.LastFirstEventID,
-			executionInfo.NextEventID,
-			executionInfo.LastProcessedEvent,
-			executionInfo.StartTimestamp,
-			cqlNowTimestamp,
-			executionInfo.CreateRequestID,
-			executionInfo.SignalCount,
-			executionInfo.HistorySize,
-			executionInfo.DecisionVersion,
-			executionInfo.DecisionScheduleID,
-			executionInfo.DecisionStartedID,
-			executionInfo.DecisionRequestID,
-			executionInfo.DecisionTimeout,
-			executionInfo.DecisionAttempt,
-			executionInfo.DecisionTimestamp,
-			executionInfo.CancelRequested,
-			executionInfo.CancelRequestID,
-			executionInfo.StickyTaskList,
-			executionInfo.StickyScheduleToStartTimeout,
-			executionInfo.ClientLibraryVersion,
-			executionInfo.ClientFeatureVersion,
-			executionInfo.ClientImpl,
-			executionInfo.Attempt,
-			executionInfo.HasRetryPolicy,
-			executionInfo.InitialInterval,
-			executionInfo.BackoffCoefficient,
-			executionInfo.MaximumInterval,
-			executionInfo.ExpirationTime,
-			executionInfo.MaximumAttempts,
-			executionInfo.NonRetriableErrors,
-			executionInfo.EventStoreVersion,
-			executionInfo.BranchToken,
-			executionInfo.CronSchedule,
-			executionInfo.ExpirationSeconds,
-			replicationState.CurrentVersion,
-			replicationState.StartVersion,
-			replicationState.LastWriteVersion,
-			replicationState.LastWriteEventID,
-			lastReplicationInfo,
-			executionInfo.NextEventID,
-			d.shardID,
-			rowTypeExecution,
-			executionInfo.DomainID,
-			executionInfo.WorkflowID,
-			executionInfo.RunID,
-			defaultVisibilityTimestamp,
-			rowTypeExecutionTaskID,
-			request.Condition)
-	}
+	d.updateExecution(batch, executionInfo, replicationState, cqlNowTimestamp, request.Condition)
 
 	d.createTransferTasks(batch, request.TransferTasks, executionInfo.DomainID, executionInfo.WorkflowID,
 		executionInfo.RunID)
@@ -1828,69 +1808,218 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *p.InternalUpdate
 	return nil
 }
 
-func (d *cassandraPersistence) ResetMutableState(request *p.InternalResetMutableStateRequest) error {
-	batch := d.session.NewBatch(gocql.LoggedBatch)
-	cqlNowTimestamp := p.UnixNanoToDBTimestamp(time.Now().UnixNano())
-	executionInfo := request.ExecutionInfo
-	replicationState := request.ReplicationState
-
-	lastReplicationInfo := make(map[string]map[string]interface{})
-	for k, v := range replicationState.LastReplicationInfo {
-		lastReplicationInfo[k] = createReplicationInfoMap(v)
-	}
-
-	parentDomainID := emptyDomainID
-	if executionInfo.ParentDomainID != "" {
-		parentDomainID = executionInfo.ParentDomainID
-	}
-	parentRunID := emptyRunID
-	if executionInfo.ParentRunID != "" {
-		parentRunID = executionInfo.ParentRunID
-	}
-
-	batch.Query(templateUpdateCurrentWorkflowExecutionQuery,
-		executionInfo.RunID,
-		executionInfo.RunID,
-		executionInfo.CreateRequestID,
-		executionInfo.State,
-		executionInfo.CloseStatus,
-		replicationState.StartVersion,
-		replicationState.LastWriteVersion,
-		replicationState.LastWriteVersion,
-		executionInfo.State,
-		d.shardID,
-		rowTypeExecution,
-		executionInfo.DomainID,
-		executionInfo.WorkflowID,
-		permanentRunID,
-		defaultVisibilityTimestamp,
-		rowTypeExecutionTaskID,
-		request.PrevRunID,
-	)
+// updateExecution adds the update of the executions row of a run, conditioned on its next event id, to the batch
+func (d *cassandraPersistence) updateExecution(batch *gocql.Batch, executionInfo *p.InternalWorkflowExecutionInfo,
+	replicationState *p.ReplicationState, cqlNowTimestamp int64, condition int64) {
 
-	completionEvent := executionInfo.CompletionEvent
-	var completionEventData []byte
-	var completionEventEncoding common.EncodingType
-	if completionEvent != nil {
-		completionEventData = completionEvent.Data
-		completionEventEncoding = completionEvent.Encoding
-	}
-	batch.Query(templateUpdateWorkflowExecutionWithReplicationQuery,
-		executionInfo.DomainID,
-		executionInfo.WorkflowID,
-		executionInfo.RunID,
-		parentDomainID,
-		executionInfo.ParentWorkflowID,
-		parentRunID,
-		executionInfo.InitiatedID,
-		completionEventData,
-		completionEventEncoding,
-		executionInfo.TaskList,
-		executionInfo.WorkflowTypeName,
-		executionInfo.WorkflowTimeout,
-		executionInfo.DecisionTimeoutValue,
-		executionInfo.ExecutionContext,
-		executionInfo.State,
+	completionData, completionEncoding := p.FromDataBlob(executionInfo.CompletionEvent)
+	if replicationState == nil {
+		// Updates will be called with null ReplicationState while the feature is disabled
+		batch.Query(templateUpdateWorkflowExecutionQuery,
+			executionInfo.DomainID,
+			executionInfo.WorkflowID,
+			executionInfo.RunID,
+			executionInfo.ParentDomainID,
+			executionInfo.ParentWorkflowID,
+			executionInfo.ParentRunID,
+			executionInfo.InitiatedID,
+			completionData,
+			completionEncoding,
+			executionInfo.TaskList,
+			executionInfo.WorkflowTypeName,
+			executionInfo.WorkflowTimeout,
+			executionInfo.DecisionTimeoutValue,
+			executionInfo.ExecutionContext,
+			executionInfo.State,
+			executionInfo.CloseStatus,
+			executionInfo.LastFirstEventID,
+			executionInfo.NextEventID,
+			executionInfo.LastProcessedEvent,
+			executionInfo.StartTimestamp,
+			cqlNowTimestamp,
+			executionInfo.CreateRequestID,
+			executionInfo.SignalCount,
+			executionInfo.HistorySize,
+			executionInfo.DecisionVersion,
+			executionInfo.DecisionScheduleID,
+			executionInfo.DecisionStartedID,
+			executionInfo.DecisionRequestID,
+			executionInfo.DecisionTimeout,
+			executionInfo.DecisionAttempt,
+			executionInfo.DecisionTimestamp,
+			executionInfo.DecisionScheduledTimestamp,
+			executionInfo.DecisionStartedTimestamp,
+			executionInfo.CancelRequested,
+			executionInfo.CancelRequestID,
+			executionInfo.StickyTaskList,
+			executionInfo.StickyScheduleToStartTimeout,
+			executionInfo.ClientLibraryVersion,
+			executionInfo.ClientFeatureVersion,
+			executionInfo.ClientImpl,
+			executionInfo.Attempt,
+			executionInfo.HasRetryPolicy,
+			executionInfo.InitialInterval,
+			executionInfo.BackoffCoefficient,
+			executionInfo.MaximumInterval,
+			executionInfo.ExpirationTime,
+			executionInfo.MaximumAttempts,
+			executionInfo.NonRetriableErrors,
+			executionInfo.EventStoreVersion,
+			executionInfo.BranchToken,
+			executionInfo.CronSchedule,
+			executionInfo.ExpirationSeconds,
+			executionInfo.Memo,
+			executionInfo.SearchAttributes,
+			executionInfo.NextEventID,
+			d.shardID,
+			rowTypeExecution,
+			executionInfo.DomainID,
+			executionInfo.WorkflowID,
+			executionInfo.RunID,
+			defaultVisibilityTimestamp,
+			rowTypeExecutionTaskID,
+			condition)
+	} else {
+		lastReplicationInfo := make(map[string]map[string]interface{})
+		for k, v := range replicationState.LastReplicationInfo {
+			lastReplicationInfo[k] = createReplicationInfoMap(v)
+		}
+
+		batch.Query(templateUpdateWorkflowExecutionWithReplicationQuery,
+			executionInfo.DomainID,
+			executionInfo.WorkflowID,
+			executionInfo.RunID,
+			executionInfo.ParentDomainID,
+			executionInfo.ParentWorkflowID,
+			executionInfo.ParentRunID,
+			executionInfo.InitiatedID,
+			completionData,
+			completionEncoding,
+			executionInfo.TaskList,
+			executionInfo.WorkflowTypeName,
+			executionInfo.WorkflowTimeout,
+			executionInfo.DecisionTimeoutValue,
+			executionInfo.ExecutionContext,
+			executionInfo.State,
+			executionInfo.CloseStatus,
+			executionInfo.LastFirstEventID,
+			executionInfo.NextEventID,
+			executionInfo.LastProcessedEvent,
+			executionInfo.StartTimestamp,
+			cqlNowTimestamp,
+			executionInfo.CreateRequestID,
+			executionInfo.SignalCount,
+			executionInfo.HistorySize,
+			executionInfo.DecisionVersion,
+			executionInfo.DecisionScheduleID,
+			executionInfo.DecisionStartedID,
+			executionInfo.DecisionRequestID,
+			executionInfo.DecisionTimeout,
+			executionInfo.DecisionAttempt,
+			executionInfo.DecisionTimestamp,
+			executionInfo.DecisionScheduledTimestamp,
+			executionInfo.DecisionStartedTimestamp,
+			executionInfo.CancelRequested,
+			executionInfo.CancelRequestID,
+			executionInfo.StickyTaskList,
+			executionInfo.StickyScheduleToStartTimeout,
+			executionInfo.ClientLibraryVersion,
+			executionInfo.ClientFeatureVersion,
+			executionInfo.ClientImpl,
+			executionInfo.Attempt,
+			executionInfo.HasRetryPolicy,
+			executionInfo.InitialInterval,
+			executionInfo.BackoffCoefficient,
+			executionInfo.MaximumInterval,
+			executionInfo.ExpirationTime,
+			executionInfo.MaximumAttempts,
+			executionInfo.NonRetriableErrors,
+			executionInfo.EventStoreVersion,
+			executionInfo.BranchToken,
+			executionInfo.CronSchedule,
+			executionInfo.ExpirationSeconds,
+			executionInfo.Memo,
+			executionInfo.SearchAttributes,
+			replicationState.CurrentVersion,
+			replicationState.StartVersion,
+			replicationState.LastWriteVersion,
+			replicationState.LastWriteEventID,
+			lastReplicationInfo,
+			executionInfo.NextEventID,
+			d.shardID,
+			rowTypeExecution,
+			executionInfo.DomainID,
+			executionInfo.WorkflowID,
+			executionInfo.RunID,
+			defaultVisibilityTimestamp,
+			rowTypeExecutionTaskID,
+			condition)
+	}
+}
+
+func (d *cassandraPersistence) ResetMutableState(request *p.InternalResetMutableStateRequest) error {
+	batch := d.session.NewBatch(gocql.LoggedBatch)
+	cqlNowTimestamp := p.UnixNanoToDBTimestamp(time.Now().UnixNano())
+	executionInfo := request.ExecutionInfo
+	replicationState := request.ReplicationState
+
+	lastReplicationInfo := make(map[string]map[string]interface{})
+	for k, v := range replicationState.LastReplicationInfo {
+		lastReplicationInfo[k] = createReplicationInfoMap(v)
+	}
+
+	parentDomainID := emptyDomainID
+	if executionInfo.ParentDomainID != "" {
+		parentDomainID = executionInfo.ParentDomainID
+	}
+	parentRunID := emptyRunID
+	if executionInfo.ParentRunID != "" {
+		parentRunID = executionInfo.ParentRunID
+	}
+
+	batch.Query(templateUpdateCurrentWorkflowExecutionQuery,
+		executionInfo.RunID,
+		executionInfo.RunID,
+		executionInfo.CreateRequestID,
+		executionInfo.State,
+		executionInfo.CloseStatus,
+		replicationState.StartVersion,
+		replicationState.LastWriteVersion,
+		replicationState.LastWriteVersion,
+		executionInfo.State,
+		d.shardID,
+		rowTypeExecution,
+		executionInfo.DomainID,
+		executionInfo.WorkflowID,
+		permanentRunID,
+		defaultVisibilityTimestamp,
+		rowTypeExecutionTaskID,
+		request.PrevRunID,
+	)
+
+	completionEvent := executionInfo.CompletionEvent
+	var completionEventData []byte
+	var completionEventEncoding common.EncodingType
+	if completionEvent != nil {
+		completionEventData = completionEvent.Data
+		completionEventEncoding = completionEvent.Encoding
+	}
+	batch.Query(templateUpdateWorkflowExecutionWithReplicationQuery,
+		executionInfo.DomainID,
+		executionInfo.WorkflowID,
+		executionInfo.RunID,
+		parentDomainID,
+		executionInfo.ParentWorkflowID,
+		parentRunID,
+		executionInfo.InitiatedID,
+		completionEventData,
+		completionEventEncoding,
+		executionInfo.TaskList,
+		executionInfo.WorkflowTypeName,
+		executionInfo.WorkflowTimeout,
+		executionInfo.DecisionTimeoutValue,
+		executionInfo.ExecutionContext,
+		executionInfo.State,
 		executionInfo.CloseStatus,
 		executionInfo.LastFirstEventID,
 		executionInfo.NextEventID,
@@ -1907,6 +2036,8 @@ func (d *cassandraPersistence) ResetMutableState(request *p.InternalResetMutable
 		executionInfo.DecisionTimeout,
 		executionInfo.DecisionAttempt,
 		executionInfo.DecisionTimestamp,
+		executionInfo.DecisionScheduledTimestamp,
+		executionInfo.DecisionStartedTimestamp,
 		executionInfo.CancelRequested,
 		executionInfo.CancelRequestID,
 		executionInfo.StickyTaskList,
@@ -1926,6 +2057,8 @@ func (d *cassandraPersistence) ResetMutableState(request *p.InternalResetMutable
 		executionInfo.BranchToken,
 		executionInfo.CronSchedule,
 		executionInfo.ExpirationSeconds,
+		executionInfo.Memo,
+		executionInfo.SearchAttributes,
 		replicationState.CurrentVersion,
 		replicationState.StartVersion,
 		replicationState.LastWriteVersion,
@@ -2004,6 +2137,208 @@ func (d *cassandraPersistence) ResetMutableState(request *p.InternalResetMutable
 	return nil
 }
 
+func (d *cassandraPersistence) ResetWorkflowExecution(request *p.InternalResetWorkflowExecutionRequest) error {
+	batch := d.session.NewBatch(gocql.LoggedBatch)
+	cqlNowTimestamp := p.UnixNanoToDBTimestamp(time.Now().UnixNano())
+	executionInfo := request.ExecutionInfo
+	replicationState := request.ReplicationState
+
+	startVersion := common.EmptyVersion
+	lastWriteVersion := common.EmptyVersion
+	if replicationState != nil {
+		startVersion = replicationState.StartVersion
+		lastWriteVersion = replicationState.LastWriteVersion
+	}
+
+	parentDomainID := emptyDomainID
+	if executionInfo.ParentDomainID != "" {
+		parentDomainID = executionInfo.ParentDomainID
+	}
+	parentRunID := emptyRunID
+	if executionInfo.ParentRunID != "" {
+		parentRunID = executionInfo.ParentRunID
+	}
+
+	activityInfos, err := resetActivityInfoMap(request.InsertActivityInfos)
+	if err != nil {
+		return err
+	}
+
+	// the previous run has to be the current run of the workflow
+	batch.Query(templateUpdateCurrentWorkflowExecutionQuery,
+		executionInfo.RunID,
+		executionInfo.RunID,
+		executionInfo.CreateRequestID,
+		executionInfo.State,
+		executionInfo.CloseStatus,
+		startVersion,
+		lastWriteVersion,
+		lastWriteVersion,
+		executionInfo.State,
+		d.shardID,
+		rowTypeExecution,
+		executionInfo.DomainID,
+		executionInfo.WorkflowID,
+		permanentRunID,
+		defaultVisibilityTimestamp,
+		rowTypeExecutionTaskID,
+		request.PrevRunID,
+	)
+
+	completionEvent := executionInfo.CompletionEvent
+	var completionEventData []byte
+	var completionEventEncoding common.EncodingType
+	if completionEvent != nil {
+		completionEventData = completionEvent.Data
+		completionEventEncoding = completionEvent.Encoding
+	}
+	args := []interface{}{
+		d.shardID,
+		executionInfo.DomainID,
+		executionInfo.WorkflowID,
+		executionInfo.RunID,
+		rowTypeExecution,
+		executionInfo.DomainID,
+		executionInfo.WorkflowID,
+		executionInfo.RunID,
+		parentDomainID,
+		executionInfo.ParentWorkflowID,
+		parentRunID,
+		executionInfo.InitiatedID,
+		completionEventData,
+		completionEventEncoding,
+		executionInfo.TaskList,
+		executionInfo.WorkflowTypeName,
+		executionInfo.WorkflowTimeout,
+		executionInfo.DecisionTimeoutValue,
+		executionInfo.ExecutionContext,
+		executionInfo.State,
+		executionInfo.CloseStatus,
+		executionInfo.LastFirstEventID,
+		executionInfo.NextEventID,
+		executionInfo.LastProcessedEvent,
+		executionInfo.StartTimestamp,
+		cqlNowTimestamp,
+		executionInfo.CreateRequestID,
+		executionInfo.SignalCount,
+		executionInfo.HistorySize,
+		executionInfo.DecisionVersion,
+		executionInfo.DecisionScheduleID,
+		executionInfo.DecisionStartedID,
+		executionInfo.DecisionRequestID,
+		executionInfo.DecisionTimeout,
+		executionInfo.DecisionAttempt,
+		executionInfo.DecisionTimestamp,
+		executionInfo.DecisionScheduledTimestamp,
+		executionInfo.DecisionStartedTimestamp,
+		executionInfo.CancelRequested,
+		executionInfo.CancelRequestID,
+		executionInfo.StickyTaskList,
+		executionInfo.StickyScheduleToStartTimeout,
+		executionInfo.ClientLibraryVersion,
+		executionInfo.ClientFeatureVersion,
+		executionInfo.ClientImpl,
+		executionInfo.Attempt,
+		executionInfo.HasRetryPolicy,
+		executionInfo.InitialInterval,
+		executionInfo.BackoffCoefficient,
+		executionInfo.MaximumInterval,
+		executionInfo.ExpirationTime,
+		executionInfo.MaximumAttempts,
+		executionInfo.NonRetriableErrors,
+		executionInfo.EventStoreVersion,
+		executionInfo.BranchToken,
+		executionInfo.CronSchedule,
+		executionInfo.ExpirationSeconds,
+		executionInfo.Memo,
+		executionInfo.SearchAttributes,
+	}
+	query := templateResetWorkflowExecutionQuery
+	if replicationState != nil {
+		lastReplicationInfo := make(map[string]map[string]interface{})
+		for k, v := range replicationState.LastReplicationInfo {
+			lastReplicationInfo[k] = createReplicationInfoMap(v)
+		}
+		args = append(args,
+			replicationState.CurrentVersion,
+			replicationState.StartVersion,
+			replicationState.LastWriteVersion,
+			replicationState.LastWriteEventID,
+			lastReplicationInfo)
+		query = templateResetWorkflowExecutionWithReplicationQuery
+	}
+	args = append(args,
+		activityInfos,
+		resetTimerInfoMap(request.InsertTimerInfos),
+		request.InsertSignalRequestedIDs,
+		executionInfo.NextEventID,
+		defaultVisibilityTimestamp,
+		rowTypeExecutionTaskID)
+	batch.Query(query, args...)
+
+	d.createTransferTasks(batch, request.TransferTasks, executionInfo.DomainID, executionInfo.WorkflowID,
+		executionInfo.RunID)
+	d.createTimerTasks(batch, request.TimerTasks, nil, executionInfo.DomainID, executionInfo.WorkflowID,
+		executionInfo.RunID, cqlNowTimestamp)
+
+	// the termination of the previous run is applied by the same batch, so that the previous run is never
+	// terminated without the new run being created
+	conditionRunID := executionInfo.RunID
+	condition := executionInfo.NextEventID
+	if currInfo := request.CurrExecutionInfo; currInfo != nil {
+		d.updateExecution(batch, currInfo, request.CurrReplicationState, cqlNowTimestamp, request.CurrCondition)
+		d.createTransferTasks(batch, request.CurrTransferTasks, currInfo.DomainID, currInfo.WorkflowID, currInfo.RunID)
+		d.createTimerTasks(batch, request.CurrTimerTasks, nil, currInfo.DomainID, currInfo.WorkflowID, currInfo.RunID,
+			cqlNowTimestamp)
+		conditionRunID = currInfo.RunID
+		condition = request.CurrCondition
+	}
+
+	// Verifies that the RangeID has not changed
+	batch.Query(templateUpdateLeaseQuery,
+		request.RangeID,
+		d.shardID,
+		rowTypeShard,
+		rowTypeShardDomainID,
+		rowTypeShardWorkflowID,
+		rowTypeShardRunID,
+		defaultVisibilityTimestamp,
+		rowTypeShardTaskID,
+		request.RangeID,
+	)
+
+	previous := make(map[string]interface{})
+	applied, iter, err := d.session.MapExecuteBatchCAS(batch, previous)
+	defer func() {
+		if iter != nil {
+			iter.Close()
+		}
+	}()
+
+	if err != nil {
+		if isTimeoutError(err) {
+			// Write may have succeeded, but we don't know
+			// return this info to the caller so they have the option of trying to find out by executing a read
+			return &p.TimeoutError{Msg: fmt.Sprintf("ResetWorkflowExecution timed out. Error: %v", err)}
+		} else if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+
+	if !applied {
+		// the new run has no condition on next_event_id, so only the RangeID, current_run_id or the next_event_id
+		// of the previous run checks can fail
+		return d.getExecutionConditionalUpdateFailure(previous, iter, conditionRunID, condition, request.RangeID, request.PrevRunID)
+	}
+
+	return nil
+}
+
 func (d *cassandraPersistence) getExecutionConditionalUpdateFailure(previous map[string]interface{}, iter *gocql.Iter, requestRunID string, requestCondition int64, requestRangeID int64, requestConditionalRunID string) error {
 	// There can be three reasons why the query does not get applied: the RangeID has changed, or the next_event_id or current_run_id check failed.
 	// Check the row info returned by Cassandra to figure out which one it is.
@@ -2097,17 +2432,44 @@ func (d *cassandraPersistence) DeleteWorkflowExecution(request *p.DeleteWorkflow
 		request.WorkflowID,
 		request.RunID,
 		defaultVisibilityTimestamp,
-		rowTypeExecutionTaskID)
+		rowTypeExecutionTaskID)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteWorkflowExecution operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) DeleteCurrentWorkflowExecution(request *p.DeleteCurrentWorkflowExecutionRequest) error {
+	query := d.session.Query(templateDeleteCurrentWorkflowExecutionQuery,
+		d.shardID,
+		rowTypeExecution,
+		request.DomainID,
+		request.WorkflowID,
+		permanentRunID,
+		defaultVisibilityTimestamp,
+		rowTypeExecutionTaskID,
+		request.RunID)
 
-	err := query.Exec()
+	// the current record is only removed while it still points to the run, a condition failure is not an error
+	_, err := query.MapScanCAS(make(map[string]interface{}))
 	if err != nil {
 		if isThrottlingError(err) {
 			return &workflow.ServiceBusyError{
-				Message: fmt.Sprintf("DeleteWorkflowExecution operation failed. Error: %v", err),
+				Message: fmt.Sprintf("DeleteCurrentWorkflowExecution operation failed. Error: %v", err),
 			}
 		}
 		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("DeleteWorkflowExecution operation failed. Error: %v", err),
+			Message: fmt.Sprintf("DeleteCurrentWorkflowExecution operation failed. Error: %v", err),
 		}
 	}
 
@@ -2153,6 +2515,106 @@ func (d *cassandraPersistence) GetCurrentExecution(request *p.GetCurrentExecutio
 	}, nil
 }
 
+// ListConcreteExecutions lists the concrete executions of the shard, the current execution rows
+// share the row type and are skipped which is why a page can contain less executions than the page size
+func (d *cassandraPersistence) ListConcreteExecutions(
+	request *p.ListConcreteExecutionsRequest,
+) (*p.ListConcreteExecutionsResponse, error) {
+
+	query := d.session.Query(templateListConcreteExecutionsQuery,
+		d.shardID,
+		rowTypeExecution,
+	).PageSize(request.PageSize).PageState(request.PageToken)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ListConcreteExecutions operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.ListConcreteExecutionsResponse{}
+	var domainID, runID gocql.UUID
+	var workflowID string
+	for iter.Scan(&domainID, &workflowID, &runID) {
+		if runID.String() != permanentRunID {
+			response.Executions = append(response.Executions, &p.ConcreteExecution{
+				DomainID:   domainID.String(),
+				WorkflowID: workflowID,
+				RunID:      runID.String(),
+			})
+		}
+	}
+	nextPageToken := iter.PageState()
+	response.PageToken = make([]byte, len(nextPageToken))
+	copy(response.PageToken, nextPageToken)
+
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("ListConcreteExecutions operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListConcreteExecutions operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
+// ListCurrentExecutions lists the current executions of the shard, the concrete execution rows
+// share the row type and are skipped which is why a page can contain less executions than the page size
+func (d *cassandraPersistence) ListCurrentExecutions(
+	request *p.ListCurrentExecutionsRequest,
+) (*p.ListCurrentExecutionsResponse, error) {
+
+	query := d.session.Query(templateListCurrentExecutionsQuery,
+		d.shardID,
+		rowTypeExecution,
+	).PageSize(request.PageSize).PageState(request.PageToken)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ListCurrentExecutions operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.ListCurrentExecutionsResponse{}
+	result := make(map[string]interface{})
+	for iter.MapScan(result) {
+		if result["run_id"].(gocql.UUID).String() == permanentRunID {
+			executionInfo := createWorkflowExecutionInfo(result["execution"].(map[string]interface{}))
+			response.Executions = append(response.Executions, &p.CurrentExecution{
+				DomainID:    result["domain_id"].(gocql.UUID).String(),
+				WorkflowID:  result["workflow_id"].(string),
+				RunID:       result["current_run_id"].(gocql.UUID).String(),
+				State:       executionInfo.State,
+				CloseStatus: executionInfo.CloseStatus,
+			})
+		}
+		// Reset result map to get it ready for next scan
+		result = make(map[string]interface{})
+	}
+	nextPageToken := iter.PageState()
+	response.PageToken = make([]byte, len(nextPageToken))
+	copy(response.PageToken, nextPageToken)
+
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("ListCurrentExecutions operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListCurrentExecutions operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
 func (d *cassandraPersistence) GetTransferTasks(request *p.GetTransferTasksRequest) (*p.GetTransferTasksResponse, error) {
 
 	// Reading transfer tasks need to be quorum level consistent, otherwise we could loose task
@@ -2317,6 +2779,133 @@ func (d *cassandraPersistence) CompleteReplicationTask(request *p.CompleteReplic
 	return nil
 }
 
+func (d *cassandraPersistence) PutReplicationTaskToDLQ(request *p.PutReplicationTaskToDLQRequest) error {
+	task := request.TaskInfo
+	query := d.session.Query(templateCreateReplicationDLQTaskQuery,
+		d.shardID,
+		rowTypeDLQ,
+		rowTypeDLQDomainID,
+		request.SourceClusterName,
+		rowTypeDLQRunID,
+		task.DomainID,
+		task.WorkflowID,
+		task.RunID,
+		task.TaskID,
+		task.Task.Data,
+		string(task.Task.Encoding),
+		defaultVisibilityTimestamp,
+		task.TaskID)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("PutReplicationTaskToDLQ operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("PutReplicationTaskToDLQ operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) GetReplicationTasksFromDLQ(
+	request *p.GetReplicationTasksFromDLQRequest,
+) (*p.GetReplicationTasksFromDLQResponse, error) {
+
+	query := d.session.Query(templateGetReplicationDLQTasksQuery,
+		d.shardID,
+		rowTypeDLQ,
+		rowTypeDLQDomainID,
+		request.SourceClusterName,
+		rowTypeDLQRunID,
+		defaultVisibilityTimestamp,
+	).PageSize(request.BatchSize).PageState(request.NextPageToken)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "GetReplicationTasksFromDLQ operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.GetReplicationTasksFromDLQResponse{}
+	task := make(map[string]interface{})
+	for iter.MapScan(task) {
+		t := createReplicationDLQTaskInfo(task["replication_dlq"].(map[string]interface{}))
+		// Reset task map to get it ready for next scan
+		task = make(map[string]interface{})
+
+		response.Tasks = append(response.Tasks, t)
+	}
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+
+	if err := iter.Close(); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetReplicationTasksFromDLQ operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
+func (d *cassandraPersistence) DeleteReplicationTaskFromDLQ(request *p.DeleteReplicationTaskFromDLQRequest) error {
+	query := d.session.Query(templateCompleteTransferTaskQuery,
+		d.shardID,
+		rowTypeDLQ,
+		rowTypeDLQDomainID,
+		request.SourceClusterName,
+		rowTypeDLQRunID,
+		defaultVisibilityTimestamp,
+		request.TaskID)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) GetReplicationDLQSize(
+	request *p.GetReplicationDLQSizeRequest,
+) (*p.GetReplicationDLQSizeResponse, error) {
+
+	query := d.session.Query(templateGetReplicationDLQSizeQuery,
+		d.shardID,
+		rowTypeDLQ,
+		rowTypeDLQDomainID,
+		request.SourceClusterName,
+		rowTypeDLQRunID,
+		defaultVisibilityTimestamp,
+	)
+
+	result := make(map[string]interface{})
+	if err := query.MapScan(result); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("GetReplicationDLQSize operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetReplicationDLQSize operation failed. Error: %v", err),
+		}
+	}
+
+	return &p.GetReplicationDLQSizeResponse{Size: result["count"].(int64)}, nil
+}
+
 func (d *cassandraPersistence) CompleteTimerTask(request *p.CompleteTimerTaskRequest) error {
 	ts := p.UnixNanoToDBTimestamp(request.VisibilityTimestamp.UnixNano())
 	query := d.session.Query(templateCompleteTimerTaskQuery,
@@ -2530,6 +3119,100 @@ func (d *cassandraPersistence) UpdateTaskList(request *p.UpdateTaskListRequest)
 	return &p.UpdateTaskListResponse{}, nil
 }
 
+// From TaskManager interface
+func (d *cassandraPersistence) ListTaskList(request *p.ListTaskListRequest) (*p.ListTaskListResponse, error) {
+	query := d.session.Query(templateListTaskListQuery, rowTypeTaskList)
+	iter := query.PageSize(request.PageSize).PageState(request.PageToken).Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ListTaskList operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	// the rows of the other domains are skipped, so a page can have less items than the page size
+	response := &p.ListTaskListResponse{}
+	var domainID gocql.UUID
+	var name string
+	var taskType int
+	var rangeID int64
+	var writeTime int64
+	tlDB := make(map[string]interface{})
+	for iter.Scan(&domainID, &name, &taskType, &rangeID, &tlDB, &writeTime) {
+		if request.DomainID == "" || domainID.String() == request.DomainID {
+			response.Items = append(response.Items, &p.TaskListInfo{
+				DomainID:    domainID.String(),
+				Name:        name,
+				TaskType:    taskType,
+				RangeID:     rangeID,
+				AckLevel:    tlDB["ack_level"].(int64),
+				Kind:        tlDB["kind"].(int),
+				LastUpdated: writeTimeToTime(writeTime),
+			})
+		}
+		tlDB = make(map[string]interface{}) // Reinitialize map as initialized fails on unmarshalling
+	}
+
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("ListTaskList operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListTaskList operation failed. Error: %v", err),
+		}
+	}
+	return response, nil
+}
+
+// From TaskManager interface
+func (d *cassandraPersistence) DeleteTaskList(request *p.DeleteTaskListRequest) error {
+	// the tasks are deleted by the same batch as the task list, so that nothing is deleted once the task list is
+	// owned by another range
+	batch := d.session.NewBatch(gocql.LoggedBatch)
+	batch.Query(templateDeleteTaskListTasksQuery,
+		request.DomainID,
+		request.TaskListName,
+		request.TaskListType,
+		rowTypeTask,
+	)
+	batch.Query(templateDeleteTaskListQuery,
+		request.DomainID,
+		request.TaskListName,
+		request.TaskListType,
+		rowTypeTaskList,
+		taskListTaskID,
+		request.RangeID,
+	)
+
+	previous := make(map[string]interface{})
+	applied, iter, err := d.session.MapExecuteBatchCAS(batch, previous)
+	defer func() {
+		if iter != nil {
+			iter.Close()
+		}
+	}()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteTaskList operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteTaskList operation failed. Error: %v", err),
+		}
+	}
+	if !applied {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("DeleteTaskList failed to apply. db rangeID %v", previous["range_id"]),
+		}
+	}
+	return nil
+}
+
 // From TaskManager interface
 func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.CreateTasksResponse, error) {
 	batch := d.session.NewBatch(gocql.LoggedBatch)
@@ -2639,6 +3322,9 @@ PopulateTasks:
 		}
 		t := createTaskInfo(task["task"].(map[string]interface{}))
 		t.TaskID = taskID.(int64)
+		if createdTime, ok := task["created_time"].(int64); ok {
+			t.CreatedTime = writeTimeToTime(createdTime)
+		}
 		response.Tasks = append(response.Tasks, t)
 		if len(response.Tasks) == request.BatchSize {
 			break PopulateTasks
@@ -2680,6 +3366,74 @@ func (d *cassandraPersistence) CompleteTask(request *p.CompleteTaskRequest) erro
 	return nil
 }
 
+// From TaskManager interface
+func (d *cassandraPersistence) CompleteTasksLessThan(request *p.CompleteTasksLessThanRequest) (int, error) {
+	// a range delete cannot be bounded, so the ids of the tasks up to the limit are read first and only the
+	// range up to the last of them is deleted
+	query := d.session.Query(templateGetTaskIDsLessThanQuery,
+		request.DomainID,
+		request.TaskListName,
+		request.TaskType,
+		rowTypeTask,
+		request.TaskID,
+	).PageSize(request.Limit)
+
+	iter := query.Iter()
+	if iter == nil {
+		return 0, &workflow.InternalServiceError{
+			Message: "CompleteTasksLessThan operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	completed := 0
+	lastTaskID := int64(0)
+	task := make(map[string]interface{})
+	for completed < request.Limit && iter.MapScan(task) {
+		taskID, ok := task["task_id"]
+		if !ok { // no tasks, but static column record returned
+			continue
+		}
+		lastTaskID = taskID.(int64)
+		completed++
+		task = make(map[string]interface{})
+	}
+
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return 0, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("CompleteTasksLessThan operation failed. Error: %v", err),
+			}
+		}
+		return 0, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CompleteTasksLessThan operation failed. Error: %v", err),
+		}
+	}
+	if completed == 0 {
+		return 0, nil
+	}
+
+	query = d.session.Query(templateCompleteTasksLessThanQuery,
+		request.DomainID,
+		request.TaskListName,
+		request.TaskType,
+		rowTypeTask,
+		lastTaskID+1)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return 0, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("CompleteTasksLessThan operation failed. Error: %v", err),
+			}
+		}
+		return 0, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CompleteTasksLessThan operation failed. Error: %v", err),
+		}
+	}
+
+	return completed, nil
+}
+
 func (d *cassandraPersistence) GetTimerIndexTasks(request *p.GetTimerIndexTasksRequest) (*p.GetTimerIndexTasksResponse,
 	error) {
 	// Reading timer tasks need to be quorum level consistent, otherwise we could loose task
@@ -2775,7 +3529,8 @@ func (d *cassandraPersistence) createTransferTasks(batch *gocql.Batch, transferT
 			targetWorkflowID = task.(*p.StartChildExecutionTask).TargetWorkflowID
 			scheduleID = task.(*p.StartChildExecutionTask).InitiatedID
 
-		case p.TransferTaskTypeCloseExecution:
+		case p.TransferTaskTypeCloseExecution, p.TransferTaskTypeUpsertWorkflowSearchAttributes,
+			p.TransferTaskTypeRecordWorkflowStarted:
 			// No explicit property needs to be set
 
 		default:
@@ -2971,6 +3726,7 @@ func (d *cassandraPersistence) updateActivityInfos(batch *gocql.Batch, activityI
 			a.ExpirationTime,
 			a.MaximumAttempts,
 			a.NonRetriableErrors,
+			a.LastFailureReason,
 			a.ScheduledEvent.Encoding,
 			d.shardID,
 			rowTypeExecution,
@@ -3418,6 +4174,8 @@ func createShardInfo(currentCluster string, result map[string]interface{}) *p.Sh
 			info.ClusterTransferAckLevel = v.(map[string]int64)
 		case "cluster_timer_ack_level":
 			info.ClusterTimerAckLevel = v.(map[string]time.Time)
+		case "cluster_replication_level":
+			info.ClusterReplicationLevel = v.(map[string]int64)
 		case "domain_notification_version":
 			info.DomainNotificationVersion = v.(int64)
 		}
@@ -3433,6 +4191,9 @@ func createShardInfo(currentCluster string, result map[string]interface{}) *p.Sh
 			currentCluster: info.TimerAckLevel,
 		}
 	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
 
 	return info
 }
@@ -3505,6 +4266,10 @@ func createWorkflowExecutionInfo(result map[string]interface{}) *p.InternalWorkf
 			info.DecisionAttempt = v.(int64)
 		case "decision_timestamp":
 			info.DecisionTimestamp = v.(int64)
+		case "decision_scheduled_timestamp":
+			info.DecisionScheduledTimestamp = v.(int64)
+		case "decision_started_timestamp":
+			info.DecisionStartedTimestamp = v.(int64)
 		case "cancel_requested":
 			info.CancelRequested = v.(bool)
 		case "cancel_request_id":
@@ -3543,6 +4308,10 @@ func createWorkflowExecutionInfo(result map[string]interface{}) *p.InternalWorkf
 			info.CronSchedule = v.(string)
 		case "expiration_seconds":
 			info.ExpirationSeconds = int32(v.(int))
+		case "memo":
+			info.Memo = v.(map[string][]byte)
+		case "search_attributes":
+			info.SearchAttributes = v.(map[string][]byte)
 		}
 	}
 	info.CompletionEvent = p.NewDataBlob(completionEventData, completionEventEncoding)
@@ -3616,6 +4385,30 @@ func createTransferTaskInfo(result map[string]interface{}) *p.TransferTaskInfo {
 	return info
 }
 
+func createReplicationDLQTaskInfo(result map[string]interface{}) *p.ReplicationDLQTaskInfo {
+	info := &p.ReplicationDLQTaskInfo{}
+	var data []byte
+	var encoding common.EncodingType
+	for k, v := range result {
+		switch k {
+		case "domain_id":
+			info.DomainID = v.(gocql.UUID).String()
+		case "workflow_id":
+			info.WorkflowID = v.(string)
+		case "run_id":
+			info.RunID = v.(gocql.UUID).String()
+		case "task_id":
+			info.TaskID = v.(int64)
+		case "data":
+			data = v.([]byte)
+		case "data_encoding":
+			encoding = common.EncodingType(v.(string))
+		}
+	}
+	info.Task = p.NewDataBlob(data, encoding)
+	return info
+}
+
 func createReplicationTaskInfo(result map[string]interface{}) *p.ReplicationTaskInfo {
 	info := &p.ReplicationTaskInfo{}
 	for k, v := range result {
@@ -3720,6 +4513,8 @@ func createActivityInfo(result map[string]interface{}) *p.InternalActivityInfo {
 			info.ExpirationTime = v.(time.Time)
 		case "non_retriable_errors":
 			info.NonRetriableErrors = v.([]string)
+		case "last_failure_reason":
+			info.LastFailureReason = v.(string)
 		case "event_data_encoding":
 			sharedEncoding = common.EncodingType(v.(string))
 		}
@@ -3878,6 +4673,7 @@ func resetActivityInfoMap(activityInfos []*p.InternalActivityInfo) (map[int64]ma
 		aInfo["expiration_time"] = a.ExpirationTime
 		aInfo["max_attempts"] = a.MaximumAttempts
 		aInfo["non_retriable_errors"] = a.NonRetriableErrors
+		aInfo["last_failure_reason"] = a.LastFailureReason
 		aInfo["event_data_encoding"] = a.ScheduledEvent.Encoding
 
 		aMap[a.ScheduleID] = aInfo
@@ -3973,6 +4769,14 @@ func createHistoryEventBatchBlob(result map[string]interface{}) *p.DataBlob {
 	return eventBatch
 }
 
+// writeTimeToTime converts the microseconds since epoch returned by writetime() to a time
+func writeTimeToTime(writeTime int64) time.Time {
+	if writeTime == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, writeTime*int64(time.Microsecond)).UTC()
+}
+
 func createTaskInfo(result map[string]interface{}) *p.TaskInfo {
 	info := &p.TaskInfo{}
 	for k, v := range result {
@@ -43,12 +43,12 @@ const (
 
 const (
 	templateCreateWorkflowExecutionStartedWithTTL = `INSERT INTO open_executions (` +
-		`domain_id, domain_partition, workflow_id, run_id, start_time, workflow_type_name) ` +
-		`VALUES (?, ?, ?, ?, ?, ?) using TTL ?`
+		`domain_id, domain_partition, workflow_id, run_id, start_time, workflow_type_name, memo, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
 
 	templateCreateWorkflowExecutionStarted = `INSERT INTO open_executions (` +
-		`domain_id, domain_partition, workflow_id, run_id, start_time, workflow_type_name) ` +
-		`VALUES (?, ?, ?, ?, ?, ?)`
+		`domain_id, domain_partition, workflow_id, run_id, start_time, workflow_type_name, memo, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
 
 	templateDeleteWorkflowExecutionStarted = `DELETE FROM open_executions ` +
 		`WHERE domain_id = ? ` +
@@ -56,37 +56,49 @@ const (
 		`AND start_time = ? ` +
 		`AND run_id = ?`
 
+	templateDeleteWorkflowExecutionClosed = `DELETE FROM closed_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND start_time = ? ` +
+		`AND run_id = ?`
+
+	templateDeleteWorkflowExecutionClosedV2 = `DELETE FROM closed_executions_v2 ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND close_time = ? ` +
+		`AND run_id = ?`
+
 	templateCreateWorkflowExecutionClosedWithTTL = `INSERT INTO closed_executions (` +
-		`domain_id, domain_partition, workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
+		`domain_id, domain_partition, workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, memo, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
 
 	templateCreateWorkflowExecutionClosed = `INSERT INTO closed_executions (` +
-		`domain_id, domain_partition, workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, domain_partition, workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, memo, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	templateCreateWorkflowExecutionClosedWithTTLV2 = `INSERT INTO closed_executions_v2 (` +
-		`domain_id, domain_partition, workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
+		`domain_id, domain_partition, workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, memo, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
 
 	templateCreateWorkflowExecutionClosedV2 = `INSERT INTO closed_executions_v2 (` +
-		`domain_id, domain_partition, workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, domain_partition, workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, memo, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
-	templateGetOpenWorkflowExecutions = `SELECT workflow_id, run_id, start_time, workflow_type_name ` +
+	templateGetOpenWorkflowExecutions = `SELECT workflow_id, run_id, start_time, workflow_type_name, memo, search_attributes ` +
 		`FROM open_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition IN (?) ` +
 		`AND start_time >= ? ` +
 		`AND start_time <= ? `
 
-	templateGetClosedWorkflowExecutions = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length ` +
+	templateGetClosedWorkflowExecutions = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, memo, search_attributes ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition IN (?) ` +
 		`AND start_time >= ? ` +
 		`AND start_time <= ? `
 
-	templateGetOpenWorkflowExecutionsByType = `SELECT workflow_id, run_id, start_time, workflow_type_name ` +
+	templateGetOpenWorkflowExecutionsByType = `SELECT workflow_id, run_id, start_time, workflow_type_name, memo, search_attributes ` +
 		`FROM open_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -94,7 +106,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_type_name = ? `
 
-	templateGetClosedWorkflowExecutionsByType = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length ` +
+	templateGetClosedWorkflowExecutionsByType = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, memo, search_attributes ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -102,7 +114,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_type_name = ? `
 
-	templateGetOpenWorkflowExecutionsByID = `SELECT workflow_id, run_id, start_time, workflow_type_name ` +
+	templateGetOpenWorkflowExecutionsByID = `SELECT workflow_id, run_id, start_time, workflow_type_name, memo, search_attributes ` +
 		`FROM open_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -110,7 +122,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_id = ? `
 
-	templateGetClosedWorkflowExecutionsByID = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length ` +
+	templateGetClosedWorkflowExecutionsByID = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, memo, search_attributes ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -118,7 +130,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_id = ? `
 
-	templateGetClosedWorkflowExecutionsByStatus = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length ` +
+	templateGetClosedWorkflowExecutionsByStatus = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, memo, search_attributes ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -126,7 +138,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND status = ? `
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length ` +
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, memo, search_attributes ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -180,6 +192,8 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionStarted(
 			*request.Execution.RunId,
 			p.UnixNanoToDBTimestamp(request.StartTimestamp),
 			request.WorkflowTypeName,
+			request.Memo,
+			request.SearchAttributes,
 		)
 	} else {
 		query = v.session.Query(templateCreateWorkflowExecutionStartedWithTTL,
@@ -189,6 +203,8 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionStarted(
 			*request.Execution.RunId,
 			p.UnixNanoToDBTimestamp(request.StartTimestamp),
 			request.WorkflowTypeName,
+			request.Memo,
+			request.SearchAttributes,
 			ttl,
 		)
 	}
@@ -208,6 +224,52 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionStarted(
 	return nil
 }
 
+func (v *cassandraVisibilityPersistence) UpsertWorkflowExecution(
+	request *p.UpsertWorkflowExecutionRequest) error {
+	// the open record is written again with the new search attributes, the write time of the
+	// update is before the close time so that it never revives the record of a closed execution
+	ttl := request.WorkflowTimeout + openExecutionTTLBuffer
+	var query *gocql.Query
+	if ttl > maxCassandraTTL {
+		query = v.session.Query(templateCreateWorkflowExecutionStarted,
+			request.DomainUUID,
+			domainPartition,
+			*request.Execution.WorkflowId,
+			*request.Execution.RunId,
+			p.UnixNanoToDBTimestamp(request.StartTimestamp),
+			request.WorkflowTypeName,
+			request.Memo,
+			request.SearchAttributes,
+		)
+	} else {
+		query = v.session.Query(templateCreateWorkflowExecutionStartedWithTTL,
+			request.DomainUUID,
+			domainPartition,
+			*request.Execution.WorkflowId,
+			*request.Execution.RunId,
+			p.UnixNanoToDBTimestamp(request.StartTimestamp),
+			request.WorkflowTypeName,
+			request.Memo,
+			request.SearchAttributes,
+			ttl,
+		)
+	}
+	query = query.WithTimestamp(p.UnixNanoToDBTimestamp(request.UpsertTimestamp))
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("UpsertWorkflowExecution operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpsertWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
 func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
 	request *p.RecordWorkflowExecutionClosedRequest) error {
 	batch := v.session.NewBatch(gocql.LoggedBatch)
@@ -239,6 +301,8 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
 			request.WorkflowTypeName,
 			request.Status,
 			request.HistoryLength,
+			request.Memo,
+			request.SearchAttributes,
 		)
 		// duplicate write to v2 to order by close time
 		batch.Query(templateCreateWorkflowExecutionClosedV2,
@@ -251,6 +315,8 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
 			request.WorkflowTypeName,
 			request.Status,
 			request.HistoryLength,
+			request.Memo,
+			request.SearchAttributes,
 		)
 	} else {
 		batch.Query(templateCreateWorkflowExecutionClosedWithTTL,
@@ -263,6 +329,8 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
 			request.WorkflowTypeName,
 			request.Status,
 			request.HistoryLength,
+			request.Memo,
+			request.SearchAttributes,
 			retention,
 		)
 		// duplicate write to v2 to order by close time
@@ -276,6 +344,8 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
 			request.WorkflowTypeName,
 			request.Status,
 			request.HistoryLength,
+			request.Memo,
+			request.SearchAttributes,
 			retention,
 		)
 	}
@@ -620,12 +690,58 @@ func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(
 	}, nil
 }
 
+func (v *cassandraVisibilityPersistence) ListWorkflowExecutions(
+	request *p.ListWorkflowExecutionsByQueryRequest) (*p.ListWorkflowExecutionsResponse, error) {
+	return p.ListWorkflowExecutionsByQuery(v, request)
+}
+
+func (v *cassandraVisibilityPersistence) DeleteWorkflowExecution(
+	request *p.VisibilityDeleteWorkflowExecutionRequest) error {
+	batch := v.session.NewBatch(gocql.LoggedBatch)
+	batch.Query(templateDeleteWorkflowExecutionStarted,
+		request.DomainUUID,
+		domainPartition,
+		p.UnixNanoToDBTimestamp(request.StartTimestamp),
+		request.Execution.GetRunId(),
+	)
+	batch.Query(templateDeleteWorkflowExecutionClosed,
+		request.DomainUUID,
+		domainPartition,
+		p.UnixNanoToDBTimestamp(request.StartTimestamp),
+		request.Execution.GetRunId(),
+	)
+	// closed_executions_v2 is keyed by the close time, which an open execution does not have
+	if request.CloseTimestamp != 0 {
+		batch.Query(templateDeleteWorkflowExecutionClosedV2,
+			request.DomainUUID,
+			domainPartition,
+			p.UnixNanoToDBTimestamp(request.CloseTimestamp),
+			request.Execution.GetRunId(),
+		)
+	}
+
+	err := v.session.ExecuteBatch(batch)
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteWorkflowExecution operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
 func readOpenWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExecutionInfo, bool) {
 	var workflowID string
 	var runID gocql.UUID
 	var typeName string
 	var startTime time.Time
-	if iter.Scan(&workflowID, &runID, &startTime, &typeName) {
+	var memo map[string][]byte
+	var searchAttributes map[string][]byte
+	if iter.Scan(&workflowID, &runID, &startTime, &typeName, &memo, &searchAttributes) {
 		execution := &workflow.WorkflowExecution{}
 		execution.WorkflowId = common.StringPtr(workflowID)
 		execution.RunId = common.StringPtr(runID.String())
@@ -637,6 +753,8 @@ func readOpenWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExecut
 		record.Execution = execution
 		record.StartTime = common.Int64Ptr(startTime.UnixNano())
 		record.Type = wfType
+		record.Memo = &workflow.Memo{Fields: memo}
+		record.SearchAttributes = &workflow.SearchAttributes{IndexedFields: searchAttributes}
 		return record, true
 	}
 	return nil, false
@@ -650,7 +768,9 @@ func readClosedWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExec
 	var closeTime time.Time
 	var status workflow.WorkflowExecutionCloseStatus
 	var historyLength int64
-	if iter.Scan(&workflowID, &runID, &startTime, &closeTime, &typeName, &status, &historyLength) {
+	var memo map[string][]byte
+	var searchAttributes map[string][]byte
+	if iter.Scan(&workflowID, &runID, &startTime, &closeTime, &typeName, &status, &historyLength, &memo, &searchAttributes) {
 		execution := &workflow.WorkflowExecution{}
 		execution.WorkflowId = common.StringPtr(workflowID)
 		execution.RunId = common.StringPtr(runID.String())
@@ -665,6 +785,8 @@ func readClosedWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExec
 		record.Type = wfType
 		record.CloseStatus = &status
 		record.HistoryLength = common.Int64Ptr(historyLength)
+		record.Memo = &workflow.Memo{Fields: memo}
+		record.SearchAttributes = &workflow.SearchAttributes{IndexedFields: searchAttributes}
 		return record, true
 	}
 	return nil, false
@@ -22,9 +22,11 @@ package persistence
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pborman/uuid"
+	"github.com/uber/cadence/.gen/go/replicator"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/codec"
@@ -99,6 +101,8 @@ const (
 	TransferTaskTypeCancelExecution
 	TransferTaskTypeStartChildExecution
 	TransferTaskTypeSignalExecution
+	TransferTaskTypeUpsertWorkflowSearchAttributes
+	TransferTaskTypeRecordWorkflowStarted
 )
 
 // Types of replication tasks
@@ -180,16 +184,18 @@ type (
 
 	// ShardInfo describes a shard
 	ShardInfo struct {
-		ShardID                   int
-		Owner                     string
-		RangeID                   int64
-		StolenSinceRenew          int
-		UpdatedAt                 time.Time
-		ReplicationAckLevel       int64
-		TransferAckLevel          int64
-		TimerAckLevel             time.Time
-		ClusterTransferAckLevel   map[string]int64
-		ClusterTimerAckLevel      map[string]time.Time
+		ShardID                 int
+		Owner                   string
+		RangeID                 int64
+		StolenSinceRenew        int
+		UpdatedAt               time.Time
+		ReplicationAckLevel     int64
+		TransferAckLevel        int64
+		TimerAckLevel           time.Time
+		ClusterTransferAckLevel map[string]int64
+		ClusterTimerAckLevel    map[string]time.Time
+		// ClusterReplicationLevel is the replication task ID applied by each cluster pulling the replication tasks
+		ClusterReplicationLevel   map[string]int64
 		TransferFailoverLevels    map[string]TransferFailoverLevel // uuid -> TransferFailoverLevel
 		TimerFailoverLevels       map[string]TimerFailoverLevel    // uuid -> TimerFailoverLevel
 		DomainNotificationVersion int64
@@ -245,6 +251,8 @@ type (
 		DecisionTimeout              int32
 		DecisionAttempt              int64
 		DecisionTimestamp            int64
+		DecisionScheduledTimestamp   int64
+		DecisionStartedTimestamp     int64
 		CancelRequested              bool
 		CancelRequestID              string
 		StickyTaskList               string
@@ -266,6 +274,8 @@ type (
 		BranchToken       []byte
 		CronSchedule      string
 		ExpirationSeconds int32
+		Memo              map[string][]byte
+		SearchAttributes  map[string][]byte
 	}
 
 	// ReplicationState represents mutable state information for global domains.
@@ -335,6 +345,8 @@ type (
 		RangeID  int64
 		AckLevel int64
 		Kind     int
+		// LastUpdated is the time the task list was last written, it is only set on the task lists read by ListTaskList
+		LastUpdated time.Time
 	}
 
 	// TaskInfo describes either activity or decision task
@@ -345,6 +357,11 @@ type (
 		TaskID                 int64
 		ScheduleID             int64
 		ScheduleToStartTimeout int32
+		// CreatedTime is the time the task was created, it is only set on the tasks read by GetTasks
+		CreatedTime time.Time
+		// Expiry is the time the schedule to start timeout of the task expires, it is zero when the task has no
+		// timeout or when the store deletes the expired tasks by itself
+		Expiry time.Time
 	}
 
 	// Task is the generic interface for workflow tasks
@@ -385,6 +402,22 @@ type (
 		Version             int64
 	}
 
+	// UpsertWorkflowSearchAttributesTask identifies a transfer task for updating the search attributes
+	// of an open execution in the visibility store
+	UpsertWorkflowSearchAttributesTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+	}
+
+	// RecordWorkflowStartedTask identifies a transfer task for recording in the visibility store an execution
+	// which is not started by a workflow started event, like the new run of a reset
+	RecordWorkflowStartedTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+	}
+
 	// DeleteHistoryEventTask identifies a timer task for deletion of history events of completed execution.
 	DeleteHistoryEventTask struct {
 		VisibilityTimestamp time.Time
@@ -553,6 +586,7 @@ type (
 		ExpirationTime     time.Time
 		MaximumAttempts    int32
 		NonRetriableErrors []string
+		LastFailureReason  string
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibility int64
 	}
@@ -650,6 +684,7 @@ type (
 		DecisionScheduleID          int64
 		DecisionStartedID           int64
 		DecisionStartToCloseTimeout int32
+		DecisionScheduledTimestamp  int64
 		CreateWorkflowMode          int
 		PreviousRunID               string
 		PreviousLastWriteVersion    int64
@@ -668,6 +703,8 @@ type (
 		BranchToken       []byte
 		CronSchedule      string
 		ExpirationSeconds int32
+		Memo              map[string][]byte
+		SearchAttributes  map[string][]byte
 	}
 
 	// CreateWorkflowExecutionResponse is the response to CreateWorkflowExecutionRequest
@@ -700,6 +737,48 @@ type (
 		CloseStatus    int
 	}
 
+	// ListConcreteExecutionsRequest is used to list the concrete executions of a shard
+	ListConcreteExecutionsRequest struct {
+		PageSize  int
+		PageToken []byte
+	}
+
+	// ListConcreteExecutionsResponse is the response to ListConcreteExecutions, a page can contain
+	// less executions than the page size even if there are more to be read
+	ListConcreteExecutionsResponse struct {
+		Executions []*ConcreteExecution
+		PageToken  []byte
+	}
+
+	// ConcreteExecution identifies a concrete execution of a workflow
+	ConcreteExecution struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
+	// ListCurrentExecutionsRequest is used to list the current executions of a shard
+	ListCurrentExecutionsRequest struct {
+		PageSize  int
+		PageToken []byte
+	}
+
+	// ListCurrentExecutionsResponse is the response to ListCurrentExecutions, a page can contain
+	// less executions than the page size even if there are more to be read
+	ListCurrentExecutionsResponse struct {
+		Executions []*CurrentExecution
+		PageToken  []byte
+	}
+
+	// CurrentExecution describes the current run of a workflow
+	CurrentExecution struct {
+		DomainID    string
+		WorkflowID  string
+		RunID       string
+		State       int
+		CloseStatus int
+	}
+
 	// UpdateWorkflowExecutionRequest is used to update a workflow execution
 	UpdateWorkflowExecutionRequest struct {
 		ExecutionInfo        *WorkflowExecutionInfo
@@ -754,6 +833,34 @@ type (
 		Encoding common.EncodingType
 	}
 
+	// ResetWorkflowExecutionRequest is used to create a new run of a workflow execution from a reset point of a
+	// previous run, and make it the current run
+	ResetWorkflowExecutionRequest struct {
+		PrevRunID        string
+		ExecutionInfo    *WorkflowExecutionInfo
+		ReplicationState *ReplicationState
+		RangeID          int64
+
+		TransferTasks []Task
+		TimerTasks    []Task
+
+		// Mutable state
+		InsertActivityInfos      []*ActivityInfo
+		InsertTimerInfos         []*TimerInfo
+		InsertSignalRequestedIDs []string
+
+		// CurrExecutionInfo is the previous run terminated by the reset, it is updated together with the creation of
+		// the new run if its next event id is still CurrCondition. It is nil when the previous run was already closed.
+		CurrExecutionInfo    *WorkflowExecutionInfo
+		CurrReplicationState *ReplicationState
+		CurrCondition        int64
+		CurrTransferTasks    []Task
+		CurrTimerTasks       []Task
+
+		//Optional. It is to suggest a binary encoding type to serialize history events
+		Encoding common.EncodingType
+	}
+
 	// DeleteWorkflowExecutionRequest is used to delete a workflow execution
 	DeleteWorkflowExecutionRequest struct {
 		DomainID   string
@@ -761,6 +868,14 @@ type (
 		RunID      string
 	}
 
+	// DeleteCurrentWorkflowExecutionRequest is used to delete the current workflow execution record,
+	// the record is only deleted if it still points to the given run
+	DeleteCurrentWorkflowExecutionRequest struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
 	// GetTransferTasksRequest is used to read tasks from the transfer task queue
 	GetTransferTasksRequest struct {
 		ReadLevel     int64
@@ -805,6 +920,51 @@ type (
 		TaskID int64
 	}
 
+	// ReplicationDLQTaskInfo describes a replication task received from another cluster which could not be applied
+	// and was moved to the replication DLQ of the shard, Task is the encoded replicator.ReplicationTask
+	ReplicationDLQTaskInfo struct {
+		TaskID     int64
+		DomainID   string
+		WorkflowID string
+		RunID      string
+		Task       *DataBlob
+	}
+
+	// PutReplicationTaskToDLQRequest is used to add a task to the replication DLQ of a source cluster
+	PutReplicationTaskToDLQRequest struct {
+		SourceClusterName string
+		TaskInfo          *ReplicationDLQTaskInfo
+	}
+
+	// GetReplicationTasksFromDLQRequest is used to read the tasks in the replication DLQ of a source cluster
+	GetReplicationTasksFromDLQRequest struct {
+		SourceClusterName string
+		BatchSize         int
+		NextPageToken     []byte
+	}
+
+	// GetReplicationTasksFromDLQResponse is the response to GetReplicationTasksFromDLQ
+	GetReplicationTasksFromDLQResponse struct {
+		Tasks         []*ReplicationDLQTaskInfo
+		NextPageToken []byte
+	}
+
+	// DeleteReplicationTaskFromDLQRequest is used to delete a task from the replication DLQ of a source cluster
+	DeleteReplicationTaskFromDLQRequest struct {
+		SourceClusterName string
+		TaskID            int64
+	}
+
+	// GetReplicationDLQSizeRequest is used to count the tasks in the replication DLQ of a source cluster
+	GetReplicationDLQSizeRequest struct {
+		SourceClusterName string
+	}
+
+	// GetReplicationDLQSizeResponse is the response to GetReplicationDLQSize
+	GetReplicationDLQSizeResponse struct {
+		Size int64
+	}
+
 	// RangeCompleteTimerTaskRequest is used to complete a range of tasks in the timer task queue
 	RangeCompleteTimerTaskRequest struct {
 		InclusiveBeginTimestamp time.Time
@@ -839,6 +999,27 @@ type (
 	UpdateTaskListResponse struct {
 	}
 
+	// ListTaskListRequest is used to list the task lists of a domain, or of all the domains when DomainID is empty
+	ListTaskListRequest struct {
+		DomainID  string
+		PageSize  int
+		PageToken []byte
+	}
+
+	// ListTaskListResponse is the response to ListTaskList
+	ListTaskListResponse struct {
+		Items         []*TaskListInfo
+		NextPageToken []byte
+	}
+
+	// DeleteTaskListRequest is used to delete a task list together with its tasks
+	DeleteTaskListRequest struct {
+		DomainID     string
+		TaskListName string
+		TaskListType int
+		RangeID      int64
+	}
+
 	// CreateTasksRequest is used to create a new task for a workflow exectution
 	CreateTasksRequest struct {
 		TaskListInfo *TaskListInfo
@@ -878,6 +1059,16 @@ type (
 		TaskID   int64
 	}
 
+	// CompleteTasksLessThanRequest is used to complete the tasks of a task list with an id less than TaskID,
+	// Limit is the max number of tasks completed
+	CompleteTasksLessThanRequest struct {
+		DomainID     string
+		TaskListName string
+		TaskType     int
+		TaskID       int64
+		Limit        int
+	}
+
 	// GetTimerIndexTasksRequest is the request for GetTimerIndexTasks
 	// TODO: replace this with an iterator that can configure min and max index.
 	GetTimerIndexTasksRequest struct {
@@ -1128,6 +1319,8 @@ type (
 		TransactionID int64
 		// It is to suggest a binary encoding type to serialize history events
 		Encoding common.EncodingType
+		// Info describes the owner of a new branch, see BuildHistoryGarbageCleanupInfo
+		Info string
 	}
 
 	// AppendHistoryNodesResponse is a response to AppendHistoryNodesRequest
@@ -1187,6 +1380,8 @@ type (
 		// Application must provide a void forking nodeID, it must be a valid nodeID in that branch. A valid nodeID is the firstEventID of a valid batch of events.
 		// And ForkNodeID > 1 because forking from 1 doesn't make any sense.
 		ForkNodeID int64
+		// Info describes the owner of the new branch, see BuildHistoryGarbageCleanupInfo
+		Info string
 	}
 
 	// ForkHistoryBranchResponse is the response to ForkHistoryBranchRequest
@@ -1213,6 +1408,30 @@ type (
 		Branches []*workflow.HistoryBranch
 	}
 
+	// GetAllHistoryTreeBranchesRequest is used to list the branches of all the history trees
+	GetAllHistoryTreeBranchesRequest struct {
+		// pagination token
+		NextPageToken []byte
+		// maximum number of branches returned per page
+		PageSize int
+	}
+
+	// GetAllHistoryTreeBranchesResponse is a response to GetAllHistoryTreeBranches
+	GetAllHistoryTreeBranchesResponse struct {
+		// pagination token
+		NextPageToken []byte
+		// the branches of the page
+		Branches []HistoryBranchDetail
+	}
+
+	// HistoryBranchDetail describes a branch of a history tree
+	HistoryBranchDetail struct {
+		TreeID   string
+		BranchID string
+		ForkTime time.Time
+		Info     string
+	}
+
 	// AppendHistoryEventsResponse is response for AppendHistoryEventsRequest
 	// Deprecated: uses V2 API-AppendHistoryNodesRequest
 	AppendHistoryEventsResponse struct {
@@ -1243,8 +1462,12 @@ type (
 		GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error)
 		UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error)
 		ResetMutableState(request *ResetMutableStateRequest) error
+		ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error
 		DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error
+		DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error
 		GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
+		ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error)
+		ListCurrentExecutions(request *ListCurrentExecutionsRequest) (*ListCurrentExecutionsResponse, error)
 
 		// Transfer task related methods
 		GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error)
@@ -1255,6 +1478,12 @@ type (
 		GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error)
 		CompleteReplicationTask(request *CompleteReplicationTaskRequest) error
 
+		// Replication DLQ related methods
+		PutReplicationTaskToDLQ(request *PutReplicationTaskToDLQRequest) error
+		GetReplicationTasksFromDLQ(request *GetReplicationTasksFromDLQRequest) (*GetReplicationTasksFromDLQResponse, error)
+		DeleteReplicationTaskFromDLQ(request *DeleteReplicationTaskFromDLQRequest) error
+		GetReplicationDLQSize(request *GetReplicationDLQSizeRequest) (*GetReplicationDLQSizeResponse, error)
+
 		// Timer related methods.
 		GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error)
 		CompleteTimerTask(request *CompleteTimerTaskRequest) error
@@ -1273,9 +1502,13 @@ type (
 		GetName() string
 		LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error)
 		UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error)
+		ListTaskList(request *ListTaskListRequest) (*ListTaskListResponse, error)
+		DeleteTaskList(request *DeleteTaskListRequest) error
 		CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error)
 		GetTasks(request *GetTasksRequest) (*GetTasksResponse, error)
 		CompleteTask(request *CompleteTaskRequest) error
+		// CompleteTasksLessThan returns the number of tasks completed
+		CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error)
 	}
 
 	// HistoryManager is used to manage Workflow Execution HistoryEventBatch
@@ -1317,6 +1550,8 @@ type (
 		DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error
 		// GetHistoryTree returns all branch information of a tree
 		GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error)
+		// GetAllHistoryTreeBranches returns the branches of all the history trees
+		GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error)
 	}
 
 	// MetadataManager is used to manage metadata CRUD for domain entities
@@ -1466,6 +1701,76 @@ func (a *CloseExecutionTask) SetVisibilityTimestamp(timestamp time.Time) {
 	a.VisibilityTimestamp = timestamp
 }
 
+// GetType returns the type of the upsert search attributes transfer task
+func (u *UpsertWorkflowSearchAttributesTask) GetType() int {
+	return TransferTaskTypeUpsertWorkflowSearchAttributes
+}
+
+// GetVersion returns the version of the upsert search attributes transfer task
+func (u *UpsertWorkflowSearchAttributesTask) GetVersion() int64 {
+	return u.Version
+}
+
+// SetVersion returns the version of the upsert search attributes transfer task
+func (u *UpsertWorkflowSearchAttributesTask) SetVersion(version int64) {
+	u.Version = version
+}
+
+// GetTaskID returns the sequence ID of the upsert search attributes transfer task
+func (u *UpsertWorkflowSearchAttributesTask) GetTaskID() int64 {
+	return u.TaskID
+}
+
+// SetTaskID sets the sequence ID of the upsert search attributes transfer task
+func (u *UpsertWorkflowSearchAttributesTask) SetTaskID(id int64) {
+	u.TaskID = id
+}
+
+// GetVisibilityTimestamp get the visibility timestamp
+func (u *UpsertWorkflowSearchAttributesTask) GetVisibilityTimestamp() time.Time {
+	return u.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp set the visibility timestamp
+func (u *UpsertWorkflowSearchAttributesTask) SetVisibilityTimestamp(timestamp time.Time) {
+	u.VisibilityTimestamp = timestamp
+}
+
+// GetType returns the type of the record workflow started transfer task
+func (r *RecordWorkflowStartedTask) GetType() int {
+	return TransferTaskTypeRecordWorkflowStarted
+}
+
+// GetVersion returns the version of the record workflow started transfer task
+func (r *RecordWorkflowStartedTask) GetVersion() int64 {
+	return r.Version
+}
+
+// SetVersion returns the version of the record workflow started transfer task
+func (r *RecordWorkflowStartedTask) SetVersion(version int64) {
+	r.Version = version
+}
+
+// GetTaskID returns the sequence ID of the record workflow started transfer task
+func (r *RecordWorkflowStartedTask) GetTaskID() int64 {
+	return r.TaskID
+}
+
+// SetTaskID sets the sequence ID of the record workflow started transfer task
+func (r *RecordWorkflowStartedTask) SetTaskID(id int64) {
+	r.TaskID = id
+}
+
+// GetVisibilityTimestamp get the visibility timestamp
+func (r *RecordWorkflowStartedTask) GetVisibilityTimestamp() time.Time {
+	return r.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp set the visibility timestamp
+func (r *RecordWorkflowStartedTask) SetVisibilityTimestamp(timestamp time.Time) {
+	r.VisibilityTimestamp = timestamp
+}
+
 // GetType returns the type of the delete execution task
 func (a *DeleteHistoryEventTask) GetType() int {
 	return TaskTypeDeleteHistoryEvent
@@ -2050,3 +2355,54 @@ func NewHistoryBranchToken(treeID string) ([]byte, error) {
 	}
 	return token, nil
 }
+
+// BuildHistoryGarbageCleanupInfo combines the domain, workflow and run owning a history branch into the
+// info stored along with the branch, so the branches left behind by a deleted execution can be found
+func BuildHistoryGarbageCleanupInfo(domainID, workflowID, runID string) string {
+	return fmt.Sprintf("%v:%v:%v", domainID, workflowID, runID)
+}
+
+// SplitHistoryGarbageCleanupInfo returns the domain, workflow and run built into the info of a history branch,
+// the workflowID can contain the separator but the domainID and runID are UUIDs which can not
+func SplitHistoryGarbageCleanupInfo(info string) (domainID, workflowID, runID string, err error) {
+	first := strings.Index(info, ":")
+	last := strings.LastIndex(info, ":")
+	if first <= 0 || last == first || last == len(info)-1 {
+		return "", "", "", fmt.Errorf("invalid history garbage cleanup info: %v", info)
+	}
+	return info[:first], info[first+1 : last], info[last+1:], nil
+}
+
+// NewReplicationDLQTaskInfo encodes a replication task which could not be applied so it can be put to the
+// replication DLQ, the domain and workflow of the task are kept so the DLQ can be filtered by them
+func NewReplicationDLQTaskInfo(taskID int64, task *replicator.ReplicationTask) (*ReplicationDLQTaskInfo, error) {
+	data, err := internalThriftEncoder.Encode(task)
+	if err != nil {
+		return nil, err
+	}
+	info := &ReplicationDLQTaskInfo{
+		TaskID: taskID,
+		Task:   NewDataBlob(data, common.EncodingTypeThriftRW),
+	}
+	switch task.GetTaskType() {
+	case replicator.ReplicationTaskTypeHistory:
+		attr := task.HistoryTaskAttributes
+		info.DomainID, info.WorkflowID, info.RunID = attr.GetDomainId(), attr.GetWorkflowId(), attr.GetRunId()
+	case replicator.ReplicationTaskTypeSyncActivity:
+		attr := task.SyncActicvityTaskAttributes
+		info.DomainID, info.WorkflowID, info.RunID = attr.GetDomainId(), attr.GetWorkflowId(), attr.GetRunId()
+	}
+	return info, nil
+}
+
+// DecodeReplicationDLQTask decodes the replication task of a replication DLQ task
+func DecodeReplicationDLQTask(info *ReplicationDLQTaskInfo) (*replicator.ReplicationTask, error) {
+	if info.Task == nil || info.Task.GetEncoding() != common.EncodingType(common.EncodingTypeThriftRW) {
+		return nil, NewHistorySerializationError(fmt.Sprintf("unknown replication DLQ task encoding, task ID: %v", info.TaskID))
+	}
+	task := &replicator.ReplicationTask{}
+	if err := internalThriftEncoder.Decode(info.Task.Data, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
@@ -136,6 +136,8 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(info *InternalWorkflowEx
 		DecisionTimeout:              info.DecisionTimeout,
 		DecisionAttempt:              info.DecisionAttempt,
 		DecisionTimestamp:            info.DecisionTimestamp,
+		DecisionScheduledTimestamp:   info.DecisionScheduledTimestamp,
+		DecisionStartedTimestamp:     info.DecisionStartedTimestamp,
 		CancelRequested:              info.CancelRequested,
 		CancelRequestID:              info.CancelRequestID,
 		StickyTaskList:               info.StickyTaskList,
@@ -155,6 +157,8 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(info *InternalWorkflowEx
 		BranchToken:                  info.BranchToken,
 		CronSchedule:                 info.CronSchedule,
 		ExpirationSeconds:            info.ExpirationSeconds,
+		Memo:                         info.Memo,
+		SearchAttributes:             info.SearchAttributes,
 	}
 	return newInfo, nil
 }
@@ -264,6 +268,7 @@ func (m *executionManagerImpl) DeserializeActivityInfos(infos map[int64]*Interna
 			ExpirationTime:                 v.ExpirationTime,
 			MaximumAttempts:                v.MaximumAttempts,
 			NonRetriableErrors:             v.NonRetriableErrors,
+			LastFailureReason:              v.LastFailureReason,
 			LastHeartbeatTimeoutVisibility: v.LastHeartbeatTimeoutVisibility,
 		}
 		newInfos[k] = a
@@ -435,6 +440,7 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(infos []*ActivityInf
 			ExpirationTime:                 v.ExpirationTime,
 			MaximumAttempts:                v.MaximumAttempts,
 			NonRetriableErrors:             v.NonRetriableErrors,
+			LastFailureReason:              v.LastFailureReason,
 			LastHeartbeatTimeoutVisibility: v.LastHeartbeatTimeoutVisibility,
 		}
 		newInfos = append(newInfos, i)
@@ -482,6 +488,8 @@ func (m *executionManagerImpl) SerializeExecutionInfo(info *WorkflowExecutionInf
 		DecisionTimeout:              info.DecisionTimeout,
 		DecisionAttempt:              info.DecisionAttempt,
 		DecisionTimestamp:            info.DecisionTimestamp,
+		DecisionScheduledTimestamp:   info.DecisionScheduledTimestamp,
+		DecisionStartedTimestamp:     info.DecisionStartedTimestamp,
 		CancelRequested:              info.CancelRequested,
 		CancelRequestID:              info.CancelRequestID,
 		StickyTaskList:               info.StickyTaskList,
@@ -501,6 +509,8 @@ func (m *executionManagerImpl) SerializeExecutionInfo(info *WorkflowExecutionInf
 		BranchToken:                  info.BranchToken,
 		CronSchedule:                 info.CronSchedule,
 		ExpirationSeconds:            info.ExpirationSeconds,
+		Memo:                         info.Memo,
+		SearchAttributes:             info.SearchAttributes,
 	}, nil
 }
 
@@ -534,6 +544,42 @@ func (m *executionManagerImpl) ResetMutableState(request *ResetMutableStateReque
 	return m.persistence.ResetMutableState(newRequest)
 }
 
+func (m *executionManagerImpl) ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error {
+	executionInfo, err := m.SerializeExecutionInfo(request.ExecutionInfo, request.Encoding)
+	if err != nil {
+		return err
+	}
+	insertActivityInfos, err := m.SerializeUpsertActivityInfos(request.InsertActivityInfos, request.Encoding)
+	if err != nil {
+		return err
+	}
+	var currExecutionInfo *InternalWorkflowExecutionInfo
+	if request.CurrExecutionInfo != nil {
+		currExecutionInfo, err = m.SerializeExecutionInfo(request.CurrExecutionInfo, request.Encoding)
+		if err != nil {
+			return err
+		}
+	}
+
+	newRequest := &InternalResetWorkflowExecutionRequest{
+		PrevRunID:                request.PrevRunID,
+		ExecutionInfo:            executionInfo,
+		ReplicationState:         request.ReplicationState,
+		RangeID:                  request.RangeID,
+		TransferTasks:            request.TransferTasks,
+		TimerTasks:               request.TimerTasks,
+		InsertActivityInfos:      insertActivityInfos,
+		InsertTimerInfos:         request.InsertTimerInfos,
+		InsertSignalRequestedIDs: request.InsertSignalRequestedIDs,
+		CurrExecutionInfo:        currExecutionInfo,
+		CurrReplicationState:     request.CurrReplicationState,
+		CurrCondition:            request.CurrCondition,
+		CurrTransferTasks:        request.CurrTransferTasks,
+		CurrTimerTasks:           request.CurrTimerTasks,
+	}
+	return m.persistence.ResetWorkflowExecution(newRequest)
+}
+
 func (m *executionManagerImpl) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
 	return m.persistence.CreateWorkflowExecution(request)
 }
@@ -541,10 +587,22 @@ func (m *executionManagerImpl) DeleteWorkflowExecution(request *DeleteWorkflowEx
 	return m.persistence.DeleteWorkflowExecution(request)
 }
 
+func (m *executionManagerImpl) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	return m.persistence.DeleteCurrentWorkflowExecution(request)
+}
+
 func (m *executionManagerImpl) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
 	return m.persistence.GetCurrentExecution(request)
 }
 
+func (m *executionManagerImpl) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	return m.persistence.ListConcreteExecutions(request)
+}
+
+func (m *executionManagerImpl) ListCurrentExecutions(request *ListCurrentExecutionsRequest) (*ListCurrentExecutionsResponse, error) {
+	return m.persistence.ListCurrentExecutions(request)
+}
+
 // Transfer task related methods
 func (m *executionManagerImpl) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
 	return m.persistence.GetTransferTasks(request)
@@ -564,6 +622,20 @@ func (m *executionManagerImpl) CompleteReplicationTask(request *CompleteReplicat
 	return m.persistence.CompleteReplicationTask(request)
 }
 
+// Replication DLQ related methods
+func (m *executionManagerImpl) PutReplicationTaskToDLQ(request *PutReplicationTaskToDLQRequest) error {
+	return m.persistence.PutReplicationTaskToDLQ(request)
+}
+func (m *executionManagerImpl) GetReplicationTasksFromDLQ(request *GetReplicationTasksFromDLQRequest) (*GetReplicationTasksFromDLQResponse, error) {
+	return m.persistence.GetReplicationTasksFromDLQ(request)
+}
+func (m *executionManagerImpl) DeleteReplicationTaskFromDLQ(request *DeleteReplicationTaskFromDLQRequest) error {
+	return m.persistence.DeleteReplicationTaskFromDLQ(request)
+}
+func (m *executionManagerImpl) GetReplicationDLQSize(request *GetReplicationDLQSizeRequest) (*GetReplicationDLQSizeResponse, error) {
+	return m.persistence.GetReplicationDLQSize(request)
+}
+
 // Timer related methods.
 func (m *executionManagerImpl) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
 	return m.persistence.GetTimerIndexTasks(request)
@@ -100,6 +100,7 @@ func (m *historyV2ManagerImpl) ForkHistoryBranch(request *ForkHistoryBranchReque
 		ForkBranchInfo: forkBranch,
 		ForkNodeID:     request.ForkNodeID,
 		NewBranchID:    uuid.New(),
+		Info:           request.Info,
 	}
 
 	resp, err := m.persistence.ForkHistoryBranch(req)
@@ -137,6 +138,11 @@ func (m *historyV2ManagerImpl) GetHistoryTree(request *GetHistoryTreeRequest) (*
 	return m.persistence.GetHistoryTree(request)
 }
 
+// GetAllHistoryTreeBranches returns the branches of all the history trees
+func (m *historyV2ManagerImpl) GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error) {
+	return m.persistence.GetAllHistoryTreeBranches(request)
+}
+
 // AppendHistoryNodes add(or override) a node to a history branch
 func (m *historyV2ManagerImpl) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
 	var branch workflow.HistoryBranch
@@ -182,6 +188,7 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(request *AppendHistoryNodesReq
 		NodeID:        nodeID,
 		Events:        blob,
 		TransactionID: request.TransactionID,
+		Info:          request.Info,
 	}
 
 	err = m.persistence.AppendHistoryNodes(req)
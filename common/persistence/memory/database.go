@@ -0,0 +1,179 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"sync"
+
+	"github.com/uber-common/bark"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+const storeName = "memory"
+
+type (
+	// memoryStore is embedded by all the in-memory stores
+	memoryStore struct {
+		db     *database
+		logger bark.Logger
+	}
+
+	// database holds all the tables of an in-memory datastore, every store guards
+	// its reads and writes with the single lock of the database it belongs to
+	database struct {
+		sync.RWMutex
+
+		shards map[int]*p.ShardInfo
+
+		taskLists map[taskListKey]*p.TaskListInfo
+		tasks     map[taskListKey]map[int64]*p.TaskInfo
+
+		executions          map[executionKey]*p.InternalWorkflowMutableState
+		currentExecutions   map[currentExecutionKey]*currentExecutionRow
+		transferTasks       map[int]map[int64]*p.TransferTaskInfo
+		replicationTasks    map[int]map[int64]*p.ReplicationTaskInfo
+		replicationDLQ      map[replicationDLQKey]map[int64]*p.ReplicationDLQTaskInfo
+		timerTasks          map[int]map[timerTaskKey]*p.TimerTaskInfo
+		history             map[historyKey]map[int64]*historyRow
+		historyTreeBranches map[string]map[string]*historyTreeRow
+		historyNodes        map[historyBranchKey]map[int64]map[int64]*p.DataBlob
+
+		domainsByName       map[string]*p.GetDomainResponse
+		domainNamesByID     map[string]string
+		notificationVersion int64
+
+		visibility map[visibilityKey]*visibilityRow
+	}
+
+	taskListKey struct {
+		domainID string
+		name     string
+		taskType int
+	}
+
+	executionKey struct {
+		shardID    int
+		domainID   string
+		workflowID string
+		runID      string
+	}
+
+	currentExecutionKey struct {
+		shardID    int
+		domainID   string
+		workflowID string
+	}
+
+	replicationDLQKey struct {
+		shardID       int
+		sourceCluster string
+	}
+
+	timerTaskKey struct {
+		visibilityTimestamp int64 // unix nanos
+		taskID              int64
+	}
+
+	historyKey struct {
+		domainID   string
+		workflowID string
+		runID      string
+	}
+
+	historyBranchKey struct {
+		treeID   string
+		branchID string
+	}
+
+	visibilityKey struct {
+		domainID string
+		runID    string
+	}
+)
+
+var (
+	databasesLock sync.Mutex
+	databases     = make(map[string]*database)
+)
+
+// getDatabase returns the in-memory database with the given name, the database is created
+// when it does not exist yet
+func getDatabase(name string) *database {
+	databasesLock.Lock()
+	defer databasesLock.Unlock()
+
+	db, ok := databases[name]
+	if !ok {
+		db = newDatabase()
+		databases[name] = db
+	}
+	return db
+}
+
+// dropDatabase removes the in-memory database with the given name along with all its data
+func dropDatabase(name string) {
+	databasesLock.Lock()
+	defer databasesLock.Unlock()
+
+	delete(databases, name)
+}
+
+func newDatabase() *database {
+	return &database{
+		shards:              make(map[int]*p.ShardInfo),
+		taskLists:           make(map[taskListKey]*p.TaskListInfo),
+		tasks:               make(map[taskListKey]map[int64]*p.TaskInfo),
+		executions:          make(map[executionKey]*p.InternalWorkflowMutableState),
+		currentExecutions:   make(map[currentExecutionKey]*currentExecutionRow),
+		transferTasks:       make(map[int]map[int64]*p.TransferTaskInfo),
+		replicationTasks:    make(map[int]map[int64]*p.ReplicationTaskInfo),
+		replicationDLQ:      make(map[replicationDLQKey]map[int64]*p.ReplicationDLQTaskInfo),
+		timerTasks:          make(map[int]map[timerTaskKey]*p.TimerTaskInfo),
+		history:             make(map[historyKey]map[int64]*historyRow),
+		historyTreeBranches: make(map[string]map[string]*historyTreeRow),
+		historyNodes:        make(map[historyBranchKey]map[int64]map[int64]*p.DataBlob),
+		domainsByName:       make(map[string]*p.GetDomainResponse),
+		domainNamesByID:     make(map[string]string),
+		visibility:          make(map[visibilityKey]*visibilityRow),
+	}
+}
+
+func (m *memoryStore) GetName() string {
+	return storeName
+}
+
+func (m *memoryStore) Close() {}
+
+func copyBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	result := make([]byte, len(b))
+	copy(result, b)
+	return result
+}
+
+func copyDataBlob(blob *p.DataBlob) *p.DataBlob {
+	if blob == nil {
+		return nil
+	}
+	return &p.DataBlob{Encoding: blob.Encoding, Data: copyBytes(blob.Data)}
+}
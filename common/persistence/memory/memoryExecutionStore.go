@@ -0,0 +1,1193 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+var (
+	minUnixNanoTime = time.Unix(0, math.MinInt64)
+	maxUnixNanoTime = time.Unix(0, math.MaxInt64)
+)
+
+type (
+	memoryExecutionStore struct {
+		memoryStore
+		shardID int
+	}
+
+	currentExecutionRow struct {
+		runID            string
+		createRequestID  string
+		state            int
+		closeStatus      int
+		startVersion     int64
+		lastWriteVersion int64
+	}
+
+	taskIDPageToken struct {
+		TaskID int64
+	}
+
+	timerTaskPageToken struct {
+		TaskID    int64
+		Timestamp time.Time
+	}
+
+	executionPageToken struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+)
+
+// newExecutionPersistence creates an instance of ExecutionStore for the given shard
+func newExecutionPersistence(db *database, shardID int, logger bark.Logger) p.ExecutionStore {
+	return &memoryExecutionStore{
+		memoryStore: memoryStore{db: db, logger: logger},
+		shardID:     shardID,
+	}
+}
+
+func (m *memoryExecutionStore) GetShardID() int {
+	return m.shardID
+}
+
+func (m *memoryExecutionStore) CreateWorkflowExecution(request *p.CreateWorkflowExecutionRequest) (*p.CreateWorkflowExecutionResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if err := m.checkRangeIDLocked(request.RangeID); err != nil {
+		return nil, err
+	}
+	if err := m.checkCurrentExecutionLocked(request); err != nil {
+		return nil, err
+	}
+
+	m.createWorkflowExecutionLocked(request, time.Now())
+	domainID := request.DomainID
+	workflowID := request.Execution.GetWorkflowId()
+	runID := request.Execution.GetRunId()
+	m.createTransferTasksLocked(request.TransferTasks, domainID, workflowID, runID)
+	m.createReplicationTasksLocked(request.ReplicationTasks, domainID, workflowID, runID)
+	m.createTimerTasksLocked(request.TimerTasks, nil, domainID, workflowID, runID)
+	return &p.CreateWorkflowExecutionResponse{}, nil
+}
+
+func (m *memoryExecutionStore) GetWorkflowExecution(request *p.GetWorkflowExecutionRequest) (*p.InternalGetWorkflowExecutionResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	execution := request.Execution
+	state, ok := m.db.executions[m.executionKey(request.DomainID, execution.GetWorkflowId(), execution.GetRunId())]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+				execution.GetWorkflowId(), execution.GetRunId()),
+		}
+	}
+	return &p.InternalGetWorkflowExecutionResponse{State: copyMutableState(state)}, nil
+}
+
+func (m *memoryExecutionStore) UpdateWorkflowExecution(request *p.InternalUpdateWorkflowExecutionRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	executionInfo := request.ExecutionInfo
+	if err := m.checkRangeIDLocked(request.RangeID); err != nil {
+		return err
+	}
+
+	// the current execution must still point to the updated run, or to the previous run when continuing as new
+	conditionalRunID := executionInfo.RunID
+	if request.ContinueAsNew != nil {
+		conditionalRunID = request.ContinueAsNew.PreviousRunID
+	}
+	currentKey := m.currentExecutionKey(executionInfo.DomainID, executionInfo.WorkflowID)
+	current, ok := m.db.currentExecutions[currentKey]
+	if !ok || current.runID != conditionalRunID {
+		return &p.CurrentWorkflowConditionFailedError{
+			Msg: fmt.Sprintf("Failed to update mutable state.  Request Current RunID: %v, Actual Value: %v",
+				conditionalRunID, currentRunID(current)),
+		}
+	}
+
+	state, err := m.getExecutionWithConditionLocked(executionInfo, request.Condition)
+	if err != nil {
+		return err
+	}
+
+	info := copyExecutionInfo(executionInfo)
+	info.LastUpdatedTimestamp = time.Now()
+	state.ExecutionInfo = info
+	state.ReplicationState = copyReplicationState(request.ReplicationState)
+
+	for _, ai := range request.UpsertActivityInfos {
+		activityInfo := *ai
+		state.ActivitInfos[ai.ScheduleID] = &activityInfo
+	}
+	for _, scheduleID := range request.DeleteActivityInfos {
+		delete(state.ActivitInfos, scheduleID)
+	}
+	for _, ti := range request.UpserTimerInfos {
+		timerInfo := *ti
+		state.TimerInfos[ti.TimerID] = &timerInfo
+	}
+	for _, timerID := range request.DeleteTimerInfos {
+		delete(state.TimerInfos, timerID)
+	}
+	for _, ci := range request.UpsertChildExecutionInfos {
+		childInfo := *ci
+		state.ChildExecutionInfos[ci.InitiatedID] = &childInfo
+	}
+	if request.DeleteChildExecutionInfo != nil {
+		delete(state.ChildExecutionInfos, *request.DeleteChildExecutionInfo)
+	}
+	for _, rci := range request.UpsertRequestCancelInfos {
+		cancelInfo := *rci
+		state.RequestCancelInfos[rci.InitiatedID] = &cancelInfo
+	}
+	if request.DeleteRequestCancelInfo != nil {
+		delete(state.RequestCancelInfos, *request.DeleteRequestCancelInfo)
+	}
+	for _, si := range request.UpsertSignalInfos {
+		signalInfo := *si
+		state.SignalInfos[si.InitiatedID] = &signalInfo
+	}
+	if request.DeleteSignalInfo != nil {
+		delete(state.SignalInfos, *request.DeleteSignalInfo)
+	}
+	for _, signalRequestedID := range request.UpsertSignalRequestedIDs {
+		state.SignalRequestedIDs[signalRequestedID] = struct{}{}
+	}
+	if request.DeleteSignalRequestedID != "" {
+		delete(state.SignalRequestedIDs, request.DeleteSignalRequestedID)
+	}
+	if request.ClearBufferedEvents {
+		state.BufferedEvents = nil
+	} else if request.NewBufferedEvents != nil {
+		state.BufferedEvents = append(state.BufferedEvents, copyDataBlob(request.NewBufferedEvents))
+	}
+	if request.NewBufferedReplicationTask != nil {
+		task := copyBufferedReplicationTask(request.NewBufferedReplicationTask)
+		state.BufferedReplicationTasks[task.FirstEventID] = task
+	}
+	if request.DeleteBufferedReplicationTask != nil {
+		delete(state.BufferedReplicationTasks, *request.DeleteBufferedReplicationTask)
+	}
+
+	domainID := executionInfo.DomainID
+	workflowID := executionInfo.WorkflowID
+	runID := executionInfo.RunID
+	m.createTransferTasksLocked(request.TransferTasks, domainID, workflowID, runID)
+	m.createReplicationTasksLocked(request.ReplicationTasks, domainID, workflowID, runID)
+	m.createTimerTasksLocked(request.TimerTasks, request.DeleteTimerTask, domainID, workflowID, runID)
+
+	if request.ContinueAsNew != nil {
+		startReq := request.ContinueAsNew
+		m.createWorkflowExecutionLocked(startReq, time.Now())
+		m.createTransferTasksLocked(startReq.TransferTasks, startReq.DomainID, startReq.Execution.GetWorkflowId(),
+			startReq.Execution.GetRunId())
+		m.createTimerTasksLocked(startReq.TimerTasks, nil, startReq.DomainID, startReq.Execution.GetWorkflowId(),
+			startReq.Execution.GetRunId())
+	} else {
+		// the current execution of a finished run is kept, there is no TTL in memory
+		m.db.currentExecutions[currentKey] = newCurrentExecutionRow(executionInfo, request.ReplicationState)
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) ResetMutableState(request *p.InternalResetMutableStateRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	executionInfo := request.ExecutionInfo
+	if err := m.checkRangeIDLocked(request.RangeID); err != nil {
+		return err
+	}
+
+	currentKey := m.currentExecutionKey(executionInfo.DomainID, executionInfo.WorkflowID)
+	current, ok := m.db.currentExecutions[currentKey]
+	if !ok || current.runID != request.PrevRunID {
+		return &p.CurrentWorkflowConditionFailedError{
+			Msg: fmt.Sprintf("Failed to reset mutable state.  Request Current RunID: %v, Actual Value: %v",
+				request.PrevRunID, currentRunID(current)),
+		}
+	}
+
+	state, err := m.getExecutionWithConditionLocked(executionInfo, request.Condition)
+	if err != nil {
+		return err
+	}
+
+	info := copyExecutionInfo(executionInfo)
+	info.LastUpdatedTimestamp = time.Now()
+	state.ExecutionInfo = info
+	state.ReplicationState = copyReplicationState(request.ReplicationState)
+	state.ActivitInfos = make(map[int64]*p.InternalActivityInfo)
+	for _, ai := range request.InsertActivityInfos {
+		activityInfo := *ai
+		state.ActivitInfos[ai.ScheduleID] = &activityInfo
+	}
+	state.TimerInfos = make(map[string]*p.TimerInfo)
+	for _, ti := range request.InsertTimerInfos {
+		timerInfo := *ti
+		state.TimerInfos[ti.TimerID] = &timerInfo
+	}
+	state.ChildExecutionInfos = make(map[int64]*p.InternalChildExecutionInfo)
+	for _, ci := range request.InsertChildExecutionInfos {
+		childInfo := *ci
+		state.ChildExecutionInfos[ci.InitiatedID] = &childInfo
+	}
+	state.RequestCancelInfos = make(map[int64]*p.RequestCancelInfo)
+	for _, rci := range request.InsertRequestCancelInfos {
+		cancelInfo := *rci
+		state.RequestCancelInfos[rci.InitiatedID] = &cancelInfo
+	}
+	state.SignalInfos = make(map[int64]*p.SignalInfo)
+	for _, si := range request.InsertSignalInfos {
+		signalInfo := *si
+		state.SignalInfos[si.InitiatedID] = &signalInfo
+	}
+	state.SignalRequestedIDs = make(map[string]struct{})
+	for _, signalRequestedID := range request.InsertSignalRequestedIDs {
+		state.SignalRequestedIDs[signalRequestedID] = struct{}{}
+	}
+	state.BufferedEvents = nil
+
+	m.db.currentExecutions[currentKey] = newCurrentExecutionRow(executionInfo, request.ReplicationState)
+	return nil
+}
+
+func (m *memoryExecutionStore) ResetWorkflowExecution(request *p.InternalResetWorkflowExecutionRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	executionInfo := request.ExecutionInfo
+	if err := m.checkRangeIDLocked(request.RangeID); err != nil {
+		return err
+	}
+
+	currentKey := m.currentExecutionKey(executionInfo.DomainID, executionInfo.WorkflowID)
+	current, ok := m.db.currentExecutions[currentKey]
+	if !ok || current.runID != request.PrevRunID {
+		return &p.CurrentWorkflowConditionFailedError{
+			Msg: fmt.Sprintf("ResetWorkflowExecution operation failed.  Request Current RunID: %v, Actual Value: %v",
+				request.PrevRunID, currentRunID(current)),
+		}
+	}
+
+	// all the conditions are checked before anything is written, so the previous run is only terminated together
+	// with the creation of the new run
+	if currInfo := request.CurrExecutionInfo; currInfo != nil {
+		currState, err := m.getExecutionWithConditionLocked(currInfo, request.CurrCondition)
+		if err != nil {
+			return err
+		}
+		info := copyExecutionInfo(currInfo)
+		info.LastUpdatedTimestamp = time.Now()
+		currState.ExecutionInfo = info
+		currState.ReplicationState = copyReplicationState(request.CurrReplicationState)
+		m.createTransferTasksLocked(request.CurrTransferTasks, currInfo.DomainID, currInfo.WorkflowID, currInfo.RunID)
+		m.createTimerTasksLocked(request.CurrTimerTasks, nil, currInfo.DomainID, currInfo.WorkflowID, currInfo.RunID)
+	}
+
+	info := copyExecutionInfo(executionInfo)
+	info.LastUpdatedTimestamp = time.Now()
+	state := newMutableState(info, copyReplicationState(request.ReplicationState))
+	for _, ai := range request.InsertActivityInfos {
+		activityInfo := *ai
+		state.ActivitInfos[ai.ScheduleID] = &activityInfo
+	}
+	for _, ti := range request.InsertTimerInfos {
+		timerInfo := *ti
+		state.TimerInfos[ti.TimerID] = &timerInfo
+	}
+	for _, signalRequestedID := range request.InsertSignalRequestedIDs {
+		state.SignalRequestedIDs[signalRequestedID] = struct{}{}
+	}
+
+	domainID := executionInfo.DomainID
+	workflowID := executionInfo.WorkflowID
+	runID := executionInfo.RunID
+	m.db.executions[m.executionKey(domainID, workflowID, runID)] = state
+	m.db.currentExecutions[currentKey] = newCurrentExecutionRow(executionInfo, request.ReplicationState)
+	m.createTransferTasksLocked(request.TransferTasks, domainID, workflowID, runID)
+	m.createTimerTasksLocked(request.TimerTasks, nil, domainID, workflowID, runID)
+	return nil
+}
+
+func (m *memoryExecutionStore) DeleteWorkflowExecution(request *p.DeleteWorkflowExecutionRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.executions, m.executionKey(request.DomainID, request.WorkflowID, request.RunID))
+	return nil
+}
+
+func (m *memoryExecutionStore) DeleteCurrentWorkflowExecution(request *p.DeleteCurrentWorkflowExecutionRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	// the current execution is only deleted when it still points to the given run
+	key := m.currentExecutionKey(request.DomainID, request.WorkflowID)
+	if current, ok := m.db.currentExecutions[key]; ok && current.runID == request.RunID {
+		delete(m.db.currentExecutions, key)
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) GetCurrentExecution(request *p.GetCurrentExecutionRequest) (*p.GetCurrentExecutionResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	current, ok := m.db.currentExecutions[m.currentExecutionKey(request.DomainID, request.WorkflowID)]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v", request.WorkflowID),
+		}
+	}
+	return &p.GetCurrentExecutionResponse{
+		StartRequestID: current.createRequestID,
+		RunID:          current.runID,
+		State:          current.state,
+		CloseStatus:    current.closeStatus,
+	}, nil
+}
+
+// ListConcreteExecutions lists the concrete executions of the shard ordered by domain, workflow and run
+func (m *memoryExecutionStore) ListConcreteExecutions(
+	request *p.ListConcreteExecutionsRequest,
+) (*p.ListConcreteExecutionsResponse, error) {
+	pageToken, err := deserializeExecutionPageToken(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	var keys []executionKey
+	for key := range m.db.executions {
+		if key.shardID == m.shardID && (pageToken == nil || executionKeyLess(*pageToken, key)) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return executionKeyLess(keys[i], keys[j]) })
+
+	response := &p.ListConcreteExecutionsResponse{}
+	for _, key := range keys {
+		if len(response.Executions) == request.PageSize {
+			last := response.Executions[len(response.Executions)-1]
+			response.PageToken = serializeExecutionPageToken(last.DomainID, last.WorkflowID, last.RunID)
+			break
+		}
+		response.Executions = append(response.Executions, &p.ConcreteExecution{
+			DomainID:   key.domainID,
+			WorkflowID: key.workflowID,
+			RunID:      key.runID,
+		})
+	}
+	return response, nil
+}
+
+// ListCurrentExecutions lists the current executions of the shard ordered by domain and workflow
+func (m *memoryExecutionStore) ListCurrentExecutions(
+	request *p.ListCurrentExecutionsRequest,
+) (*p.ListCurrentExecutionsResponse, error) {
+	pageToken, err := deserializeExecutionPageToken(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	var keys []executionKey
+	for key := range m.db.currentExecutions {
+		current := m.executionKey(key.domainID, key.workflowID, "")
+		if key.shardID == m.shardID && (pageToken == nil || executionKeyLess(*pageToken, current)) {
+			keys = append(keys, current)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return executionKeyLess(keys[i], keys[j]) })
+
+	response := &p.ListCurrentExecutionsResponse{}
+	for _, key := range keys {
+		if len(response.Executions) == request.PageSize {
+			last := response.Executions[len(response.Executions)-1]
+			response.PageToken = serializeExecutionPageToken(last.DomainID, last.WorkflowID, "")
+			break
+		}
+		current := m.db.currentExecutions[m.currentExecutionKey(key.domainID, key.workflowID)]
+		response.Executions = append(response.Executions, &p.CurrentExecution{
+			DomainID:    key.domainID,
+			WorkflowID:  key.workflowID,
+			RunID:       current.runID,
+			State:       current.state,
+			CloseStatus: current.closeStatus,
+		})
+	}
+	return response, nil
+}
+
+func (m *memoryExecutionStore) GetTransferTasks(request *p.GetTransferTasksRequest) (*p.GetTransferTasksResponse, error) {
+	readLevel, err := deserializeTaskIDPageToken(request.NextPageToken, request.ReadLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	m.db.RLock()
+	var tasks []*p.TransferTaskInfo
+	for taskID, t := range m.db.transferTasks[m.shardID] {
+		if taskID > readLevel && taskID <= request.MaxReadLevel {
+			task := *t
+			tasks = append(tasks, &task)
+		}
+	}
+	m.db.RUnlock()
+
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].TaskID < tasks[j].TaskID })
+	response := &p.GetTransferTasksResponse{Tasks: tasks}
+	if request.BatchSize > 0 && len(tasks) > request.BatchSize {
+		response.Tasks = tasks[:request.BatchSize]
+		response.NextPageToken = serializeTaskIDPageToken(response.Tasks[request.BatchSize-1].TaskID)
+	}
+	return response, nil
+}
+
+func (m *memoryExecutionStore) CompleteTransferTask(request *p.CompleteTransferTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.transferTasks[m.shardID], request.TaskID)
+	return nil
+}
+
+func (m *memoryExecutionStore) RangeCompleteTransferTask(request *p.RangeCompleteTransferTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tasks := m.db.transferTasks[m.shardID]
+	for taskID := range tasks {
+		if taskID > request.ExclusiveBeginTaskID && taskID <= request.InclusiveEndTaskID {
+			delete(tasks, taskID)
+		}
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) GetReplicationTasks(request *p.GetReplicationTasksRequest) (*p.GetReplicationTasksResponse, error) {
+	readLevel, err := deserializeTaskIDPageToken(request.NextPageToken, request.ReadLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	m.db.RLock()
+	var tasks []*p.ReplicationTaskInfo
+	for taskID, t := range m.db.replicationTasks[m.shardID] {
+		if taskID > readLevel && taskID <= request.MaxReadLevel {
+			task := *t
+			tasks = append(tasks, &task)
+		}
+	}
+	m.db.RUnlock()
+
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].TaskID < tasks[j].TaskID })
+	response := &p.GetReplicationTasksResponse{Tasks: tasks}
+	if request.BatchSize > 0 && len(tasks) > request.BatchSize {
+		response.Tasks = tasks[:request.BatchSize]
+		response.NextPageToken = serializeTaskIDPageToken(response.Tasks[request.BatchSize-1].TaskID)
+	}
+	return response, nil
+}
+
+func (m *memoryExecutionStore) CompleteReplicationTask(request *p.CompleteReplicationTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.replicationTasks[m.shardID], request.TaskID)
+	return nil
+}
+
+func (m *memoryExecutionStore) PutReplicationTaskToDLQ(request *p.PutReplicationTaskToDLQRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := replicationDLQKey{shardID: m.shardID, sourceCluster: request.SourceClusterName}
+	tasks, ok := m.db.replicationDLQ[key]
+	if !ok {
+		tasks = make(map[int64]*p.ReplicationDLQTaskInfo)
+		m.db.replicationDLQ[key] = tasks
+	}
+	tasks[request.TaskInfo.TaskID] = copyReplicationDLQTaskInfo(request.TaskInfo)
+	return nil
+}
+
+func (m *memoryExecutionStore) GetReplicationTasksFromDLQ(
+	request *p.GetReplicationTasksFromDLQRequest,
+) (*p.GetReplicationTasksFromDLQResponse, error) {
+	readLevel, err := deserializeTaskIDPageToken(request.NextPageToken, math.MinInt64)
+	if err != nil {
+		return nil, err
+	}
+
+	m.db.RLock()
+	var tasks []*p.ReplicationDLQTaskInfo
+	for taskID, t := range m.db.replicationDLQ[replicationDLQKey{shardID: m.shardID, sourceCluster: request.SourceClusterName}] {
+		if taskID > readLevel {
+			tasks = append(tasks, copyReplicationDLQTaskInfo(t))
+		}
+	}
+	m.db.RUnlock()
+
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].TaskID < tasks[j].TaskID })
+	response := &p.GetReplicationTasksFromDLQResponse{Tasks: tasks}
+	if request.BatchSize > 0 && len(tasks) > request.BatchSize {
+		response.Tasks = tasks[:request.BatchSize]
+		response.NextPageToken = serializeTaskIDPageToken(response.Tasks[request.BatchSize-1].TaskID)
+	}
+	return response, nil
+}
+
+func (m *memoryExecutionStore) DeleteReplicationTaskFromDLQ(request *p.DeleteReplicationTaskFromDLQRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.replicationDLQ[replicationDLQKey{shardID: m.shardID, sourceCluster: request.SourceClusterName}], request.TaskID)
+	return nil
+}
+
+func (m *memoryExecutionStore) GetReplicationDLQSize(request *p.GetReplicationDLQSizeRequest) (*p.GetReplicationDLQSizeResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	tasks := m.db.replicationDLQ[replicationDLQKey{shardID: m.shardID, sourceCluster: request.SourceClusterName}]
+	return &p.GetReplicationDLQSizeResponse{Size: int64(len(tasks))}, nil
+}
+
+func (m *memoryExecutionStore) GetTimerIndexTasks(request *p.GetTimerIndexTasksRequest) (*p.GetTimerIndexTasksResponse, error) {
+	pageToken := &timerTaskPageToken{TaskID: math.MinInt64, Timestamp: request.MinTimestamp}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, pageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("error deserializing timerTaskPageToken: %v", err),
+			}
+		}
+	}
+	minKey := newTimerTaskKey(pageToken.Timestamp, pageToken.TaskID)
+	maxTimestamp := unixNano(request.MaxTimestamp)
+
+	m.db.RLock()
+	var timers []*p.TimerTaskInfo
+	for key, t := range m.db.timerTasks[m.shardID] {
+		if !timerTaskKeyLess(key, minKey) && key.visibilityTimestamp < maxTimestamp {
+			timer := *t
+			timers = append(timers, &timer)
+		}
+	}
+	m.db.RUnlock()
+
+	sort.Slice(timers, func(i, j int) bool {
+		return timerTaskKeyLess(newTimerTaskKey(timers[i].VisibilityTimestamp, timers[i].TaskID),
+			newTimerTaskKey(timers[j].VisibilityTimestamp, timers[j].TaskID))
+	})
+	response := &p.GetTimerIndexTasksResponse{Timers: timers}
+	if len(timers) > request.BatchSize {
+		next := timers[request.BatchSize]
+		nextPageToken, err := json.Marshal(&timerTaskPageToken{TaskID: next.TaskID, Timestamp: next.VisibilityTimestamp})
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetTimerTasks: error serializing page token: %v", err),
+			}
+		}
+		response.Timers = timers[:request.BatchSize]
+		response.NextPageToken = nextPageToken
+	}
+	return response, nil
+}
+
+func (m *memoryExecutionStore) CompleteTimerTask(request *p.CompleteTimerTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.timerTasks[m.shardID], newTimerTaskKey(request.VisibilityTimestamp, request.TaskID))
+	return nil
+}
+
+func (m *memoryExecutionStore) RangeCompleteTimerTask(request *p.RangeCompleteTimerTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	begin := unixNano(request.InclusiveBeginTimestamp)
+	end := unixNano(request.ExclusiveEndTimestamp)
+	timers := m.db.timerTasks[m.shardID]
+	for key := range timers {
+		if key.visibilityTimestamp >= begin && key.visibilityTimestamp < end {
+			delete(timers, key)
+		}
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) executionKey(domainID, workflowID, runID string) executionKey {
+	return executionKey{shardID: m.shardID, domainID: domainID, workflowID: workflowID, runID: runID}
+}
+
+func (m *memoryExecutionStore) currentExecutionKey(domainID, workflowID string) currentExecutionKey {
+	return currentExecutionKey{shardID: m.shardID, domainID: domainID, workflowID: workflowID}
+}
+
+// checkRangeIDLocked verifies that the shard was not acquired by another owner since the
+// request was built, it must be called with the database lock held
+func (m *memoryExecutionStore) checkRangeIDLocked(rangeID int64) error {
+	shard, ok := m.db.shards[m.shardID]
+	if !ok || shard.RangeID != rangeID {
+		actualRangeID := int64(0)
+		if ok {
+			actualRangeID = shard.RangeID
+		}
+		return &p.ShardOwnershipLostError{
+			ShardID: m.shardID,
+			Msg: fmt.Sprintf("Failed to update mutable state.  Request RangeID: %v, Actual RangeID: %v",
+				rangeID, actualRangeID),
+		}
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) getExecutionWithConditionLocked(
+	executionInfo *p.InternalWorkflowExecutionInfo,
+	condition int64,
+) (*p.InternalWorkflowMutableState, error) {
+	state, ok := m.db.executions[m.executionKey(executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID)]
+	if !ok {
+		return nil, &p.ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to update mutable state.  Workflow execution not found.  WorkflowId: %v, RunId: %v",
+				executionInfo.WorkflowID, executionInfo.RunID),
+		}
+	}
+	if state.ExecutionInfo.NextEventID != condition {
+		return nil, &p.ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to update mutable state.  Request Condition: %v, Actual Value: %v",
+				condition, state.ExecutionInfo.NextEventID),
+		}
+	}
+	return state, nil
+}
+
+// checkCurrentExecutionLocked verifies that the current execution of the workflow allows the creation
+// of the requested run according to the creation mode
+func (m *memoryExecutionStore) checkCurrentExecutionLocked(request *p.CreateWorkflowExecutionRequest) error {
+	workflowID := request.Execution.GetWorkflowId()
+	current, ok := m.db.currentExecutions[m.currentExecutionKey(request.DomainID, workflowID)]
+
+	switch request.CreateWorkflowMode {
+	case p.CreateWorkflowModeBrandNew:
+		if !ok {
+			return nil
+		}
+		lastWriteVersion := common.EmptyVersion
+		if request.ReplicationState != nil {
+			lastWriteVersion = current.lastWriteVersion
+		}
+		return &p.WorkflowExecutionAlreadyStartedError{
+			Msg:              fmt.Sprintf("Workflow execution already running. WorkflowId: %v", workflowID),
+			StartRequestID:   current.createRequestID,
+			RunID:            current.runID,
+			State:            current.state,
+			CloseStatus:      current.closeStatus,
+			LastWriteVersion: lastWriteVersion,
+		}
+	case p.CreateWorkflowModeWorkflowIDReuse:
+		if !ok {
+			return &p.CurrentWorkflowConditionFailedError{
+				Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, no current execution", workflowID),
+			}
+		}
+		// the last write version is only tracked for the global domains
+		if request.ReplicationState != nil && current.lastWriteVersion != request.PreviousLastWriteVersion {
+			return &p.CurrentWorkflowConditionFailedError{
+				Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+					"LastWriteVersion: %v, PreviousLastWriteVersion: %v",
+					workflowID, current.lastWriteVersion, request.PreviousLastWriteVersion),
+			}
+		}
+		if request.ReplicationState != nil && current.state != p.WorkflowStateCompleted {
+			return &p.CurrentWorkflowConditionFailedError{
+				Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+					"State: %v, Expected: %v",
+					workflowID, current.state, p.WorkflowStateCompleted),
+			}
+		}
+		if current.runID != request.PreviousRunID {
+			return &p.CurrentWorkflowConditionFailedError{
+				Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+					"RunID: %v, PreviousRunID: %v",
+					workflowID, current.runID, request.PreviousRunID),
+			}
+		}
+	case p.CreateWorkflowModeContinueAsNew:
+		if !ok || current.runID != request.PreviousRunID {
+			return &p.CurrentWorkflowConditionFailedError{
+				Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+					"RunID: %v, PreviousRunID: %v",
+					workflowID, currentRunID(current), request.PreviousRunID),
+			}
+		}
+	default:
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CreateWorkflowExecution operation failed. Unknown workflow creation mode: %v", request.CreateWorkflowMode),
+		}
+	}
+	return nil
+}
+
+// createWorkflowExecutionLocked writes the run and makes it the current execution of the workflow,
+// the caller is responsible for checking the current execution first
+func (m *memoryExecutionStore) createWorkflowExecutionLocked(request *p.CreateWorkflowExecutionRequest, now time.Time) {
+	info := &p.InternalWorkflowExecutionInfo{
+		DomainID:                   request.DomainID,
+		WorkflowID:                 request.Execution.GetWorkflowId(),
+		RunID:                      request.Execution.GetRunId(),
+		InitiatedID:                common.EmptyEventID,
+		TaskList:                   request.TaskList,
+		WorkflowTypeName:           request.WorkflowTypeName,
+		WorkflowTimeout:            request.WorkflowTimeout,
+		DecisionTimeoutValue:       request.DecisionTimeoutValue,
+		ExecutionContext:           copyBytes(request.ExecutionContext),
+		State:                      p.WorkflowStateCreated,
+		CloseStatus:                p.WorkflowCloseStatusNone,
+		LastFirstEventID:           common.FirstEventID,
+		NextEventID:                request.NextEventID,
+		LastProcessedEvent:         request.LastProcessedEvent,
+		StartTimestamp:             now,
+		LastUpdatedTimestamp:       now,
+		CreateRequestID:            request.RequestID,
+		SignalCount:                request.SignalCount,
+		HistorySize:                request.HistorySize,
+		DecisionVersion:            request.DecisionVersion,
+		DecisionScheduleID:         request.DecisionScheduleID,
+		DecisionStartedID:          request.DecisionStartedID,
+		DecisionTimeout:            request.DecisionStartToCloseTimeout,
+		DecisionScheduledTimestamp: request.DecisionScheduledTimestamp,
+		Attempt:                    request.Attempt,
+		HasRetryPolicy:             request.HasRetryPolicy,
+		InitialInterval:            request.InitialInterval,
+		BackoffCoefficient:         request.BackoffCoefficient,
+		MaximumInterval:            request.MaximumInterval,
+		ExpirationTime:             request.ExpirationTime,
+		MaximumAttempts:            request.MaximumAttempts,
+		NonRetriableErrors:         copyStrings(request.NonRetriableErrors),
+		EventStoreVersion:          request.EventStoreVersion,
+		BranchToken:                copyBytes(request.BranchToken),
+		CronSchedule:               request.CronSchedule,
+		ExpirationSeconds:          request.ExpirationSeconds,
+		Memo:                       copyBytesMap(request.Memo),
+		SearchAttributes:           copyBytesMap(request.SearchAttributes),
+	}
+	if request.ParentExecution != nil {
+		info.ParentDomainID = request.ParentDomainID
+		info.ParentWorkflowID = request.ParentExecution.GetWorkflowId()
+		info.ParentRunID = request.ParentExecution.GetRunId()
+		info.InitiatedID = request.InitiatedID
+	}
+
+	current := &currentExecutionRow{
+		runID:            info.RunID,
+		createRequestID:  request.RequestID,
+		state:            p.WorkflowStateRunning,
+		closeStatus:      p.WorkflowCloseStatusNone,
+		startVersion:     common.EmptyVersion,
+		lastWriteVersion: common.EmptyVersion,
+	}
+	if request.ParentExecution != nil {
+		current.state = p.WorkflowStateCreated
+	}
+	if request.ReplicationState != nil {
+		current.startVersion = request.ReplicationState.StartVersion
+		current.lastWriteVersion = request.ReplicationState.LastWriteVersion
+	}
+
+	m.db.executions[m.executionKey(info.DomainID, info.WorkflowID, info.RunID)] =
+		newMutableState(info, copyReplicationState(request.ReplicationState))
+	m.db.currentExecutions[m.currentExecutionKey(info.DomainID, info.WorkflowID)] = current
+}
+
+func (m *memoryExecutionStore) createTransferTasksLocked(transferTasks []p.Task, domainID, workflowID, runID string) {
+	if len(transferTasks) == 0 {
+		return
+	}
+	tasks, ok := m.db.transferTasks[m.shardID]
+	if !ok {
+		tasks = make(map[int64]*p.TransferTaskInfo)
+		m.db.transferTasks[m.shardID] = tasks
+	}
+
+	for _, task := range transferTasks {
+		info := &p.TransferTaskInfo{
+			DomainID:            domainID,
+			WorkflowID:          workflowID,
+			RunID:               runID,
+			VisibilityTimestamp: task.GetVisibilityTimestamp(),
+			TaskID:              task.GetTaskID(),
+			TargetDomainID:      domainID,
+			TargetWorkflowID:    p.TransferTaskTransferTargetWorkflowID,
+			TaskType:            task.GetType(),
+			Version:             task.GetVersion(),
+		}
+
+		switch t := task.(type) {
+		case *p.ActivityTask:
+			info.TargetDomainID = t.DomainID
+			info.TaskList = t.TaskList
+			info.ScheduleID = t.ScheduleID
+		case *p.DecisionTask:
+			info.TargetDomainID = t.DomainID
+			info.TaskList = t.TaskList
+			info.ScheduleID = t.ScheduleID
+		case *p.CancelExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			info.TargetRunID = t.TargetRunID
+			info.TargetChildWorkflowOnly = t.TargetChildWorkflowOnly
+			info.ScheduleID = t.InitiatedID
+		case *p.SignalExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			info.TargetRunID = t.TargetRunID
+			info.TargetChildWorkflowOnly = t.TargetChildWorkflowOnly
+			info.ScheduleID = t.InitiatedID
+		case *p.StartChildExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			info.ScheduleID = t.InitiatedID
+		}
+		tasks[info.TaskID] = info
+	}
+}
+
+func (m *memoryExecutionStore) createReplicationTasksLocked(replicationTasks []p.Task, domainID, workflowID, runID string) {
+	if len(replicationTasks) == 0 {
+		return
+	}
+	tasks, ok := m.db.replicationTasks[m.shardID]
+	if !ok {
+		tasks = make(map[int64]*p.ReplicationTaskInfo)
+		m.db.replicationTasks[m.shardID] = tasks
+	}
+
+	for _, task := range replicationTasks {
+		info := &p.ReplicationTaskInfo{
+			DomainID:     domainID,
+			WorkflowID:   workflowID,
+			RunID:        runID,
+			TaskID:       task.GetTaskID(),
+			TaskType:     task.GetType(),
+			FirstEventID: common.EmptyEventID,
+			NextEventID:  common.EmptyEventID,
+			Version:      task.GetVersion(),
+			ScheduledID:  common.EmptyEventID,
+		}
+
+		switch t := task.(type) {
+		case *p.HistoryReplicationTask:
+			info.FirstEventID = t.FirstEventID
+			info.NextEventID = t.NextEventID
+			info.LastReplicationInfo = copyReplicationInfo(t.LastReplicationInfo)
+			info.EventStoreVersion = t.EventStoreVersion
+			info.BranchToken = copyBytes(t.BranchToken)
+			info.NewRunEventStoreVersion = t.NewRunEventStoreVersion
+			info.NewRunBranchToken = copyBytes(t.NewRunBranchToken)
+		case *p.SyncActivityTask:
+			info.ScheduledID = t.ScheduledID
+		}
+		tasks[info.TaskID] = info
+	}
+}
+
+func (m *memoryExecutionStore) createTimerTasksLocked(timerTasks []p.Task, deleteTimerTask p.Task, domainID, workflowID, runID string) {
+	timers, ok := m.db.timerTasks[m.shardID]
+	if !ok {
+		timers = make(map[timerTaskKey]*p.TimerTaskInfo)
+		m.db.timerTasks[m.shardID] = timers
+	}
+
+	for _, task := range timerTasks {
+		info := &p.TimerTaskInfo{
+			DomainID:            domainID,
+			WorkflowID:          workflowID,
+			RunID:               runID,
+			VisibilityTimestamp: task.GetVisibilityTimestamp(),
+			TaskID:              task.GetTaskID(),
+			TaskType:            task.GetType(),
+			Version:             task.GetVersion(),
+		}
+
+		switch t := task.(type) {
+		case *p.DecisionTimeoutTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+			info.ScheduleAttempt = t.ScheduleAttempt
+		case *p.ActivityTimeoutTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+			info.ScheduleAttempt = t.Attempt
+		case *p.UserTimerTask:
+			info.EventID = t.EventID
+		case *p.ActivityRetryTimerTask:
+			info.EventID = t.EventID
+			info.ScheduleAttempt = int64(t.Attempt)
+		case *p.WorkflowBackoffTimerTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+		}
+		timers[newTimerTaskKey(info.VisibilityTimestamp, info.TaskID)] = info
+	}
+
+	if deleteTimerTask != nil {
+		delete(timers, newTimerTaskKey(deleteTimerTask.GetVisibilityTimestamp(), deleteTimerTask.GetTaskID()))
+	}
+}
+
+func newCurrentExecutionRow(executionInfo *p.InternalWorkflowExecutionInfo, replicationState *p.ReplicationState) *currentExecutionRow {
+	current := &currentExecutionRow{
+		runID:            executionInfo.RunID,
+		createRequestID:  executionInfo.CreateRequestID,
+		state:            executionInfo.State,
+		closeStatus:      executionInfo.CloseStatus,
+		startVersion:     common.EmptyVersion,
+		lastWriteVersion: common.EmptyVersion,
+	}
+	if replicationState != nil {
+		current.startVersion = replicationState.StartVersion
+		current.lastWriteVersion = replicationState.LastWriteVersion
+	}
+	return current
+}
+
+func currentRunID(current *currentExecutionRow) string {
+	if current == nil {
+		return ""
+	}
+	return current.runID
+}
+
+func newMutableState(info *p.InternalWorkflowExecutionInfo, replicationState *p.ReplicationState) *p.InternalWorkflowMutableState {
+	return &p.InternalWorkflowMutableState{
+		ActivitInfos:             make(map[int64]*p.InternalActivityInfo),
+		TimerInfos:               make(map[string]*p.TimerInfo),
+		ChildExecutionInfos:      make(map[int64]*p.InternalChildExecutionInfo),
+		RequestCancelInfos:       make(map[int64]*p.RequestCancelInfo),
+		SignalInfos:              make(map[int64]*p.SignalInfo),
+		SignalRequestedIDs:       make(map[string]struct{}),
+		ExecutionInfo:            info,
+		ReplicationState:         replicationState,
+		BufferedReplicationTasks: make(map[int64]*p.InternalBufferedReplicationTask),
+	}
+}
+
+func newTimerTaskKey(visibilityTimestamp time.Time, taskID int64) timerTaskKey {
+	return timerTaskKey{visibilityTimestamp: unixNano(visibilityTimestamp), taskID: taskID}
+}
+
+// unixNano converts the time to unix nanoseconds, clamping the times which can not be represented
+// that way, e.g. the zero time which is used as the lower bound of the timer scans
+func unixNano(t time.Time) int64 {
+	if t.Before(minUnixNanoTime) {
+		return math.MinInt64
+	}
+	if t.After(maxUnixNanoTime) {
+		return math.MaxInt64
+	}
+	return t.UnixNano()
+}
+
+func timerTaskKeyLess(a, b timerTaskKey) bool {
+	if a.visibilityTimestamp != b.visibilityTimestamp {
+		return a.visibilityTimestamp < b.visibilityTimestamp
+	}
+	return a.taskID < b.taskID
+}
+
+func executionKeyLess(a, b executionKey) bool {
+	if a.domainID != b.domainID {
+		return a.domainID < b.domainID
+	}
+	if a.workflowID != b.workflowID {
+		return a.workflowID < b.workflowID
+	}
+	return a.runID < b.runID
+}
+
+func serializeExecutionPageToken(domainID, workflowID, runID string) []byte {
+	// marshalling a struct of strings can not fail
+	token, _ := json.Marshal(&executionPageToken{DomainID: domainID, WorkflowID: workflowID, RunID: runID})
+	return token
+}
+
+// deserializeExecutionPageToken returns the key of the last execution of the previous page, nil for the first page
+func deserializeExecutionPageToken(token []byte) (*executionKey, error) {
+	if len(token) == 0 {
+		return nil, nil
+	}
+	pageToken := &executionPageToken{}
+	if err := json.Unmarshal(token, pageToken); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("error deserializing execution page token: %v", err),
+		}
+	}
+	return &executionKey{domainID: pageToken.DomainID, workflowID: pageToken.WorkflowID, runID: pageToken.RunID}, nil
+}
+
+func serializeTaskIDPageToken(taskID int64) []byte {
+	// marshalling a struct of an int64 can not fail
+	token, _ := json.Marshal(&taskIDPageToken{TaskID: taskID})
+	return token
+}
+
+func deserializeTaskIDPageToken(token []byte, defaultTaskID int64) (int64, error) {
+	if len(token) == 0 {
+		return defaultTaskID, nil
+	}
+	pageToken := &taskIDPageToken{}
+	if err := json.Unmarshal(token, pageToken); err != nil {
+		return 0, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("error deserializing task page token: %v", err),
+		}
+	}
+	return pageToken.TaskID, nil
+}
+
+func copyMutableState(state *p.InternalWorkflowMutableState) *p.InternalWorkflowMutableState {
+	copied := newMutableState(copyExecutionInfo(state.ExecutionInfo), copyReplicationState(state.ReplicationState))
+	for scheduleID, ai := range state.ActivitInfos {
+		activityInfo := *ai
+		activityInfo.ScheduledEvent = copyDataBlob(ai.ScheduledEvent)
+		activityInfo.StartedEvent = copyDataBlob(ai.StartedEvent)
+		activityInfo.Details = copyBytes(ai.Details)
+		activityInfo.NonRetriableErrors = copyStrings(ai.NonRetriableErrors)
+		copied.ActivitInfos[scheduleID] = &activityInfo
+	}
+	for timerID, ti := range state.TimerInfos {
+		timerInfo := *ti
+		copied.TimerInfos[timerID] = &timerInfo
+	}
+	for initiatedID, ci := range state.ChildExecutionInfos {
+		childInfo := *ci
+		childInfo.InitiatedEvent.Data = copyBytes(ci.InitiatedEvent.Data)
+		childInfo.StartedEvent = copyDataBlob(ci.StartedEvent)
+		copied.ChildExecutionInfos[initiatedID] = &childInfo
+	}
+	for initiatedID, rci := range state.RequestCancelInfos {
+		cancelInfo := *rci
+		copied.RequestCancelInfos[initiatedID] = &cancelInfo
+	}
+	for initiatedID, si := range state.SignalInfos {
+		signalInfo := *si
+		signalInfo.Input = copyBytes(si.Input)
+		signalInfo.Control = copyBytes(si.Control)
+		copied.SignalInfos[initiatedID] = &signalInfo
+	}
+	for signalRequestedID := range state.SignalRequestedIDs {
+		copied.SignalRequestedIDs[signalRequestedID] = struct{}{}
+	}
+	for _, events := range state.BufferedEvents {
+		copied.BufferedEvents = append(copied.BufferedEvents, copyDataBlob(events))
+	}
+	for firstEventID, task := range state.BufferedReplicationTasks {
+		copied.BufferedReplicationTasks[firstEventID] = copyBufferedReplicationTask(task)
+	}
+	return copied
+}
+
+func copyExecutionInfo(info *p.InternalWorkflowExecutionInfo) *p.InternalWorkflowExecutionInfo {
+	copied := *info
+	copied.CompletionEvent = copyDataBlob(info.CompletionEvent)
+	copied.ExecutionContext = copyBytes(info.ExecutionContext)
+	copied.NonRetriableErrors = copyStrings(info.NonRetriableErrors)
+	copied.BranchToken = copyBytes(info.BranchToken)
+	copied.Memo = copyBytesMap(info.Memo)
+	copied.SearchAttributes = copyBytesMap(info.SearchAttributes)
+	return &copied
+}
+
+func copyReplicationState(state *p.ReplicationState) *p.ReplicationState {
+	if state == nil {
+		return nil
+	}
+	copied := *state
+	copied.LastReplicationInfo = copyReplicationInfo(state.LastReplicationInfo)
+	return &copied
+}
+
+func copyReplicationInfo(replicationInfo map[string]*p.ReplicationInfo) map[string]*p.ReplicationInfo {
+	if replicationInfo == nil {
+		return nil
+	}
+	copied := make(map[string]*p.ReplicationInfo, len(replicationInfo))
+	for cluster, info := range replicationInfo {
+		ri := *info
+		copied[cluster] = &ri
+	}
+	return copied
+}
+
+func copyBufferedReplicationTask(task *p.InternalBufferedReplicationTask) *p.InternalBufferedReplicationTask {
+	copied := *task
+	copied.History = copyDataBlob(task.History)
+	copied.NewRunHistory = copyDataBlob(task.NewRunHistory)
+	return &copied
+}
+
+func copyReplicationDLQTaskInfo(info *p.ReplicationDLQTaskInfo) *p.ReplicationDLQTaskInfo {
+	copied := *info
+	copied.Task = copyDataBlob(info.Task)
+	return &copied
+}
+
+func copyStrings(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	return append([]string(nil), values...)
+}
+
+func copyBytesMap(values map[string][]byte) map[string][]byte {
+	if values == nil {
+		return nil
+	}
+	copied := make(map[string][]byte, len(values))
+	for key, value := range values {
+		copied[key] = copyBytes(value)
+	}
+	return copied
+}
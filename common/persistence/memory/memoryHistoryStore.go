@@ -0,0 +1,155 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	memoryHistoryStore struct {
+		memoryStore
+	}
+
+	historyRow struct {
+		eventBatchVersion int64
+		rangeID           int64
+		txID              int64
+		events            *p.DataBlob
+	}
+
+	historyPageToken struct {
+		FirstEventID int64
+	}
+)
+
+// newHistoryPersistence creates an instance of HistoryStore
+func newHistoryPersistence(db *database, logger bark.Logger) p.HistoryStore {
+	return &memoryHistoryStore{memoryStore: memoryStore{db: db, logger: logger}}
+}
+
+func (m *memoryHistoryStore) AppendHistoryEvents(request *p.InternalAppendHistoryEventsRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := historyKey{
+		domainID:   request.DomainID,
+		workflowID: request.Execution.GetWorkflowId(),
+		runID:      request.Execution.GetRunId(),
+	}
+	rows, ok := m.db.history[key]
+	if !ok {
+		rows = make(map[int64]*historyRow)
+		m.db.history[key] = rows
+	}
+
+	row, exists := rows[request.FirstEventID]
+	if request.Overwrite {
+		// an overwrite is only allowed by a newer transaction of the same or a later shard owner
+		if !exists || row.rangeID > request.RangeID || row.txID >= request.TransactionID {
+			return &p.ConditionFailedError{Msg: "Failed to append history events."}
+		}
+	} else if exists {
+		return &p.ConditionFailedError{Msg: "Failed to append history events."}
+	}
+
+	rows[request.FirstEventID] = &historyRow{
+		eventBatchVersion: request.EventBatchVersion,
+		rangeID:           request.RangeID,
+		txID:              request.TransactionID,
+		events:            copyDataBlob(request.Events),
+	}
+	return nil
+}
+
+func (m *memoryHistoryStore) GetWorkflowExecutionHistory(request *p.InternalGetWorkflowExecutionHistoryRequest) (
+	*p.InternalGetWorkflowExecutionHistoryResponse, error) {
+	execution := request.Execution
+	firstEventID := request.FirstEventID
+	if len(request.NextPageToken) > 0 {
+		pageToken := &historyPageToken{}
+		if err := json.Unmarshal(request.NextPageToken, pageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetWorkflowExecutionHistory operation failed. Error: %v", err),
+			}
+		}
+		firstEventID = pageToken.FirstEventID
+	}
+
+	m.db.RLock()
+	key := historyKey{domainID: request.DomainID, workflowID: execution.GetWorkflowId(), runID: execution.GetRunId()}
+	var firstEventIDs []int64
+	for id := range m.db.history[key] {
+		if id >= firstEventID && id < request.NextEventID {
+			firstEventIDs = append(firstEventIDs, id)
+		}
+	}
+	sort.Slice(firstEventIDs, func(i, j int) bool { return firstEventIDs[i] < firstEventIDs[j] })
+
+	var nextPageToken []byte
+	if request.PageSize > 0 && len(firstEventIDs) > request.PageSize {
+		nextPageToken, _ = json.Marshal(&historyPageToken{FirstEventID: firstEventIDs[request.PageSize]})
+		firstEventIDs = firstEventIDs[:request.PageSize]
+	}
+
+	//NOTE: in this method, we need to make sure is NOT decreasing(otherwise we skip the events)
+	lastEventBatchVersion := request.LastEventBatchVersion
+	history := make([]*p.DataBlob, 0, len(firstEventIDs))
+	for _, id := range firstEventIDs {
+		row := m.db.history[key][id]
+		if row.eventBatchVersion >= lastEventBatchVersion {
+			history = append(history, copyDataBlob(row.events))
+			lastEventBatchVersion = row.eventBatchVersion
+		}
+	}
+	m.db.RUnlock()
+
+	if len(firstEventIDs) == 0 && len(request.NextPageToken) == 0 {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution history not found.  WorkflowId: %v, RunId: %v",
+				execution.GetWorkflowId(), execution.GetRunId()),
+		}
+	}
+
+	return &p.InternalGetWorkflowExecutionHistoryResponse{
+		NextPageToken:         nextPageToken,
+		History:               history,
+		LastEventBatchVersion: lastEventBatchVersion,
+	}, nil
+}
+
+func (m *memoryHistoryStore) DeleteWorkflowExecutionHistory(request *p.DeleteWorkflowExecutionHistoryRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.history, historyKey{
+		domainID:   request.DomainID,
+		workflowID: request.Execution.GetWorkflowId(),
+		runID:      request.Execution.GetRunId(),
+	})
+	return nil
+}
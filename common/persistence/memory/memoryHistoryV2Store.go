@@ -0,0 +1,368 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	memoryHistoryV2Store struct {
+		memoryStore
+	}
+
+	historyTreeRow struct {
+		ancestors  []*workflow.HistoryBranchRange
+		inProgress bool
+		forkTime   time.Time
+		info       string
+	}
+
+	historyTreePageToken struct {
+		TreeID   string
+		BranchID string
+	}
+)
+
+// newHistoryV2Persistence creates an instance of HistoryV2Store
+func newHistoryV2Persistence(db *database, logger bark.Logger) p.HistoryV2Store {
+	return &memoryHistoryV2Store{memoryStore: memoryStore{db: db, logger: logger}}
+}
+
+// AppendHistoryNodes upsert a batch of events as a single node to a history branch
+// Note that it's not allowed to append above the branch's ancestors' nodes, which means nodeID >= ForkNodeID
+func (m *memoryHistoryV2Store) AppendHistoryNodes(request *p.InternalAppendHistoryNodesRequest) error {
+	branchInfo := request.BranchInfo
+	if request.NodeID < getBeginNodeID(branchInfo) {
+		return &p.InvalidPersistenceRequestError{
+			Msg: fmt.Sprintf("cannot append to ancestors' nodes"),
+		}
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	treeID := branchInfo.GetTreeID()
+	branchID := branchInfo.GetBranchID()
+	if request.IsNewBranch {
+		branches, ok := m.db.historyTreeBranches[treeID]
+		if !ok {
+			branches = make(map[string]*historyTreeRow)
+			m.db.historyTreeBranches[treeID] = branches
+		}
+		branches[branchID] = &historyTreeRow{
+			ancestors: copyBranchRanges(branchInfo.Ancestors),
+			forkTime:  time.Now(),
+			info:      request.Info,
+		}
+	}
+
+	key := historyBranchKey{treeID: treeID, branchID: branchID}
+	nodes, ok := m.db.historyNodes[key]
+	if !ok {
+		nodes = make(map[int64]map[int64]*p.DataBlob)
+		m.db.historyNodes[key] = nodes
+	}
+	txns, ok := nodes[request.NodeID]
+	if !ok {
+		txns = make(map[int64]*p.DataBlob)
+		nodes[request.NodeID] = txns
+	}
+	txns[request.TransactionID] = copyDataBlob(request.Events)
+	return nil
+}
+
+// ReadHistoryBranch returns history node data for a branch, only the node written by the
+// latest transaction is returned for each nodeID
+// NOTE: all the nodes within the range are returned in a single page
+func (m *memoryHistoryV2Store) ReadHistoryBranch(request *p.InternalReadHistoryBranchRequest) (*p.InternalReadHistoryBranchResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	nodes := m.db.historyNodes[historyBranchKey{treeID: request.TreeID, branchID: request.BranchID}]
+	var nodeIDs []int64
+	for nodeID := range nodes {
+		if nodeID >= request.MinNodeID && nodeID < request.MaxNodeID {
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+	}
+	sort.Slice(nodeIDs, func(i, j int) bool { return nodeIDs[i] < nodeIDs[j] })
+
+	history := make([]*p.DataBlob, 0, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		lastTxnID := int64(0)
+		var eventBlob *p.DataBlob
+		for txnID, blob := range nodes[nodeID] {
+			if eventBlob == nil || txnID > lastTxnID {
+				lastTxnID = txnID
+				eventBlob = blob
+			}
+		}
+		history = append(history, copyDataBlob(eventBlob))
+	}
+
+	return &p.InternalReadHistoryBranchResponse{History: history}, nil
+}
+
+// ForkHistoryBranch forks a new branch from an existing old branch
+// Note that application must provide a void forking nodeID, it must be a valid nodeID in that branch.
+// See the Cassandra implementation for the details about the ancestors of the new branch
+func (m *memoryHistoryV2Store) ForkHistoryBranch(request *p.InternalForkHistoryBranchRequest) (*p.InternalForkHistoryBranchResponse, error) {
+	forkB := request.ForkBranchInfo
+	treeID := forkB.GetTreeID()
+	newAncestors := make([]*workflow.HistoryBranchRange, 0, len(forkB.Ancestors)+1)
+
+	beginNodeID := getBeginNodeID(forkB)
+	if beginNodeID >= request.ForkNodeID {
+		// this is the case that new branch's ancestors doesn't include the forking branch
+		for _, br := range forkB.Ancestors {
+			if *br.EndNodeID >= request.ForkNodeID {
+				newAncestors = append(newAncestors, &workflow.HistoryBranchRange{
+					BranchID:    br.BranchID,
+					BeginNodeID: br.BeginNodeID,
+					EndNodeID:   common.Int64Ptr(request.ForkNodeID),
+				})
+				break
+			} else {
+				newAncestors = append(newAncestors, br)
+			}
+		}
+	} else {
+		// this is the case the new branch will inherit all ancestors from forking branch
+		newAncestors = append(newAncestors, forkB.Ancestors...)
+		newAncestors = append(newAncestors, &workflow.HistoryBranchRange{
+			BranchID:    forkB.BranchID,
+			BeginNodeID: common.Int64Ptr(beginNodeID),
+			EndNodeID:   common.Int64Ptr(request.ForkNodeID),
+		})
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	// NOTE: To prevent leaking event data caused by forking, the new branch is marked as in progress
+	// until the first append to it writes the actual ancestors
+	branches, ok := m.db.historyTreeBranches[treeID]
+	if !ok {
+		branches = make(map[string]*historyTreeRow)
+		m.db.historyTreeBranches[treeID] = branches
+	}
+	branches[request.NewBranchID] = &historyTreeRow{inProgress: true, forkTime: time.Now(), info: request.Info}
+
+	return &p.InternalForkHistoryBranchResponse{
+		NewBranchInfo: workflow.HistoryBranch{
+			TreeID:    &treeID,
+			BranchID:  common.StringPtr(request.NewBranchID),
+			Ancestors: newAncestors,
+		},
+	}, nil
+}
+
+// DeleteHistoryBranch removes a branch
+func (m *memoryHistoryV2Store) DeleteHistoryBranch(request *p.InternalDeleteHistoryBranchRequest) error {
+	branch := request.BranchInfo
+	treeID := branch.GetTreeID()
+	brsToDelete := append([]*workflow.HistoryBranchRange{}, branch.Ancestors...)
+	brsToDelete = append(brsToDelete, &workflow.HistoryBranchRange{
+		BranchID:    branch.BranchID,
+		BeginNodeID: common.Int64Ptr(getBeginNodeID(branch)),
+	})
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	// We won't delete the branch if there is any branch forking in progress
+	branches, err := m.getHistoryTreeLocked(treeID)
+	if err != nil {
+		return err
+	}
+
+	// validBRsMaxEndNode is to know each branch range that is being used, we want to know what is the max nodeID referred by other valid branch
+	validBRsMaxEndNode := map[string]int64{}
+	for _, b := range branches {
+		for _, br := range b.Ancestors {
+			curr, ok := validBRsMaxEndNode[*br.BranchID]
+			if !ok || curr < *br.EndNodeID {
+				validBRsMaxEndNode[*br.BranchID] = *br.EndNodeID
+			}
+		}
+	}
+
+	delete(m.db.historyTreeBranches[treeID], branch.GetBranchID())
+	if len(m.db.historyTreeBranches[treeID]) == 0 {
+		delete(m.db.historyTreeBranches, treeID)
+	}
+
+	// for each branch range to delete, we iterate from bottom to up, and delete up to the point according to validBRsEndNode
+	for i := len(brsToDelete) - 1; i >= 0; i-- {
+		br := brsToDelete[i]
+		maxReferredEndNodeID, ok := validBRsMaxEndNode[*br.BranchID]
+		if ok {
+			// we can only delete from the maxEndNode and stop here
+			m.deleteBranchRangeNodesLocked(treeID, *br.BranchID, maxReferredEndNodeID)
+			break
+		} else {
+			// No any branch is using this range, we can delete all of it
+			m.deleteBranchRangeNodesLocked(treeID, *br.BranchID, *br.BeginNodeID)
+		}
+	}
+	return nil
+}
+
+// GetHistoryTree returns all branch information of a tree
+func (m *memoryHistoryV2Store) GetHistoryTree(request *p.GetHistoryTreeRequest) (*p.GetHistoryTreeResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	branches, err := m.getHistoryTreeLocked(request.TreeID)
+	if err != nil {
+		return nil, err
+	}
+	return &p.GetHistoryTreeResponse{Branches: branches}, nil
+}
+
+// GetAllHistoryTreeBranches returns the branches of all the history trees ordered by tree and branch
+func (m *memoryHistoryV2Store) GetAllHistoryTreeBranches(request *p.GetAllHistoryTreeBranchesRequest) (*p.GetAllHistoryTreeBranchesResponse, error) {
+	var pageToken *historyTreePageToken
+	if len(request.NextPageToken) > 0 {
+		pageToken = &historyTreePageToken{}
+		if err := json.Unmarshal(request.NextPageToken, pageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("error deserializing history tree page token: %v", err),
+			}
+		}
+	}
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	var keys []historyBranchKey
+	for treeID, branches := range m.db.historyTreeBranches {
+		for branchID := range branches {
+			key := historyBranchKey{treeID: treeID, branchID: branchID}
+			if pageToken == nil || historyBranchKeyLess(historyBranchKey{treeID: pageToken.TreeID, branchID: pageToken.BranchID}, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return historyBranchKeyLess(keys[i], keys[j]) })
+
+	response := &p.GetAllHistoryTreeBranchesResponse{}
+	for _, key := range keys {
+		if len(response.Branches) == request.PageSize {
+			last := response.Branches[len(response.Branches)-1]
+			// marshalling a struct of strings can not fail
+			response.NextPageToken, _ = json.Marshal(&historyTreePageToken{TreeID: last.TreeID, BranchID: last.BranchID})
+			break
+		}
+		row := m.db.historyTreeBranches[key.treeID][key.branchID]
+		response.Branches = append(response.Branches, p.HistoryBranchDetail{
+			TreeID:   key.treeID,
+			BranchID: key.branchID,
+			ForkTime: row.forkTime,
+			Info:     row.info,
+		})
+	}
+	return response, nil
+}
+
+func (m *memoryHistoryV2Store) getHistoryTreeLocked(treeID string) ([]*workflow.HistoryBranch, error) {
+	branches := make([]*workflow.HistoryBranch, 0, len(m.db.historyTreeBranches[treeID]))
+	for branchID, row := range m.db.historyTreeBranches[treeID] {
+		if row.inProgress {
+			return nil, &p.ConditionFailedError{
+				Msg: " a branch is forking in progress, retry later",
+			}
+		}
+
+		ancestors := make([]*workflow.HistoryBranchRange, 0, len(row.ancestors))
+		for _, an := range row.ancestors {
+			ancestors = append(ancestors, &workflow.HistoryBranchRange{
+				BranchID:  common.StringPtr(an.GetBranchID()),
+				EndNodeID: common.Int64Ptr(an.GetEndNodeID()),
+			})
+		}
+		if len(ancestors) > 0 {
+			// sort ancestors based on EndNodeID so that we can set BeginNodeID
+			sort.Slice(ancestors, func(i, j int) bool { return *ancestors[i].EndNodeID < *ancestors[j].EndNodeID })
+			ancestors[0].BeginNodeID = common.Int64Ptr(int64(1))
+			for i := 1; i < len(ancestors); i++ {
+				ancestors[i].BeginNodeID = ancestors[i-1].EndNodeID
+			}
+		}
+
+		branches = append(branches, &workflow.HistoryBranch{
+			TreeID:    common.StringPtr(treeID),
+			BranchID:  common.StringPtr(branchID),
+			Ancestors: ancestors,
+		})
+	}
+	return branches, nil
+}
+
+func (m *memoryHistoryV2Store) deleteBranchRangeNodesLocked(treeID, branchID string, beginNodeID int64) {
+	key := historyBranchKey{treeID: treeID, branchID: branchID}
+	nodes := m.db.historyNodes[key]
+	for nodeID := range nodes {
+		if nodeID >= beginNodeID {
+			delete(nodes, nodeID)
+		}
+	}
+	if len(nodes) == 0 {
+		delete(m.db.historyNodes, key)
+	}
+}
+
+func getBeginNodeID(bi workflow.HistoryBranch) int64 {
+	if len(bi.Ancestors) == 0 {
+		// root branch
+		return 1
+	}
+	idx := len(bi.Ancestors) - 1
+	return *bi.Ancestors[idx].EndNodeID
+}
+
+func historyBranchKeyLess(a, b historyBranchKey) bool {
+	if a.treeID != b.treeID {
+		return a.treeID < b.treeID
+	}
+	return a.branchID < b.branchID
+}
+
+func copyBranchRanges(ranges []*workflow.HistoryBranchRange) []*workflow.HistoryBranchRange {
+	copied := make([]*workflow.HistoryBranchRange, 0, len(ranges))
+	for _, br := range ranges {
+		copied = append(copied, &workflow.HistoryBranchRange{
+			BranchID:    common.StringPtr(br.GetBranchID()),
+			BeginNodeID: common.Int64Ptr(br.GetBeginNodeID()),
+			EndNodeID:   common.Int64Ptr(br.GetEndNodeID()),
+		})
+	}
+	return copied
+}
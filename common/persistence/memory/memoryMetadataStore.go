@@ -0,0 +1,231 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	memoryMetadataStore struct {
+		memoryStore
+		currentClusterName string
+	}
+)
+
+// newMetadataPersistence creates an instance of MetadataStore
+func newMetadataPersistence(db *database, currentClusterName string, logger bark.Logger) p.MetadataStore {
+	return &memoryMetadataStore{
+		memoryStore:        memoryStore{db: db, logger: logger},
+		currentClusterName: currentClusterName,
+	}
+}
+
+// CreateDomain create a domain, the domain is visible to the other datastores of the same database
+// as soon as this call returns
+func (m *memoryMetadataStore) CreateDomain(request *p.CreateDomainRequest) (*p.CreateDomainResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if _, ok := m.db.domainNamesByID[request.Info.ID]; ok {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CreateDomain operation failed because of uuid collision."),
+		}
+	}
+	if domain, ok := m.db.domainsByName[request.Info.Name]; ok {
+		return nil, &workflow.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("Domain already exists.  DomainId: %v", domain.Info.ID),
+		}
+	}
+
+	m.db.domainsByName[request.Info.Name] = &p.GetDomainResponse{
+		Info:                        copyDomainInfo(request.Info),
+		Config:                      copyDomainConfig(request.Config),
+		ReplicationConfig:           copyDomainReplicationConfig(request.ReplicationConfig),
+		IsGlobalDomain:              request.IsGlobalDomain,
+		ConfigVersion:               request.ConfigVersion,
+		FailoverVersion:             request.FailoverVersion,
+		FailoverNotificationVersion: p.InitialFailoverNotificationVersion,
+		NotificationVersion:         m.db.notificationVersion,
+	}
+	m.db.domainNamesByID[request.Info.ID] = request.Info.Name
+	m.db.notificationVersion++
+	return &p.CreateDomainResponse{ID: request.Info.ID}, nil
+}
+
+func (m *memoryMetadataStore) GetDomain(request *p.GetDomainRequest) (*p.GetDomainResponse, error) {
+	if len(request.ID) > 0 && len(request.Name) > 0 {
+		return nil, &workflow.BadRequestError{
+			Message: "GetDomain operation failed.  Both ID and Name specified in request.",
+		}
+	} else if len(request.ID) == 0 && len(request.Name) == 0 {
+		return nil, &workflow.BadRequestError{
+			Message: "GetDomain operation failed.  Both ID and Name are empty.",
+		}
+	}
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	identity := request.Name
+	domainName := request.Name
+	if len(request.ID) > 0 {
+		identity = request.ID
+		domainName = m.db.domainNamesByID[request.ID]
+	}
+	domain, ok := m.db.domainsByName[domainName]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Domain %s does not exist.", identity),
+		}
+	}
+	return m.toDomainResponse(domain), nil
+}
+
+func (m *memoryMetadataStore) UpdateDomain(request *p.UpdateDomainRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	domain, ok := m.db.domainsByName[request.Info.Name]
+	if !ok || request.NotificationVersion != m.db.notificationVersion {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateDomain operation failed because of conditional failure."),
+		}
+	}
+
+	m.db.domainsByName[request.Info.Name] = &p.GetDomainResponse{
+		Info:                        copyDomainInfo(request.Info),
+		Config:                      copyDomainConfig(request.Config),
+		ReplicationConfig:           copyDomainReplicationConfig(request.ReplicationConfig),
+		IsGlobalDomain:              domain.IsGlobalDomain,
+		ConfigVersion:               request.ConfigVersion,
+		FailoverVersion:             request.FailoverVersion,
+		FailoverNotificationVersion: request.FailoverNotificationVersion,
+		NotificationVersion:         request.NotificationVersion,
+	}
+	m.db.notificationVersion++
+	return nil
+}
+
+func (m *memoryMetadataStore) DeleteDomain(request *p.DeleteDomainRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if name, ok := m.db.domainNamesByID[request.ID]; ok {
+		delete(m.db.domainsByName, name)
+		delete(m.db.domainNamesByID, request.ID)
+	}
+	return nil
+}
+
+func (m *memoryMetadataStore) DeleteDomainByName(request *p.DeleteDomainByNameRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if domain, ok := m.db.domainsByName[request.Name]; ok {
+		delete(m.db.domainNamesByID, domain.Info.ID)
+		delete(m.db.domainsByName, request.Name)
+	}
+	return nil
+}
+
+func (m *memoryMetadataStore) ListDomains(request *p.ListDomainsRequest) (*p.ListDomainsResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	lastName := string(request.NextPageToken)
+	var names []string
+	for name := range m.db.domainsByName {
+		if name > lastName {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	response := &p.ListDomainsResponse{}
+	if request.PageSize > 0 && len(names) > request.PageSize {
+		names = names[:request.PageSize]
+		response.NextPageToken = []byte(names[len(names)-1])
+	}
+	for _, name := range names {
+		response.Domains = append(response.Domains, m.toDomainResponse(m.db.domainsByName[name]))
+	}
+	return response, nil
+}
+
+func (m *memoryMetadataStore) GetMetadata() (*p.GetMetadataResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	return &p.GetMetadataResponse{NotificationVersion: m.db.notificationVersion}, nil
+}
+
+func (m *memoryMetadataStore) toDomainResponse(domain *p.GetDomainResponse) *p.GetDomainResponse {
+	response := *domain
+	response.Info = copyDomainInfo(domain.Info)
+	if response.Info.Data == nil {
+		response.Info.Data = map[string]string{}
+	}
+	response.Config = copyDomainConfig(domain.Config)
+	response.ReplicationConfig = copyDomainReplicationConfig(domain.ReplicationConfig)
+	response.ReplicationConfig.ActiveClusterName = p.GetOrUseDefaultActiveCluster(m.currentClusterName,
+		response.ReplicationConfig.ActiveClusterName)
+	response.ReplicationConfig.Clusters = p.GetOrUseDefaultClusters(m.currentClusterName,
+		response.ReplicationConfig.Clusters)
+	response.TableVersion = p.DomainTableVersionV2
+	return &response
+}
+
+func copyDomainInfo(info *p.DomainInfo) *p.DomainInfo {
+	copied := *info
+	if info.Data != nil {
+		copied.Data = make(map[string]string, len(info.Data))
+		for k, v := range info.Data {
+			copied.Data[k] = v
+		}
+	}
+	return &copied
+}
+
+func copyDomainConfig(config *p.DomainConfig) *p.DomainConfig {
+	if config == nil {
+		return &p.DomainConfig{}
+	}
+	copied := *config
+	return &copied
+}
+
+func copyDomainReplicationConfig(config *p.DomainReplicationConfig) *p.DomainReplicationConfig {
+	copied := &p.DomainReplicationConfig{}
+	if config == nil {
+		return copied
+	}
+	copied.ActiveClusterName = config.ActiveClusterName
+	for _, cluster := range config.Clusters {
+		copied.Clusters = append(copied.Clusters, &p.ClusterReplicationConfig{ClusterName: cluster.ClusterName})
+	}
+	return copied
+}
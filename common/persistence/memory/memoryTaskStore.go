@@ -0,0 +1,267 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	memoryTaskStore struct {
+		memoryStore
+	}
+
+	taskListPageToken struct {
+		DomainID string `json:",omitempty"`
+		Name     string
+		TaskType int
+	}
+)
+
+// newTaskPersistence creates an instance of TaskStore
+func newTaskPersistence(db *database, logger bark.Logger) p.TaskStore {
+	return &memoryTaskStore{
+		memoryStore: memoryStore{db: db, logger: logger},
+	}
+}
+
+func (m *memoryTaskStore) LeaseTaskList(request *p.LeaseTaskListRequest) (*p.LeaseTaskListResponse, error) {
+	if len(request.TaskList) == 0 {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("LeaseTaskList requires non empty task list"),
+		}
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := taskListKey{domainID: request.DomainID, name: request.TaskList, taskType: request.TaskType}
+	info, ok := m.db.taskLists[key]
+	if !ok {
+		info = &p.TaskListInfo{
+			DomainID: request.DomainID,
+			Name:     request.TaskList,
+			TaskType: request.TaskType,
+		}
+		m.db.taskLists[key] = info
+	}
+	info.RangeID++
+	info.Kind = request.TaskListKind
+	info.LastUpdated = time.Now()
+
+	result := *info
+	return &p.LeaseTaskListResponse{TaskListInfo: &result}, nil
+}
+
+func (m *memoryTaskStore) UpdateTaskList(request *p.UpdateTaskListRequest) (*p.UpdateTaskListResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tli := request.TaskListInfo
+	key := taskListKey{domainID: tli.DomainID, name: tli.Name, taskType: tli.TaskType}
+	// the sticky task lists are updated without the range check, like their TTL based upserts in the other stores
+	if tli.Kind != p.TaskListKindSticky {
+		info, ok := m.db.taskLists[key]
+		if !ok || info.RangeID != tli.RangeID {
+			return nil, &p.ConditionFailedError{
+				Msg: fmt.Sprintf("Failed to update task list. name: %v, type: %v, rangeID: %v", tli.Name, tli.TaskType, tli.RangeID),
+			}
+		}
+	}
+
+	info := *tli
+	info.LastUpdated = time.Now()
+	m.db.taskLists[key] = &info
+	return &p.UpdateTaskListResponse{}, nil
+}
+
+func (m *memoryTaskStore) ListTaskList(request *p.ListTaskListRequest) (*p.ListTaskListResponse, error) {
+	// the task lists are returned in the order of their domain, name and type, starting after the last one of the
+	// previous page
+	pageToken := &taskListPageToken{TaskType: -1}
+	if len(request.PageToken) > 0 {
+		if err := json.Unmarshal(request.PageToken, pageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ListTaskList operation failed. Error deserializing page token: %v", err),
+			}
+		}
+	}
+
+	m.db.RLock()
+	var items []*p.TaskListInfo
+	after := taskListKey{domainID: pageToken.DomainID, name: pageToken.Name, taskType: pageToken.TaskType}
+	if request.DomainID != "" {
+		after.domainID = request.DomainID
+	}
+	for key, info := range m.db.taskLists {
+		if request.DomainID != "" && key.domainID != request.DomainID {
+			continue
+		}
+		if !taskListKeyLess(after, key) {
+			continue
+		}
+		item := *info
+		items = append(items, &item)
+	}
+	m.db.RUnlock()
+
+	sort.Slice(items, func(i, j int) bool {
+		return taskListKeyLess(
+			taskListKey{domainID: items[i].DomainID, name: items[i].Name, taskType: items[i].TaskType},
+			taskListKey{domainID: items[j].DomainID, name: items[j].Name, taskType: items[j].TaskType})
+	})
+
+	response := &p.ListTaskListResponse{Items: items}
+	if request.PageSize > 0 && len(items) >= request.PageSize {
+		response.Items = items[:request.PageSize]
+		last := response.Items[len(response.Items)-1]
+		nextPageToken, err := json.Marshal(&taskListPageToken{DomainID: last.DomainID, Name: last.Name, TaskType: last.TaskType})
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ListTaskList operation failed. Error serializing page token: %v", err),
+			}
+		}
+		response.NextPageToken = nextPageToken
+	}
+	return response, nil
+}
+
+func (m *memoryTaskStore) DeleteTaskList(request *p.DeleteTaskListRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	// the task list is only deleted if it was not leased again since it was read
+	key := taskListKey{domainID: request.DomainID, name: request.TaskListName, taskType: request.TaskListType}
+	info, ok := m.db.taskLists[key]
+	if !ok || info.RangeID != request.RangeID {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("DeleteTaskList operation failed. Task list %v of type %v with range ID %v does not exist.",
+				request.TaskListName, request.TaskListType, request.RangeID),
+		}
+	}
+	delete(m.db.taskLists, key)
+	delete(m.db.tasks, key)
+	return nil
+}
+
+func (m *memoryTaskStore) CreateTasks(request *p.CreateTasksRequest) (*p.CreateTasksResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tli := request.TaskListInfo
+	key := taskListKey{domainID: tli.DomainID, name: tli.Name, taskType: tli.TaskType}
+	info, ok := m.db.taskLists[key]
+	if !ok || info.RangeID != tli.RangeID {
+		rangeID := int64(0)
+		if ok {
+			rangeID = info.RangeID
+		}
+		return nil, &p.ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to create task. TaskList: %v, taskListType: %v, rangeID: %v, db rangeID: %v",
+				tli.Name, tli.TaskType, tli.RangeID, rangeID),
+		}
+	}
+
+	tasks, ok := m.db.tasks[key]
+	if !ok {
+		tasks = make(map[int64]*p.TaskInfo)
+		m.db.tasks[key] = tasks
+	}
+	now := time.Now()
+	for _, t := range request.Tasks {
+		task := *t.Data
+		task.TaskID = t.TaskID
+		task.CreatedTime = now
+		if task.ScheduleToStartTimeout > 0 {
+			task.Expiry = now.Add(time.Duration(task.ScheduleToStartTimeout) * time.Second)
+		}
+		tasks[t.TaskID] = &task
+	}
+	return &p.CreateTasksResponse{}, nil
+}
+
+func (m *memoryTaskStore) GetTasks(request *p.GetTasksRequest) (*p.GetTasksResponse, error) {
+	m.db.RLock()
+	key := taskListKey{domainID: request.DomainID, name: request.TaskList, taskType: request.TaskType}
+	var tasks []*p.TaskInfo
+	for taskID, t := range m.db.tasks[key] {
+		if taskID > request.ReadLevel && taskID <= request.MaxReadLevel {
+			task := *t
+			tasks = append(tasks, &task)
+		}
+	}
+	m.db.RUnlock()
+
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].TaskID < tasks[j].TaskID })
+	if len(tasks) > request.BatchSize {
+		tasks = tasks[:request.BatchSize]
+	}
+	return &p.GetTasksResponse{Tasks: tasks}, nil
+}
+
+func (m *memoryTaskStore) CompleteTask(request *p.CompleteTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tli := request.TaskList
+	key := taskListKey{domainID: tli.DomainID, name: tli.Name, taskType: tli.TaskType}
+	delete(m.db.tasks[key], request.TaskID)
+	return nil
+}
+
+func (m *memoryTaskStore) CompleteTasksLessThan(request *p.CompleteTasksLessThanRequest) (int, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := taskListKey{domainID: request.DomainID, name: request.TaskListName, taskType: request.TaskType}
+	var taskIDs []int64
+	for taskID := range m.db.tasks[key] {
+		if taskID < request.TaskID {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	// the tasks with the lowest ids are completed first, the ones over the limit are left for the next call
+	sort.Slice(taskIDs, func(i, j int) bool { return taskIDs[i] < taskIDs[j] })
+	if len(taskIDs) > request.Limit {
+		taskIDs = taskIDs[:request.Limit]
+	}
+	for _, taskID := range taskIDs {
+		delete(m.db.tasks[key], taskID)
+	}
+	return len(taskIDs), nil
+}
+
+func taskListKeyLess(a taskListKey, b taskListKey) bool {
+	if a.domainID != b.domainID {
+		return a.domainID < b.domainID
+	}
+	if a.name != b.name {
+		return a.name < b.name
+	}
+	return a.taskType < b.taskType
+}
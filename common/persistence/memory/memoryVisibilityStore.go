@@ -0,0 +1,261 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	memoryVisibilityStore struct {
+		memoryStore
+	}
+
+	visibilityRow struct {
+		domainID         string
+		workflowID       string
+		runID            string
+		workflowTypeName string
+		startTime        int64
+		closed           bool
+		closeTime        int64
+		closeStatus      workflow.WorkflowExecutionCloseStatus
+		historyLength    int64
+		memo             map[string][]byte
+		searchAttributes map[string][]byte
+	}
+
+	visibilityPageToken struct {
+		Time  int64
+		RunID string
+	}
+)
+
+// newVisibilityPersistence creates an instance of VisibilityStore
+func newVisibilityPersistence(db *database, logger bark.Logger) p.VisibilityStore {
+	return &memoryVisibilityStore{memoryStore: memoryStore{db: db, logger: logger}}
+}
+
+func (m *memoryVisibilityStore) RecordWorkflowExecutionStarted(request *p.RecordWorkflowExecutionStartedRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	m.db.visibility[visibilityKey{domainID: request.DomainUUID, runID: request.Execution.GetRunId()}] = &visibilityRow{
+		domainID:         request.DomainUUID,
+		workflowID:       request.Execution.GetWorkflowId(),
+		runID:            request.Execution.GetRunId(),
+		workflowTypeName: request.WorkflowTypeName,
+		startTime:        request.StartTimestamp,
+		memo:             copyBytesMap(request.Memo),
+		searchAttributes: copyBytesMap(request.SearchAttributes),
+	}
+	return nil
+}
+
+// RecordWorkflowExecutionClosed writes the closed record of an execution, the record is written even if
+// the execution was never recorded as started
+func (m *memoryVisibilityStore) RecordWorkflowExecutionClosed(request *p.RecordWorkflowExecutionClosedRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	m.db.visibility[visibilityKey{domainID: request.DomainUUID, runID: request.Execution.GetRunId()}] = &visibilityRow{
+		domainID:         request.DomainUUID,
+		workflowID:       request.Execution.GetWorkflowId(),
+		runID:            request.Execution.GetRunId(),
+		workflowTypeName: request.WorkflowTypeName,
+		startTime:        request.StartTimestamp,
+		closed:           true,
+		closeTime:        request.CloseTimestamp,
+		closeStatus:      request.Status,
+		historyLength:    request.HistoryLength,
+		memo:             copyBytesMap(request.Memo),
+		searchAttributes: copyBytesMap(request.SearchAttributes),
+	}
+	return nil
+}
+
+func (m *memoryVisibilityStore) UpsertWorkflowExecution(request *p.UpsertWorkflowExecutionRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	// the record is not updated if the execution is already closed, or not recorded as started yet, in
+	// which case the search attributes are written together with the start or close record
+	row, ok := m.db.visibility[visibilityKey{domainID: request.DomainUUID, runID: request.Execution.GetRunId()}]
+	if ok && !row.closed {
+		row.searchAttributes = copyBytesMap(request.SearchAttributes)
+	}
+	return nil
+}
+
+func (m *memoryVisibilityStore) ListOpenWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.ListWorkflowExecutionsResponse, error) {
+	return m.listWorkflowExecutions(request, func(row *visibilityRow) bool {
+		return !row.closed
+	})
+}
+
+func (m *memoryVisibilityStore) ListClosedWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.ListWorkflowExecutionsResponse, error) {
+	return m.listWorkflowExecutions(request, func(row *visibilityRow) bool {
+		return row.closed
+	})
+}
+
+func (m *memoryVisibilityStore) ListOpenWorkflowExecutionsByType(request *p.ListWorkflowExecutionsByTypeRequest) (*p.ListWorkflowExecutionsResponse, error) {
+	return m.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, func(row *visibilityRow) bool {
+		return !row.closed && row.workflowTypeName == request.WorkflowTypeName
+	})
+}
+
+func (m *memoryVisibilityStore) ListClosedWorkflowExecutionsByType(request *p.ListWorkflowExecutionsByTypeRequest) (*p.ListWorkflowExecutionsResponse, error) {
+	return m.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, func(row *visibilityRow) bool {
+		return row.closed && row.workflowTypeName == request.WorkflowTypeName
+	})
+}
+
+func (m *memoryVisibilityStore) ListOpenWorkflowExecutionsByWorkflowID(request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.ListWorkflowExecutionsResponse, error) {
+	return m.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, func(row *visibilityRow) bool {
+		return !row.closed && row.workflowID == request.WorkflowID
+	})
+}
+
+func (m *memoryVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.ListWorkflowExecutionsResponse, error) {
+	return m.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, func(row *visibilityRow) bool {
+		return row.closed && row.workflowID == request.WorkflowID
+	})
+}
+
+func (m *memoryVisibilityStore) ListClosedWorkflowExecutionsByStatus(request *p.ListClosedWorkflowExecutionsByStatusRequest) (*p.ListWorkflowExecutionsResponse, error) {
+	return m.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, func(row *visibilityRow) bool {
+		return row.closed && row.closeStatus == request.Status
+	})
+}
+
+func (m *memoryVisibilityStore) GetClosedWorkflowExecution(request *p.GetClosedWorkflowExecutionRequest) (*p.GetClosedWorkflowExecutionResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	execution := request.Execution
+	row, ok := m.db.visibility[visibilityKey{domainID: request.DomainUUID, runID: execution.GetRunId()}]
+	if !ok || !row.closed {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+				execution.GetWorkflowId(), execution.GetRunId()),
+		}
+	}
+	return &p.GetClosedWorkflowExecutionResponse{Execution: rowToInfo(row)}, nil
+}
+
+func (m *memoryVisibilityStore) ListWorkflowExecutions(request *p.ListWorkflowExecutionsByQueryRequest) (*p.ListWorkflowExecutionsResponse, error) {
+	return p.ListWorkflowExecutionsByQuery(m, request)
+}
+
+func (m *memoryVisibilityStore) DeleteWorkflowExecution(request *p.VisibilityDeleteWorkflowExecutionRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.visibility, visibilityKey{domainID: request.DomainUUID, runID: request.Execution.GetRunId()})
+	return nil
+}
+
+// listWorkflowExecutions returns the executions of the domain which pass the filter and were started in the
+// requested time range, ordered by start time descending and then by run id
+func (m *memoryVisibilityStore) listWorkflowExecutions(
+	request *p.ListWorkflowExecutionsRequest,
+	filter func(row *visibilityRow) bool,
+) (*p.ListWorkflowExecutionsResponse, error) {
+	readLevel := &visibilityPageToken{Time: request.LatestStartTime}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, readLevel); err != nil {
+			return nil, &workflow.BadRequestError{Message: "Invalid next page token."}
+		}
+	}
+
+	m.db.RLock()
+	var rows []*visibilityRow
+	for _, row := range m.db.visibility {
+		if row.domainID != request.DomainUUID || !filter(row) {
+			continue
+		}
+		if row.startTime < request.EarliestStartTime || row.startTime > request.LatestStartTime {
+			continue
+		}
+		// rows up to and including the last row of the previous page are skipped
+		if row.startTime > readLevel.Time || (row.startTime == readLevel.Time && row.runID <= readLevel.RunID) {
+			continue
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].startTime != rows[j].startTime {
+			return rows[i].startTime > rows[j].startTime
+		}
+		return rows[i].runID < rows[j].runID
+	})
+
+	response := &p.ListWorkflowExecutionsResponse{}
+	if request.PageSize > 0 && len(rows) > request.PageSize {
+		rows = rows[:request.PageSize]
+		lastRow := rows[len(rows)-1]
+		token, err := json.Marshal(&visibilityPageToken{Time: lastRow.startTime, RunID: lastRow.runID})
+		if err != nil {
+			m.db.RUnlock()
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ListWorkflowExecutions operation failed. Error: %v", err),
+			}
+		}
+		response.NextPageToken = token
+	}
+	for _, row := range rows {
+		response.Executions = append(response.Executions, rowToInfo(row))
+	}
+	m.db.RUnlock()
+	return response, nil
+}
+
+func rowToInfo(row *visibilityRow) *workflow.WorkflowExecutionInfo {
+	info := &workflow.WorkflowExecutionInfo{
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(row.workflowID),
+			RunId:      common.StringPtr(row.runID),
+		},
+		Type:      &workflow.WorkflowType{Name: common.StringPtr(row.workflowTypeName)},
+		StartTime: common.Int64Ptr(row.startTime),
+	}
+	if row.closed {
+		status := row.closeStatus
+		info.CloseStatus = &status
+		info.CloseTime = common.Int64Ptr(row.closeTime)
+		info.HistoryLength = common.Int64Ptr(row.historyLength)
+	}
+	if len(row.memo) > 0 {
+		info.Memo = &workflow.Memo{Fields: copyBytesMap(row.memo)}
+	}
+	if len(row.searchAttributes) > 0 {
+		info.SearchAttributes = &workflow.SearchAttributes{IndexedFields: copyBytesMap(row.searchAttributes)}
+	}
+	return info
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/cassandra"
+	"github.com/uber/cadence/common/persistence/memory"
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/service/config"
 )
@@ -294,6 +295,10 @@ func newStore(cfg config.DataStore, tb common.TokenBucket, clusterName string, m
 		ds.factory = newSQLStore(*cfg.SQL, clusterName, maxConnsOverride, logger)
 		return ds
 	}
+	if cfg.Memory != nil {
+		ds.factory = memory.NewFactory(*cfg.Memory, clusterName, logger)
+		return ds
+	}
 	ds.factory = newCassandraStore(*cfg.Cassandra, clusterName, maxConnsOverride, logger)
 	return ds
 }
@@ -732,6 +732,43 @@ func (s *ExecutionManagerSuite) TestDeleteWorkflow() {
 	s.NoError(err5)
 }
 
+// TestDeleteCurrentWorkflowExecution test
+func (s *ExecutionManagerSuite) TestDeleteCurrentWorkflowExecution() {
+	domainID := "4ba7bd3b-3bb1-4a8d-b1a3-5c3ba1f4c2d9"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("delete-current-workflow-execution-test"),
+		RunId:      common.StringPtr("2c4f8a31-27c0-4b44-8b8c-16b0d5bde6e1"),
+	}
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 20, 13, nil, 3, 0, 2, nil)
+	s.NoError(err0)
+	s.NotNil(task0, "Expected non empty task identifier.")
+
+	// the current record points to another run, so it must be kept
+	err1 := s.ExecutionManager.DeleteCurrentWorkflowExecution(&p.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowExecution.GetWorkflowId(),
+		RunID:      "a8e7b1a4-0c5b-4bb8-9a7b-3e2ad2f1b5c0",
+	})
+	s.NoError(err1)
+	runID, err2 := s.GetCurrentWorkflowRunID(domainID, workflowExecution.GetWorkflowId())
+	s.NoError(err2)
+	s.Equal(workflowExecution.GetRunId(), runID)
+
+	err3 := s.ExecutionManager.DeleteCurrentWorkflowExecution(&p.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowExecution.GetWorkflowId(),
+		RunID:      workflowExecution.GetRunId(),
+	})
+	s.NoError(err3)
+	_, err4 := s.GetCurrentWorkflowRunID(domainID, workflowExecution.GetWorkflowId())
+	s.Error(err4, "expected non nil error.")
+
+	state, err5 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.NoError(err5)
+	err6 := s.DeleteWorkflowExecution(state.ExecutionInfo)
+	s.NoError(err6)
+}
+
 // TestDeleteCurrentWorkflow test
 func (s *ExecutionManagerSuite) TestDeleteCurrentWorkflow() {
 	if s.ExecutionManager.GetName() != "cassandra" {
@@ -1167,6 +1204,135 @@ func (s *ExecutionManagerSuite) TestReplicationTasks() {
 	}
 }
 
+// TestReplicationDLQ test
+func (s *ExecutionManagerSuite) TestReplicationDLQ() {
+	sourceCluster := "dlq-test-" + uuid.New()
+	domainID := uuid.New()
+	runID := uuid.New()
+	var taskIDs []int64
+	for i := int64(1); i <= 3; i++ {
+		err := s.ExecutionManager.PutReplicationTaskToDLQ(&p.PutReplicationTaskToDLQRequest{
+			SourceClusterName: sourceCluster,
+			TaskInfo: &p.ReplicationDLQTaskInfo{
+				TaskID:     i * 10,
+				DomainID:   domainID,
+				WorkflowID: fmt.Sprintf("replication-dlq-test-%v", i),
+				RunID:      runID,
+				Task:       p.NewDataBlob([]byte(fmt.Sprintf("task-%v", i)), common.EncodingTypeThriftRW),
+			},
+		})
+		s.NoError(err)
+		taskIDs = append(taskIDs, i*10)
+	}
+
+	sizeResponse, err := s.ExecutionManager.GetReplicationDLQSize(&p.GetReplicationDLQSizeRequest{SourceClusterName: sourceCluster})
+	s.NoError(err)
+	s.Equal(int64(3), sizeResponse.Size)
+
+	var tasks []*p.ReplicationDLQTaskInfo
+	var token []byte
+	for {
+		response, err := s.ExecutionManager.GetReplicationTasksFromDLQ(&p.GetReplicationTasksFromDLQRequest{
+			SourceClusterName: sourceCluster,
+			BatchSize:         2,
+			NextPageToken:     token,
+		})
+		s.NoError(err)
+		tasks = append(tasks, response.Tasks...)
+		token = response.NextPageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+	s.Equal(3, len(tasks))
+	for i, task := range tasks {
+		s.Equal(taskIDs[i], task.TaskID)
+		s.Equal(domainID, task.DomainID)
+		s.Equal(fmt.Sprintf("replication-dlq-test-%v", i+1), task.WorkflowID)
+		s.Equal(runID, task.RunID)
+		s.Equal(common.EncodingType(common.EncodingTypeThriftRW), task.Task.Encoding)
+		s.Equal([]byte(fmt.Sprintf("task-%v", i+1)), task.Task.Data)
+	}
+
+	response, err := s.ExecutionManager.GetReplicationTasksFromDLQ(&p.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: "another-" + sourceCluster,
+		BatchSize:         10,
+	})
+	s.NoError(err)
+	s.Empty(response.Tasks)
+
+	err = s.ExecutionManager.DeleteReplicationTaskFromDLQ(&p.DeleteReplicationTaskFromDLQRequest{
+		SourceClusterName: sourceCluster,
+		TaskID:            taskIDs[1],
+	})
+	s.NoError(err)
+	response, err = s.ExecutionManager.GetReplicationTasksFromDLQ(&p.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: sourceCluster,
+		BatchSize:         10,
+	})
+	s.NoError(err)
+	s.Equal(2, len(response.Tasks))
+	s.Equal(taskIDs[0], response.Tasks[0].TaskID)
+	s.Equal(taskIDs[2], response.Tasks[1].TaskID)
+}
+
+// TestListExecutions test
+func (s *ExecutionManagerSuite) TestListExecutions() {
+	domainID := uuid.New()
+	runIDs := make(map[string]string)
+	for i := 0; i < 3; i++ {
+		workflowExecution := gen.WorkflowExecution{
+			WorkflowId: common.StringPtr(fmt.Sprintf("list-executions-test-%v", i)),
+			RunId:      common.StringPtr(uuid.New()),
+		}
+		_, err := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 20, 13, nil, 3, 0, 2, nil)
+		s.NoError(err)
+		runIDs[workflowExecution.GetWorkflowId()] = workflowExecution.GetRunId()
+	}
+
+	concreteExecutions := make(map[string]string)
+	var token []byte
+	for {
+		response, err := s.ExecutionManager.ListConcreteExecutions(&p.ListConcreteExecutionsRequest{
+			PageSize:  2,
+			PageToken: token,
+		})
+		s.NoError(err)
+		for _, execution := range response.Executions {
+			if execution.DomainID == domainID {
+				concreteExecutions[execution.WorkflowID] = execution.RunID
+			}
+		}
+		token = response.PageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+	s.Equal(runIDs, concreteExecutions)
+
+	currentExecutions := make(map[string]string)
+	token = nil
+	for {
+		response, err := s.ExecutionManager.ListCurrentExecutions(&p.ListCurrentExecutionsRequest{
+			PageSize:  2,
+			PageToken: token,
+		})
+		s.NoError(err)
+		for _, execution := range response.Executions {
+			if execution.DomainID == domainID {
+				s.Equal(p.WorkflowStateCreated, execution.State)
+				s.Equal(p.WorkflowCloseStatusNone, execution.CloseStatus)
+				currentExecutions[execution.WorkflowID] = execution.RunID
+			}
+		}
+		token = response.PageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+	s.Equal(runIDs, currentExecutions)
+}
+
 // TestTransferTasksComplete test
 func (s *ExecutionManagerSuite) TestTransferTasksComplete() {
 	domainID := "8bfb47be-5b57-4d55-9109-5fb35e20b1d7"
@@ -3048,6 +3214,184 @@ func (s *ExecutionManagerSuite) TestResetMutableStateCurrentIsNotSelf() {
 	s.Equal(workflowExecutionReset.GetRunId(), runID)
 }
 
+// TestResetWorkflowExecution test
+func (s *ExecutionManagerSuite) TestResetWorkflowExecution() {
+	domainID := "a9c8e4b1-2f6d-4f4a-9a1e-2b3f1c5d7e90"
+	workflowID := "test-reset-workflow-execution-test"
+
+	workflowExecutionBase := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr("bbbbbbbb-aaaa-aaaa-aaaa-aaaaaaaaaaa0"),
+	}
+	task, err := s.CreateWorkflowExecution(domainID, workflowExecutionBase, "taskList", "wType", 20, 13, nil, 3, 0, 2, nil)
+	s.NoError(err)
+	s.NotNil(task, "Expected non empty task identifier.")
+
+	state, err := s.GetWorkflowExecutionInfo(domainID, workflowExecutionBase)
+	s.NoError(err)
+	info := state.ExecutionInfo
+	terminatedInfo := copyWorkflowExecutionInfo(info)
+	terminatedInfo.State = p.WorkflowStateCompleted
+	terminatedInfo.CloseStatus = p.WorkflowCloseStatusTerminated
+	terminatedInfo.NextEventID = int64(6)
+	terminatedInfo.LastProcessedEvent = int64(2)
+	err = s.UpdateWorkflowExecutionAndFinish(terminatedInfo, info.NextEventID, 123)
+	s.NoError(err)
+
+	workflowExecutionReset := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr("bbbbbbbb-aaaa-aaaa-aaaa-aaaaaaaaaaa1"),
+	}
+	currentTime := time.Now()
+	resetInfo := copyWorkflowExecutionInfo(info)
+	resetInfo.RunID = workflowExecutionReset.GetRunId()
+	resetInfo.NextEventID = int64(8)
+	resetInfo.LastFirstEventID = int64(5)
+	resetInfo.DecisionScheduleID = int64(7)
+	resetInfo.DecisionStartedID = common.EmptyEventID
+	resetInfo.CreateRequestID = uuid.New()
+	activityInfos := []*p.ActivityInfo{{
+		Version:                common.EmptyVersion,
+		ScheduleID:             4,
+		ScheduledEvent:         &gen.HistoryEvent{EventId: int64Ptr(4)},
+		ScheduledTime:          currentTime,
+		StartedID:              common.EmptyEventID,
+		ActivityID:             "activity-4",
+		ScheduleToCloseTimeout: 1,
+		ScheduleToStartTimeout: 2,
+		StartToCloseTimeout:    3,
+		HeartbeatTimeout:       4,
+	}}
+	timerInfos := []*p.TimerInfo{{
+		Version:    common.EmptyVersion,
+		TimerID:    "timer-3",
+		ExpiryTime: currentTime,
+		StartedID:  3,
+		TaskID:     common.EmptyEventID,
+	}}
+	transferTasks := []p.Task{&p.DecisionTask{
+		TaskID:     s.GetNextSequenceNumber(),
+		DomainID:   domainID,
+		TaskList:   "taskList",
+		ScheduleID: 7,
+	}}
+
+	// the reset fails if the previous run is not the current run of the workflow
+	err = s.ResetWorkflowExecution(workflowExecutionReset.GetRunId(), resetInfo, transferTasks, nil, activityInfos, timerInfos, []string{"signal-1"})
+	s.Error(err)
+
+	err = s.ResetWorkflowExecution(workflowExecutionBase.GetRunId(), resetInfo, transferTasks, nil, activityInfos, timerInfos, []string{"signal-1"})
+	s.NoError(err)
+
+	runID, err := s.GetCurrentWorkflowRunID(domainID, workflowID)
+	s.NoError(err)
+	s.Equal(workflowExecutionReset.GetRunId(), runID)
+
+	state, err = s.GetWorkflowExecutionInfo(domainID, workflowExecutionReset)
+	s.NoError(err)
+	s.Equal(int64(8), state.ExecutionInfo.NextEventID)
+	s.Equal(int64(7), state.ExecutionInfo.DecisionScheduleID)
+	s.Equal(p.WorkflowStateRunning, state.ExecutionInfo.State)
+	s.Equal(1, len(state.ActivityInfos))
+	s.Equal("activity-4", state.ActivityInfos[4].ActivityID)
+	s.Equal(1, len(state.TimerInfos))
+	s.Equal(int64(3), state.TimerInfos["timer-3"].StartedID)
+	s.Equal(1, len(state.SignalRequestedIDs))
+	_, ok := state.SignalRequestedIDs["signal-1"]
+	s.True(ok)
+
+	tasks, err := s.GetTransferTasks(10, true)
+	s.NoError(err)
+	found := false
+	for _, t := range tasks {
+		if t.RunID == workflowExecutionReset.GetRunId() && t.TaskType == p.TransferTaskTypeDecisionTask {
+			found = true
+			s.Equal(int64(7), t.ScheduleID)
+		}
+	}
+	s.True(found)
+}
+
+// TestResetWorkflowExecutionTerminateCurrentRun test
+func (s *ExecutionManagerSuite) TestResetWorkflowExecutionTerminateCurrentRun() {
+	domainID := "b2e4c6d8-3a5f-4e7b-8c9d-1f2a3b4c5d6e"
+	workflowID := "test-reset-workflow-execution-terminate-current-run-test"
+
+	workflowExecutionCurr := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr("cccccccc-aaaa-aaaa-aaaa-aaaaaaaaaaa0"),
+	}
+	task, err := s.CreateWorkflowExecution(domainID, workflowExecutionCurr, "taskList", "wType", 20, 13, nil, 3, 0, 2, nil)
+	s.NoError(err)
+	s.NotNil(task, "Expected non empty task identifier.")
+
+	state, err := s.GetWorkflowExecutionInfo(domainID, workflowExecutionCurr)
+	s.NoError(err)
+	info := state.ExecutionInfo
+	currCondition := info.NextEventID
+	terminatedInfo := copyWorkflowExecutionInfo(info)
+	terminatedInfo.State = p.WorkflowStateCompleted
+	terminatedInfo.CloseStatus = p.WorkflowCloseStatusTerminated
+	terminatedInfo.NextEventID = currCondition + 1
+	closeTasks := []p.Task{&p.CloseExecutionTask{TaskID: s.GetNextSequenceNumber()}}
+
+	workflowExecutionReset := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr("cccccccc-aaaa-aaaa-aaaa-aaaaaaaaaaa1"),
+	}
+	resetInfo := copyWorkflowExecutionInfo(info)
+	resetInfo.RunID = workflowExecutionReset.GetRunId()
+	resetInfo.NextEventID = int64(5)
+	resetInfo.DecisionScheduleID = int64(4)
+	resetInfo.DecisionStartedID = common.EmptyEventID
+	resetInfo.CreateRequestID = uuid.New()
+	transferTasks := []p.Task{&p.DecisionTask{
+		TaskID:     s.GetNextSequenceNumber(),
+		DomainID:   domainID,
+		TaskList:   "taskList",
+		ScheduleID: 4,
+	}}
+
+	// neither run is written if the current run was updated concurrently
+	err = s.ResetWorkflowExecutionAndTerminateCurrent(workflowExecutionCurr.GetRunId(), resetInfo, transferTasks,
+		terminatedInfo, currCondition-1, closeTasks)
+	s.Error(err)
+
+	runID, err := s.GetCurrentWorkflowRunID(domainID, workflowID)
+	s.NoError(err)
+	s.Equal(workflowExecutionCurr.GetRunId(), runID)
+	state, err = s.GetWorkflowExecutionInfo(domainID, workflowExecutionCurr)
+	s.NoError(err)
+	s.Equal(p.WorkflowStateRunning, state.ExecutionInfo.State)
+	s.Equal(currCondition, state.ExecutionInfo.NextEventID)
+
+	err = s.ResetWorkflowExecutionAndTerminateCurrent(workflowExecutionCurr.GetRunId(), resetInfo, transferTasks,
+		terminatedInfo, currCondition, closeTasks)
+	s.NoError(err)
+
+	runID, err = s.GetCurrentWorkflowRunID(domainID, workflowID)
+	s.NoError(err)
+	s.Equal(workflowExecutionReset.GetRunId(), runID)
+	state, err = s.GetWorkflowExecutionInfo(domainID, workflowExecutionCurr)
+	s.NoError(err)
+	s.Equal(p.WorkflowStateCompleted, state.ExecutionInfo.State)
+	s.Equal(p.WorkflowCloseStatusTerminated, state.ExecutionInfo.CloseStatus)
+	s.Equal(currCondition+1, state.ExecutionInfo.NextEventID)
+	state, err = s.GetWorkflowExecutionInfo(domainID, workflowExecutionReset)
+	s.NoError(err)
+	s.Equal(p.WorkflowStateRunning, state.ExecutionInfo.State)
+
+	tasks, err := s.GetTransferTasks(10, true)
+	s.NoError(err)
+	found := false
+	for _, t := range tasks {
+		if t.RunID == workflowExecutionCurr.GetRunId() && t.TaskType == p.TransferTaskTypeCloseExecution {
+			found = true
+		}
+	}
+	s.True(found)
+}
+
 // TestCreateGetShardBackfill test
 func (s *ExecutionManagerSuite) TestCreateGetShardBackfill() {
 	shardID := 4
@@ -3078,6 +3422,7 @@ func (s *ExecutionManagerSuite) TestCreateGetShardBackfill() {
 	shardInfo.ClusterTimerAckLevel = map[string]time.Time{
 		s.ClusterMetadata.GetCurrentClusterName(): currentClusterTimerAck,
 	}
+	shardInfo.ClusterReplicationLevel = map[string]int64{}
 	resp, err := s.ShardMgr.GetShard(&p.GetShardRequest{ShardID: shardID})
 	s.NoError(err)
 	s.True(timeComparator(shardInfo.UpdatedAt, resp.ShardInfo.UpdatedAt, TimePrecision))
@@ -3099,6 +3444,7 @@ func (s *ExecutionManagerSuite) TestCreateGetUpdateGetShard() {
 	currentReplicationAck := int64(27)
 	currentClusterTransferAck := int64(21)
 	alternativeClusterTransferAck := int64(32)
+	alternativeClusterReplicationAck := int64(25)
 	currentClusterTimerAck := timestampConvertor(time.Now().Add(-10 * time.Second))
 	alternativeClusterTimerAck := timestampConvertor(time.Now().Add(-20 * time.Second))
 	domainNotificationVersion := int64(8192)
@@ -3119,6 +3465,9 @@ func (s *ExecutionManagerSuite) TestCreateGetUpdateGetShard() {
 			cluster.TestCurrentClusterName:     currentClusterTimerAck,
 			cluster.TestAlternativeClusterName: alternativeClusterTimerAck,
 		},
+		ClusterReplicationLevel: map[string]int64{
+			cluster.TestAlternativeClusterName: alternativeClusterReplicationAck,
+		},
 		DomainNotificationVersion: domainNotificationVersion,
 	}
 	createRequest := &p.CreateShardRequest{
@@ -3140,6 +3489,7 @@ func (s *ExecutionManagerSuite) TestCreateGetUpdateGetShard() {
 	currentReplicationAck = int64(270)
 	currentClusterTransferAck = int64(210)
 	alternativeClusterTransferAck = int64(320)
+	alternativeClusterReplicationAck = int64(250)
 	currentClusterTimerAck = timestampConvertor(time.Now().Add(-100 * time.Second))
 	alternativeClusterTimerAck = timestampConvertor(time.Now().Add(-200 * time.Second))
 	domainNotificationVersion = int64(16384)
@@ -3160,6 +3510,9 @@ func (s *ExecutionManagerSuite) TestCreateGetUpdateGetShard() {
 			cluster.TestCurrentClusterName:     currentClusterTimerAck,
 			cluster.TestAlternativeClusterName: alternativeClusterTimerAck,
 		},
+		ClusterReplicationLevel: map[string]int64{
+			cluster.TestAlternativeClusterName: alternativeClusterReplicationAck,
+		},
 		DomainNotificationVersion: domainNotificationVersion,
 	}
 	updateRequest := &p.UpdateShardRequest{
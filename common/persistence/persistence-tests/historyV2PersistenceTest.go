@@ -112,6 +112,65 @@ func (s *HistoryV2PersistenceSuite) TestGenUUIDs() {
 	s.Equal(concurrency, cnt)
 }
 
+// TestGetAllHistoryTreeBranches test
+func (s *HistoryV2PersistenceSuite) TestGetAllHistoryTreeBranches() {
+	treeID := uuid.New()
+	info := p.BuildHistoryGarbageCleanupInfo(uuid.New(), "get-all-branches-test", treeID)
+	branch, err := s.newHistoryBranch(treeID)
+	s.NoError(err)
+	_, err = s.HistoryV2Mgr.AppendHistoryNodes(&p.AppendHistoryNodesRequest{
+		IsNewBranch:   true,
+		BranchToken:   branch,
+		Events:        s.genRandomEvents([]int64{1, 2, 3}, 1),
+		TransactionID: 1,
+		Encoding:      pickRandomEncoding(),
+		Info:          info,
+	})
+	s.NoError(err)
+	s.NoError(s.append(branch, s.genRandomEvents([]int64{4, 5}, 1), 2, false))
+
+	forkResp, err := s.HistoryV2Mgr.ForkHistoryBranch(&p.ForkHistoryBranchRequest{
+		ForkBranchToken: branch,
+		ForkNodeID:      4,
+		Info:            info,
+	})
+	s.NoError(err)
+	_, err = s.HistoryV2Mgr.AppendHistoryNodes(&p.AppendHistoryNodesRequest{
+		IsNewBranch:   true,
+		BranchToken:   forkResp.NewBranchToken,
+		Events:        s.genRandomEvents([]int64{4}, 2),
+		TransactionID: 3,
+		Encoding:      pickRandomEncoding(),
+		Info:          info,
+	})
+	s.NoError(err)
+
+	branchIDs := make(map[string]bool)
+	var token []byte
+	for {
+		response, err := s.HistoryV2Mgr.GetAllHistoryTreeBranches(&p.GetAllHistoryTreeBranchesRequest{
+			PageSize:      1,
+			NextPageToken: token,
+		})
+		s.NoError(err)
+		for _, br := range response.Branches {
+			if br.TreeID == treeID {
+				s.Equal(info, br.Info)
+				s.False(br.ForkTime.IsZero())
+				branchIDs[br.BranchID] = true
+			}
+		}
+		token = response.NextPageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+	s.Equal(2, len(branchIDs))
+	for _, br := range s.descTree(treeID) {
+		s.True(branchIDs[br.GetBranchID()])
+	}
+}
+
 //TestConcurrentlyCreateAndAppendBranches test
 func (s *HistoryV2PersistenceSuite) TestConcurrentlyCreateAndAppendBranches() {
 	treeID := uuid.New()
@@ -21,6 +21,7 @@
 package persistencetests
 
 import (
+	"math"
 	"os"
 	"testing"
 	"time"
@@ -225,3 +226,198 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskListSticky() {
 	})
 	s.NoError(err) // because update with ttl doesn't check rangeID
 }
+
+// TestListAndDeleteTaskList test
+func (s *MatchingPersistenceSuite) TestListAndDeleteTaskList() {
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("list-delete-task-list-test"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	taskLists := map[string]bool{"list-tl-a": true, "list-tl-b": true, "list-tl-c": true}
+	_, err := s.CreateActivityTasks(domainID, workflowExecution, map[int64]string{10: "list-tl-a", 20: "list-tl-b", 30: "list-tl-c"})
+	s.NoError(err)
+
+	var listed []*p.TaskListInfo
+	var token []byte
+	for {
+		resp, err := s.TaskMgr.ListTaskList(&p.ListTaskListRequest{
+			DomainID:  domainID,
+			PageSize:  2,
+			PageToken: token,
+		})
+		s.NoError(err)
+		listed = append(listed, resp.Items...)
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+	s.Equal(len(taskLists), len(listed))
+	for _, tli := range listed {
+		s.Equal(domainID, tli.DomainID)
+		s.True(taskLists[tli.Name])
+	}
+
+	err = s.TaskMgr.DeleteTaskList(&p.DeleteTaskListRequest{
+		DomainID:     domainID,
+		TaskListName: listed[0].Name,
+		TaskListType: listed[0].TaskType,
+		RangeID:      listed[0].RangeID + 1,
+	})
+	s.Error(err)
+	_, ok := err.(*p.ConditionFailedError)
+	s.True(ok, "ConditionFailedError")
+	// the tasks are kept when the task list is not deleted
+	tasks, err := s.TaskMgr.GetTasks(&p.GetTasksRequest{
+		DomainID:     domainID,
+		TaskList:     listed[0].Name,
+		TaskType:     listed[0].TaskType,
+		BatchSize:    10,
+		RangeID:      listed[0].RangeID,
+		MaxReadLevel: math.MaxInt64,
+	})
+	s.NoError(err)
+	s.Equal(1, len(tasks.Tasks))
+
+	for _, tli := range listed {
+		err := s.TaskMgr.DeleteTaskList(&p.DeleteTaskListRequest{
+			DomainID:     domainID,
+			TaskListName: tli.Name,
+			TaskListType: tli.TaskType,
+			RangeID:      tli.RangeID,
+		})
+		s.NoError(err)
+	}
+
+	resp, err := s.TaskMgr.ListTaskList(&p.ListTaskListRequest{
+		DomainID: domainID,
+		PageSize: 10,
+	})
+	s.NoError(err)
+	s.Empty(resp.Items)
+
+	tasks, err = s.GetTasks(domainID, "list-tl-a", p.TaskListTypeActivity, 10)
+	s.NoError(err)
+	s.Empty(tasks.Tasks)
+}
+
+// TestListTaskListAllDomains test
+func (s *MatchingPersistenceSuite) TestListTaskListAllDomains() {
+	domainIDs := []string{uuid.New(), uuid.New()}
+	for _, domainID := range domainIDs {
+		workflowExecution := gen.WorkflowExecution{
+			WorkflowId: common.StringPtr("list-all-task-lists-test"),
+			RunId:      common.StringPtr(uuid.New()),
+		}
+		_, err := s.CreateActivityTasks(domainID, workflowExecution, map[int64]string{10: "list-all-tl"})
+		s.NoError(err)
+	}
+
+	found := make(map[string]*p.TaskListInfo)
+	var token []byte
+	for {
+		resp, err := s.TaskMgr.ListTaskList(&p.ListTaskListRequest{
+			PageSize:  1,
+			PageToken: token,
+		})
+		s.NoError(err)
+		for _, tli := range resp.Items {
+			if tli.Name == "list-all-tl" {
+				found[tli.DomainID] = tli
+			}
+		}
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+	s.Equal(len(domainIDs), len(found))
+	for _, domainID := range domainIDs {
+		s.NotNil(found[domainID])
+		s.WithinDuration(time.Now(), found[domainID].LastUpdated, time.Minute)
+	}
+}
+
+// TestCompleteTasksLessThan test
+func (s *MatchingPersistenceSuite) TestCompleteTasksLessThan() {
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("complete-tasks-less-than-test"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	taskList := "complete-tasks-less-than-tl"
+	for scheduleID := int64(10); scheduleID <= 50; scheduleID += 10 {
+		_, err := s.CreateActivityTasks(domainID, workflowExecution, map[int64]string{scheduleID: taskList})
+		s.NoError(err)
+	}
+
+	resp, err := s.GetTasks(domainID, taskList, p.TaskListTypeActivity, 10)
+	s.NoError(err)
+	s.Equal(5, len(resp.Tasks))
+	for _, t := range resp.Tasks {
+		s.WithinDuration(time.Now(), t.CreatedTime, time.Minute)
+	}
+
+	completed, err := s.TaskMgr.CompleteTasksLessThan(&p.CompleteTasksLessThanRequest{
+		DomainID:     domainID,
+		TaskListName: taskList,
+		TaskType:     p.TaskListTypeActivity,
+		TaskID:       resp.Tasks[2].TaskID,
+		Limit:        100,
+	})
+	s.NoError(err)
+	s.Equal(2, completed)
+
+	remaining, err := s.GetTasks(domainID, taskList, p.TaskListTypeActivity, 10)
+	s.NoError(err)
+	s.Equal(3, len(remaining.Tasks))
+	s.Equal(resp.Tasks[2].TaskID, remaining.Tasks[0].TaskID)
+}
+
+// TestCompleteTasksLessThanLimit test
+func (s *MatchingPersistenceSuite) TestCompleteTasksLessThanLimit() {
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("complete-tasks-less-than-limit-test"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	taskList := "complete-tasks-less-than-limit-tl"
+	for scheduleID := int64(10); scheduleID <= 50; scheduleID += 10 {
+		_, err := s.CreateActivityTasks(domainID, workflowExecution, map[int64]string{scheduleID: taskList})
+		s.NoError(err)
+	}
+
+	resp, err := s.GetTasks(domainID, taskList, p.TaskListTypeActivity, 10)
+	s.NoError(err)
+	s.Equal(5, len(resp.Tasks))
+
+	request := &p.CompleteTasksLessThanRequest{
+		DomainID:     domainID,
+		TaskListName: taskList,
+		TaskType:     p.TaskListTypeActivity,
+		TaskID:       resp.Tasks[4].TaskID,
+		Limit:        3,
+	}
+	completed, err := s.TaskMgr.CompleteTasksLessThan(request)
+	s.NoError(err)
+	s.Equal(3, completed)
+
+	remaining, err := s.GetTasks(domainID, taskList, p.TaskListTypeActivity, 10)
+	s.NoError(err)
+	s.Equal(2, len(remaining.Tasks))
+	s.Equal(resp.Tasks[4].TaskID, remaining.Tasks[1].TaskID)
+
+	completed, err = s.TaskMgr.CompleteTasksLessThan(request)
+	s.NoError(err)
+	s.Equal(1, completed)
+
+	completed, err = s.TaskMgr.CompleteTasksLessThan(request)
+	s.NoError(err)
+	s.Equal(0, completed)
+
+	remaining, err = s.GetTasks(domainID, taskList, p.TaskListTypeActivity, 10)
+	s.NoError(err)
+	s.Equal(1, len(remaining.Tasks))
+	s.Equal(resp.Tasks[4].TaskID, remaining.Tasks[0].TaskID)
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/cluster"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/cassandra"
+	"github.com/uber/cadence/common/persistence/memory"
 	pfactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/service/config"
@@ -127,6 +128,15 @@ func NewTestBaseWithSQL(options *TestBaseOptions) TestBase {
 	return newTestBase(options, testCluster)
 }
 
+// NewTestBaseWithMemory returns a new persistence test base backed by an in-memory datastore
+func NewTestBaseWithMemory(options *TestBaseOptions) TestBase {
+	if options.DBName == "" {
+		options.DBName = GenerateRandomDBName(10)
+	}
+	testCluster := memory.NewTestCluster(options.DBName)
+	return newTestBase(options, testCluster)
+}
+
 func newTestBase(options *TestBaseOptions, testCluster PersistenceTestCluster) TestBase {
 	metadata := options.ClusterMetadata
 	if metadata == nil {
@@ -293,7 +303,8 @@ func (s *TestBase) CreateWorkflowExecutionWithReplication(domainID string, workf
 	var replicationTasks []p.Task
 	for _, task := range txTasks {
 		switch t := task.(type) {
-		case *p.DecisionTask, *p.ActivityTask, *p.CloseExecutionTask, *p.CancelExecutionTask, *p.StartChildExecutionTask, *p.SignalExecutionTask:
+		case *p.DecisionTask, *p.ActivityTask, *p.CloseExecutionTask, *p.CancelExecutionTask, *p.StartChildExecutionTask, *p.SignalExecutionTask,
+			*p.UpsertWorkflowSearchAttributesTask, *p.RecordWorkflowStartedTask:
 			transferTasks = append(transferTasks, t)
 		case *p.HistoryReplicationTask:
 			replicationTasks = append(replicationTasks, t)
@@ -640,7 +651,8 @@ func (s *TestBase) UpdateWorkflowExecutionWithReplication(updatedInfo *p.Workflo
 	var replicationTasks []p.Task
 	for _, task := range txTasks {
 		switch t := task.(type) {
-		case *p.DecisionTask, *p.ActivityTask, *p.CloseExecutionTask, *p.CancelExecutionTask, *p.StartChildExecutionTask, *p.SignalExecutionTask:
+		case *p.DecisionTask, *p.ActivityTask, *p.CloseExecutionTask, *p.CancelExecutionTask, *p.StartChildExecutionTask, *p.SignalExecutionTask,
+			*p.UpsertWorkflowSearchAttributesTask, *p.RecordWorkflowStartedTask:
 			transferTasks = append(transferTasks, t)
 		case *p.HistoryReplicationTask, *p.SyncActivityTask:
 			replicationTasks = append(replicationTasks, t)
@@ -834,6 +846,38 @@ func (s *TestBase) ResetMutableState(prevRunID string, info *p.WorkflowExecution
 	})
 }
 
+// ResetWorkflowExecution is a utility method to reset a workflow to a new run
+func (s *TestBase) ResetWorkflowExecution(prevRunID string, info *p.WorkflowExecutionInfo, transferTasks, timerTasks []p.Task,
+	activityInfos []*p.ActivityInfo, timerInfos []*p.TimerInfo, ids []string) error {
+	return s.ExecutionManager.ResetWorkflowExecution(&p.ResetWorkflowExecutionRequest{
+		PrevRunID:                prevRunID,
+		ExecutionInfo:            info,
+		RangeID:                  s.ShardInfo.RangeID,
+		TransferTasks:            transferTasks,
+		TimerTasks:               timerTasks,
+		InsertActivityInfos:      activityInfos,
+		InsertTimerInfos:         timerInfos,
+		InsertSignalRequestedIDs: ids,
+		Encoding:                 pickRandomEncoding(),
+	})
+}
+
+// ResetWorkflowExecutionAndTerminateCurrent is a utility method to reset a workflow to a new run, terminating the
+// current run in the same operation
+func (s *TestBase) ResetWorkflowExecutionAndTerminateCurrent(prevRunID string, info *p.WorkflowExecutionInfo,
+	transferTasks []p.Task, currInfo *p.WorkflowExecutionInfo, currCondition int64, currTransferTasks []p.Task) error {
+	return s.ExecutionManager.ResetWorkflowExecution(&p.ResetWorkflowExecutionRequest{
+		PrevRunID:         prevRunID,
+		ExecutionInfo:     info,
+		RangeID:           s.ShardInfo.RangeID,
+		TransferTasks:     transferTasks,
+		CurrExecutionInfo: currInfo,
+		CurrCondition:     currCondition,
+		CurrTransferTasks: currTransferTasks,
+		Encoding:          pickRandomEncoding(),
+	})
+}
+
 // DeleteWorkflowExecution is a utility method to delete a workflow execution
 func (s *TestBase) DeleteWorkflowExecution(info *p.WorkflowExecutionInfo) error {
 	return s.ExecutionManager.DeleteWorkflowExecution(&p.DeleteWorkflowExecutionRequest{
@@ -437,12 +437,13 @@ func (s *VisibilityPersistenceSuite) TestGetClosedExecution() {
 	s.True(ok, "EntityNotExistsError")
 	s.Nil(closedResp)
 
+	closeTime := time.Now().UnixNano()
 	err2 := s.VisibilityMgr.RecordWorkflowExecutionClosed(&p.RecordWorkflowExecutionClosedRequest{
 		DomainUUID:       testDomainUUID,
 		Execution:        workflowExecution,
 		WorkflowTypeName: "visibility-workflow",
 		StartTimestamp:   startTime,
-		CloseTimestamp:   time.Now().UnixNano(),
+		CloseTimestamp:   closeTime,
 		HistoryLength:    3,
 	})
 	s.Nil(err2)
@@ -454,4 +455,221 @@ func (s *VisibilityPersistenceSuite) TestGetClosedExecution() {
 	s.Nil(err3)
 	s.Equal(workflowExecution.WorkflowId, resp.Execution.Execution.WorkflowId)
 	s.Equal(int64(3), *resp.Execution.HistoryLength)
+	// the close time is returned in nanoseconds, like the start time, by all the stores
+	s.InDelta(closeTime, resp.Execution.GetCloseTime(), float64(time.Millisecond))
+}
+
+// TestDeleteWorkflowExecution test
+func (s *VisibilityPersistenceSuite) TestDeleteWorkflowExecution() {
+	testDomainUUID := uuid.New()
+
+	openExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-delete-open-test"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	closedExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-delete-closed-test"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+	for _, execution := range []gen.WorkflowExecution{openExecution, closedExecution} {
+		err := s.VisibilityMgr.RecordWorkflowExecutionStarted(&p.RecordWorkflowExecutionStartedRequest{
+			DomainUUID:       testDomainUUID,
+			Execution:        execution,
+			WorkflowTypeName: "visibility-workflow",
+			StartTimestamp:   startTime,
+		})
+		s.Nil(err)
+	}
+
+	closeTime := time.Now().UnixNano()
+	err := s.VisibilityMgr.RecordWorkflowExecutionClosed(&p.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        closedExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		CloseTimestamp:   closeTime,
+		HistoryLength:    3,
+	})
+	s.Nil(err)
+
+	err = s.VisibilityMgr.DeleteWorkflowExecution(&p.VisibilityDeleteWorkflowExecutionRequest{
+		DomainUUID:     testDomainUUID,
+		Execution:      openExecution,
+		StartTimestamp: startTime,
+	})
+	s.Nil(err)
+	err = s.VisibilityMgr.DeleteWorkflowExecution(&p.VisibilityDeleteWorkflowExecutionRequest{
+		DomainUUID:     testDomainUUID,
+		Execution:      closedExecution,
+		StartTimestamp: startTime,
+		CloseTimestamp: closeTime,
+	})
+	s.Nil(err)
+
+	openResp, err := s.VisibilityMgr.ListOpenWorkflowExecutions(&p.ListWorkflowExecutionsRequest{
+		DomainUUID:        testDomainUUID,
+		PageSize:          10,
+		EarliestStartTime: startTime,
+		LatestStartTime:   startTime,
+	})
+	s.Nil(err)
+	s.Empty(openResp.Executions)
+
+	closedResp, err := s.VisibilityMgr.ListClosedWorkflowExecutions(&p.ListWorkflowExecutionsRequest{
+		DomainUUID:        testDomainUUID,
+		PageSize:          10,
+		EarliestStartTime: startTime,
+		LatestStartTime:   closeTime,
+	})
+	s.Nil(err)
+	s.Empty(closedResp.Executions)
+
+	_, err = s.VisibilityMgr.GetClosedWorkflowExecution(&p.GetClosedWorkflowExecutionRequest{
+		DomainUUID: testDomainUUID,
+		Execution:  closedExecution,
+	})
+	s.Error(err)
+	_, ok := err.(*gen.EntityNotExistsError)
+	s.True(ok, "EntityNotExistsError")
+}
+
+// TestSearchAttributes test
+func (s *VisibilityPersistenceSuite) TestSearchAttributes() {
+	testDomainUUID := uuid.New()
+
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+	workflowExecution1 := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-search-attributes-test1"),
+		RunId:      common.StringPtr("fb15e4b5-356f-466d-8c6d-a29223e5c536"),
+	}
+	err0 := s.VisibilityMgr.RecordWorkflowExecutionStarted(&p.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution1,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		SearchAttributes: map[string][]byte{"CustomKeywordField": []byte(`"customer-a"`)},
+	})
+	s.Nil(err0)
+
+	workflowExecution2 := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-search-attributes-test2"),
+		RunId:      common.StringPtr("843f6fc7-102a-4c63-a2d4-7c653b01bf52"),
+	}
+	err1 := s.VisibilityMgr.RecordWorkflowExecutionStarted(&p.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution2,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		SearchAttributes: map[string][]byte{"CustomKeywordField": []byte(`"customer-b"`)},
+	})
+	s.Nil(err1)
+
+	resp, err2 := s.VisibilityMgr.ListWorkflowExecutions(&p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      `CustomKeywordField = "customer-a"`,
+	})
+	s.Nil(err2)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(workflowExecution1.WorkflowId, resp.Executions[0].Execution.WorkflowId)
+	s.Equal([]byte(`"customer-a"`), resp.Executions[0].SearchAttributes.IndexedFields["CustomKeywordField"])
+
+	err3 := s.VisibilityMgr.UpsertWorkflowExecution(&p.UpsertWorkflowExecutionRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution2,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		UpsertTimestamp:  time.Now().UnixNano(),
+		SearchAttributes: map[string][]byte{"CustomKeywordField": []byte(`"customer-a"`)},
+	})
+	s.Nil(err3)
+
+	resp, err4 := s.VisibilityMgr.ListWorkflowExecutions(&p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      `CustomKeywordField = "customer-a" AND CloseTime = missing`,
+	})
+	s.Nil(err4)
+	s.Equal(2, len(resp.Executions))
+
+	err5 := s.VisibilityMgr.RecordWorkflowExecutionClosed(&p.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution1,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		CloseTimestamp:   time.Now().UnixNano(),
+		Status:           gen.WorkflowExecutionCloseStatusCompleted,
+		SearchAttributes: map[string][]byte{"CustomKeywordField": []byte(`"customer-a"`)},
+	})
+	s.Nil(err5)
+
+	resp, err6 := s.VisibilityMgr.ListWorkflowExecutions(&p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      `CustomKeywordField = "customer-a" AND CloseStatus = "COMPLETED"`,
+	})
+	s.Nil(err6)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(workflowExecution1.WorkflowId, resp.Executions[0].Execution.WorkflowId)
+
+	_, err7 := s.VisibilityMgr.ListWorkflowExecutions(&p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      `CustomKeywordField = `,
+	})
+	s.IsType(&gen.BadRequestError{}, err7)
+}
+
+// TestVisibilityMemo test
+func (s *VisibilityPersistenceSuite) TestVisibilityMemo() {
+	testDomainUUID := uuid.New()
+	memo := map[string][]byte{"customer": []byte(`"customer-a"`), "total": []byte(`42.5`)}
+
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-memo-test"),
+		RunId:      common.StringPtr("2bb5bd3d-4a46-4bd4-a4a8-41a41df5b2b1"),
+	}
+
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+	err0 := s.VisibilityMgr.RecordWorkflowExecutionStarted(&p.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		Memo:             memo,
+	})
+	s.Nil(err0)
+
+	resp, err1 := s.VisibilityMgr.ListOpenWorkflowExecutions(&p.ListWorkflowExecutionsRequest{
+		DomainUUID:        testDomainUUID,
+		PageSize:          1,
+		EarliestStartTime: startTime,
+		LatestStartTime:   startTime,
+	})
+	s.Nil(err1)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(memo, resp.Executions[0].Memo.Fields)
+
+	err2 := s.VisibilityMgr.RecordWorkflowExecutionClosed(&p.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		CloseTimestamp:   time.Now().UnixNano(),
+		Status:           gen.WorkflowExecutionCloseStatusCompleted,
+		Memo:             memo,
+	})
+	s.Nil(err2)
+
+	resp, err3 := s.VisibilityMgr.ListClosedWorkflowExecutions(&p.ListWorkflowExecutionsRequest{
+		DomainUUID:        testDomainUUID,
+		PageSize:          1,
+		EarliestStartTime: startTime,
+		LatestStartTime:   startTime,
+	})
+	s.Nil(err3)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(memo, resp.Executions[0].Memo.Fields)
 }
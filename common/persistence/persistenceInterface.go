@@ -55,10 +55,14 @@ type (
 		GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*InternalGetWorkflowExecutionResponse, error)
 		UpdateWorkflowExecution(request *InternalUpdateWorkflowExecutionRequest) error
 		ResetMutableState(request *InternalResetMutableStateRequest) error
+		ResetWorkflowExecution(request *InternalResetWorkflowExecutionRequest) error
 
 		CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error)
 		DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error
+		DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error
 		GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
+		ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error)
+		ListCurrentExecutions(request *ListCurrentExecutionsRequest) (*ListCurrentExecutionsResponse, error)
 
 		// Transfer task related methods
 		GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error)
@@ -69,6 +73,12 @@ type (
 		GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error)
 		CompleteReplicationTask(request *CompleteReplicationTaskRequest) error
 
+		// Replication DLQ related methods
+		PutReplicationTaskToDLQ(request *PutReplicationTaskToDLQRequest) error
+		GetReplicationTasksFromDLQ(request *GetReplicationTasksFromDLQRequest) (*GetReplicationTasksFromDLQResponse, error)
+		DeleteReplicationTaskFromDLQ(request *DeleteReplicationTaskFromDLQRequest) error
+		GetReplicationDLQSize(request *GetReplicationDLQSizeRequest) (*GetReplicationDLQSizeResponse, error)
+
 		// Timer related methods.
 		GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error)
 		CompleteTimerTask(request *CompleteTimerTaskRequest) error
@@ -108,6 +118,8 @@ type (
 		DeleteHistoryBranch(request *InternalDeleteHistoryBranchRequest) error
 		// GetHistoryTree returns all branch information of a tree
 		GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error)
+		// GetAllHistoryTreeBranches returns the branches of all the history trees
+		GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error)
 	}
 
 	// DataBlob represents a blob for any binary data.
@@ -150,6 +162,8 @@ type (
 		DecisionTimeout              int32
 		DecisionAttempt              int64
 		DecisionTimestamp            int64
+		DecisionScheduledTimestamp   int64
+		DecisionStartedTimestamp     int64
 		CancelRequested              bool
 		CancelRequestID              string
 		StickyTaskList               string
@@ -171,6 +185,8 @@ type (
 		BranchToken       []byte
 		CronSchedule      string
 		ExpirationSeconds int32
+		Memo              map[string][]byte
+		SearchAttributes  map[string][]byte
 	}
 
 	// InternalWorkflowMutableState indicates workflow related state for Persistence Interface
@@ -219,6 +235,7 @@ type (
 		ExpirationTime     time.Time
 		MaximumAttempts    int32
 		NonRetriableErrors []string
+		LastFailureReason  string
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibility int64
 	}
@@ -294,6 +311,30 @@ type (
 		InsertSignalRequestedIDs  []string
 	}
 
+	// InternalResetWorkflowExecutionRequest is used to create a new run of a workflow execution from a reset point
+	// for Persistence Interface
+	InternalResetWorkflowExecutionRequest struct {
+		PrevRunID        string
+		ExecutionInfo    *InternalWorkflowExecutionInfo
+		ReplicationState *ReplicationState
+		RangeID          int64
+
+		TransferTasks []Task
+		TimerTasks    []Task
+
+		// Mutable state
+		InsertActivityInfos      []*InternalActivityInfo
+		InsertTimerInfos         []*TimerInfo
+		InsertSignalRequestedIDs []string
+
+		// The previous run terminated by the reset, nil when it was already closed
+		CurrExecutionInfo    *InternalWorkflowExecutionInfo
+		CurrReplicationState *ReplicationState
+		CurrCondition        int64
+		CurrTransferTasks    []Task
+		CurrTimerTasks       []Task
+	}
+
 	// InternalAppendHistoryEventsRequest is used to append new events to workflow execution history  for Persistence Interface
 	InternalAppendHistoryEventsRequest struct {
 		DomainID          string
@@ -318,6 +359,8 @@ type (
 		Events *DataBlob
 		// requested TransactionID for conditional update
 		TransactionID int64
+		// Info describes the owner of a new branch
+		Info string
 	}
 
 	// InternalGetWorkflowExecutionResponse is the response to GetworkflowExecutionRequest for Persistence Interface
@@ -360,6 +403,8 @@ type (
 		ForkNodeID int64
 		// branchID of the new branch
 		NewBranchID string
+		// Info describes the owner of the new branch
+		Info string
 	}
 
 	// InternalForkHistoryBranchResponse is the response to ForkHistoryBranchRequest
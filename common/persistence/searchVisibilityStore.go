@@ -0,0 +1,225 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/search"
+)
+
+type (
+	searchVisibilityStore struct {
+		client search.Client
+	}
+)
+
+// errSearchVisibilityReadOnly is returned by the write operations, the executions are written to the search
+// backend by the indexer of the worker service
+var errSearchVisibilityReadOnly = errors.New("search visibility store is read only")
+
+var _ VisibilityManager = (*searchVisibilityStore)(nil)
+
+// NewSearchVisibilityManager returns a visibility manager which lists workflow executions from the search backend
+func NewSearchVisibilityManager(client search.Client) VisibilityManager {
+	return &searchVisibilityStore{
+		client: client,
+	}
+}
+
+func (v *searchVisibilityStore) Close() {}
+
+func (v *searchVisibilityStore) GetName() string {
+	return "search"
+}
+
+func (v *searchVisibilityStore) RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error {
+	return errSearchVisibilityReadOnly
+}
+
+func (v *searchVisibilityStore) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
+	return errSearchVisibilityReadOnly
+}
+
+func (v *searchVisibilityStore) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
+	return errSearchVisibilityReadOnly
+}
+
+func (v *searchVisibilityStore) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
+	return errSearchVisibilityReadOnly
+}
+
+func (v *searchVisibilityStore) ListOpenWorkflowExecutions(
+	request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	query := openSearchQuery(request)
+	return v.list("ListOpenWorkflowExecutions", request, query)
+}
+
+func (v *searchVisibilityStore) ListClosedWorkflowExecutions(
+	request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	query := closedSearchQuery(request)
+	return v.list("ListClosedWorkflowExecutions", request, query)
+}
+
+func (v *searchVisibilityStore) ListOpenWorkflowExecutionsByType(
+	request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	query := openSearchQuery(&request.ListWorkflowExecutionsRequest) + andEquals(definition.WorkflowType, request.WorkflowTypeName)
+	return v.list("ListOpenWorkflowExecutionsByType", &request.ListWorkflowExecutionsRequest, query)
+}
+
+func (v *searchVisibilityStore) ListClosedWorkflowExecutionsByType(
+	request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	query := closedSearchQuery(&request.ListWorkflowExecutionsRequest) + andEquals(definition.WorkflowType, request.WorkflowTypeName)
+	return v.list("ListClosedWorkflowExecutionsByType", &request.ListWorkflowExecutionsRequest, query)
+}
+
+func (v *searchVisibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
+	request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	query := openSearchQuery(&request.ListWorkflowExecutionsRequest) + andEquals(definition.WorkflowID, request.WorkflowID)
+	return v.list("ListOpenWorkflowExecutionsByWorkflowID", &request.ListWorkflowExecutionsRequest, query)
+}
+
+func (v *searchVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
+	request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	query := closedSearchQuery(&request.ListWorkflowExecutionsRequest) + andEquals(definition.WorkflowID, request.WorkflowID)
+	return v.list("ListClosedWorkflowExecutionsByWorkflowID", &request.ListWorkflowExecutionsRequest, query)
+}
+
+func (v *searchVisibilityStore) ListClosedWorkflowExecutionsByStatus(
+	request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	query := closedSearchQuery(&request.ListWorkflowExecutionsRequest) + fmt.Sprintf(" AND %v = %v", definition.CloseStatus, int32(request.Status))
+	return v.list("ListClosedWorkflowExecutionsByStatus", &request.ListWorkflowExecutionsRequest, query)
+}
+
+func (v *searchVisibilityStore) GetClosedWorkflowExecution(
+	request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	execution := request.Execution
+	query := fmt.Sprintf("%v != missing", definition.CloseTime) +
+		andEquals(definition.WorkflowID, execution.GetWorkflowId()) +
+		andEquals(definition.RunID, execution.GetRunId())
+	response, err := v.client.Query(context.Background(), &search.QueryRequest{
+		DomainID: request.DomainUUID,
+		Query:    query,
+		PageSize: 1,
+	})
+	if err != nil {
+		return nil, convertSearchError("GetClosedWorkflowExecution", err)
+	}
+	if len(response.Documents) == 0 {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+				execution.GetWorkflowId(), execution.GetRunId()),
+		}
+	}
+	return &GetClosedWorkflowExecutionResponse{
+		Execution: searchDocumentToExecutionInfo(response.Documents[0]),
+	}, nil
+}
+
+func (v *searchVisibilityStore) ListWorkflowExecutions(
+	request *ListWorkflowExecutionsByQueryRequest) (*ListWorkflowExecutionsResponse, error) {
+	response, err := v.client.Query(context.Background(), &search.QueryRequest{
+		DomainID:      request.DomainUUID,
+		Query:         request.Query,
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, convertSearchError("ListWorkflowExecutions", err)
+	}
+	return toSearchListResponse(response), nil
+}
+
+func (v *searchVisibilityStore) list(
+	operation string, request *ListWorkflowExecutionsRequest, query string) (*ListWorkflowExecutionsResponse, error) {
+	response, err := v.client.Query(context.Background(), &search.QueryRequest{
+		DomainID:      request.DomainUUID,
+		Query:         query,
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, convertSearchError(operation, err)
+	}
+	return toSearchListResponse(response), nil
+}
+
+func openSearchQuery(request *ListWorkflowExecutionsRequest) string {
+	return fmt.Sprintf("%v = missing AND %v BETWEEN %v AND %v",
+		definition.CloseTime, definition.StartTime, request.EarliestStartTime, request.LatestStartTime)
+}
+
+func closedSearchQuery(request *ListWorkflowExecutionsRequest) string {
+	return fmt.Sprintf("%v != missing AND %v BETWEEN %v AND %v",
+		definition.CloseTime, definition.StartTime, request.EarliestStartTime, request.LatestStartTime)
+}
+
+// andEquals returns the condition that the key equals the string value, quoted for the query
+func andEquals(key string, value string) string {
+	escaped := strings.Replace(strings.Replace(value, `\`, `\\`, -1), `'`, `\'`, -1)
+	return fmt.Sprintf(" AND %v = '%v'", key, escaped)
+}
+
+func convertSearchError(operation string, err error) error {
+	if _, ok := err.(*workflow.BadRequestError); ok {
+		return err
+	}
+	return &workflow.InternalServiceError{
+		Message: fmt.Sprintf("%v operation failed. Error: %v", operation, err),
+	}
+}
+
+func toSearchListResponse(response *search.QueryResponse) *ListWorkflowExecutionsResponse {
+	executions := make([]*workflow.WorkflowExecutionInfo, 0, len(response.Documents))
+	for _, document := range response.Documents {
+		executions = append(executions, searchDocumentToExecutionInfo(document))
+	}
+	return &ListWorkflowExecutionsResponse{
+		Executions:    executions,
+		NextPageToken: response.NextPageToken,
+	}
+}
+
+func searchDocumentToExecutionInfo(document *search.Document) *workflow.WorkflowExecutionInfo {
+	record := &workflow.WorkflowExecutionInfo{
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(document.WorkflowID),
+			RunId:      common.StringPtr(document.RunID),
+		},
+		Type:             &workflow.WorkflowType{Name: common.StringPtr(document.WorkflowType)},
+		StartTime:        common.Int64Ptr(document.StartTime),
+		Memo:             &workflow.Memo{Fields: document.Memo},
+		SearchAttributes: &workflow.SearchAttributes{IndexedFields: document.SearchAttributes},
+	}
+	if document.CloseTime != nil {
+		status := workflow.WorkflowExecutionCloseStatus(common.Int32Default(document.CloseStatus))
+		record.CloseTime = common.Int64Ptr(*document.CloseTime)
+		record.CloseStatus = &status
+		record.HistoryLength = common.Int64Ptr(common.Int64Default(document.HistoryLength))
+	}
+	return record
+}
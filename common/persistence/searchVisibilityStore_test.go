@@ -0,0 +1,313 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/search"
+	"github.com/uber/cadence/common/search/httpjson"
+)
+
+type (
+	searchVisibilityStoreSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+
+		backend       *standInSearchBackend
+		server        *httptest.Server
+		client        search.Client
+		visibilityMgr VisibilityManager
+	}
+
+	// standInSearchBackend is a local stand-in for the HTTP/JSON search backend, it keeps the documents
+	// in memory and evaluates the queries with VisibilityQuery
+	standInSearchBackend struct {
+		sync.Mutex
+		documents map[string]*search.Document
+	}
+)
+
+const testSearchDomainID = "3bb4e2a7-5de1-4b1c-b1f8-4c6b9f1a2e3d"
+
+func TestSearchVisibilityStoreSuite(t *testing.T) {
+	s := new(searchVisibilityStoreSuite)
+	suite.Run(t, s)
+}
+
+func (s *searchVisibilityStoreSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.backend = &standInSearchBackend{documents: make(map[string]*search.Document)}
+	s.server = httptest.NewServer(s.backend)
+
+	var err error
+	s.client, err = httpjson.NewClient(&httpjson.Config{URL: s.server.URL, Index: "cadence-visibility"})
+	s.NoError(err)
+	s.visibilityMgr = NewSearchVisibilityManager(s.client)
+}
+
+func (s *searchVisibilityStoreSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *searchVisibilityStoreSuite) TestBulk_StaleVersionConflicts() {
+	open := s.document("wid", "rid", "type", 100)
+	closed := s.closedDocument(open, 300, workflow.WorkflowExecutionCloseStatusCompleted)
+	staleUpsert := *open
+	staleUpsert.Version = 200
+
+	errs, err := s.client.Bulk(context.Background(), []*search.Document{open, closed, &staleUpsert})
+	s.NoError(err)
+	s.Equal([]error{nil, nil, search.ErrVersionConflict}, errs)
+
+	response, err := s.visibilityMgr.ListOpenWorkflowExecutions(s.listRequest())
+	s.NoError(err)
+	s.Empty(response.Executions)
+	response, err = s.visibilityMgr.ListClosedWorkflowExecutions(s.listRequest())
+	s.NoError(err)
+	s.Len(response.Executions, 1)
+	s.Equal(int64(300), response.Executions[0].GetCloseTime())
+}
+
+func (s *searchVisibilityStoreSuite) TestListOpenAndClosed() {
+	open1 := s.document("wid-1", "rid-1", "type-a", 100)
+	open2 := s.document("wid-2", "rid-2", "type-b", 200)
+	closed := s.closedDocument(s.document("wid-3", "rid-3", "type-a", 150), 400, workflow.WorkflowExecutionCloseStatusFailed)
+	s.index(open1, open2, closed)
+
+	response, err := s.visibilityMgr.ListOpenWorkflowExecutions(s.listRequest())
+	s.NoError(err)
+	s.Equal([]string{"wid-2", "wid-1"}, workflowIDs(response.Executions))
+	s.Nil(response.Executions[0].CloseStatus)
+
+	response, err = s.visibilityMgr.ListOpenWorkflowExecutionsByType(&ListWorkflowExecutionsByTypeRequest{
+		ListWorkflowExecutionsRequest: *s.listRequest(),
+		WorkflowTypeName:              "type-a",
+	})
+	s.NoError(err)
+	s.Equal([]string{"wid-1"}, workflowIDs(response.Executions))
+
+	response, err = s.visibilityMgr.ListClosedWorkflowExecutionsByStatus(&ListClosedWorkflowExecutionsByStatusRequest{
+		ListWorkflowExecutionsRequest: *s.listRequest(),
+		Status:                        workflow.WorkflowExecutionCloseStatusFailed,
+	})
+	s.NoError(err)
+	s.Equal([]string{"wid-3"}, workflowIDs(response.Executions))
+	s.Equal(workflow.WorkflowExecutionCloseStatusFailed, response.Executions[0].GetCloseStatus())
+	s.Equal(int64(42), response.Executions[0].GetHistoryLength())
+
+	request := s.listRequest()
+	request.EarliestStartTime = 150
+	response, err = s.visibilityMgr.ListOpenWorkflowExecutions(request)
+	s.NoError(err)
+	s.Equal([]string{"wid-2"}, workflowIDs(response.Executions))
+}
+
+func (s *searchVisibilityStoreSuite) TestListByWorkflowID_Quoted() {
+	s.index(s.document("it's \\ tricky", "rid-1", "type", 100), s.document("other", "rid-2", "type", 100))
+
+	response, err := s.visibilityMgr.ListOpenWorkflowExecutionsByWorkflowID(&ListWorkflowExecutionsByWorkflowIDRequest{
+		ListWorkflowExecutionsRequest: *s.listRequest(),
+		WorkflowID:                    "it's \\ tricky",
+	})
+	s.NoError(err)
+	s.Equal([]string{"it's \\ tricky"}, workflowIDs(response.Executions))
+}
+
+func (s *searchVisibilityStoreSuite) TestListWorkflowExecutions_Paging() {
+	for i := 0; i < 5; i++ {
+		s.index(s.document("wid-"+strconv.Itoa(i), "rid", "type", int64(100+i)))
+	}
+
+	request := &ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testSearchDomainID,
+		Query:      "WorkflowType = 'type' AND StartTime >= 101",
+		PageSize:   3,
+	}
+	response, err := s.visibilityMgr.ListWorkflowExecutions(request)
+	s.NoError(err)
+	s.Equal([]string{"wid-4", "wid-3", "wid-2"}, workflowIDs(response.Executions))
+	s.NotEmpty(response.NextPageToken)
+
+	request.NextPageToken = response.NextPageToken
+	response, err = s.visibilityMgr.ListWorkflowExecutions(request)
+	s.NoError(err)
+	s.Equal([]string{"wid-1"}, workflowIDs(response.Executions))
+	s.Empty(response.NextPageToken)
+}
+
+func (s *searchVisibilityStoreSuite) TestListWorkflowExecutions_InvalidQuery() {
+	_, err := s.visibilityMgr.ListWorkflowExecutions(&ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testSearchDomainID,
+		Query:      "WorkflowType = ",
+		PageSize:   10,
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *searchVisibilityStoreSuite) TestGetClosedWorkflowExecution() {
+	s.index(s.document("wid-open", "rid-open", "type", 100),
+		s.closedDocument(s.document("wid-closed", "rid-closed", "type", 100), 200, workflow.WorkflowExecutionCloseStatusCompleted))
+
+	response, err := s.visibilityMgr.GetClosedWorkflowExecution(&GetClosedWorkflowExecutionRequest{
+		DomainUUID: testSearchDomainID,
+		Execution:  workflow.WorkflowExecution{WorkflowId: common.StringPtr("wid-closed"), RunId: common.StringPtr("rid-closed")},
+	})
+	s.NoError(err)
+	s.Equal("wid-closed", response.Execution.Execution.GetWorkflowId())
+
+	_, err = s.visibilityMgr.GetClosedWorkflowExecution(&GetClosedWorkflowExecutionRequest{
+		DomainUUID: testSearchDomainID,
+		Execution:  workflow.WorkflowExecution{WorkflowId: common.StringPtr("wid-open"), RunId: common.StringPtr("rid-open")},
+	})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+}
+
+func (s *searchVisibilityStoreSuite) TestWritesAreRejected() {
+	s.Error(s.visibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{}))
+	s.Error(s.visibilityMgr.RecordWorkflowExecutionClosed(&RecordWorkflowExecutionClosedRequest{}))
+	s.Error(s.visibilityMgr.UpsertWorkflowExecution(&UpsertWorkflowExecutionRequest{}))
+}
+
+func (s *searchVisibilityStoreSuite) document(workflowID string, runID string, workflowType string, startTime int64) *search.Document {
+	return &search.Document{
+		DomainID:     testSearchDomainID,
+		WorkflowID:   workflowID,
+		RunID:        runID,
+		WorkflowType: workflowType,
+		StartTime:    startTime,
+		Version:      startTime,
+	}
+}
+
+func (s *searchVisibilityStoreSuite) closedDocument(
+	open *search.Document, closeTime int64, status workflow.WorkflowExecutionCloseStatus) *search.Document {
+	closed := *open
+	closed.CloseTime = common.Int64Ptr(closeTime)
+	closed.CloseStatus = common.Int32Ptr(int32(status))
+	closed.HistoryLength = common.Int64Ptr(42)
+	closed.Version = closeTime
+	return &closed
+}
+
+func (s *searchVisibilityStoreSuite) index(documents ...*search.Document) {
+	errs, err := s.client.Bulk(context.Background(), documents)
+	s.NoError(err)
+	for _, err := range errs {
+		s.NoError(err)
+	}
+}
+
+func (s *searchVisibilityStoreSuite) listRequest() *ListWorkflowExecutionsRequest {
+	return &ListWorkflowExecutionsRequest{
+		DomainUUID:        testSearchDomainID,
+		EarliestStartTime: 0,
+		LatestStartTime:   math.MaxInt64,
+		PageSize:          10,
+	}
+}
+
+func workflowIDs(executions []*workflow.WorkflowExecutionInfo) []string {
+	var result []string
+	for _, execution := range executions {
+		result = append(result, execution.Execution.GetWorkflowId())
+	}
+	return result
+}
+
+func (b *standInSearchBackend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.Lock()
+	defer b.Unlock()
+
+	switch r.URL.Path {
+	case "/cadence-visibility/_bulk":
+		var request struct{ Documents []*search.Document }
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			writeStandInError(w, http.StatusBadRequest, err)
+			return
+		}
+		var items []map[string]interface{}
+		for _, document := range request.Documents {
+			id := document.DomainID + "/" + document.WorkflowID + "/" + document.RunID
+			if existing, ok := b.documents[id]; ok && existing.Version > document.Version {
+				items = append(items, map[string]interface{}{"status": http.StatusConflict})
+				continue
+			}
+			b.documents[id] = document
+			items = append(items, map[string]interface{}{"status": http.StatusOK})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"items": items})
+
+	case "/cadence-visibility/_query":
+		request := &search.QueryRequest{}
+		if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+			writeStandInError(w, http.StatusBadRequest, err)
+			return
+		}
+		query, err := ParseVisibilityQuery(request.Query, nil)
+		if err != nil {
+			writeStandInError(w, http.StatusBadRequest, err)
+			return
+		}
+		var matches []*search.Document
+		for _, document := range b.documents {
+			if document.DomainID == request.DomainID && query.Match(searchDocumentToExecutionInfo(document)) {
+				matches = append(matches, document)
+			}
+		}
+		sort.Slice(matches, func(i, j int) bool {
+			return matches[i].StartTime > matches[j].StartTime
+		})
+
+		offset := 0
+		if len(request.NextPageToken) > 0 {
+			offset, _ = strconv.Atoi(string(request.NextPageToken))
+		}
+		response := &search.QueryResponse{Documents: matches[offset:]}
+		if len(response.Documents) > request.PageSize {
+			response.Documents = response.Documents[:request.PageSize]
+			response.NextPageToken = []byte(strconv.Itoa(offset + request.PageSize))
+		}
+		json.NewEncoder(w).Encode(response)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func writeStandInError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
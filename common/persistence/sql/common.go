@@ -26,7 +26,6 @@ import (
 	"encoding/gob"
 	"fmt"
 
-	"github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
 	"github.com/uber-common/bark"
 	workflow "github.com/uber/cadence/.gen/go/shared"
@@ -81,15 +80,6 @@ func (m *sqlStore) txExecute(operation string, f func(tx *sqlx.Tx) error) error
 	return nil
 }
 
-// ErrDupEntry MySQL Error 1062 indicates a duplicate primary key i.e. the row already exists,
-// so we don't do the insert and return a ConditionalUpdate error.
-const ErrDupEntry = 1062
-
-func isDupEntry(err error) bool {
-	sqlErr, ok := err.(*mysql.MySQLError)
-	return ok && sqlErr.Number == ErrDupEntry
-}
-
 func gobSerialize(x interface{}) ([]byte, error) {
 	b := bytes.Buffer{}
 	e := gob.NewEncoder(&b)
@@ -115,10 +105,6 @@ func gobDeserialize(a []byte, x interface{}) error {
 	return nil
 }
 
-const (
-	dataSourceName = "%s:%s@%v(%v)/%s?multiStatements=true&tx_isolation=%%27READ-COMMITTED%%27&parseTime=true&clientFoundRows=true"
-)
-
 func boolToInt64(b bool) int64 {
 	if b {
 		return 1
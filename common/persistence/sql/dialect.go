@@ -0,0 +1,216 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	mysqlDriverName    = "mysql"
+	postgresDriverName = "postgres"
+
+	// ErrDupEntry MySQL Error 1062 indicates a duplicate primary key i.e. the row already exists,
+	// so we don't do the insert and return a ConditionalUpdate error.
+	ErrDupEntry = 1062
+
+	// errPostgresUniqueViolation is the SQLSTATE postgres reports for a duplicate primary key
+	errPostgresUniqueViolation = "23505"
+
+	mysqlDataSourceName = "%s:%s@%v(%v)/%s?multiStatements=true&tx_isolation=%%27READ-COMMITTED%%27&parseTime=true&clientFoundRows=true"
+)
+
+type (
+	// dialect captures the parts of the SQL syntax and of the driver behaviour which differ between
+	// the databases supported by the sql stores. Queries written with ? bind vars are portable as
+	// long as they are rebound for the driver, everything else which is not goes through the dialect.
+	dialect interface {
+		// dataSourceName returns the connection string for the database dbName
+		dataSourceName(cfg config.SQL, dbName string) string
+		// maintenanceDatabaseName is the database to connect to for creating and dropping databases
+		maintenanceDatabaseName() string
+		// dropDatabaseQueries returns the statements which drop the database dbName
+		dropDatabaseQueries(dbName string) []string
+		// upsertQuery returns a named query inserting a row into table which replaces the existing row
+		// when there is one with the same keyColumns, valueColumns are the remaining columns of the row
+		upsertQuery(table string, keyColumns []string, valueColumns []string) string
+		// insertIgnoreQuery returns a named query inserting a row into table which does nothing when
+		// there already is one with the same key
+		insertIgnoreQuery(table string, columns []string) string
+		// readLockClause is appended to a SELECT to take a shared lock on the rows it returns
+		readLockClause() string
+		// limitedDeleteQuery returns a query deleting at most a number of the rows of table matching condition,
+		// the limit is bound after the bind vars of the condition
+		limitedDeleteQuery(table string, condition string) string
+		// isDupEntry tells whether err was caused by inserting a duplicate key
+		isDupEntry(err error) bool
+	}
+
+	mysqlDialect struct{}
+
+	// postgresDialect is the dialect of PostgreSQL 9.5 and later, blobs are stored as BYTEA
+	// which lib/pq reads and writes as []byte the same way the mysql driver does BLOB
+	postgresDialect struct{}
+
+	// dialectSQLQuery holds the text of a query which differs between dialects, keyed by driver name
+	dialectSQLQuery map[string]string
+
+	driverNamer interface {
+		DriverName() string
+	}
+)
+
+var dialects = map[string]dialect{
+	mysqlDriverName:    mysqlDialect{},
+	postgresDriverName: postgresDialect{},
+}
+
+func getDialect(driverName string) (dialect, error) {
+	d, ok := dialects[driverName]
+	if !ok {
+		return nil, fmt.Errorf("unsupported sql driver: %v", driverName)
+	}
+	return d, nil
+}
+
+// newDialectSQLQuery builds the text of the query for every dialect
+func newDialectSQLQuery(build func(d dialect) string) dialectSQLQuery {
+	q := make(dialectSQLQuery, len(dialects))
+	for driverName, d := range dialects {
+		q[driverName] = build(d)
+	}
+	return q
+}
+
+// on returns the text of the query for the driver of the db or tx it is going to be run on
+func (q dialectSQLQuery) on(db driverNamer) string {
+	return q[db.DriverName()]
+}
+
+func isDupEntry(err error) bool {
+	for _, d := range dialects {
+		if d.isDupEntry(err) {
+			return true
+		}
+	}
+	return false
+}
+
+func insertQuery(table string, columns []string) string {
+	return fmt.Sprintf("INTO %v (%v) VALUES (%v)",
+		table, strings.Join(columns, ", "), strings.Join(prependColons(columns), ", "))
+}
+
+func (mysqlDialect) dataSourceName(cfg config.SQL, dbName string) string {
+	return fmt.Sprintf(mysqlDataSourceName, cfg.User, cfg.Password, cfg.ConnectProtocol, cfg.ConnectAddr, dbName)
+}
+
+func (mysqlDialect) maintenanceDatabaseName() string {
+	return ""
+}
+
+func (mysqlDialect) dropDatabaseQueries(dbName string) []string {
+	return []string{"DROP DATABASE " + dbName}
+}
+
+func (mysqlDialect) upsertQuery(table string, keyColumns []string, valueColumns []string) string {
+	return "REPLACE " + insertQuery(table, append(append([]string{}, keyColumns...), valueColumns...))
+}
+
+func (mysqlDialect) insertIgnoreQuery(table string, columns []string) string {
+	return "INSERT IGNORE " + insertQuery(table, columns)
+}
+
+func (mysqlDialect) readLockClause() string {
+	return "LOCK IN SHARE MODE"
+}
+
+func (mysqlDialect) limitedDeleteQuery(table string, condition string) string {
+	return fmt.Sprintf("DELETE FROM %v WHERE %v LIMIT ?", table, condition)
+}
+
+func (mysqlDialect) isDupEntry(err error) bool {
+	sqlErr, ok := err.(*mysql.MySQLError)
+	return ok && sqlErr.Number == ErrDupEntry
+}
+
+func (postgresDialect) dataSourceName(cfg config.SQL, dbName string) string {
+	dsn := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(cfg.User, cfg.Password),
+		Path:   "/" + dbName,
+	}
+	params := url.Values{}
+	params.Set("sslmode", "disable")
+	// have timestamps read back in UTC as they are with the mysql driver
+	params.Set("timezone", "UTC")
+	if cfg.ConnectProtocol == "unix" {
+		// lib/pq takes the directory of the socket as the host
+		params.Set("host", cfg.ConnectAddr)
+	} else {
+		dsn.Host = cfg.ConnectAddr
+	}
+	dsn.RawQuery = params.Encode()
+	return dsn.String()
+}
+
+func (postgresDialect) maintenanceDatabaseName() string {
+	return "postgres"
+}
+
+func (postgresDialect) dropDatabaseQueries(dbName string) []string {
+	// a database cannot be dropped while there are connections to it
+	return []string{
+		fmt.Sprintf("SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = '%v' AND pid <> pg_backend_pid()", dbName),
+		"DROP DATABASE " + dbName,
+	}
+}
+
+func (postgresDialect) upsertQuery(table string, keyColumns []string, valueColumns []string) string {
+	return "INSERT " + insertQuery(table, append(append([]string{}, keyColumns...), valueColumns...)) +
+		fmt.Sprintf(" ON CONFLICT (%v) DO UPDATE SET %v",
+			strings.Join(keyColumns, ", "),
+			strings.Join(stringMap(valueColumns, func(x string) string { return x + " = excluded." + x }), ", "))
+}
+
+func (postgresDialect) insertIgnoreQuery(table string, columns []string) string {
+	return "INSERT " + insertQuery(table, columns) + " ON CONFLICT DO NOTHING"
+}
+
+func (postgresDialect) readLockClause() string {
+	return "FOR SHARE"
+}
+
+func (postgresDialect) limitedDeleteQuery(table string, condition string) string {
+	// postgres has no DELETE ... LIMIT, the rows are picked by their physical location instead
+	return fmt.Sprintf("DELETE FROM %v WHERE ctid IN (SELECT ctid FROM %v WHERE %v LIMIT ?)", table, table, condition)
+}
+
+func (postgresDialect) isDupEntry(err error) bool {
+	sqlErr, ok := err.(*pq.Error)
+	return ok && sqlErr.Code == errPostgresUniqueViolation
+}
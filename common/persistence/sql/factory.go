@@ -31,7 +31,7 @@ import (
 )
 
 type (
-	// Factory vends store objects backed by MySQL
+	// Factory vends store objects backed by MySQL or PostgreSQL
 	Factory struct {
 		sync.RWMutex
 		cfg              config.SQL
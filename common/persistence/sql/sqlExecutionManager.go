@@ -96,6 +96,8 @@ type (
 		DecisionTimeout              int64
 		DecisionAttempt              int64
 		DecisionTimestamp            int64
+		DecisionScheduledTimestamp   int64
+		DecisionStartedTimestamp     int64
 		CancelRequested              *int64
 		CancelRequestID              *string
 		StickyTaskList               string
@@ -106,6 +108,16 @@ type (
 		ShardID                      int64
 		SignalCount                  int
 		CronSchedule                 string
+		Memo                         *[]byte
+		SearchAttributes             *[]byte
+	}
+
+	// executionPageToken is the key of the last execution of a page of ListConcreteExecutions or ListCurrentExecutions,
+	// it is also used to read the keys of the concrete executions
+	executionPageToken struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
 	}
 
 	currentExecutionRow struct {
@@ -158,6 +170,17 @@ type (
 		Data         []byte
 		DataEncoding string
 	}
+
+	replicationTasksDLQRow struct {
+		SourceClusterName string
+		ShardID           int
+		TaskID            int64
+		DomainID          string
+		WorkflowID        string
+		RunID             string
+		Data              []byte
+		DataEncoding      string
+	}
 )
 
 const (
@@ -183,6 +206,8 @@ decision_started_id,
 decision_timeout,
 decision_attempt,
 decision_timestamp,
+decision_scheduled_timestamp,
+decision_started_timestamp,
 sticky_task_list,
 sticky_schedule_to_start_timeout,
 client_library_version,
@@ -214,6 +239,8 @@ cron_schedule`
 :decision_timeout,
 :decision_attempt,
 :decision_timestamp,
+:decision_scheduled_timestamp,
+:decision_started_timestamp,
 :sticky_task_list,
 :sticky_schedule_to_start_timeout,
 :client_library_version,
@@ -224,10 +251,14 @@ cron_schedule`
 :cron_schedule`
 
 	executionsBlobColumns = `completion_event,
-execution_context`
+execution_context,
+memo,
+search_attributes`
 
 	executionsBlobColumnsTags = `:completion_event,
-:execution_context`
+:execution_context,
+:memo,
+:search_attributes`
 
 	// Excluding completion_event
 	executionsNonblobParentColumns = `parent_domain_id,
@@ -251,6 +282,8 @@ cancel_request_id`
 		executionsNonblobParentColumns +
 		`,
 execution_context,
+memo,
+search_attributes,
 cancel_requested,
 cancel_request_id,` +
 		executionsReplicationStateColumns +
@@ -259,6 +292,8 @@ VALUES
 (` + executionsNonNullableColumnsTags + `,` +
 		executionsNonblobParentColumnsTags + `,
 :execution_context,
+:memo,
+:search_attributes,
 :cancel_requested,
 :cancel_request_id,` +
 		executionsReplicationStateColumnsTags +
@@ -280,6 +315,8 @@ workflow_type_name = :workflow_type_name,
 workflow_timeout_seconds = :workflow_timeout_seconds,
 decision_task_timeout_minutes = :decision_task_timeout_minutes,
 execution_context = :execution_context,
+memo = :memo,
+search_attributes = :search_attributes,
 state = :state,
 close_status = :close_status,
 last_first_event_id = :last_first_event_id,
@@ -295,6 +332,8 @@ decision_request_id = :decision_request_id,
 decision_timeout = :decision_timeout,
 decision_attempt = :decision_attempt,
 decision_timestamp = :decision_timestamp,
+decision_scheduled_timestamp = :decision_scheduled_timestamp,
+decision_started_timestamp = :decision_started_timestamp,
 cancel_requested = :cancel_requested,
 cancel_request_id = :cancel_request_id,
 sticky_task_list = :sticky_task_list,
@@ -326,6 +365,12 @@ run_id = :run_id
 shard_id = ? AND
 domain_id = ? AND
 workflow_id = ? AND
+run_id = ?`
+
+	deleteCurrentExecutionSQLQuery = `DELETE FROM current_executions WHERE
+shard_id = ? AND
+domain_id = ? AND
+workflow_id = ? AND
 run_id = ?`
 
 	deleteExecutionSQLQuery = `DELETE FROM executions WHERE
@@ -388,6 +433,14 @@ WHERE ce.shard_id = ? AND ce.domain_id = ? AND ce.workflow_id = ?
 
 	getCurrentExecutionSQLQueryForUpdate = getCurrentExecutionSQLQuery + " FOR UPDATE"
 
+	listConcreteExecutionsSQLQuery = `SELECT domain_id, workflow_id, run_id FROM executions
+WHERE shard_id = ? AND (domain_id, workflow_id, run_id) > (?, ?, ?)
+ORDER BY domain_id, workflow_id, run_id LIMIT ?`
+
+	listCurrentExecutionsSQLQuery = `SELECT domain_id, workflow_id, run_id, state, close_status FROM current_executions
+WHERE shard_id = ? AND (domain_id, workflow_id) > (?, ?)
+ORDER BY domain_id, workflow_id LIMIT ?`
+
 	// The following queries together comprise ContinueAsNew.
 	// The updates must be executed only after locking current_run_id of
 	// the current_executions row that we are going to update,
@@ -470,6 +523,23 @@ LIMIT ?`
 
 	completeReplicationTaskSQLQuery = `DELETE FROM replication_tasks WHERE shard_id = ? AND task_id = ?`
 
+	replicationTasksDLQColumns = `source_cluster_name, shard_id, task_id, domain_id, workflow_id, run_id, data, data_encoding`
+
+	insertReplicationTaskDLQSQLQuery = `INSERT INTO replication_tasks_dlq (` + replicationTasksDLQColumns + `)
+VALUES (:source_cluster_name, :shard_id, :task_id, :domain_id, :workflow_id, :run_id, :data, :data_encoding)`
+
+	getReplicationTasksDLQSQLQuery = `SELECT ` + replicationTasksDLQColumns + `
+FROM replication_tasks_dlq WHERE
+source_cluster_name = ? AND
+shard_id = ? AND
+task_id > ?
+ORDER BY task_id
+LIMIT ?`
+
+	deleteReplicationTaskDLQSQLQuery = `DELETE FROM replication_tasks_dlq WHERE source_cluster_name = ? AND shard_id = ? AND task_id = ?`
+
+	getReplicationTasksDLQSizeSQLQuery = `SELECT COUNT(1) FROM replication_tasks_dlq WHERE source_cluster_name = ? AND shard_id = ?`
+
 	timerTaskInfoColumns     = `visibility_timestamp, task_id, domain_id, workflow_id, run_id, task_type, timeout_type, event_id, schedule_attempt, version`
 	timerTaskInfoColumnsTags = `:visibility_timestamp, :task_id, :domain_id, :workflow_id, :run_id, :task_type, :timeout_type, :event_id, :schedule_attempt, :version`
 	timerTasksColumns        = `shard_id,` + timerTaskInfoColumns
@@ -649,7 +719,7 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 	}
 
 	var execution executionRow
-	if err := sqlx.Get(tx, &execution, getExecutionSQLQuery,
+	if err := sqlx.Get(tx, &execution, tx.Rebind(getExecutionSQLQuery),
 		m.shardID,
 		request.DomainID,
 		*request.Execution.WorkflowId,
@@ -690,6 +760,8 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 		DecisionTimeout:              int32(execution.DecisionTimeout),
 		DecisionAttempt:              execution.DecisionAttempt,
 		DecisionTimestamp:            execution.DecisionTimestamp,
+		DecisionScheduledTimestamp:   execution.DecisionScheduledTimestamp,
+		DecisionStartedTimestamp:     execution.DecisionStartedTimestamp,
 		StickyTaskList:               execution.StickyTaskList,
 		StickyScheduleToStartTimeout: int32(execution.StickyScheduleToStartTimeout),
 		ClientLibraryVersion:         execution.ClientLibraryVersion,
@@ -703,6 +775,22 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 		state.ExecutionInfo.ExecutionContext = *execution.ExecutionContext
 	}
 
+	if execution.Memo != nil && len(*execution.Memo) > 0 {
+		if err := gobDeserialize(*execution.Memo, &state.ExecutionInfo.Memo); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetWorkflowExecution failed. Failed to deserialize Memo. Error: %v", err),
+			}
+		}
+	}
+
+	if execution.SearchAttributes != nil && len(*execution.SearchAttributes) > 0 {
+		if err := gobDeserialize(*execution.SearchAttributes, &state.ExecutionInfo.SearchAttributes); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetWorkflowExecution failed. Failed to deserialize SearchAttributes. Error: %v", err),
+			}
+		}
+	}
+
 	if execution.LastWriteEventID != nil {
 		state.ReplicationState = &p.ReplicationState{}
 		state.ReplicationState.StartVersion = execution.StartVersion
@@ -856,7 +944,7 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 func getBufferedEvents(tx *sqlx.Tx, shardID int, domainID string, workflowID string, runID string) (result []*p.DataBlob, err error) {
 	var rows []bufferedEventsRow
 
-	if err := tx.Select(&rows, getBufferedEventsQuery, shardID, domainID, workflowID, runID); err != nil && err != sql.ErrNoRows {
+	if err := tx.Select(&rows, tx.Rebind(getBufferedEventsQuery), shardID, domainID, workflowID, runID); err != nil && err != sql.ErrNoRows {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("getBufferedEvents operation failed. Select failed: %v", err),
 		}
@@ -1280,8 +1368,184 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx *sqlx.Tx, request *p.Intern
 	return nil
 }
 
+func (m *sqlExecutionManager) ResetWorkflowExecution(request *p.InternalResetWorkflowExecutionRequest) error {
+	return m.txExecuteShardLocked("ResetWorkflowExecution", request.RangeID, func(tx *sqlx.Tx) error {
+		return m.resetWorkflowExecutionTx(tx, request)
+	})
+}
+
+func (m *sqlExecutionManager) resetWorkflowExecutionTx(tx *sqlx.Tx, request *p.InternalResetWorkflowExecutionRequest) error {
+	info := request.ExecutionInfo
+	replicationState := request.ReplicationState
+
+	// the termination of the previous run is applied by the same transaction, so that the previous run is never
+	// terminated without the new run being created
+	if request.CurrExecutionInfo != nil {
+		if err := m.terminateCurrentRunTx(tx, request); err != nil {
+			return err
+		}
+	}
+
+	startVersion := common.EmptyVersion
+	lastWriteVersion := common.EmptyVersion
+	if replicationState != nil {
+		startVersion = replicationState.StartVersion
+		lastWriteVersion = replicationState.LastWriteVersion
+	}
+
+	if err := continueAsNew(tx,
+		m.shardID,
+		info.DomainID,
+		info.WorkflowID,
+		info.RunID,
+		request.PrevRunID,
+		info.CreateRequestID,
+		info.State,
+		info.CloseStatus,
+		startVersion,
+		lastWriteVersion); err != nil {
+		switch err.(type) {
+		case *p.ConditionFailedError:
+			return &p.CurrentWorkflowConditionFailedError{
+				Msg: fmt.Sprintf("ResetWorkflowExecution operation failed. Error: %v", err),
+			}
+		default:
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to update current execution. Error: %v", err),
+			}
+		}
+	}
+
+	// insert the executions row first, then overwrite it with the complete execution info
+	if err := createExecution(tx, &p.CreateWorkflowExecutionRequest{
+		RequestID: info.CreateRequestID,
+		DomainID:  info.DomainID,
+		Execution: workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(info.WorkflowID),
+			RunId:      common.StringPtr(info.RunID),
+		},
+		TaskList:         info.TaskList,
+		WorkflowTypeName: info.WorkflowTypeName,
+		NextEventID:      info.NextEventID,
+		ReplicationState: replicationState,
+	}, m.shardID, info.StartTimestamp); err != nil {
+		return err
+	}
+
+	if err := updateExecution(tx, info, replicationState, info.NextEventID, m.shardID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to update executions row. Error: %v", err),
+		}
+	}
+
+	if err := updateActivityInfos(tx,
+		request.InsertActivityInfos,
+		nil,
+		m.shardID,
+		info.DomainID,
+		info.WorkflowID,
+		info.RunID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to insert into activity info map. Error: %v", err),
+		}
+	}
+
+	if err := updateTimerInfos(tx,
+		request.InsertTimerInfos,
+		nil,
+		m.shardID,
+		info.DomainID,
+		info.WorkflowID,
+		info.RunID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to insert into timer info map. Error: %v", err),
+		}
+	}
+
+	if err := updateSignalsRequested(tx,
+		request.InsertSignalRequestedIDs,
+		"",
+		m.shardID,
+		info.DomainID,
+		info.WorkflowID,
+		info.RunID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to insert into signals requested set. Error: %v", err),
+		}
+	}
+
+	if err := createTransferTasks(tx,
+		request.TransferTasks,
+		m.shardID,
+		info.DomainID,
+		info.WorkflowID,
+		info.RunID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to create transfer tasks. Error: %v", err),
+		}
+	}
+
+	if err := createTimerTasks(tx,
+		request.TimerTasks,
+		nil,
+		m.shardID,
+		info.DomainID,
+		info.WorkflowID,
+		info.RunID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to create timer tasks. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlExecutionManager) terminateCurrentRunTx(tx *sqlx.Tx, request *p.InternalResetWorkflowExecutionRequest) error {
+	info := request.CurrExecutionInfo
+	if err := lockAndCheckNextEventID(tx, m.shardID, info.DomainID, info.WorkflowID, info.RunID,
+		request.CurrCondition); err != nil {
+		switch err.(type) {
+		case *p.ConditionFailedError:
+			return err
+		default:
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to lock executions row. Error: %v", err),
+			}
+		}
+	}
+
+	if err := updateExecution(tx, info, request.CurrReplicationState, request.CurrCondition, m.shardID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to update executions row. Error: %v", err),
+		}
+	}
+
+	if err := createTransferTasks(tx,
+		request.CurrTransferTasks,
+		m.shardID,
+		info.DomainID,
+		info.WorkflowID,
+		info.RunID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to create transfer tasks. Error: %v", err),
+		}
+	}
+
+	if err := createTimerTasks(tx,
+		request.CurrTimerTasks,
+		nil,
+		m.shardID,
+		info.DomainID,
+		info.WorkflowID,
+		info.RunID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to create timer tasks. Error: %v", err),
+		}
+	}
+	return nil
+}
+
 func (m *sqlExecutionManager) DeleteWorkflowExecution(request *p.DeleteWorkflowExecutionRequest) error {
-	if _, err := m.db.Exec(deleteExecutionSQLQuery, m.shardID, request.DomainID, request.WorkflowID, request.RunID); err != nil {
+	if _, err := m.db.Exec(m.db.Rebind(deleteExecutionSQLQuery), m.shardID, request.DomainID, request.WorkflowID, request.RunID); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("DeleteWorkflowExecution operation failed. Error: %v", err),
 		}
@@ -1289,9 +1553,18 @@ func (m *sqlExecutionManager) DeleteWorkflowExecution(request *p.DeleteWorkflowE
 	return nil
 }
 
+func (m *sqlExecutionManager) DeleteCurrentWorkflowExecution(request *p.DeleteCurrentWorkflowExecutionRequest) error {
+	if _, err := m.db.Exec(m.db.Rebind(deleteCurrentExecutionSQLQuery), m.shardID, request.DomainID, request.WorkflowID, request.RunID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteCurrentWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
 func (m *sqlExecutionManager) GetCurrentExecution(request *p.GetCurrentExecutionRequest) (*p.GetCurrentExecutionResponse, error) {
 	var row currentExecutionRow
-	if err := m.db.Get(&row, getCurrentExecutionSQLQuery, m.shardID, request.DomainID, request.WorkflowID); err != nil {
+	if err := m.db.Get(&row, m.db.Rebind(getCurrentExecutionSQLQuery), m.shardID, request.DomainID, request.WorkflowID); err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("GetCurrentExecution operation failed. Error: %v", err),
 		}
@@ -1304,10 +1577,94 @@ func (m *sqlExecutionManager) GetCurrentExecution(request *p.GetCurrentExecution
 	}, nil
 }
 
+// ListConcreteExecutions lists the concrete executions of the shard ordered by domain, workflow and run
+func (m *sqlExecutionManager) ListConcreteExecutions(
+	request *p.ListConcreteExecutionsRequest,
+) (*p.ListConcreteExecutionsResponse, error) {
+	var last executionPageToken
+	if len(request.PageToken) > 0 {
+		if err := json.Unmarshal(request.PageToken, &last); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ListConcreteExecutions operation failed. Invalid page token. Error: %v", err),
+			}
+		}
+	}
+
+	var rows []executionPageToken
+	if err := m.db.Select(&rows,
+		m.db.Rebind(listConcreteExecutionsSQLQuery),
+		m.shardID,
+		last.DomainID,
+		last.WorkflowID,
+		last.RunID,
+		request.PageSize); err != nil && err != sql.ErrNoRows {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListConcreteExecutions operation failed. Select failed. Error: %v", err),
+		}
+	}
+
+	response := &p.ListConcreteExecutionsResponse{}
+	for _, row := range rows {
+		response.Executions = append(response.Executions, &p.ConcreteExecution{
+			DomainID:   row.DomainID,
+			WorkflowID: row.WorkflowID,
+			RunID:      row.RunID,
+		})
+	}
+	if len(rows) > 0 && len(rows) == request.PageSize {
+		// marshalling a struct of strings can not fail
+		response.PageToken, _ = json.Marshal(&rows[len(rows)-1])
+	}
+	return response, nil
+}
+
+// ListCurrentExecutions lists the current executions of the shard ordered by domain and workflow
+func (m *sqlExecutionManager) ListCurrentExecutions(
+	request *p.ListCurrentExecutionsRequest,
+) (*p.ListCurrentExecutionsResponse, error) {
+	var last executionPageToken
+	if len(request.PageToken) > 0 {
+		if err := json.Unmarshal(request.PageToken, &last); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ListCurrentExecutions operation failed. Invalid page token. Error: %v", err),
+			}
+		}
+	}
+
+	var rows []currentExecutionRow
+	if err := m.db.Select(&rows,
+		m.db.Rebind(listCurrentExecutionsSQLQuery),
+		m.shardID,
+		last.DomainID,
+		last.WorkflowID,
+		request.PageSize); err != nil && err != sql.ErrNoRows {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListCurrentExecutions operation failed. Select failed. Error: %v", err),
+		}
+	}
+
+	response := &p.ListCurrentExecutionsResponse{}
+	for _, row := range rows {
+		response.Executions = append(response.Executions, &p.CurrentExecution{
+			DomainID:    row.DomainID,
+			WorkflowID:  row.WorkflowID,
+			RunID:       row.RunID,
+			State:       row.State,
+			CloseStatus: row.CloseStatus,
+		})
+	}
+	if len(rows) > 0 && len(rows) == request.PageSize {
+		lastRow := rows[len(rows)-1]
+		// marshalling a struct of strings can not fail
+		response.PageToken, _ = json.Marshal(&executionPageToken{DomainID: lastRow.DomainID, WorkflowID: lastRow.WorkflowID})
+	}
+	return response, nil
+}
+
 func (m *sqlExecutionManager) GetTransferTasks(request *p.GetTransferTasksRequest) (*p.GetTransferTasksResponse, error) {
 	var resp p.GetTransferTasksResponse
 	if err := m.db.Select(&resp.Tasks,
-		getTransferTasksSQLQuery,
+		m.db.Rebind(getTransferTasksSQLQuery),
 		m.shardID,
 		request.ReadLevel,
 		request.MaxReadLevel); err != nil {
@@ -1333,7 +1690,7 @@ func (m *sqlExecutionManager) CompleteTransferTask(request *p.CompleteTransferTa
 }
 
 func (m *sqlExecutionManager) RangeCompleteTransferTask(request *p.RangeCompleteTransferTaskRequest) error {
-	if _, err := m.db.Exec(rangeCompleteTransferTaskSQLQuery, m.shardID, request.ExclusiveBeginTaskID, request.InclusiveEndTaskID); err != nil {
+	if _, err := m.db.Exec(m.db.Rebind(rangeCompleteTransferTaskSQLQuery), m.shardID, request.ExclusiveBeginTaskID, request.InclusiveEndTaskID); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("RangeCompleteTransferTask operation failed. Error: %v", err),
 		}
@@ -1357,7 +1714,7 @@ func (m *sqlExecutionManager) GetReplicationTasks(request *p.GetReplicationTasks
 	maxReadLevelInclusive = collection.MaxInt64(
 		readLevel+int64(request.BatchSize), request.MaxReadLevel)
 	if err := m.db.Select(&rows,
-		getReplicationTasksSQLQuery,
+		m.db.Rebind(getReplicationTasksSQLQuery),
 		m.shardID,
 		readLevel,
 		maxReadLevelInclusive,
@@ -1407,7 +1764,7 @@ func (m *sqlExecutionManager) GetReplicationTasks(request *p.GetReplicationTasks
 }
 
 func (m *sqlExecutionManager) CompleteReplicationTask(request *p.CompleteReplicationTaskRequest) error {
-	if _, err := m.db.Exec(completeReplicationTaskSQLQuery, m.shardID, request.TaskID); err != nil {
+	if _, err := m.db.Exec(m.db.Rebind(completeReplicationTaskSQLQuery), m.shardID, request.TaskID); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("CompleteReplicationTask operation failed. Error: %v", err),
 		}
@@ -1415,6 +1772,88 @@ func (m *sqlExecutionManager) CompleteReplicationTask(request *p.CompleteReplica
 	return nil
 }
 
+func (m *sqlExecutionManager) PutReplicationTaskToDLQ(request *p.PutReplicationTaskToDLQRequest) error {
+	task := request.TaskInfo
+	if _, err := m.db.NamedExec(insertReplicationTaskDLQSQLQuery, &replicationTasksDLQRow{
+		SourceClusterName: request.SourceClusterName,
+		ShardID:           m.shardID,
+		TaskID:            task.TaskID,
+		DomainID:          task.DomainID,
+		WorkflowID:        task.WorkflowID,
+		RunID:             task.RunID,
+		Data:              task.Task.Data,
+		DataEncoding:      string(task.Task.Encoding),
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("PutReplicationTaskToDLQ operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlExecutionManager) GetReplicationTasksFromDLQ(
+	request *p.GetReplicationTasksFromDLQRequest,
+) (*p.GetReplicationTasksFromDLQResponse, error) {
+	readLevel := int64(math.MinInt64)
+	if len(request.NextPageToken) > 0 {
+		var err error
+		if readLevel, err = deserializePageToken(request.NextPageToken); err != nil {
+			return nil, err
+		}
+	}
+
+	var rows []replicationTasksDLQRow
+	if err := m.db.Select(&rows,
+		m.db.Rebind(getReplicationTasksDLQSQLQuery),
+		request.SourceClusterName,
+		m.shardID,
+		readLevel,
+		request.BatchSize); err != nil && err != sql.ErrNoRows {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetReplicationTasksFromDLQ operation failed. Select failed: %v", err),
+		}
+	}
+
+	tasks := make([]*p.ReplicationDLQTaskInfo, len(rows))
+	for i, row := range rows {
+		tasks[i] = &p.ReplicationDLQTaskInfo{
+			TaskID:     row.TaskID,
+			DomainID:   row.DomainID,
+			WorkflowID: row.WorkflowID,
+			RunID:      row.RunID,
+			Task:       p.NewDataBlob(row.Data, common.EncodingType(row.DataEncoding)),
+		}
+	}
+	var nextPageToken []byte
+	if len(rows) > 0 && len(rows) == request.BatchSize {
+		nextPageToken = serializePageToken(rows[len(rows)-1].TaskID)
+	}
+	return &p.GetReplicationTasksFromDLQResponse{
+		Tasks:         tasks,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (m *sqlExecutionManager) DeleteReplicationTaskFromDLQ(request *p.DeleteReplicationTaskFromDLQRequest) error {
+	if _, err := m.db.Exec(m.db.Rebind(deleteReplicationTaskDLQSQLQuery),
+		request.SourceClusterName, m.shardID, request.TaskID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlExecutionManager) GetReplicationDLQSize(request *p.GetReplicationDLQSizeRequest) (*p.GetReplicationDLQSizeResponse, error) {
+	var size int64
+	if err := m.db.Get(&size, m.db.Rebind(getReplicationTasksDLQSizeSQLQuery), request.SourceClusterName, m.shardID); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetReplicationDLQSize operation failed. Error: %v", err),
+		}
+	}
+	return &p.GetReplicationDLQSizeResponse{Size: size}, nil
+}
+
 type timerTaskPageToken struct {
 	TaskID    int64
 	Timestamp time.Time
@@ -1440,7 +1879,7 @@ func (m *sqlExecutionManager) GetTimerIndexTasks(request *p.GetTimerIndexTasksRe
 
 	var resp p.GetTimerIndexTasksResponse
 
-	if err := m.db.Select(&resp.Timers, getTimerTasksSQLQuery,
+	if err := m.db.Select(&resp.Timers, m.db.Rebind(getTimerTasksSQLQuery),
 		m.shardID,
 		pageToken.Timestamp,
 		pageToken.TaskID,
@@ -1471,7 +1910,7 @@ func (m *sqlExecutionManager) GetTimerIndexTasks(request *p.GetTimerIndexTasksRe
 }
 
 func (m *sqlExecutionManager) CompleteTimerTask(request *p.CompleteTimerTaskRequest) error {
-	if _, err := m.db.Exec(completeTimerTaskSQLQuery, m.shardID, request.VisibilityTimestamp, request.TaskID); err != nil {
+	if _, err := m.db.Exec(m.db.Rebind(completeTimerTaskSQLQuery), m.shardID, request.VisibilityTimestamp, request.TaskID); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("CompleteTimerTask operation failed. Error: %v", err),
 		}
@@ -1482,7 +1921,7 @@ func (m *sqlExecutionManager) CompleteTimerTask(request *p.CompleteTimerTaskRequ
 func (m *sqlExecutionManager) RangeCompleteTimerTask(request *p.RangeCompleteTimerTaskRequest) error {
 	start := request.InclusiveBeginTimestamp
 	end := request.ExclusiveEndTimestamp
-	if _, err := m.db.Exec(rangeCompleteTimerTaskSQLQuery, m.shardID, start, end); err != nil {
+	if _, err := m.db.Exec(m.db.Rebind(rangeCompleteTimerTaskSQLQuery), m.shardID, start, end); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("CompleteTimerTask operation failed. Error: %v", err),
 		}
@@ -1505,7 +1944,7 @@ func NewSQLExecutionStore(db *sqlx.DB, logger bark.Logger, shardID int) (p.Execu
 // locking it in the DB
 func lockCurrentExecutionIfExists(tx *sqlx.Tx, shardID int64, domainID string, workflowID string) (*currentExecutionRow, error) {
 	var rows []*currentExecutionRow
-	if err := tx.Select(&rows, getCurrentExecutionSQLQueryForUpdate, shardID, domainID, workflowID); err != nil {
+	if err := tx.Select(&rows, tx.Rebind(getCurrentExecutionSQLQueryForUpdate), shardID, domainID, workflowID); err != nil {
 		if err != sql.ErrNoRows {
 			return nil, &workflow.InternalServiceError{
 				Message: fmt.Sprintf("Failed to get current_executions row for (shard,domain,workflow) = (%v, %v, %v). Error: %v", shardID, domainID, workflowID, err),
@@ -1548,6 +1987,8 @@ func createExecution(tx *sqlx.Tx, request *p.CreateWorkflowExecutionRequest, sha
 		DecisionTimeout:              int64(request.DecisionStartToCloseTimeout),
 		DecisionAttempt:              0,
 		DecisionTimestamp:            0,
+		DecisionScheduledTimestamp:   request.DecisionScheduledTimestamp,
+		DecisionStartedTimestamp:     0,
 		StickyTaskList:               "",
 		StickyScheduleToStartTimeout: 0,
 		ClientLibraryVersion:         "",
@@ -1572,6 +2013,26 @@ func createExecution(tx *sqlx.Tx, request *p.CreateWorkflowExecutionRequest, sha
 		args.LastReplicationInfo = &lastReplicationInfo
 	}
 
+	if len(request.Memo) > 0 {
+		memo, err := gobSerialize(&request.Memo)
+		if err != nil {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("CreateWorkflowExecution operation failed. Failed to serialize Memo. Error: %v", err),
+			}
+		}
+		args.Memo = &memo
+	}
+
+	if len(request.SearchAttributes) > 0 {
+		searchAttributes, err := gobSerialize(&request.SearchAttributes)
+		if err != nil {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("CreateWorkflowExecution operation failed. Failed to serialize SearchAttributes. Error: %v", err),
+			}
+		}
+		args.SearchAttributes = &searchAttributes
+	}
+
 	if request.ParentExecution != nil {
 		args.InitiatedID = &request.InitiatedID
 		args.ParentDomainID = &request.ParentDomainID
@@ -1669,7 +2130,7 @@ func lockAndCheckNextEventID(tx *sqlx.Tx, shardID int, domainID, workflowID, run
 
 func lockNextEventID(tx *sqlx.Tx, shardID int, domainID, workflowID, runID string) (*int64, error) {
 	var nextEventID int64
-	if err := tx.Get(&nextEventID, lockAndCheckNextEventIDSQLQuery, shardID, domainID, workflowID, runID); err != nil {
+	if err := tx.Get(&nextEventID, tx.Rebind(lockAndCheckNextEventIDSQLQuery), shardID, domainID, workflowID, runID); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, &workflow.EntityNotExistsError{
 				Message: fmt.Sprintf("Failed to lock executions row with (shard, domain, workflow, run) = (%v,%v,%v,%v) which does not exist.", shardID, domainID, workflowID, runID),
@@ -1733,7 +2194,8 @@ func createTransferTasks(tx *sqlx.Tx, transferTasks []p.Task, shardID int, domai
 			transferTasksRows[i].TargetWorkflowID = task.(*p.StartChildExecutionTask).TargetWorkflowID
 			transferTasksRows[i].ScheduleID = task.(*p.StartChildExecutionTask).InitiatedID
 
-		case p.TransferTaskTypeCloseExecution:
+		case p.TransferTaskTypeCloseExecution, p.TransferTaskTypeUpsertWorkflowSearchAttributes,
+			p.TransferTaskTypeRecordWorkflowStarted:
 			// No explicit property needs to be set
 
 		default:
@@ -1927,7 +2389,7 @@ func createTimerTasks(tx *sqlx.Tx, timerTasks []p.Task, deleteTimerTask p.Task,
 
 	if deleteTimerTask != nil {
 		ts := deleteTimerTask.GetVisibilityTimestamp()
-		if _, err := tx.Exec(completeTimerTaskSQLQuery, shardID, ts, deleteTimerTask.GetTaskID()); err != nil {
+		if _, err := tx.Exec(tx.Rebind(completeTimerTaskSQLQuery), shardID, ts, deleteTimerTask.GetTaskID()); err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("Failed to delete timer task. Task: %v. Error: %v", deleteTimerTask, err),
 			}
@@ -1941,7 +2403,7 @@ func continueAsNew(tx *sqlx.Tx, shardID int, domainID, workflowID, runID, previo
 	createRequestID string, state int, closeStatus int, startVersion int64, lastWriteVersion int64) error {
 
 	var currentRunID string
-	if err := tx.Get(&currentRunID, continueAsNewLockRunIDSQLQuery, int64(shardID), domainID, workflowID); err != nil {
+	if err := tx.Get(&currentRunID, tx.Rebind(continueAsNewLockRunIDSQLQuery), int64(shardID), domainID, workflowID); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("ContinueAsNew failed. Failed to check current run ID. Error: %v", err),
 		}
@@ -2020,6 +2482,8 @@ func updateExecution(tx *sqlx.Tx,
 			DecisionTimeout:              int64(executionInfo.DecisionTimeout),
 			DecisionAttempt:              executionInfo.DecisionAttempt,
 			DecisionTimestamp:            executionInfo.DecisionTimestamp,
+			DecisionScheduledTimestamp:   executionInfo.DecisionScheduledTimestamp,
+			DecisionStartedTimestamp:     executionInfo.DecisionStartedTimestamp,
 			StickyTaskList:               executionInfo.StickyTaskList,
 			StickyScheduleToStartTimeout: int64(executionInfo.StickyScheduleToStartTimeout),
 			ClientLibraryVersion:         executionInfo.ClientLibraryVersion,
@@ -2038,6 +2502,26 @@ func updateExecution(tx *sqlx.Tx,
 		args.executionRow.ExecutionContext = &executionInfo.ExecutionContext
 	}
 
+	if len(executionInfo.Memo) > 0 {
+		memo, err := gobSerialize(&executionInfo.Memo)
+		if err != nil {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Failed to serialize Memo. Error: %v", err),
+			}
+		}
+		args.executionRow.Memo = &memo
+	}
+
+	if len(executionInfo.SearchAttributes) > 0 {
+		searchAttributes, err := gobSerialize(&executionInfo.SearchAttributes)
+		if err != nil {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Failed to serialize SearchAttributes. Error: %v", err),
+			}
+		}
+		args.executionRow.SearchAttributes = &searchAttributes
+	}
+
 	completionEvent := executionInfo.CompletionEvent
 	if completionEvent != nil {
 		args.executionRow.CompletionEvent = &completionEvent.Data
@@ -30,11 +30,14 @@ import (
 	"github.com/uber/cadence/common/service/config"
 )
 
-const defaultDriverName = "mysql"
+const defaultDriverName = mysqlDriverName
 
 func newConnection(cfg config.SQL) (*sqlx.DB, error) {
-	var db, err = sqlx.Connect(cfg.DriverName,
-		fmt.Sprintf(dataSourceName, cfg.User, cfg.Password, cfg.ConnectProtocol, cfg.ConnectAddr, cfg.DatabaseName))
+	d, err := getDialect(cfg.DriverName)
+	if err != nil {
+		return nil, err
+	}
+	db, err := sqlx.Connect(cfg.DriverName, d.dataSourceName(cfg, cfg.DatabaseName))
 	if err != nil {
 		return nil, err
 	}
@@ -43,34 +46,56 @@ func newConnection(cfg config.SQL) (*sqlx.DB, error) {
 	return db, nil
 }
 
-func createDatabase(driver string, addr string, username, password, dbName string, overwrite bool) error {
-	var db, err = sqlx.Connect(driver, fmt.Sprintf(dataSourceName, username, password, "tcp", addr, ""))
+// newMaintenanceConnection connects to the database used for creating and dropping the database of cfg
+func newMaintenanceConnection(cfg config.SQL) (*sqlx.DB, dialect, error) {
+	d, err := getDialect(cfg.DriverName)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, err := sqlx.Connect(cfg.DriverName, d.dataSourceName(cfg, d.maintenanceDatabaseName()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failure connecting to %v database: %v", cfg.DriverName, err)
+	}
+	return db, d, nil
+}
+
+func createDatabase(cfg config.SQL, overwrite bool) error {
+	db, d, err := newMaintenanceConnection(cfg)
 	if err != nil {
-		return fmt.Errorf("failure connecting to mysql database: %v", err)
+		return err
 	}
+	defer db.Close()
 
 	if overwrite {
-		dropDatabase(db, dbName)
+		dropDatabaseWith(db, d, cfg.DatabaseName)
 	}
-	_, err = db.Exec(`CREATE DATABASE ` + dbName)
+	_, err = db.Exec(`CREATE DATABASE ` + cfg.DatabaseName)
 	if err != nil {
-		return fmt.Errorf("failure creating database %v: %v", dbName, err)
+		return fmt.Errorf("failure creating database %v: %v", cfg.DatabaseName, err)
 	}
-	log.WithField(`database-name`, dbName).Debug(`created database`)
+	log.WithField(`database-name`, cfg.DatabaseName).Debug(`created database`)
 	return nil
 }
 
-// DropCassandraKeyspace drops the given keyspace, if it exists
-func dropDatabase(db *sqlx.DB, dbName string) (err error) {
-	_, err = db.Exec("DROP DATABASE " + dbName)
+func dropDatabase(cfg config.SQL) error {
+	db, d, err := newMaintenanceConnection(cfg)
 	if err != nil {
 		return err
 	}
+	defer db.Close()
+	return dropDatabaseWith(db, d, cfg.DatabaseName)
+}
+
+func dropDatabaseWith(db *sqlx.DB, d dialect, dbName string) (err error) {
+	for _, query := range d.dropDatabaseQueries(dbName) {
+		if _, err = db.Exec(query); err != nil {
+			return err
+		}
+	}
 	log.WithField(`database-name`, dbName).Info(`dropped database`)
 	return nil
 }
 
-// LoadCassandraSchema loads the schema from the given .sql files on this database
 func loadDatabaseSchema(dir string, fileNames []string, db *sqlx.DB, override bool) (err error) {
 
 	for _, file := range fileNames {
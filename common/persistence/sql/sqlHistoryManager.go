@@ -25,7 +25,6 @@ import (
 
 	"database/sql"
 
-	"github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
 	"github.com/uber-common/bark"
 	workflow "github.com/uber/cadence/.gen/go/shared"
@@ -113,7 +112,7 @@ func (m *sqlHistoryManager) AppendHistoryEvents(request *p.InternalAppendHistory
 		return m.overWriteHistoryEvents(request, arg)
 	}
 	if _, err := m.db.NamedExec(appendHistorySQLQuery, arg); err != nil {
-		if sqlErr, ok := err.(*mysql.MySQLError); ok && sqlErr.Number == ErrDupEntry {
+		if isDupEntry(err) {
 			return &p.ConditionFailedError{Msg: fmt.Sprintf("AppendHistoryEvents: event already exist: %v", err)}
 		}
 		return &workflow.InternalServiceError{Message: fmt.Sprintf("AppendHistoryEvents: %v", err)}
@@ -136,7 +135,7 @@ func (m *sqlHistoryManager) GetWorkflowExecutionHistory(request *p.InternalGetWo
 	}
 
 	var rows []eventsRow
-	err := m.db.Select(&rows, getWorkflowExecutionHistorySQLQuery,
+	err := m.db.Select(&rows, m.db.Rebind(getWorkflowExecutionHistorySQLQuery),
 		request.DomainID,
 		request.Execution.WorkflowId,
 		request.Execution.RunId,
@@ -185,7 +184,7 @@ func (m *sqlHistoryManager) GetWorkflowExecutionHistory(request *p.InternalGetWo
 }
 
 func (m *sqlHistoryManager) DeleteWorkflowExecutionHistory(request *p.DeleteWorkflowExecutionHistoryRequest) error {
-	if _, err := m.db.Exec(deleteWorkflowExecutionHistorySQLQuery, request.DomainID, request.Execution.WorkflowId, request.Execution.RunId); err != nil {
+	if _, err := m.db.Exec(m.db.Rebind(deleteWorkflowExecutionHistorySQLQuery), request.DomainID, request.Execution.WorkflowId, request.Execution.RunId); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("DeleteWorkflowExecutionHistory: %v", err),
 		}
@@ -215,7 +214,7 @@ func (m *sqlHistoryManager) overWriteHistoryEvents(request *p.InternalAppendHist
 
 func lockEventForUpdate(tx *sqlx.Tx, req *p.InternalAppendHistoryEventsRequest) error {
 	var row eventsRow
-	err := tx.Get(&row, lockEventSQLQuery, req.DomainID, *req.Execution.WorkflowId, *req.Execution.RunId, req.FirstEventID)
+	err := tx.Get(&row, tx.Rebind(lockEventSQLQuery), req.DomainID, *req.Execution.WorkflowId, *req.Execution.RunId, req.FirstEventID)
 	if err != nil {
 		return err
 	}
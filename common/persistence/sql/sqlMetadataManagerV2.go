@@ -24,7 +24,6 @@ import (
 	"database/sql"
 	"fmt"
 
-	"github.com/go-sql-driver/mysql"
 	"github.com/uber-common/bark"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
@@ -214,7 +213,7 @@ func updateMetadata(tx *sqlx.Tx, oldNotificationVersion int64) error {
 
 func lockMetadata(tx *sqlx.Tx) error {
 	var notificationVersion int
-	err := tx.Get(&notificationVersion, lockMetadataSQLQuery)
+	err := tx.Get(&notificationVersion, tx.Rebind(lockMetadataSQLQuery))
 	if err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("Failed to lock domain metadata. Error: %v", err),
@@ -269,7 +268,7 @@ func (m *sqlMetadataManagerV2) CreateDomain(request *persistence.CreateDomainReq
 			FailoverNotificationVersion: persistence.InitialFailoverNotificationVersion,
 			IsGlobalDomain:              request.IsGlobalDomain,
 		}); err1 != nil {
-			if sqlErr, ok := err1.(*mysql.MySQLError); ok && sqlErr.Number == ErrDupEntry {
+			if isDupEntry(err1) {
 				return &workflow.DomainAlreadyExistsError{
 					Message: fmt.Sprintf("name: %v", request.Info.Name),
 				}
@@ -450,7 +449,7 @@ func (m *sqlMetadataManagerV2) DeleteDomainByName(request *persistence.DeleteDom
 
 func (m *sqlMetadataManagerV2) GetMetadata() (*persistence.GetMetadataResponse, error) {
 	var notificationVersion int64
-	row := m.db.QueryRow(getMetadataSQLQuery)
+	row := m.db.QueryRow(m.db.Rebind(getMetadataSQLQuery))
 	if err := row.Scan(&notificationVersion); err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("GetMetadata operation failed. Error: %v", err),
@@ -460,7 +459,7 @@ func (m *sqlMetadataManagerV2) GetMetadata() (*persistence.GetMetadataResponse,
 }
 
 func (m *sqlMetadataManagerV2) ListDomains(request *persistence.ListDomainsRequest) (*persistence.ListDomainsResponse, error) {
-	rows, err := m.db.Queryx(listDomainsSQLQuery)
+	rows, err := m.db.Queryx(m.db.Rebind(listDomainsSQLQuery))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return &persistence.ListDomainsResponse{}, nil
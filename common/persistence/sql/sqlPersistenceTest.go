@@ -34,10 +34,19 @@ import (
 
 const (
 	testWorkflowClusterHosts = "127.0.0.1"
-	testPort                 = 3306
 	testUser                 = "uber"
 	testPassword             = "uber"
-	testSchemaDir            = "schema/mysql/v56"
+)
+
+var (
+	testPorts = map[string]int{
+		mysqlDriverName:    3306,
+		postgresDriverName: 5432,
+	}
+	testSchemaDirs = map[string]string{
+		mysqlDriverName:    "schema/mysql/v56",
+		postgresDriverName: "schema/postgres",
+	}
 )
 
 // TestCluster allows executing cassandra operations in testing.
@@ -50,14 +59,14 @@ type TestCluster struct {
 
 // NewTestCluster returns a new SQL test cluster
 func NewTestCluster(port int, dbName string, schemaDir string, driverName string) *TestCluster {
+	if driverName == "" {
+		driverName = defaultDriverName
+	}
 	if schemaDir == "" {
-		schemaDir = testSchemaDir
+		schemaDir = testSchemaDirs[driverName]
 	}
 	if port == 0 {
-		port = testPort
-	}
-	if driverName == "" {
-		driverName = defaultDriverName
+		port = testPorts[driverName]
 	}
 	var result TestCluster
 	result.dbName = dbName
@@ -109,8 +118,8 @@ func (s *TestCluster) Config() config.Persistence {
 
 // TearDownTestDatabase from PersistenceTestCluster interface
 func (s *TestCluster) TearDownTestDatabase() {
-	s.DropDatabase()
 	s.db.Close()
+	s.DropDatabase()
 }
 
 // CreateSession from PersistenceTestCluster interface
@@ -124,7 +133,7 @@ func (s *TestCluster) CreateSession() {
 
 // CreateDatabase from PersistenceTestCluster interface
 func (s *TestCluster) CreateDatabase() {
-	err := createDatabase(s.cfg.DriverName, s.cfg.ConnectAddr, testUser, testPassword, s.dbName, true)
+	err := createDatabase(s.cfg, true)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -132,7 +141,7 @@ func (s *TestCluster) CreateDatabase() {
 
 // DropDatabase from PersistenceTestCluster interface
 func (s *TestCluster) DropDatabase() {
-	err := dropDatabase(s.db, s.dbName)
+	err := dropDatabase(s.cfg)
 	if err != nil {
 		log.Fatal(err)
 	}
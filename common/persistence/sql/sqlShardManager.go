@@ -50,6 +50,7 @@ type (
 		TimerAckLevel             time.Time
 		ClusterTransferAckLevel   []byte
 		ClusterTimerAckLevel      []byte
+		ClusterReplicationLevel   []byte
 		DomainNotificationVersion int64
 	}
 )
@@ -66,6 +67,7 @@ transfer_ack_level,
 timer_ack_level,
 cluster_transfer_ack_level,
 cluster_timer_ack_level,
+cluster_replication_level,
 domain_notification_version)
 VALUES
 (:shard_id, 
@@ -78,6 +80,7 @@ VALUES
 :timer_ack_level,
 :cluster_transfer_ack_level,
 :cluster_timer_ack_level,
+:cluster_replication_level,
 :domain_notification_version)`
 
 	getShardSQLQuery = `SELECT
@@ -91,6 +94,7 @@ transfer_ack_level,
 timer_ack_level,
 cluster_transfer_ack_level,
 cluster_timer_ack_level,
+cluster_replication_level,
 domain_notification_version
 FROM shards WHERE
 shard_id = ?
@@ -109,15 +113,19 @@ transfer_ack_level = :transfer_ack_level,
 timer_ack_level = :timer_ack_level,
 cluster_transfer_ack_level = :cluster_transfer_ack_level,
 cluster_timer_ack_level = :cluster_timer_ack_level,
+cluster_replication_level = :cluster_replication_level,
 domain_notification_version = :domain_notification_version
 WHERE
 shard_id = :shard_id
 `
 
-	lockShardSQLQuery     = `SELECT range_id FROM shards WHERE shard_id = ? FOR UPDATE`
-	readLockShardSQLQuery = `SELECT range_id FROM shards WHERE shard_id = ? LOCK IN SHARE MODE`
+	lockShardSQLQuery = `SELECT range_id FROM shards WHERE shard_id = ? FOR UPDATE`
 )
 
+var readLockShardSQLQuery = newDialectSQLQuery(func(d dialect) string {
+	return `SELECT range_id FROM shards WHERE shard_id = ? ` + d.readLockClause()
+})
+
 // newShardPersistence creates an instance of ShardManager
 func newShardPersistence(cfg config.SQL, currentClusterName string, log bark.Logger) (persistence.ShardManager, error) {
 	var db, err = newConnection(cfg)
@@ -161,7 +169,7 @@ func (m *sqlShardManager) CreateShard(request *persistence.CreateShardRequest) e
 
 func (m *sqlShardManager) GetShard(request *persistence.GetShardRequest) (*persistence.GetShardResponse, error) {
 	var row shardsRow
-	if err := m.db.Get(&row, getShardSQLQuery, request.ShardID); err != nil {
+	if err := m.db.Get(&row, m.db.Rebind(getShardSQLQuery), request.ShardID); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, &workflow.EntityNotExistsError{
 				Message: fmt.Sprintf("GetShard operation failed. Shard with ID %v not found. Error: %v", request.ShardID, err),
@@ -196,6 +204,15 @@ func (m *sqlShardManager) GetShard(request *persistence.GetShardRequest) (*persi
 		}
 	}
 
+	clusterReplicationLevel := make(map[string]int64)
+	if len(row.ClusterReplicationLevel) > 0 {
+		if err := gobDeserialize(row.ClusterReplicationLevel, &clusterReplicationLevel); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetShard operation failed. Failed to deserialize ShardInfo.ClusterReplicationLevel. ShardId: %v. Error: %v", request.ShardID, err),
+			}
+		}
+	}
+
 	resp := &persistence.GetShardResponse{ShardInfo: &persistence.ShardInfo{
 		ShardID:                   int(row.ShardID),
 		Owner:                     row.Owner,
@@ -207,6 +224,7 @@ func (m *sqlShardManager) GetShard(request *persistence.GetShardRequest) (*persi
 		TimerAckLevel:             row.TimerAckLevel,
 		ClusterTransferAckLevel:   clusterTransferAckLevel,
 		ClusterTimerAckLevel:      clusterTimerAckLevel,
+		ClusterReplicationLevel:   clusterReplicationLevel,
 		DomainNotificationVersion: row.DomainNotificationVersion,
 	}}
 
@@ -243,7 +261,7 @@ func (m *sqlShardManager) UpdateShard(request *persistence.UpdateShardRequest) e
 func lockShard(tx *sqlx.Tx, shardID int, oldRangeID int64) error {
 	var rangeID int64
 
-	err := tx.Get(&rangeID, lockShardSQLQuery, shardID)
+	err := tx.Get(&rangeID, tx.Rebind(lockShardSQLQuery), shardID)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -270,7 +288,7 @@ func lockShard(tx *sqlx.Tx, shardID int, oldRangeID int64) error {
 func readLockShard(tx *sqlx.Tx, shardID int, oldRangeID int64) error {
 	var rangeID int64
 
-	err := tx.Get(&rangeID, readLockShardSQLQuery, shardID)
+	err := tx.Get(&rangeID, tx.Rebind(readLockShardSQLQuery.on(tx)), shardID)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -307,6 +325,13 @@ func shardInfoToShardsRow(s persistence.ShardInfo) (*shardsRow, error) {
 		}
 	}
 
+	clusterReplicationLevel, err := gobSerialize(s.ClusterReplicationLevel)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CreateShard operation failed. Failed to serialize ShardInfo.ClusterReplicationLevel. Error: %v", err),
+		}
+	}
+
 	return &shardsRow{
 		ShardID:                   int64(s.ShardID),
 		Owner:                     s.Owner,
@@ -318,6 +343,7 @@ func shardInfoToShardsRow(s persistence.ShardInfo) (*shardsRow, error) {
 		TimerAckLevel:             s.TimerAckLevel,
 		ClusterTransferAckLevel:   clusterTransferAckLevel,
 		ClusterTimerAckLevel:      clusterTimerAckLevel,
+		ClusterReplicationLevel:   clusterReplicationLevel,
 		DomainNotificationVersion: s.DomainNotificationVersion,
 	}, nil
 }
@@ -21,6 +21,7 @@
 package sql
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/uber-common/bark"
 
@@ -47,33 +48,39 @@ type (
 		TaskListName string
 		TaskListType int64
 		ExpiryTs     time.Time
+		CreatedTime  time.Time
 	}
 
 	tasksListsRow struct {
-		DomainID string
-		RangeID  int64
-		Name     string
-		TaskType int64
-		AckLevel int64
-		Kind     int64
-		ExpiryTs time.Time
+		DomainID    string
+		RangeID     int64
+		Name        string
+		TaskType    int64
+		AckLevel    int64
+		Kind        int64
+		ExpiryTs    time.Time
+		LastUpdated time.Time
 	}
 
 	updateTaskListsRow struct {
 		tasksListsRow
 		OldRangeID int64
 	}
+
+	taskListPageToken struct {
+		DomainID string `json:",omitempty"`
+		Name     string
+		TaskType int64
+	}
 )
 
 const (
-	taskListCreatePart = `INTO task_lists(domain_id, range_id, name, task_type, ack_level, kind, expiry_ts) ` +
-		`VALUES (:domain_id, :range_id, :name, :task_type, :ack_level, :kind, :expiry_ts)`
+	taskListCreatePart = `INTO task_lists(domain_id, range_id, name, task_type, ack_level, kind, expiry_ts, last_updated) ` +
+		`VALUES (:domain_id, :range_id, :name, :task_type, :ack_level, :kind, :expiry_ts, :last_updated)`
 
 	// (default range ID: initialRangeID == 1)
 	createTaskListSQLQuery = `INSERT ` + taskListCreatePart
 
-	updateTaskListWithTTLSQLQuery = `REPLACE ` + taskListCreatePart
-
 	updateTaskListSQLQuery = `UPDATE task_lists SET
 domain_id = :domain_id,
 range_id = :range_id,
@@ -81,32 +88,59 @@ name = :name,
 task_type = :task_type,
 ack_level = :ack_level,
 kind = :kind,
-expiry_ts = :expiry_ts
+expiry_ts = :expiry_ts,
+last_updated = :last_updated
 WHERE
 domain_id = :domain_id AND
 name = :name AND
 task_type = :task_type
 `
 
-	getTaskListSQLQuery = `SELECT domain_id, range_id, name, task_type, ack_level, kind, expiry_ts ` +
+	getTaskListSQLQuery = `SELECT domain_id, range_id, name, task_type, ack_level, kind, expiry_ts, last_updated ` +
 		`FROM task_lists ` +
 		`WHERE domain_id = ? AND name = ? AND task_type = ?`
 
+	listTaskListSQLQuery = `SELECT domain_id, range_id, name, task_type, ack_level, kind, expiry_ts, last_updated ` +
+		`FROM task_lists ` +
+		`WHERE domain_id = ? AND (name > ? OR (name = ? AND task_type > ?)) ` +
+		`ORDER BY name, task_type LIMIT ?`
+
+	listAllTaskListSQLQuery = `SELECT domain_id, range_id, name, task_type, ack_level, kind, expiry_ts, last_updated ` +
+		`FROM task_lists ` +
+		`WHERE domain_id > ? OR (domain_id = ? AND (name > ? OR (name = ? AND task_type > ?))) ` +
+		`ORDER BY domain_id, name, task_type LIMIT ?`
+
+	deleteTaskListSQLQuery = `DELETE FROM task_lists ` +
+		`WHERE domain_id = ? AND name = ? AND task_type = ? AND range_id = ?`
+
 	lockTaskListSQLQuery = `SELECT range_id FROM task_lists ` +
 		`WHERE domain_id = ? AND name = ? AND task_type = ? FOR UPDATE`
 
-	getTaskSQLQuery = `SELECT workflow_id, run_id, schedule_id, task_id ` +
+	getTaskSQLQuery = `SELECT workflow_id, run_id, schedule_id, task_id, expiry_ts, created_time ` +
 		`FROM tasks ` +
 		`WHERE domain_id = ? AND task_list_name = ? AND task_list_type = ? AND task_id > ? AND task_id <= ?`
 
 	createTaskSQLQuery = `INSERT INTO ` +
-		`tasks(domain_id, workflow_id, run_id, schedule_id, task_list_name, task_list_type, task_id, expiry_ts) ` +
-		`VALUES(:domain_id, :workflow_id, :run_id, :schedule_id, :task_list_name, :task_list_type, :task_id, :expiry_ts)`
+		`tasks(domain_id, workflow_id, run_id, schedule_id, task_list_name, task_list_type, task_id, expiry_ts, created_time) ` +
+		`VALUES(:domain_id, :workflow_id, :run_id, :schedule_id, :task_list_name, :task_list_type, :task_id, :expiry_ts, :created_time)`
 
 	deleteTaskSQLQuery = `DELETE FROM tasks ` +
 		`WHERE domain_id = ? AND task_list_name = ? AND task_list_type = ? AND task_id = ?`
+
+	deleteTaskListTasksSQLQuery = `DELETE FROM tasks ` +
+		`WHERE domain_id = ? AND task_list_name = ? AND task_list_type = ?`
 )
 
+var updateTaskListWithTTLSQLQuery = newDialectSQLQuery(func(d dialect) string {
+	return d.upsertQuery("task_lists",
+		[]string{"domain_id", "name", "task_type"},
+		[]string{"range_id", "ack_level", "kind", "expiry_ts", "last_updated"})
+})
+
+var deleteTasksLessThanSQLQuery = newDialectSQLQuery(func(d dialect) string {
+	return d.limitedDeleteQuery("tasks", "domain_id = ? AND task_list_name = ? AND task_list_type = ? AND task_id < ?")
+})
+
 // newTaskPersistence creates a new instance of TaskManager
 func newTaskPersistence(cfg config.SQL, log bark.Logger) (persistence.TaskManager, error) {
 	var db, err = newConnection(cfg)
@@ -125,15 +159,16 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 	var row tasksListsRow
 	var rangeID int64
 	var ackLevel int64
-	if err := m.db.Get(&row, getTaskListSQLQuery, request.DomainID, request.TaskList, request.TaskType); err != nil {
+	if err := m.db.Get(&row, m.db.Rebind(getTaskListSQLQuery), request.DomainID, request.TaskList, request.TaskType); err != nil {
 		if err == sql.ErrNoRows {
 			row = tasksListsRow{
-				DomainID: request.DomainID,
-				Name:     request.TaskList,
-				TaskType: int64(request.TaskType),
-				AckLevel: ackLevel,
-				Kind:     int64(request.TaskListKind),
-				ExpiryTs: time.Time{},
+				DomainID:    request.DomainID,
+				Name:        request.TaskList,
+				TaskType:    int64(request.TaskType),
+				AckLevel:    ackLevel,
+				Kind:        int64(request.TaskListKind),
+				ExpiryTs:    time.Time{},
+				LastUpdated: time.Now(),
 			}
 			if _, err := m.db.NamedExec(createTaskListSQLQuery, &row); err != nil {
 				return nil, &workflow.InternalServiceError{
@@ -160,13 +195,14 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 		result, err1 := tx.NamedExec(updateTaskListSQLQuery,
 			&updateTaskListsRow{
 				tasksListsRow{
-					DomainID: row.DomainID,
-					RangeID:  row.RangeID + 1,
-					Name:     row.Name,
-					TaskType: row.TaskType,
-					AckLevel: row.AckLevel,
-					Kind:     row.Kind,
-					ExpiryTs: row.ExpiryTs,
+					DomainID:    row.DomainID,
+					RangeID:     row.RangeID + 1,
+					Name:        row.Name,
+					TaskType:    row.TaskType,
+					AckLevel:    row.AckLevel,
+					Kind:        row.Kind,
+					ExpiryTs:    row.ExpiryTs,
+					LastUpdated: time.Now(),
 				},
 				row.RangeID,
 			})
@@ -196,14 +232,15 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 func (m *sqlTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequest) (*persistence.UpdateTaskListResponse, error) {
 	if request.TaskListInfo.Kind == persistence.TaskListKindSticky {
 		// If sticky, update with TTL
-		if _, err := m.db.NamedExec(updateTaskListWithTTLSQLQuery, &tasksListsRow{
-			DomainID: request.TaskListInfo.DomainID,
-			RangeID:  request.TaskListInfo.RangeID,
-			Name:     request.TaskListInfo.Name,
-			TaskType: int64(request.TaskListInfo.TaskType),
-			AckLevel: request.TaskListInfo.AckLevel,
-			Kind:     int64(request.TaskListInfo.Kind),
-			ExpiryTs: stickyTaskListTTL(),
+		if _, err := m.db.NamedExec(updateTaskListWithTTLSQLQuery.on(m.db), &tasksListsRow{
+			DomainID:    request.TaskListInfo.DomainID,
+			RangeID:     request.TaskListInfo.RangeID,
+			Name:        request.TaskListInfo.Name,
+			TaskType:    int64(request.TaskListInfo.TaskType),
+			AckLevel:    request.TaskListInfo.AckLevel,
+			Kind:        int64(request.TaskListInfo.Kind),
+			ExpiryTs:    stickyTaskListTTL(),
+			LastUpdated: time.Now(),
 		}); err != nil {
 			return nil, &workflow.InternalServiceError{
 				Message: fmt.Sprintf("UpdateTaskList operation failed. Failed to make sticky task list. Error: %v", err),
@@ -227,6 +264,7 @@ func (m *sqlTaskManager) UpdateTaskList(request *persistence.UpdateTaskListReque
 					request.TaskListInfo.AckLevel,
 					int64(request.TaskListInfo.Kind),
 					time.Time{},
+					time.Now(),
 				},
 				request.TaskListInfo.RangeID,
 			})
@@ -246,6 +284,92 @@ func (m *sqlTaskManager) UpdateTaskList(request *persistence.UpdateTaskListReque
 	return resp, err
 }
 
+func (t *taskListPageToken) serialize() ([]byte, error) {
+	return json.Marshal(t)
+}
+
+func (t *taskListPageToken) deserialize(payload []byte) error {
+	return json.Unmarshal(payload, t)
+}
+
+func (m *sqlTaskManager) ListTaskList(request *persistence.ListTaskListRequest) (*persistence.ListTaskListResponse, error) {
+	// the task lists are read in the order of their primary key, starting after the last one of the previous page
+	pageToken := &taskListPageToken{TaskType: -1}
+	if len(request.PageToken) > 0 {
+		if err := pageToken.deserialize(request.PageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ListTaskList operation failed. Error deserializing page token: %v", err),
+			}
+		}
+	}
+
+	var rows []tasksListsRow
+	var err error
+	if request.DomainID == "" {
+		err = m.db.Select(&rows, m.db.Rebind(listAllTaskListSQLQuery),
+			pageToken.DomainID,
+			pageToken.DomainID,
+			pageToken.Name,
+			pageToken.Name,
+			pageToken.TaskType,
+			request.PageSize)
+	} else {
+		err = m.db.Select(&rows, m.db.Rebind(listTaskListSQLQuery),
+			request.DomainID,
+			pageToken.Name,
+			pageToken.Name,
+			pageToken.TaskType,
+			request.PageSize)
+	}
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListTaskList operation failed. Failed to get rows. Error: %v", err),
+		}
+	}
+
+	response := &persistence.ListTaskListResponse{Items: make([]*persistence.TaskListInfo, len(rows))}
+	for i, row := range rows {
+		response.Items[i] = &persistence.TaskListInfo{
+			DomainID:    row.DomainID,
+			Name:        row.Name,
+			TaskType:    int(row.TaskType),
+			RangeID:     row.RangeID,
+			AckLevel:    row.AckLevel,
+			Kind:        int(row.Kind),
+			LastUpdated: row.LastUpdated,
+		}
+	}
+
+	if len(rows) > 0 && len(rows) == request.PageSize {
+		last := rows[len(rows)-1]
+		nextPageToken, err := (&taskListPageToken{DomainID: last.DomainID, Name: last.Name, TaskType: last.TaskType}).serialize()
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ListTaskList operation failed. Error serializing page token: %v", err),
+			}
+		}
+		response.NextPageToken = nextPageToken
+	}
+	return response, nil
+}
+
+func (m *sqlTaskManager) DeleteTaskList(request *persistence.DeleteTaskListRequest) error {
+	return m.txExecute("DeleteTaskList", func(tx *sqlx.Tx) error {
+		// the task list is only deleted if it was not leased again since it was read
+		if err := lockTaskList(
+			tx, request.DomainID, request.TaskListName, request.TaskListType, request.RangeID); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(tx.Rebind(deleteTaskListTasksSQLQuery),
+			request.DomainID, request.TaskListName, request.TaskListType); err != nil {
+			return err
+		}
+		_, err := tx.Exec(tx.Rebind(deleteTaskListSQLQuery),
+			request.DomainID, request.TaskListName, request.TaskListType, request.RangeID)
+		return err
+	})
+}
+
 func (m *sqlTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
 	tasksRows := make([]tasksRow, len(request.Tasks))
 	for i, v := range request.Tasks {
@@ -262,6 +386,7 @@ func (m *sqlTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*
 			TaskListType: int64(request.TaskListInfo.TaskType),
 			TaskID:       v.TaskID,
 			ExpiryTs:     expiryTime,
+			CreatedTime:  time.Now(),
 		}
 	}
 	var resp *persistence.CreateTasksResponse
@@ -287,7 +412,7 @@ func (m *sqlTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*
 
 func (m *sqlTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
 	var rows []tasksRow
-	if err := m.db.Select(&rows, getTaskSQLQuery, request.DomainID, request.TaskList, request.TaskType, request.ReadLevel, request.MaxReadLevel); err != nil {
+	if err := m.db.Select(&rows, m.db.Rebind(getTaskSQLQuery), request.DomainID, request.TaskList, request.TaskType, request.ReadLevel, request.MaxReadLevel); err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("GetTasks operation failed. Failed to get rows. Error: %v", err),
 		}
@@ -296,11 +421,13 @@ func (m *sqlTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persis
 	var tasks = make([]*persistence.TaskInfo, len(rows))
 	for i, v := range rows {
 		tasks[i] = &persistence.TaskInfo{
-			DomainID:   request.DomainID,
-			WorkflowID: v.WorkflowID,
-			RunID:      v.RunID,
-			TaskID:     v.TaskID,
-			ScheduleID: v.ScheduleID,
+			DomainID:    request.DomainID,
+			WorkflowID:  v.WorkflowID,
+			RunID:       v.RunID,
+			TaskID:      v.TaskID,
+			ScheduleID:  v.ScheduleID,
+			CreatedTime: v.CreatedTime,
+			Expiry:      v.ExpiryTs,
 		}
 	}
 
@@ -311,16 +438,33 @@ func (m *sqlTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persis
 func (m *sqlTaskManager) CompleteTask(request *persistence.CompleteTaskRequest) error {
 	taskID := request.TaskID
 	taskList := request.TaskList
-	_, err := m.db.Exec(deleteTaskSQLQuery, taskList.DomainID, taskList.Name, int64(taskList.TaskType), taskID)
+	_, err := m.db.Exec(m.db.Rebind(deleteTaskSQLQuery), taskList.DomainID, taskList.Name, int64(taskList.TaskType), taskID)
 	if err != nil && err != sql.ErrNoRows {
 		return &workflow.InternalServiceError{Message: err.Error()}
 	}
 	return nil
 }
 
+func (m *sqlTaskManager) CompleteTasksLessThan(request *persistence.CompleteTasksLessThanRequest) (int, error) {
+	result, err := m.db.Exec(m.db.Rebind(deleteTasksLessThanSQLQuery.on(m.db)),
+		request.DomainID, request.TaskListName, request.TaskType, request.TaskID, request.Limit)
+	if err != nil {
+		return 0, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CompleteTasksLessThan operation failed. Error: %v", err),
+		}
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CompleteTasksLessThan operation failed. rowsAffected error: %v", err),
+		}
+	}
+	return int(rowsAffected), nil
+}
+
 func lockTaskList(tx *sqlx.Tx, domainID, name string, taskListType int, oldRangeID int64) error {
 	var rangeID int64
-	if err := tx.Get(&rangeID, lockTaskListSQLQuery, domainID, name, taskListType); err != nil {
+	if err := tx.Get(&rangeID, tx.Rebind(lockTaskListSQLQuery), domainID, name, taskListType); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("Failed to lock task list. Error: %v", err),
 		}
@@ -35,15 +35,21 @@ import (
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, workflow_type_name) ` +
-		`VALUES (?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, workflow_type_name, memo, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?)`
 
 	templateUpdateWorkflowExecutionClosed = `UPDATE executions_visibility SET
 		close_time = ?, 
         close_status = ?, 
-        history_length = ?
+        history_length = ?,
+        memo = ?,
+        search_attributes = ?
         WHERE domain_id = ? AND run_id = ?`
 
+	templateUpsertWorkflowExecution = `UPDATE executions_visibility SET
+		search_attributes = ?
+		WHERE domain_id = ? AND run_id = ? AND close_status IS NULL`
+
 	// RunID condition is needed for correct pagination
 	templateConditions = ` AND domain_id = ?
 		 AND start_time >= ?
@@ -52,7 +58,7 @@ const (
          ORDER BY start_time DESC, run_id
          LIMIT ?`
 
-	templateOpenFieldNames = `workflow_id, run_id, start_time, workflow_type_name`
+	templateOpenFieldNames = `workflow_id, run_id, start_time, workflow_type_name, memo, search_attributes`
 	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE close_status IS NULL `
 
 	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length
@@ -72,7 +78,9 @@ const (
 
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND close_status = ?` + templateConditions
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, close_status, history_length
+	templateDeleteWorkflowExecution = `DELETE FROM executions_visibility WHERE domain_id = ? AND run_id = ?`
+
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, close_status, history_length, memo, search_attributes
 		 FROM executions_visibility
 		 WHERE domain_id = ? AND close_status IS NOT NULL
 		 AND run_id = ?`
@@ -92,6 +100,8 @@ type (
 		CloseStatus      *int32
 		CloseTime        *time.Time
 		HistoryLength    *int64
+		Memo             *[]byte
+		SearchAttributes *[]byte
 	}
 
 	visibilityPageToken struct {
@@ -115,12 +125,22 @@ func NewSQLVisibilityStore(cfg config.SQL, logger bark.Logger) (p.VisibilityMana
 }
 
 func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(request *p.RecordWorkflowExecutionStartedRequest) error {
-	result, err := s.db.Exec(templateCreateWorkflowExecutionStarted,
+	memo, err := gobSerialize(request.Memo)
+	if err != nil {
+		return err
+	}
+	searchAttributes, err := gobSerialize(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
+	result, err := s.db.Exec(s.db.Rebind(templateCreateWorkflowExecutionStarted),
 		request.DomainUUID,
 		request.Execution.WorkflowId,
 		request.Execution.RunId,
 		time.Unix(0, request.StartTimestamp),
-		request.WorkflowTypeName)
+		request.WorkflowTypeName,
+		memo,
+		searchAttributes)
 	if err != nil {
 		return err
 	}
@@ -135,10 +155,20 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(request *p.RecordWor
 }
 
 func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.RecordWorkflowExecutionClosedRequest) error {
-	result, err := s.db.Exec(templateUpdateWorkflowExecutionClosed,
+	memo, err := gobSerialize(request.Memo)
+	if err != nil {
+		return err
+	}
+	searchAttributes, err := gobSerialize(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
+	result, err := s.db.Exec(s.db.Rebind(templateUpdateWorkflowExecutionClosed),
 		time.Unix(0, request.CloseTimestamp),
 		request.Status,
 		request.HistoryLength,
+		memo,
+		searchAttributes,
 		request.DomainUUID,
 		request.Execution.RunId)
 	if err != nil {
@@ -154,11 +184,29 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.RecordWork
 	return nil
 }
 
+func (s *sqlVisibilityStore) UpsertWorkflowExecution(request *p.UpsertWorkflowExecutionRequest) error {
+	searchAttributes, err := gobSerialize(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
+	// no row is updated if the execution is already closed, or not recorded as started yet, in
+	// which case the search attributes are written together with the start or close record
+	if _, err := s.db.Exec(s.db.Rebind(templateUpsertWorkflowExecution),
+		searchAttributes,
+		request.DomainUUID,
+		request.Execution.RunId); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpsertWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.ListWorkflowExecutionsResponse, error) {
 	return s.listWorkflowExecutions("ListOpenWorkflowExecutions", request.NextPageToken, request.EarliestStartTime, request.LatestStartTime,
 		func(readLevel *visibilityPageToken, rows *[]executionVisibilityRow) error {
 			return s.db.Select(rows,
-				templateGetOpenWorkflowExecutions,
+				s.db.Rebind(templateGetOpenWorkflowExecutions),
 				request.DomainUUID,
 				time.Unix(0, request.EarliestStartTime),
 				readLevel.Time,
@@ -172,7 +220,7 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutions(request *p.ListWorkflo
 	return s.listWorkflowExecutions("ListClosedWorkflowExecutions", request.NextPageToken, request.EarliestStartTime, request.LatestStartTime,
 		func(readLevel *visibilityPageToken, rows *[]executionVisibilityRow) error {
 			return s.db.Select(rows,
-				templateGetClosedWorkflowExecutions,
+				s.db.Rebind(templateGetClosedWorkflowExecutions),
 				request.DomainUUID,
 				time.Unix(0, request.EarliestStartTime),
 				readLevel.Time,
@@ -186,7 +234,7 @@ func (s *sqlVisibilityStore) ListOpenWorkflowExecutionsByType(request *p.ListWor
 	return s.listWorkflowExecutions("ListOpenWorkflowExecutionsByType", request.NextPageToken, request.EarliestStartTime, request.LatestStartTime,
 		func(readLevel *visibilityPageToken, rows *[]executionVisibilityRow) error {
 			return s.db.Select(rows,
-				templateGetOpenWorkflowExecutionsByType,
+				s.db.Rebind(templateGetOpenWorkflowExecutionsByType),
 				request.WorkflowTypeName,
 				request.DomainUUID,
 				time.Unix(0, request.EarliestStartTime),
@@ -201,7 +249,7 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByType(request *p.ListW
 	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByType", request.NextPageToken, request.EarliestStartTime, request.LatestStartTime,
 		func(readLevel *visibilityPageToken, rows *[]executionVisibilityRow) error {
 			return s.db.Select(rows,
-				templateGetClosedWorkflowExecutionsByType,
+				s.db.Rebind(templateGetClosedWorkflowExecutionsByType),
 				request.WorkflowTypeName,
 				request.DomainUUID,
 				time.Unix(0, request.EarliestStartTime),
@@ -216,7 +264,7 @@ func (s *sqlVisibilityStore) ListOpenWorkflowExecutionsByWorkflowID(request *p.L
 	return s.listWorkflowExecutions("ListOpenWorkflowExecutionsByWorkflowID", request.NextPageToken, request.EarliestStartTime, request.LatestStartTime,
 		func(readLevel *visibilityPageToken, rows *[]executionVisibilityRow) error {
 			return s.db.Select(rows,
-				templateGetOpenWorkflowExecutionsByID,
+				s.db.Rebind(templateGetOpenWorkflowExecutionsByID),
 				request.WorkflowID,
 				request.DomainUUID,
 				time.Unix(0, request.EarliestStartTime),
@@ -231,7 +279,7 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(request *p
 	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByWorkflowID", request.NextPageToken, request.EarliestStartTime, request.LatestStartTime,
 		func(readLevel *visibilityPageToken, rows *[]executionVisibilityRow) error {
 			return s.db.Select(rows,
-				templateGetClosedWorkflowExecutionsByID,
+				s.db.Rebind(templateGetClosedWorkflowExecutionsByID),
 				request.WorkflowID,
 				request.DomainUUID,
 				time.Unix(0, request.EarliestStartTime),
@@ -246,7 +294,7 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByStatus(request *p.Lis
 	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByStatus", request.NextPageToken, request.EarliestStartTime, request.LatestStartTime,
 		func(readLevel *visibilityPageToken, rows *[]executionVisibilityRow) error {
 			return s.db.Select(rows,
-				templateGetClosedWorkflowExecutionsByStatus,
+				s.db.Rebind(templateGetClosedWorkflowExecutionsByStatus),
 				request.Status,
 				request.DomainUUID,
 				time.Unix(0, request.EarliestStartTime),
@@ -260,7 +308,7 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByStatus(request *p.Lis
 func (s *sqlVisibilityStore) GetClosedWorkflowExecution(request *p.GetClosedWorkflowExecutionRequest) (*p.GetClosedWorkflowExecutionResponse, error) {
 	var row executionVisibilityRow
 	execution := request.Execution
-	if err := s.db.Get(&row, templateGetClosedWorkflowExecution, request.DomainUUID, execution.RunId); err != nil {
+	if err := s.db.Get(&row, s.db.Rebind(templateGetClosedWorkflowExecution), request.DomainUUID, execution.RunId); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, &workflow.EntityNotExistsError{
 				Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
@@ -274,25 +322,58 @@ func (s *sqlVisibilityStore) GetClosedWorkflowExecution(request *p.GetClosedWork
 	row.DomainID = request.DomainUUID
 	row.RunID = execution.GetRunId()
 	row.WorkflowID = execution.GetWorkflowId()
-	return &p.GetClosedWorkflowExecutionResponse{Execution: rowToInfo(row)}, nil
+	info, err := rowToInfo(row)
+	if err != nil {
+		return nil, err
+	}
+	return &p.GetClosedWorkflowExecutionResponse{Execution: info}, nil
+}
+
+func (s *sqlVisibilityStore) ListWorkflowExecutions(request *p.ListWorkflowExecutionsByQueryRequest) (*p.ListWorkflowExecutionsResponse, error) {
+	return p.ListWorkflowExecutionsByQuery(s, request)
 }
 
-func rowToInfo(row executionVisibilityRow) *workflow.WorkflowExecutionInfo {
+func (s *sqlVisibilityStore) DeleteWorkflowExecution(request *p.VisibilityDeleteWorkflowExecutionRequest) error {
+	if _, err := s.db.Exec(s.db.Rebind(templateDeleteWorkflowExecution),
+		request.DomainUUID,
+		request.Execution.GetRunId()); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func rowToInfo(row executionVisibilityRow) (*workflow.WorkflowExecutionInfo, error) {
 	info := &workflow.WorkflowExecutionInfo{
 		Execution: &workflow.WorkflowExecution{
 			WorkflowId: common.StringPtr(row.WorkflowID),
 			RunId:      common.StringPtr(row.RunID),
 		},
-		Type: &workflow.WorkflowType{Name: common.StringPtr(row.WorkflowTypeName)},
+		Type:      &workflow.WorkflowType{Name: common.StringPtr(row.WorkflowTypeName)},
+		StartTime: common.Int64Ptr(row.StartTime.UnixNano()),
 	}
 	if row.CloseStatus != nil {
 		status := workflow.WorkflowExecutionCloseStatus(*row.CloseStatus)
 		info.CloseStatus = &status
-		closeTime := row.CloseTime.Unix()
-		info.CloseTime = &closeTime
+		info.CloseTime = common.Int64Ptr(row.CloseTime.UnixNano())
 		info.HistoryLength = row.HistoryLength
 	}
-	return info
+	if row.Memo != nil && len(*row.Memo) > 0 {
+		var memo map[string][]byte
+		if err := gobDeserialize(*row.Memo, &memo); err != nil {
+			return nil, err
+		}
+		info.Memo = &workflow.Memo{Fields: memo}
+	}
+	if row.SearchAttributes != nil && len(*row.SearchAttributes) > 0 {
+		var searchAttributes map[string][]byte
+		if err := gobDeserialize(*row.SearchAttributes, &searchAttributes); err != nil {
+			return nil, err
+		}
+		info.SearchAttributes = &workflow.SearchAttributes{IndexedFields: searchAttributes}
+	}
+	return info, nil
 }
 
 func (s *sqlVisibilityStore) listWorkflowExecutions(opName string, pageToken []byte, earliestTime int64, latestTime int64, selectOp func(readLevel *visibilityPageToken, rows *[]executionVisibilityRow) error) (*p.ListWorkflowExecutionsResponse, error) {
@@ -319,7 +400,10 @@ func (s *sqlVisibilityStore) listWorkflowExecutions(opName string, pageToken []b
 
 	var infos = make([]*workflow.WorkflowExecutionInfo, len(rows))
 	for i, row := range rows {
-		infos[i] = rowToInfo(row)
+		infos[i], err = rowToInfo(row)
+		if err != nil {
+			return nil, err
+		}
 	}
 	var nextPageToken []byte
 	lastRow := rows[len(rows)-1]
@@ -66,17 +66,6 @@ domain_id = :domain_id AND
 workflow_id = :workflow_id AND
 run_id = :run_id`
 
-	// %[2]v is the columns of the value struct (i.e. no primary key columns), comma separated
-	// %[3]v should be %[2]v with colons prepended.
-	// i.e. %[3]v = ",".join(":" + s for s in %[2]v)
-	// So that this query can be used with BindNamed
-	// %[4]v should be the name of the key associated with the map
-	// e.g. for ActivityInfo it is "schedule_id"
-	setKeyInMapSQLQueryTemplate = `REPLACE INTO %[1]v
-(shard_id, domain_id, workflow_id, run_id, %[4]v, %[2]v)
-VALUES
-(:shard_id, :domain_id, :workflow_id, :run_id, :%[4]v, %[3]v)`
-
 	// %[2]v is the name of the key
 	deleteKeyInMapSQLQueryTemplate = `DELETE FROM %[1]v
 WHERE
@@ -101,14 +90,15 @@ func makeDeleteMapSQLQuery(tableName string) string {
 	return fmt.Sprintf(deleteMapSQLQueryTemplate, tableName)
 }
 
-func makeSetKeyInMapSQLQuery(tableName string, nonPrimaryKeyColumns []string, mapKeyName string) string {
-	return fmt.Sprintf(setKeyInMapSQLQueryTemplate,
-		tableName,
-		strings.Join(nonPrimaryKeyColumns, ","),
-		strings.Join(stringMap(nonPrimaryKeyColumns, func(x string) string {
-			return ":" + x
-		}), ","),
-		mapKeyName)
+// makeSetKeyInMapSQLQuery returns a query for use with BindNamed which inserts or replaces keys of the map,
+// nonPrimaryKeyColumns are the columns of the value struct and mapKeyName is the name of the key associated
+// with the map e.g. for ActivityInfo it is "schedule_id"
+func makeSetKeyInMapSQLQuery(tableName string, nonPrimaryKeyColumns []string, mapKeyName string) dialectSQLQuery {
+	return newDialectSQLQuery(func(d dialect) string {
+		return d.upsertQuery(tableName,
+			[]string{"shard_id", "domain_id", "workflow_id", "run_id", mapKeyName},
+			nonPrimaryKeyColumns)
+	})
 }
 
 func makeDeleteKeyInMapSQLQuery(tableName string, mapKeyName string) string {
@@ -156,6 +146,7 @@ var (
 		"expiration_time",
 		"max_attempts",
 		"non_retriable_errors",
+		"last_failure_reason",
 	}
 	activityInfoTableName = "activity_info_maps"
 	activityInfoKey       = "schedule_id"
@@ -206,6 +197,7 @@ type (
 		ExpirationTime           time.Time
 		MaxAttempts              int64
 		NonRetriableErrors       *[]byte
+		LastFailureReason        *string
 	}
 )
 
@@ -264,6 +256,10 @@ func updateActivityInfos(tx *sqlx.Tx,
 				activityInfoMapsRows[i].Details = &v.Details
 			}
 
+			if v.LastFailureReason != "" {
+				activityInfoMapsRows[i].LastFailureReason = &v.LastFailureReason
+			}
+
 			if v.NonRetriableErrors != nil {
 				nonRetriableErrors, err := gobSerialize(&v.NonRetriableErrors)
 				if err != nil {
@@ -275,7 +271,7 @@ func updateActivityInfos(tx *sqlx.Tx,
 			}
 		}
 
-		query, args, err := tx.BindNamed(setKeyInActivityInfoMapSQLQuery, activityInfoMapsRows)
+		query, args, err := tx.BindNamed(setKeyInActivityInfoMapSQLQuery.on(tx), activityInfoMapsRows)
 		if err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("Failed to update activity info. Failed to bind query. Error: %v", err),
@@ -287,8 +283,8 @@ func updateActivityInfos(tx *sqlx.Tx,
 				Message: fmt.Sprintf("Failed to update activity info. Failed to execute update query. Error: %v", err),
 			}
 		}
-		// There is no sense in checking rowsAffected == len(activityInfo) for a REPLACE query, because
-		// it is 1 for each inserted row and 2 for each replaced row
+		// There is no sense in checking rowsAffected == len(activityInfo) for an upsert query, because
+		// with MySQL it is 1 for each inserted row and 2 for each replaced row
 
 		//rowsAffected, err := result.RowsAffected()
 		//if err != nil {
@@ -355,7 +351,7 @@ func getActivityInfoMap(tx *sqlx.Tx,
 	var activityInfoMapsRows []activityInfoMapsRow
 
 	if err := tx.Select(&activityInfoMapsRows,
-		getActivityInfoMapSQLQuery,
+		tx.Rebind(getActivityInfoMapSQLQuery),
 		shardID,
 		domainID,
 		workflowID,
@@ -405,6 +401,10 @@ func getActivityInfoMap(tx *sqlx.Tx,
 			ret[v.ScheduleID].Details = *v.Details
 		}
 
+		if v.LastFailureReason != nil {
+			ret[v.ScheduleID].LastFailureReason = *v.LastFailureReason
+		}
+
 		if v.NonRetriableErrors != nil {
 			ret[v.ScheduleID].NonRetriableErrors = []string{}
 			if err := gobDeserialize(*v.NonRetriableErrors, &ret[v.ScheduleID].NonRetriableErrors); err != nil {
@@ -491,7 +491,7 @@ func updateTimerInfos(tx *sqlx.Tx,
 			}
 		}
 
-		query, args, err := tx.BindNamed(setKeyInTimerInfoMapSQLQuery, timerInfoMapsRows)
+		query, args, err := tx.BindNamed(setKeyInTimerInfoMapSQLQuery.on(tx), timerInfoMapsRows)
 		if err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("Failed to update timer info. Failed to bind query. Error: %v", err),
@@ -551,7 +551,7 @@ func getTimerInfoMap(tx *sqlx.Tx,
 	var timerInfoMapsRows []timerInfoMapsRow
 
 	if err := tx.Select(&timerInfoMapsRows,
-		getTimerInfoMapSQLQuery,
+		tx.Rebind(getTimerInfoMapSQLQuery),
 		shardID,
 		domainID,
 		workflowID,
@@ -660,7 +660,7 @@ func updateChildExecutionInfos(tx *sqlx.Tx,
 			timerInfoMapsRows[i] = row
 		}
 
-		query, args, err := tx.BindNamed(setKeyInChildExecutionInfoMapSQLQuery, timerInfoMapsRows)
+		query, args, err := tx.BindNamed(setKeyInChildExecutionInfoMapSQLQuery.on(tx), timerInfoMapsRows)
 		if err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("Failed to update child execution info. Failed to bind query. Error: %v", err),
@@ -699,7 +699,7 @@ func getChildExecutionInfoMap(tx *sqlx.Tx,
 	var childExecutionInfoMapsRows []childExecutionInfoMapsRow
 
 	if err := tx.Select(&childExecutionInfoMapsRows,
-		getChildExecutionInfoMapSQLQuery,
+		tx.Rebind(getChildExecutionInfoMapSQLQuery),
 		shardID,
 		domainID,
 		workflowID,
@@ -798,7 +798,7 @@ func updateRequestCancelInfos(tx *sqlx.Tx,
 			}
 		}
 
-		query, args, err := tx.BindNamed(setKeyInRequestCancelInfoMapSQLQuery, requestCancelInfoMapsRows)
+		query, args, err := tx.BindNamed(setKeyInRequestCancelInfoMapSQLQuery.on(tx), requestCancelInfoMapsRows)
 		if err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("Failed to update request cancel info. Failed to bind query. Error: %v", err),
@@ -849,7 +849,7 @@ func getRequestCancelInfoMap(tx *sqlx.Tx,
 	var requestCancelInfoMapsRows []requestCancelInfoMapsRow
 
 	if err := tx.Select(&requestCancelInfoMapsRows,
-		getRequestCancelInfoMapSQLQuery,
+		tx.Rebind(getRequestCancelInfoMapSQLQuery),
 		shardID,
 		domainID,
 		workflowID,
@@ -947,7 +947,7 @@ func updateSignalInfos(tx *sqlx.Tx,
 			}
 		}
 
-		query, args, err := tx.BindNamed(setKeyInSignalInfoMapSQLQuery, signalInfoMapsRows)
+		query, args, err := tx.BindNamed(setKeyInSignalInfoMapSQLQuery.on(tx), signalInfoMapsRows)
 		if err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("Failed to update signal info. Failed to bind query. Error: %v", err),
@@ -997,7 +997,7 @@ func getSignalInfoMap(tx *sqlx.Tx,
 	var signalInfoMapsRows []signalInfoMapsRow
 
 	if err := tx.Select(&signalInfoMapsRows,
-		getSignalInfoMapSQLQuery,
+		tx.Rebind(getSignalInfoMapSQLQuery),
 		shardID,
 		domainID,
 		workflowID,
@@ -1131,7 +1131,7 @@ func updateBufferedReplicationTasks(tx *sqlx.Tx,
 				arg.History = &historyBlob.Data
 				arg.HistoryEncoding = string(historyBlob.Encoding)
 			}
-			if _, err := tx.NamedExec(setKeyInBufferedReplicationTasksMapSQLQuery, arg); err != nil {
+			if _, err := tx.NamedExec(setKeyInBufferedReplicationTasksMapSQLQuery.on(tx), arg); err != nil {
 				return &workflow.InternalServiceError{
 					Message: fmt.Sprintf("Failed to update buffered replication tasks. Failed to execute update query. Error: %v", err),
 				}
@@ -1153,7 +1153,7 @@ func updateBufferedReplicationTasks(tx *sqlx.Tx,
 				arg.History = &historyBlob.Data
 				arg.HistoryEncoding = string(historyBlob.Encoding)
 			}
-			if _, err := tx.NamedExec(setKeyInBufferedReplicationTasksNoNewRunHistoryMapSQLQuery, arg); err != nil {
+			if _, err := tx.NamedExec(setKeyInBufferedReplicationTasksNoNewRunHistoryMapSQLQuery.on(tx), arg); err != nil {
 				return &workflow.InternalServiceError{
 					Message: fmt.Sprintf("Failed to update buffered replication tasks. Failed to execute update query. Error: %v", err),
 				}
@@ -1197,7 +1197,7 @@ func getBufferedReplicationTasks(tx *sqlx.Tx,
 	var bufferedReplicationTaskMapsRows []bufferedReplicationTaskMapsRow
 
 	if err := tx.Select(&bufferedReplicationTaskMapsRows,
-		getBufferedReplicationTasksMapSQLQuery,
+		tx.Rebind(getBufferedReplicationTasksMapSQLQuery),
 		shardID,
 		domainID,
 		workflowID,
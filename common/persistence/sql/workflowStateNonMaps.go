@@ -37,10 +37,6 @@ workflow_id = :workflow_id AND
 run_id = :run_id
 `
 
-	addToSignalsRequestedSetSQLQuery = `INSERT IGNORE INTO signals_requested_sets
-(shard_id, domain_id, workflow_id, run_id, signal_id) VALUES
-(:shard_id, :domain_id, :workflow_id, :run_id, :signal_id)`
-
 	removeFromSignalsRequestedSetSQLQuery = `DELETE FROM signals_requested_sets
 WHERE 
 shard_id = :shard_id AND
@@ -56,6 +52,11 @@ workflow_id = ? AND
 run_id = ?`
 )
 
+var addToSignalsRequestedSetSQLQuery = newDialectSQLQuery(func(d dialect) string {
+	return d.insertIgnoreQuery("signals_requested_sets",
+		[]string{"shard_id", "domain_id", "workflow_id", "run_id", "signal_id"})
+})
+
 type (
 	signalsRequestedSetsRow struct {
 		ShardID    int64
@@ -83,7 +84,7 @@ func updateSignalsRequested(tx *sqlx.Tx,
 			}
 		}
 
-		query, args, err := tx.BindNamed(addToSignalsRequestedSetSQLQuery, signalsRequestedSetsRows)
+		query, args, err := tx.BindNamed(addToSignalsRequestedSetSQLQuery.on(tx), signalsRequestedSetsRows)
 		if err != nil {
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("Failed to update signals requested. Failed to bind query. Error: %v", err),
@@ -120,7 +121,7 @@ func getSignalsRequested(tx *sqlx.Tx,
 	workflowID,
 	runID string) (map[string]struct{}, error) {
 	var signals []string
-	if err := tx.Select(&signals, getSignalsRequestedSetSQLQuery,
+	if err := tx.Select(&signals, tx.Rebind(getSignalsRequestedSetSQLQuery),
 		shardID,
 		domainID,
 		workflowID,
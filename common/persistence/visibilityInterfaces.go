@@ -38,6 +38,8 @@ type (
 		WorkflowTypeName string
 		StartTimestamp   int64
 		WorkflowTimeout  int64
+		Memo             map[string][]byte
+		SearchAttributes map[string][]byte
 	}
 
 	// RecordWorkflowExecutionClosedRequest is used to add a record of a newly
@@ -52,6 +54,21 @@ type (
 		Status           s.WorkflowExecutionCloseStatus
 		HistoryLength    int64
 		RetentionSeconds int64
+		Memo             map[string][]byte
+		SearchAttributes map[string][]byte
+	}
+
+	// UpsertWorkflowExecutionRequest is used to update the search attributes of an open execution
+	UpsertWorkflowExecutionRequest struct {
+		DomainUUID       string
+		Domain           string // domain name is not persisted, but used as config filter key
+		Execution        s.WorkflowExecution
+		WorkflowTypeName string
+		StartTimestamp   int64
+		UpsertTimestamp  int64 // time of the update, which has to be before the close time of the execution
+		WorkflowTimeout  int64
+		Memo             map[string][]byte // the record is written again, so the memo has to be carried along
+		SearchAttributes map[string][]byte
 	}
 
 	// ListWorkflowExecutionsRequest is used to list executions in a domain
@@ -96,6 +113,19 @@ type (
 		Status s.WorkflowExecutionCloseStatus
 	}
 
+	// ListWorkflowExecutionsByQueryRequest is used to list open and closed executions in a domain
+	// which match a query, see VisibilityQuery for the syntax
+	ListWorkflowExecutionsByQueryRequest struct {
+		DomainUUID string
+		Domain     string // domain name is not persisted, but used as config filter key
+		Query      string
+		// Maximum number of workflow executions per page
+		PageSize int
+		// Token to continue reading next page of workflow executions.
+		// Pass in empty slice for first page.
+		NextPageToken []byte
+	}
+
 	// GetClosedWorkflowExecutionRequest is used retrieve the record for a specific execution
 	GetClosedWorkflowExecutionRequest struct {
 		DomainUUID string
@@ -107,12 +137,22 @@ type (
 		Execution *s.WorkflowExecutionInfo
 	}
 
+	// VisibilityDeleteWorkflowExecutionRequest is used to remove the records of an execution
+	VisibilityDeleteWorkflowExecutionRequest struct {
+		DomainUUID     string
+		Domain         string // domain name is not persisted, but used as config filter key
+		Execution      s.WorkflowExecution
+		StartTimestamp int64
+		CloseTimestamp int64 // zero if the execution was never closed
+	}
+
 	// VisibilityManager is used to manage the visibility store
 	VisibilityManager interface {
 		Closeable
 		GetName() string
 		RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error
 		RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error
+		UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error
 		ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error)
 		ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error)
@@ -121,5 +161,7 @@ type (
 		ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error)
 		GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error)
+		ListWorkflowExecutions(request *ListWorkflowExecutionsByQueryRequest) (*ListWorkflowExecutionsResponse, error)
+		DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error
 	}
 )
@@ -0,0 +1,120 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// visibilityManagerWrapper reads the executions of a domain from the search visibility manager
+	// when enabled for the domain, and from the database visibility manager otherwise
+	visibilityManagerWrapper struct {
+		visibilityManager       VisibilityManager
+		searchVisibilityManager VisibilityManager
+		enableReadFromSearch    dynamicconfig.BoolPropertyFnWithDomainFilter
+	}
+)
+
+var _ VisibilityManager = (*visibilityManagerWrapper)(nil)
+
+// NewVisibilityManagerWrapper returns a visibility manager which writes to the database visibility manager
+// and reads from either of them, depending on enableReadFromSearch for the domain of the request
+func NewVisibilityManagerWrapper(
+	visibilityManager VisibilityManager,
+	searchVisibilityManager VisibilityManager,
+	enableReadFromSearch dynamicconfig.BoolPropertyFnWithDomainFilter,
+) VisibilityManager {
+	return &visibilityManagerWrapper{
+		visibilityManager:       visibilityManager,
+		searchVisibilityManager: searchVisibilityManager,
+		enableReadFromSearch:    enableReadFromSearch,
+	}
+}
+
+func (v *visibilityManagerWrapper) Close() {
+	v.visibilityManager.Close()
+	v.searchVisibilityManager.Close()
+}
+
+func (v *visibilityManagerWrapper) GetName() string {
+	return v.visibilityManager.GetName()
+}
+
+func (v *visibilityManagerWrapper) RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error {
+	return v.visibilityManager.RecordWorkflowExecutionStarted(request)
+}
+
+func (v *visibilityManagerWrapper) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
+	return v.visibilityManager.RecordWorkflowExecutionClosed(request)
+}
+
+func (v *visibilityManagerWrapper) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
+	return v.visibilityManager.UpsertWorkflowExecution(request)
+}
+
+func (v *visibilityManagerWrapper) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.chooseReader(request.Domain).ListOpenWorkflowExecutions(request)
+}
+
+func (v *visibilityManagerWrapper) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.chooseReader(request.Domain).ListClosedWorkflowExecutions(request)
+}
+
+func (v *visibilityManagerWrapper) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.chooseReader(request.Domain).ListOpenWorkflowExecutionsByType(request)
+}
+
+func (v *visibilityManagerWrapper) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.chooseReader(request.Domain).ListClosedWorkflowExecutionsByType(request)
+}
+
+func (v *visibilityManagerWrapper) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.chooseReader(request.Domain).ListOpenWorkflowExecutionsByWorkflowID(request)
+}
+
+func (v *visibilityManagerWrapper) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.chooseReader(request.Domain).ListClosedWorkflowExecutionsByWorkflowID(request)
+}
+
+func (v *visibilityManagerWrapper) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.chooseReader(request.Domain).ListClosedWorkflowExecutionsByStatus(request)
+}
+
+func (v *visibilityManagerWrapper) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	// the request has no domain name, so the closed execution is always read from the database
+	return v.visibilityManager.GetClosedWorkflowExecution(request)
+}
+
+func (v *visibilityManagerWrapper) ListWorkflowExecutions(request *ListWorkflowExecutionsByQueryRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.chooseReader(request.Domain).ListWorkflowExecutions(request)
+}
+
+func (v *visibilityManagerWrapper) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
+	return v.visibilityManager.DeleteWorkflowExecution(request)
+}
+
+func (v *visibilityManagerWrapper) chooseReader(domain string) VisibilityManager {
+	if v.enableReadFromSearch(domain) {
+		return v.searchVisibilityManager
+	}
+	return v.visibilityManager
+}
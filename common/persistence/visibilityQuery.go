@@ -0,0 +1,803 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/definition"
+)
+
+type (
+	// VisibilityQuery is a parsed ListWorkflowExecutions query. The query is a SQL like WHERE
+	// clause over the system and custom search attributes of workflow executions, e.g.
+	//   WorkflowType = 'OrderWorkflow' AND CustomKeywordField IN ('a', 'b') AND CloseTime = missing
+	// It supports AND, OR, NOT, parentheses, the =, !=, <>, >, >=, <, <= operators, BETWEEN ... AND ...,
+	// IN (...), and the missing keyword, which matches attributes that are not set.
+	VisibilityQuery struct {
+		root queryNode
+
+		// EarliestStartTime and LatestStartTime are the bounds of the start time implied by the query
+		EarliestStartTime int64
+		LatestStartTime   int64
+		// OpenOnly and ClosedOnly are set when the query can only match open, resp. closed, executions
+		OpenOnly   bool
+		ClosedOnly bool
+	}
+
+	queryNode interface {
+		match(values map[string]interface{}) bool
+	}
+
+	andNode struct {
+		left  queryNode
+		right queryNode
+	}
+
+	orNode struct {
+		left  queryNode
+		right queryNode
+	}
+
+	notNode struct {
+		node queryNode
+	}
+
+	matchAllNode struct{}
+
+	compareNode struct {
+		key   string
+		op    string
+		value interface{}
+	}
+
+	betweenNode struct {
+		key  string
+		from interface{}
+		to   interface{}
+	}
+
+	inNode struct {
+		key    string
+		values []interface{}
+	}
+
+	// missingValue is the value of the missing keyword
+	missingValue struct{}
+
+	queryTokenType int
+
+	queryToken struct {
+		tokenType queryTokenType
+		text      string
+		value     interface{}
+	}
+
+	queryParser struct {
+		tokens    []queryToken
+		pos       int
+		validKeys map[string]workflow.IndexedValueType
+	}
+
+	// visibilityQueryPageToken is the page token of ListWorkflowExecutionsByQuery, the open executions
+	// are listed first and then the closed ones
+	visibilityQueryPageToken struct {
+		Closed bool
+		Token  []byte
+	}
+)
+
+// maxVisibilityQueryScanPages is the number of pages read from the visibility store by a single
+// ListWorkflowExecutionsByQuery call, a page with less executions than requested is returned
+// after that to bound the latency of queries which match only few executions
+const maxVisibilityQueryScanPages = 10
+
+const (
+	tokenEOF queryTokenType = iota
+	tokenIdentifier
+	tokenLiteral
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+)
+
+// ParseVisibilityQuery parses a visibility query. If validKeys is not nil, the query can only
+// use those keys. An empty query matches every execution.
+func ParseVisibilityQuery(query string, validKeys map[string]workflow.IndexedValueType) (*VisibilityQuery, error) {
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &queryParser{tokens: tokens, validKeys: validKeys}
+	var root queryNode = matchAllNode{}
+	if parser.peek().tokenType != tokenEOF {
+		root, err = parser.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if token := parser.peek(); token.tokenType != tokenEOF {
+			return nil, fmt.Errorf("unexpected %q in query", token.text)
+		}
+	}
+
+	result := &VisibilityQuery{
+		root:              root,
+		EarliestStartTime: 0,
+		LatestStartTime:   math.MaxInt64,
+	}
+	result.computeHints(root)
+	return result, nil
+}
+
+// ListWorkflowExecutionsByQuery implements ListWorkflowExecutions for the visibility stores which
+// can not evaluate queries natively. It reads the open and then the closed executions in the start
+// time range of the query and filters them with the query.
+func ListWorkflowExecutionsByQuery(
+	visibilityMgr VisibilityManager,
+	request *ListWorkflowExecutionsByQueryRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	query, err := ParseVisibilityQuery(request.Query, nil)
+	if err != nil {
+		return nil, &workflow.BadRequestError{Message: err.Error()}
+	}
+
+	token := &visibilityQueryPageToken{}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, token); err != nil {
+			return nil, &workflow.BadRequestError{Message: "Invalid next page token."}
+		}
+	}
+
+	response := &ListWorkflowExecutionsResponse{
+		Executions: make([]*workflow.WorkflowExecutionInfo, 0),
+	}
+	if query.MatchesNothing() {
+		return response, nil
+	}
+	if query.ClosedOnly && !token.Closed {
+		token = &visibilityQueryPageToken{Closed: true}
+	}
+
+	for page := 0; page < maxVisibilityQueryScanPages && len(response.Executions) < request.PageSize; page++ {
+		// never read more executions than still fit into the response, so that the next
+		// page can continue from the page token of the store
+		listRequest := &ListWorkflowExecutionsRequest{
+			DomainUUID:        request.DomainUUID,
+			Domain:            request.Domain,
+			EarliestStartTime: query.EarliestStartTime,
+			LatestStartTime:   query.LatestStartTime,
+			PageSize:          request.PageSize - len(response.Executions),
+			NextPageToken:     token.Token,
+		}
+		var listResponse *ListWorkflowExecutionsResponse
+		if token.Closed {
+			listResponse, err = visibilityMgr.ListClosedWorkflowExecutions(listRequest)
+		} else {
+			listResponse, err = visibilityMgr.ListOpenWorkflowExecutions(listRequest)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, execution := range listResponse.Executions {
+			if query.Match(execution) {
+				response.Executions = append(response.Executions, execution)
+			}
+		}
+
+		if len(listResponse.NextPageToken) > 0 {
+			token.Token = listResponse.NextPageToken
+			continue
+		}
+		if token.Closed || query.OpenOnly {
+			return response, nil
+		}
+		token = &visibilityQueryPageToken{Closed: true}
+	}
+
+	response.NextPageToken, err = json.Marshal(token)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListWorkflowExecutions operation failed. Error: %v", err),
+		}
+	}
+	return response, nil
+}
+
+// Match returns true if the execution satisfies the query
+func (q *VisibilityQuery) Match(info *workflow.WorkflowExecutionInfo) bool {
+	return q.root.match(getQueryValues(info))
+}
+
+// MatchesNothing returns true if the query is known to match no execution
+func (q *VisibilityQuery) MatchesNothing() bool {
+	return (q.OpenOnly && q.ClosedOnly) || q.EarliestStartTime > q.LatestStartTime
+}
+
+// computeHints narrows the start time range and the open / closed state from the conditions
+// that every matching execution has to satisfy, i.e. the top level conjunctions of the query
+func (q *VisibilityQuery) computeHints(node queryNode) {
+	switch n := node.(type) {
+	case andNode:
+		q.computeHints(n.left)
+		q.computeHints(n.right)
+	case compareNode:
+		if n.key == definition.StartTime {
+			if v, ok := n.value.(int64); ok {
+				switch n.op {
+				case "=":
+					q.narrowStartTime(v, v)
+				case ">":
+					q.narrowStartTime(v+1, math.MaxInt64)
+				case ">=":
+					q.narrowStartTime(v, math.MaxInt64)
+				case "<":
+					q.narrowStartTime(0, v-1)
+				case "<=":
+					q.narrowStartTime(0, v)
+				}
+			}
+		}
+		if isCloseAttribute(n.key) {
+			_, isMissing := n.value.(missingValue)
+			if isMissing && n.op == "=" {
+				q.OpenOnly = true
+			} else {
+				q.ClosedOnly = true
+			}
+		}
+	case betweenNode:
+		if n.key == definition.StartTime {
+			from, fromOK := n.from.(int64)
+			to, toOK := n.to.(int64)
+			if fromOK && toOK {
+				q.narrowStartTime(from, to)
+			}
+		}
+		if isCloseAttribute(n.key) {
+			q.ClosedOnly = true
+		}
+	case inNode:
+		if isCloseAttribute(n.key) {
+			q.ClosedOnly = true
+		}
+	}
+}
+
+func (q *VisibilityQuery) narrowStartTime(earliest int64, latest int64) {
+	if earliest > q.EarliestStartTime {
+		q.EarliestStartTime = earliest
+	}
+	if latest < q.LatestStartTime {
+		q.LatestStartTime = latest
+	}
+}
+
+func isCloseAttribute(key string) bool {
+	return key == definition.CloseTime || key == definition.CloseStatus || key == definition.HistoryLength
+}
+
+func (n andNode) match(values map[string]interface{}) bool {
+	return n.left.match(values) && n.right.match(values)
+}
+
+func (n orNode) match(values map[string]interface{}) bool {
+	return n.left.match(values) || n.right.match(values)
+}
+
+func (n notNode) match(values map[string]interface{}) bool {
+	return !n.node.match(values)
+}
+
+func (n matchAllNode) match(values map[string]interface{}) bool {
+	return true
+}
+
+func (n compareNode) match(values map[string]interface{}) bool {
+	actual, ok := values[n.key]
+	if _, isMissing := n.value.(missingValue); isMissing {
+		switch n.op {
+		case "=":
+			return !ok
+		case "!=":
+			return ok
+		}
+		return false
+	}
+	if !ok {
+		return false
+	}
+
+	result, ok := compareQueryValues(actual, n.value)
+	if !ok {
+		return false
+	}
+	switch n.op {
+	case "=":
+		return result == 0
+	case "!=":
+		return result != 0
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	}
+	return false
+}
+
+func (n betweenNode) match(values map[string]interface{}) bool {
+	actual, ok := values[n.key]
+	if !ok {
+		return false
+	}
+	lower, ok := compareQueryValues(actual, n.from)
+	if !ok || lower < 0 {
+		return false
+	}
+	upper, ok := compareQueryValues(actual, n.to)
+	return ok && upper <= 0
+}
+
+func (n inNode) match(values map[string]interface{}) bool {
+	actual, ok := values[n.key]
+	if !ok {
+		return false
+	}
+	for _, value := range n.values {
+		if result, ok := compareQueryValues(actual, value); ok && result == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// compareQueryValues compares two values of the same kind, numbers are compared numerically,
+// strings which are both RFC3339 timestamps chronologically, and other strings lexically
+func compareQueryValues(a interface{}, b interface{}) (int, bool) {
+	switch x := a.(type) {
+	case int64:
+		switch y := b.(type) {
+		case int64:
+			return compareInt64(x, y), true
+		case float64:
+			return compareFloat64(float64(x), y), true
+		}
+	case float64:
+		switch y := b.(type) {
+		case int64:
+			return compareFloat64(x, float64(y)), true
+		case float64:
+			return compareFloat64(x, y), true
+		}
+	case string:
+		if y, ok := b.(string); ok {
+			tx, errX := time.Parse(time.RFC3339Nano, x)
+			ty, errY := time.Parse(time.RFC3339Nano, y)
+			if errX == nil && errY == nil {
+				return compareInt64(tx.UnixNano(), ty.UnixNano()), true
+			}
+			return strings.Compare(x, y), true
+		}
+	case bool:
+		if y, ok := b.(bool); ok {
+			switch {
+			case x == y:
+				return 0, true
+			case y:
+				return -1, true
+			default:
+				return 1, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func compareInt64(x int64, y int64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareFloat64(x float64, y float64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// getQueryValues returns the values of the system and custom search attributes of an execution,
+// attributes which are not set, e.g. the CloseTime of an open execution, are absent
+func getQueryValues(info *workflow.WorkflowExecutionInfo) map[string]interface{} {
+	values := map[string]interface{}{
+		definition.StartTime: info.GetStartTime(),
+	}
+	// the generated getters of nested structs are not nil safe
+	if info.Execution != nil {
+		values[definition.WorkflowID] = info.Execution.GetWorkflowId()
+		values[definition.RunID] = info.Execution.GetRunId()
+	}
+	if info.Type != nil {
+		values[definition.WorkflowType] = info.Type.GetName()
+	}
+	if info.CloseStatus != nil {
+		values[definition.CloseStatus] = int64(info.GetCloseStatus())
+		values[definition.CloseTime] = info.GetCloseTime()
+		values[definition.HistoryLength] = info.GetHistoryLength()
+	}
+	if info.SearchAttributes == nil {
+		return values
+	}
+
+	for key, data := range info.SearchAttributes.IndexedFields {
+		if definition.IsSystemIndexedKey(key) {
+			continue
+		}
+		if value, ok := decodeSearchAttributeValue(data); ok {
+			values[key] = value
+		}
+	}
+	return values
+}
+
+// decodeSearchAttributeValue decodes a json encoded search attribute value into int64, float64,
+// string or bool
+func decodeSearchAttributeValue(data []byte) (interface{}, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, false
+	}
+
+	switch v := value.(type) {
+	case json.Number:
+		return parseNumber(v.String())
+	case string, bool:
+		return v, true
+	}
+	return nil, false
+}
+
+func parseNumber(text string) (interface{}, bool) {
+	if i, err := strconv.ParseInt(text, 10, 64); err == nil {
+		return i, true
+	}
+	if f, err := strconv.ParseFloat(text, 64); err == nil {
+		return f, true
+	}
+	return nil, false
+}
+
+func (p *queryParser) peek() queryToken {
+	return p.tokens[p.pos]
+}
+
+func (p *queryParser) next() queryToken {
+	token := p.tokens[p.pos]
+	if token.tokenType != tokenEOF {
+		p.pos++
+	}
+	return token
+}
+
+func (p *queryParser) isKeyword(keyword string) bool {
+	token := p.peek()
+	return token.tokenType == tokenIdentifier && strings.EqualFold(token.text, keyword)
+}
+
+func (p *queryParser) expect(tokenType queryTokenType, text string) error {
+	token := p.next()
+	if token.tokenType != tokenType {
+		return fmt.Errorf("expected %v but found %q in query", text, token.text)
+	}
+	return nil
+}
+
+func (p *queryParser) parseOr() (queryNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("OR") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *queryParser) parseAnd() (queryNode, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("AND") {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *queryParser) parseNot() (queryNode, error) {
+	if p.isKeyword("NOT") {
+		p.next()
+		node, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{node: node}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *queryParser) parsePrimary() (queryNode, error) {
+	if p.peek().tokenType == tokenLeftParen {
+		p.next()
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokenRightParen, "')'"); err != nil {
+			return nil, err
+		}
+		return node, nil
+	}
+	return p.parseCondition()
+}
+
+func (p *queryParser) parseCondition() (queryNode, error) {
+	token := p.next()
+	if token.tokenType != tokenIdentifier || isReservedWord(token.text) {
+		return nil, fmt.Errorf("expected search attribute but found %q in query", token.text)
+	}
+	key := token.text
+	if p.validKeys != nil {
+		if _, ok := p.validKeys[key]; !ok {
+			return nil, fmt.Errorf("invalid search attribute %q in query", key)
+		}
+	}
+
+	negate := false
+	if p.isKeyword("NOT") {
+		p.next()
+		negate = true
+		if !p.isKeyword("BETWEEN") && !p.isKeyword("IN") {
+			return nil, fmt.Errorf("expected BETWEEN or IN after NOT in query")
+		}
+	}
+
+	var node queryNode
+	switch {
+	case p.isKeyword("BETWEEN"):
+		p.next()
+		from, err := p.parseValue(key, false)
+		if err != nil {
+			return nil, err
+		}
+		if !p.isKeyword("AND") {
+			return nil, fmt.Errorf("expected AND in BETWEEN condition of %v", key)
+		}
+		p.next()
+		to, err := p.parseValue(key, false)
+		if err != nil {
+			return nil, err
+		}
+		node = betweenNode{key: key, from: from, to: to}
+
+	case p.isKeyword("IN"):
+		p.next()
+		if err := p.expect(tokenLeftParen, "'('"); err != nil {
+			return nil, err
+		}
+		var values []interface{}
+		for {
+			value, err := p.parseValue(key, false)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+			if p.peek().tokenType != tokenComma {
+				break
+			}
+			p.next()
+		}
+		if err := p.expect(tokenRightParen, "')'"); err != nil {
+			return nil, err
+		}
+		node = inNode{key: key, values: values}
+
+	default:
+		opToken := p.next()
+		if opToken.tokenType != tokenOperator {
+			return nil, fmt.Errorf("expected operator after %v but found %q in query", key, opToken.text)
+		}
+		op := opToken.text
+		if op == "<>" {
+			op = "!="
+		}
+		value, err := p.parseValue(key, true)
+		if err != nil {
+			return nil, err
+		}
+		if _, isMissing := value.(missingValue); isMissing && op != "=" && op != "!=" {
+			return nil, fmt.Errorf("missing can only be used with = and != in query")
+		}
+		node = compareNode{key: key, op: op, value: value}
+	}
+
+	if negate {
+		node = notNode{node: node}
+	}
+	return node, nil
+}
+
+// parseValue parses a literal, converting the values of the system time and close status
+// attributes to the int64 values they are compared against
+func (p *queryParser) parseValue(key string, allowMissing bool) (interface{}, error) {
+	token := p.next()
+	var value interface{}
+	switch {
+	case token.tokenType == tokenLiteral:
+		value = token.value
+	case token.tokenType == tokenIdentifier && strings.EqualFold(token.text, "TRUE"):
+		value = true
+	case token.tokenType == tokenIdentifier && strings.EqualFold(token.text, "FALSE"):
+		value = false
+	case token.tokenType == tokenIdentifier && strings.EqualFold(token.text, "MISSING") && allowMissing:
+		return missingValue{}, nil
+	default:
+		return nil, fmt.Errorf("expected value for %v but found %q in query", key, token.text)
+	}
+
+	text, isString := value.(string)
+	if !isString {
+		return value, nil
+	}
+	switch key {
+	case definition.StartTime, definition.CloseTime:
+		t, err := time.Parse(time.RFC3339Nano, text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time %q for %v in query", text, key)
+		}
+		return t.UnixNano(), nil
+	case definition.CloseStatus:
+		var status workflow.WorkflowExecutionCloseStatus
+		if err := status.UnmarshalText([]byte(strings.ToUpper(text))); err != nil {
+			return nil, fmt.Errorf("invalid close status %q in query", text)
+		}
+		return int64(status), nil
+	}
+	return value, nil
+}
+
+func isReservedWord(text string) bool {
+	switch strings.ToUpper(text) {
+	case "AND", "OR", "NOT", "BETWEEN", "IN", "TRUE", "FALSE", "MISSING":
+		return true
+	}
+	return false
+}
+
+func tokenizeQuery(query string) ([]queryToken, error) {
+	var tokens []queryToken
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '(':
+			tokens = append(tokens, queryToken{tokenType: tokenLeftParen, text: "("})
+			i++
+
+		case r == ')':
+			tokens = append(tokens, queryToken{tokenType: tokenRightParen, text: ")"})
+			i++
+
+		case r == ',':
+			tokens = append(tokens, queryToken{tokenType: tokenComma, text: ","})
+			i++
+
+		case r == '=' || r == '!' || r == '<' || r == '>':
+			op := string(r)
+			if i+1 < len(runes) && (runes[i+1] == '=' || (r == '<' && runes[i+1] == '>')) {
+				op += string(runes[i+1])
+			}
+			if op == "!" {
+				return nil, fmt.Errorf("invalid operator ! in query")
+			}
+			tokens = append(tokens, queryToken{tokenType: tokenOperator, text: op})
+			i += len(op)
+
+		case r == '\'' || r == '"':
+			var sb bytes.Buffer
+			j := i + 1
+			for ; j < len(runes) && runes[j] != r; j++ {
+				if runes[j] == '\\' && j+1 < len(runes) {
+					j++
+				}
+				sb.WriteRune(runes[j])
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated string in query")
+			}
+			tokens = append(tokens, queryToken{tokenType: tokenLiteral, text: string(runes[i : j+1]), value: sb.String()})
+			i = j + 1
+
+		case r == '-' || r == '.' || unicode.IsDigit(r):
+			j := i + 1
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || strings.ContainsRune(".eE+-", runes[j])) {
+				j++
+			}
+			text := string(runes[i:j])
+			value, ok := parseNumber(text)
+			if !ok {
+				return nil, fmt.Errorf("invalid number %q in query", text)
+			}
+			tokens = append(tokens, queryToken{tokenType: tokenLiteral, text: text, value: value})
+			i = j
+
+		case r == '_' || unicode.IsLetter(r):
+			j := i + 1
+			for j < len(runes) && (runes[j] == '_' || unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j])) {
+				j++
+			}
+			tokens = append(tokens, queryToken{tokenType: tokenIdentifier, text: string(runes[i:j])})
+			i = j
+
+		default:
+			return nil, fmt.Errorf("unexpected character %q in query", r)
+		}
+	}
+	return append(tokens, queryToken{tokenType: tokenEOF, text: "end of query"}), nil
+}
@@ -0,0 +1,297 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+)
+
+type (
+	visibilityQuerySuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+
+		startTime time.Time
+		open      *workflow.WorkflowExecutionInfo
+		closed    *workflow.WorkflowExecutionInfo
+	}
+
+	// pagingVisibilityManager serves ListOpenWorkflowExecutions and ListClosedWorkflowExecutions
+	// from memory, the page token is the index of the next execution
+	pagingVisibilityManager struct {
+		VisibilityManager
+		open   []*workflow.WorkflowExecutionInfo
+		closed []*workflow.WorkflowExecutionInfo
+		calls  int
+	}
+)
+
+func TestVisibilityQuerySuite(t *testing.T) {
+	s := new(visibilityQuerySuite)
+	suite.Run(t, s)
+}
+
+func (s *visibilityQuerySuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+
+	s.startTime = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.open = &workflow.WorkflowExecutionInfo{
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr("order-1"),
+			RunId:      common.StringPtr("run-1"),
+		},
+		Type:      &workflow.WorkflowType{Name: common.StringPtr("OrderWorkflow")},
+		StartTime: common.Int64Ptr(s.startTime.UnixNano()),
+		SearchAttributes: &workflow.SearchAttributes{
+			IndexedFields: map[string][]byte{
+				definition.CustomKeywordField:  []byte(`"customer-x"`),
+				definition.CustomIntField:      []byte(`42`),
+				definition.CustomDoubleField:   []byte(`1.5`),
+				definition.CustomBoolField:     []byte(`true`),
+				definition.CustomDatetimeField: []byte(`"2019-01-02T00:00:00Z"`),
+			},
+		},
+	}
+	s.closed = &workflow.WorkflowExecutionInfo{
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr("order-2"),
+			RunId:      common.StringPtr("run-2"),
+		},
+		Type:          &workflow.WorkflowType{Name: common.StringPtr("OrderWorkflow")},
+		StartTime:     common.Int64Ptr(s.startTime.Add(time.Hour).UnixNano()),
+		CloseTime:     common.Int64Ptr(s.startTime.Add(2 * time.Hour).UnixNano()),
+		CloseStatus:   workflow.WorkflowExecutionCloseStatusFailed.Ptr(),
+		HistoryLength: common.Int64Ptr(10),
+	}
+}
+
+func (s *visibilityQuerySuite) match(query string, info *workflow.WorkflowExecutionInfo) bool {
+	q, err := ParseVisibilityQuery(query, nil)
+	s.NoError(err, query)
+	return q.Match(info)
+}
+
+func (s *visibilityQuerySuite) TestMatch() {
+	testCases := []struct {
+		query  string
+		open   bool
+		closed bool
+	}{
+		{``, true, true},
+		{`WorkflowType = 'OrderWorkflow'`, true, true},
+		{`WorkflowID = "order-1"`, true, false},
+		{`WorkflowID != "order-1"`, false, true},
+		{`WorkflowID <> "order-1"`, false, true},
+		{`CustomKeywordField = 'customer-x'`, true, false},
+		{`CustomKeywordField = missing`, false, true},
+		{`CustomKeywordField != missing`, true, false},
+		{`CustomIntField > 41 AND CustomIntField <= 42`, true, false},
+		{`CustomIntField = 42.0`, true, false},
+		{`CustomDoubleField BETWEEN 1 AND 2`, true, false},
+		{`CustomDoubleField NOT BETWEEN 1 AND 2`, false, true},
+		{`CustomBoolField = true`, true, false},
+		{`CustomBoolField = false`, false, false},
+		{`CustomDatetimeField > "2019-01-01T23:00:00Z"`, true, false},
+		{`CustomDatetimeField < "2019-01-01T23:00:00+02:00"`, false, false},
+		{`CloseTime = missing`, true, false},
+		{`CloseStatus = 'FAILED'`, false, true},
+		{`CloseStatus = 1`, false, true},
+		{`CloseStatus != 1`, false, false},
+		{`HistoryLength >= 10`, false, true},
+		{`StartTime >= "2019-01-01T00:30:00Z"`, false, true},
+		{`StartTime = ` + strconv.FormatInt(s.startTime.UnixNano(), 10), true, false},
+		{`WorkflowID IN ('order-1', 'order-3')`, true, false},
+		{`WorkflowID NOT IN ('order-1', 'order-3')`, false, true},
+		{`NOT WorkflowID = 'order-1'`, false, true},
+		{`WorkflowID = 'order-1' OR CloseStatus = 'FAILED'`, true, true},
+		{`(WorkflowID = 'order-1' OR WorkflowID = 'order-2') AND CustomIntField = 42`, true, false},
+		{`WorkflowID = 'order-1' OR WorkflowID = 'order-2' AND CustomIntField = 42`, true, false},
+		{`workflowtype = 'OrderWorkflow'`, false, false},
+		{`CustomKeywordField = 42`, false, false},
+	}
+
+	for _, tc := range testCases {
+		s.Equal(tc.open, s.match(tc.query, s.open), tc.query)
+		s.Equal(tc.closed, s.match(tc.query, s.closed), tc.query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestParseErrors() {
+	for _, query := range []string{
+		`WorkflowID`,
+		`WorkflowID =`,
+		`WorkflowID = 'order-1' AND`,
+		`WorkflowID = 'order-1`,
+		`(WorkflowID = 'order-1'`,
+		`WorkflowID = 'order-1')`,
+		`WorkflowID ! 'order-1'`,
+		`WorkflowID IN ()`,
+		`WorkflowID BETWEEN 1`,
+		`WorkflowID > missing`,
+		`WorkflowID NOT = 'order-1'`,
+		`AND = 1`,
+		`StartTime > 'yesterday'`,
+		`CloseStatus = 'DONE'`,
+		`CustomIntField = 1x`,
+		`CustomIntField = @`,
+	} {
+		_, err := ParseVisibilityQuery(query, nil)
+		s.Error(err, query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestValidKeys() {
+	validKeys, err := definition.GetIndexedKeys(map[string]interface{}{"CustomerID": "KEYWORD"})
+	s.NoError(err)
+
+	_, err = ParseVisibilityQuery(`CustomerID = 'x' AND WorkflowType = 'OrderWorkflow'`, validKeys)
+	s.NoError(err)
+	_, err = ParseVisibilityQuery(`CustomIntField = 1`, validKeys)
+	s.Error(err)
+}
+
+func (s *visibilityQuerySuite) TestHints() {
+	q, err := ParseVisibilityQuery(`StartTime >= 10 AND StartTime < 20 AND CloseTime = missing`, nil)
+	s.NoError(err)
+	s.Equal(int64(10), q.EarliestStartTime)
+	s.Equal(int64(19), q.LatestStartTime)
+	s.True(q.OpenOnly)
+	s.False(q.ClosedOnly)
+
+	q, err = ParseVisibilityQuery(`StartTime BETWEEN 10 AND 20 AND CloseStatus = 'COMPLETED'`, nil)
+	s.NoError(err)
+	s.Equal(int64(10), q.EarliestStartTime)
+	s.Equal(int64(20), q.LatestStartTime)
+	s.False(q.OpenOnly)
+	s.True(q.ClosedOnly)
+
+	// conditions below OR and NOT do not narrow the query
+	q, err = ParseVisibilityQuery(`StartTime >= 10 OR NOT CloseTime = missing`, nil)
+	s.NoError(err)
+	s.Equal(int64(0), q.EarliestStartTime)
+	s.False(q.OpenOnly)
+	s.False(q.ClosedOnly)
+
+	q, err = ParseVisibilityQuery(`CloseTime = missing AND CloseStatus = 'FAILED'`, nil)
+	s.NoError(err)
+	s.True(q.MatchesNothing())
+}
+
+func (s *visibilityQuerySuite) TestListWorkflowExecutionsByQuery() {
+	visibilityMgr := &pagingVisibilityManager{}
+	for i := 0; i < 5; i++ {
+		visibilityMgr.open = append(visibilityMgr.open, s.execution(i, false))
+		visibilityMgr.closed = append(visibilityMgr.closed, s.execution(i, true))
+	}
+
+	request := &ListWorkflowExecutionsByQueryRequest{
+		PageSize: 3,
+		Query:    `CustomIntField >= 1`,
+	}
+	var workflowIDs []string
+	for {
+		response, err := ListWorkflowExecutionsByQuery(visibilityMgr, request)
+		s.NoError(err)
+		s.True(len(response.Executions) <= request.PageSize)
+		for _, execution := range response.Executions {
+			workflowIDs = append(workflowIDs, execution.Execution.GetWorkflowId())
+		}
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal([]string{"open-1", "open-2", "open-3", "open-4", "closed-1", "closed-2", "closed-3", "closed-4"}, workflowIDs)
+
+	visibilityMgr.calls = 0
+	response, err := ListWorkflowExecutionsByQuery(visibilityMgr, &ListWorkflowExecutionsByQueryRequest{
+		PageSize: 10,
+		Query:    `CloseStatus = 'COMPLETED' AND CustomIntField < 2`,
+	})
+	s.NoError(err)
+	s.Equal(2, len(response.Executions))
+	s.Empty(response.NextPageToken)
+	s.Equal(1, visibilityMgr.calls)
+
+	_, err = ListWorkflowExecutionsByQuery(visibilityMgr, &ListWorkflowExecutionsByQueryRequest{
+		PageSize: 10,
+		Query:    `CustomIntField >=`,
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *visibilityQuerySuite) execution(i int, closed bool) *workflow.WorkflowExecutionInfo {
+	prefix := "open-"
+	info := &workflow.WorkflowExecutionInfo{
+		Execution: &workflow.WorkflowExecution{
+			RunId: common.StringPtr(strconv.Itoa(i)),
+		},
+		StartTime: common.Int64Ptr(s.startTime.UnixNano()),
+		SearchAttributes: &workflow.SearchAttributes{
+			IndexedFields: map[string][]byte{definition.CustomIntField: []byte(strconv.Itoa(i))},
+		},
+	}
+	if closed {
+		prefix = "closed-"
+		info.CloseStatus = workflow.WorkflowExecutionCloseStatusCompleted.Ptr()
+		info.CloseTime = common.Int64Ptr(s.startTime.Add(time.Hour).UnixNano())
+	}
+	info.Execution.WorkflowId = common.StringPtr(prefix + strconv.Itoa(i))
+	return info
+}
+
+func (m *pagingVisibilityManager) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	return m.list(m.open, request)
+}
+
+func (m *pagingVisibilityManager) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	return m.list(m.closed, request)
+}
+
+func (m *pagingVisibilityManager) list(
+	executions []*workflow.WorkflowExecutionInfo,
+	request *ListWorkflowExecutionsRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	m.calls++
+	start := 0
+	if len(request.NextPageToken) > 0 {
+		start, _ = strconv.Atoi(string(request.NextPageToken))
+	}
+	end := start + request.PageSize
+	if end >= len(executions) {
+		return &ListWorkflowExecutionsResponse{Executions: executions[start:]}, nil
+	}
+	return &ListWorkflowExecutionsResponse{
+		Executions:    executions[start:end],
+		NextPageToken: []byte(strconv.Itoa(end)),
+	}, nil
+}
@@ -121,6 +121,19 @@ func (p *visibilitySamplingClient) RecordWorkflowExecutionClosed(request *Record
 	return nil
 }
 
+func (p *visibilitySamplingClient) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
+	domain := request.Domain
+
+	rateLimiter := p.rateLimitersForOpen.getRateLimiter(domain, numOfPriorityForOpen, p.config.VisibilityOpenMaxQPS(domain))
+	if ok, _ := rateLimiter.GetToken(0, 1); ok {
+		return p.persistence.UpsertWorkflowExecution(request)
+	}
+
+	logging.LogOpenWorkflowSampled(p.logger, domain, request.Execution.GetWorkflowId(), request.Execution.GetRunId(), request.WorkflowTypeName)
+	p.metricClient.IncCounter(metrics.PersistenceUpsertWorkflowExecutionScope, metrics.PersistenceSampledCounter)
+	return nil
+}
+
 func (p *visibilitySamplingClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	domain := request.Domain
 
@@ -198,10 +211,25 @@ func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByStatus(request
 	return p.persistence.ListClosedWorkflowExecutionsByStatus(request)
 }
 
+func (p *visibilitySamplingClient) ListWorkflowExecutions(request *ListWorkflowExecutionsByQueryRequest) (*ListWorkflowExecutionsResponse, error) {
+	domain := request.Domain
+
+	rateLimiter := p.rateLimitersForList.getRateLimiter(domain, numOfPriorityForList, p.config.VisibilityListMaxQPS(domain))
+	if ok, _ := rateLimiter.GetToken(0, 1); !ok {
+		return nil, ErrPersistenceLimitExceededForList
+	}
+
+	return p.persistence.ListWorkflowExecutions(request)
+}
+
 func (p *visibilitySamplingClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
 	return p.persistence.GetClosedWorkflowExecution(request)
 }
 
+func (p *visibilitySamplingClient) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
+	return p.persistence.DeleteWorkflowExecution(request)
+}
+
 func (p *visibilitySamplingClient) Close() {
 	p.persistence.Close()
 }
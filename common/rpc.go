@@ -43,6 +43,11 @@ const (
 	// ClientImplHeaderName refers to the name of the
 	// header that contains the client implementation
 	ClientImplHeaderName = "cadence-client-name"
+
+	// CallerIdentityHeaderName refers to the name of the
+	// header that contains the identity of the caller
+	// which requests are authorized for
+	CallerIdentityHeaderName = "cadence-caller-identity"
 )
 
 type (
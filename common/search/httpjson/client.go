@@ -0,0 +1,155 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package httpjson
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/search"
+)
+
+// The backend is expected to serve two endpoints for every index:
+//   POST <url>/<index>/_bulk   with a bulkRequest, returning a bulkResponse
+//   POST <url>/<index>/_query  with a search.QueryRequest, returning a search.QueryResponse
+// Failed requests return a non 200 status code with an errorResponse, a bad query is reported with 400.
+const (
+	bulkPath  = "_bulk"
+	queryPath = "_query"
+
+	defaultTimeout = 10 * time.Second
+)
+
+type (
+	client struct {
+		indexURL   string
+		httpClient *http.Client
+	}
+
+	bulkRequest struct {
+		Documents []*search.Document `json:"documents"`
+	}
+
+	// bulkResponse has an item per document of the request, in the same order
+	bulkResponse struct {
+		Items []bulkItem `json:"items"`
+	}
+
+	// bulkItem is the result of indexing a document, the status is 200 if the document was indexed,
+	// 409 if a newer version of the document is already indexed and any other code if it failed
+	bulkItem struct {
+		Status int    `json:"status"`
+		Error  string `json:"error,omitempty"`
+	}
+
+	errorResponse struct {
+		Error string `json:"error"`
+	}
+)
+
+var _ search.Client = (*client)(nil)
+
+// NewClient returns a new search client which talks HTTP/JSON to the backend
+func NewClient(cfg *Config) (search.Client, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	return &client{
+		indexURL:   strings.TrimSuffix(cfg.URL, "/") + "/" + cfg.Index,
+		httpClient: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (c *client) Bulk(ctx context.Context, documents []*search.Document) ([]error, error) {
+	response := &bulkResponse{}
+	if err := c.post(ctx, bulkPath, &bulkRequest{Documents: documents}, response); err != nil {
+		return nil, err
+	}
+	if len(response.Items) != len(documents) {
+		return nil, fmt.Errorf("bulk response has %v items for %v documents", len(response.Items), len(documents))
+	}
+
+	errs := make([]error, len(documents))
+	for i, item := range response.Items {
+		switch item.Status {
+		case http.StatusOK:
+		case http.StatusConflict:
+			errs[i] = search.ErrVersionConflict
+		default:
+			errs[i] = fmt.Errorf("failed to index document, status %v: %v", item.Status, item.Error)
+		}
+	}
+	return errs, nil
+}
+
+func (c *client) Query(ctx context.Context, request *search.QueryRequest) (*search.QueryResponse, error) {
+	response := &search.QueryResponse{}
+	if err := c.post(ctx, queryPath, request, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *client) post(ctx context.Context, path string, request interface{}, response interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	httpRequest, err := http.NewRequest(http.MethodPost, c.indexURL+"/"+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	httpResponse, err := c.httpClient.Do(httpRequest.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+	data, err := ioutil.ReadAll(httpResponse.Body)
+	if err != nil {
+		return err
+	}
+
+	if httpResponse.StatusCode != http.StatusOK {
+		message := strings.TrimSpace(string(data))
+		errResponse := &errorResponse{}
+		if err := json.Unmarshal(data, errResponse); err == nil && len(errResponse.Error) > 0 {
+			message = errResponse.Error
+		}
+		if httpResponse.StatusCode == http.StatusBadRequest {
+			return &workflow.BadRequestError{Message: message}
+		}
+		return fmt.Errorf("search backend request %v failed, status %v: %v", path, httpResponse.StatusCode, message)
+	}
+	return json.Unmarshal(data, response)
+}
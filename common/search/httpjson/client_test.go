@@ -0,0 +1,155 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package httpjson
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/search"
+)
+
+type ClientSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	server  *httptest.Server
+	handler http.HandlerFunc
+	client  search.Client
+}
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(ClientSuite))
+}
+
+func (s *ClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.handler(w, r)
+	}))
+	var err error
+	s.client, err = NewClient(&Config{URL: s.server.URL + "/", Index: "test-index"})
+	s.NoError(err)
+}
+
+func (s *ClientSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *ClientSuite) TestNewClientInvalidConfig() {
+	client, err := NewClient(&Config{URL: "http://127.0.0.1:9200"})
+	s.Error(err)
+	s.Nil(client)
+}
+
+func (s *ClientSuite) TestBulk() {
+	documents := []*search.Document{
+		{DomainID: "domain-id", WorkflowID: "wid-1", RunID: "rid-1", Version: 1},
+		{DomainID: "domain-id", WorkflowID: "wid-2", RunID: "rid-2", Version: 2},
+		{DomainID: "domain-id", WorkflowID: "wid-3", RunID: "rid-3", Version: 3},
+	}
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		s.Equal(http.MethodPost, r.Method)
+		s.Equal("/test-index/_bulk", r.URL.Path)
+		request := &bulkRequest{}
+		s.NoError(json.NewDecoder(r.Body).Decode(request))
+		s.Equal(documents, request.Documents)
+		json.NewEncoder(w).Encode(&bulkResponse{Items: []bulkItem{
+			{Status: http.StatusOK},
+			{Status: http.StatusConflict},
+			{Status: http.StatusInternalServerError, Error: "disk full"},
+		}})
+	}
+
+	errs, err := s.client.Bulk(context.Background(), documents)
+	s.NoError(err)
+	s.Len(errs, 3)
+	s.NoError(errs[0])
+	s.Equal(search.ErrVersionConflict, errs[1])
+	s.Error(errs[2])
+	s.Contains(errs[2].Error(), "disk full")
+}
+
+func (s *ClientSuite) TestBulk_Failed() {
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(w).Encode(&errorResponse{Error: "unavailable"})
+	}
+
+	errs, err := s.client.Bulk(context.Background(), []*search.Document{{WorkflowID: "wid"}})
+	s.Error(err)
+	s.Contains(err.Error(), "unavailable")
+	s.Nil(errs)
+}
+
+func (s *ClientSuite) TestBulk_ItemCountMismatch() {
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&bulkResponse{})
+	}
+
+	_, err := s.client.Bulk(context.Background(), []*search.Document{{WorkflowID: "wid"}})
+	s.Error(err)
+}
+
+func (s *ClientSuite) TestQuery() {
+	closeTime := int64(200)
+	request := &search.QueryRequest{
+		DomainID:      "domain-id",
+		Query:         "WorkflowType = 'type'",
+		PageSize:      10,
+		NextPageToken: []byte("token"),
+	}
+	response := &search.QueryResponse{
+		Documents: []*search.Document{
+			{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", StartTime: 100, CloseTime: &closeTime},
+		},
+		NextPageToken: []byte("next-token"),
+	}
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		s.Equal("/test-index/_query", r.URL.Path)
+		s.Equal("application/json", r.Header.Get("Content-Type"))
+		actual := &search.QueryRequest{}
+		s.NoError(json.NewDecoder(r.Body).Decode(actual))
+		s.Equal(request, actual)
+		json.NewEncoder(w).Encode(response)
+	}
+
+	actual, err := s.client.Query(context.Background(), request)
+	s.NoError(err)
+	s.Equal(response, actual)
+}
+
+func (s *ClientSuite) TestQuery_BadRequest() {
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(&errorResponse{Error: "unexpected \"=\" in query"})
+	}
+
+	_, err := s.client.Query(context.Background(), &search.QueryRequest{Query: "= 1"})
+	s.IsType(&workflow.BadRequestError{}, err)
+	s.Equal("unexpected \"=\" in query", err.(*workflow.BadRequestError).Message)
+}
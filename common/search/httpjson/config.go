@@ -0,0 +1,56 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package httpjson
+
+import (
+	"errors"
+	"net/url"
+	"time"
+)
+
+type (
+	// Config describes the configuration needed to construct a search client which talks HTTP/JSON to the backend
+	Config struct {
+		// URL is the base url of the backend, e.g. http://127.0.0.1:9200
+		URL string `yaml:"url"`
+		// Index is the name of the index the documents of this cluster are stored in
+		Index string `yaml:"index"`
+		// Timeout is the timeout of a single request, 10s by default
+		Timeout time.Duration `yaml:"timeout"`
+	}
+)
+
+// Validate validates config
+func (c *Config) Validate() error {
+	if len(c.URL) == 0 {
+		return errors.New("empty url")
+	}
+	if _, err := url.Parse(c.URL); err != nil {
+		return err
+	}
+	if len(c.Index) == 0 {
+		return errors.New("empty index")
+	}
+	if c.Timeout < 0 {
+		return errors.New("negative timeout")
+	}
+	return nil
+}
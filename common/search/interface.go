@@ -0,0 +1,79 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package search
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrVersionConflict is returned for a document of a bulk request which was not indexed because
+	// a document with a higher version is already indexed
+	ErrVersionConflict = errors.New("a newer version of the document is already indexed")
+)
+
+type (
+	// Document is the record of a workflow execution in the search backend, the close attributes are
+	// only set for closed executions
+	Document struct {
+		DomainID         string            `json:"domainID"`
+		WorkflowID       string            `json:"workflowID"`
+		RunID            string            `json:"runID"`
+		WorkflowType     string            `json:"workflowType"`
+		StartTime        int64             `json:"startTime"`
+		CloseTime        *int64            `json:"closeTime,omitempty"`
+		CloseStatus      *int32            `json:"closeStatus,omitempty"`
+		HistoryLength    *int64            `json:"historyLength,omitempty"`
+		Memo             map[string][]byte `json:"memo,omitempty"`
+		SearchAttributes map[string][]byte `json:"searchAttributes,omitempty"`
+		// Version orders the writes of the document, a document is only replaced by one with a
+		// version which is at least as high
+		Version int64 `json:"version"`
+	}
+
+	// QueryRequest lists the documents of a domain which match a query, the query uses the syntax
+	// of persistence.VisibilityQuery. The documents are returned ordered by start time, latest first.
+	QueryRequest struct {
+		DomainID string `json:"domainID"`
+		Query    string `json:"query"`
+		PageSize int    `json:"pageSize"`
+		// NextPageToken is the token returned with the previous page, empty for the first page
+		NextPageToken []byte `json:"nextPageToken,omitempty"`
+	}
+
+	// QueryResponse is a page of documents which match a QueryRequest
+	QueryResponse struct {
+		Documents []*Document `json:"documents"`
+		// NextPageToken is empty if there are no more documents
+		NextPageToken []byte `json:"nextPageToken,omitempty"`
+	}
+
+	// Client is the interface of the search backend workflow executions are indexed into by the indexer
+	// of the worker service and listed from by the frontend
+	Client interface {
+		// Bulk indexes the documents, it returns an error per document, which is nil if the document was
+		// indexed, and an error if the request as a whole failed
+		Bulk(ctx context.Context, documents []*Document) ([]error, error)
+		// Query lists the documents which match the request
+		Query(ctx context.Context, request *QueryRequest) (*QueryResponse, error)
+	}
+)
@@ -22,11 +22,15 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/uber/cadence/common/blobstore/filestore"
 	"time"
 
 	"github.com/uber-go/tally/m3"
+	"github.com/uber-go/tally/prometheus"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/search/httpjson"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/ringpop-go/discovery"
 )
@@ -48,6 +52,12 @@ type (
 		Kafka messaging.KafkaConfig `yaml:"kafka"`
 		// Archival is the config for archival
 		Archival Archival `yaml:"archival"`
+		// Search is the config for connecting to the search backend visibility records are indexed into, optional
+		Search *httpjson.Config `yaml:"search"`
+		// Authorization is the config of the role based authorizer of the frontend APIs, all calls are allowed if not set
+		Authorization *authorization.Config `yaml:"authorization"`
+		// DynamicConfigClient is the config for setting up the file based dynamic config client
+		DynamicConfigClient dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
 	}
 
 	// Service contains the service specific config items
@@ -76,6 +86,8 @@ type (
 		DisableLogging bool `yaml:"disableLogging"`
 		// LogLevel is the desired log level
 		LogLevel string `yaml:"logLevel"`
+		// TLS is the TLS configuration of the inbound and outbound connections of the service
+		TLS TLS `yaml:"tls"`
 	}
 
 	// Ringpop contains the ringpop config items
@@ -118,6 +130,8 @@ type (
 		Cassandra *Cassandra `yaml:"cassandra"`
 		// SQL contains the config for a SQL based datastore
 		SQL *SQL `yaml:"sql"`
+		// Memory contains the config for an in-memory datastore
+		Memory *Memory `yaml:"memory"`
 	}
 
 	// SamplingConfig is config for visibility sampling
@@ -158,7 +172,7 @@ type (
 		User string `yaml:"user"`
 		// Password is the password corresponding to the user name
 		Password string `yaml:"password"`
-		// DriverName is the name of SQL driver
+		// DriverName is the name of SQL driver, one of mysql or postgres
 		DriverName string `yaml:"driverName" validate:"nonzero"`
 		// DatabaseName is the name of SQL database to connect to
 		DatabaseName string `yaml:"databaseName" validate:"nonzero"`
@@ -172,6 +186,14 @@ type (
 		MaxConns int `yaml:"maxConns"`
 	}
 
+	// Memory is the configuration for a datastore which keeps all of its data in the
+	// memory of the process, the data is lost when the process exits
+	Memory struct {
+		// Name is the name of the in-memory database, the datastores with the same
+		// name share their data within a process
+		Name string `yaml:"name" validate:"nonzero"`
+	}
+
 	// Replicator describes the configuration of replicator
 	Replicator struct{}
 
@@ -201,6 +223,11 @@ type (
 		ClusterInitialFailoverVersions map[string]int64 `yaml:"clusterInitialFailoverVersion"`
 		// ClusterAddress contains all cluster names to corresponding address
 		ClusterAddress map[string]Address `yaml:"clusterAddress"`
+		// ReplicationMode is how the replication tasks are shipped between clusters, either "kafka" (default),
+		// where they are published to kafka by the active cluster, or "pull", where the history hosts of the
+		// other clusters long poll the history replication tasks from the active cluster and their workers poll
+		// the changed domains. All the clusters replicating with each other must use the same mode.
+		ReplicationMode string `yaml:"replicationMode"`
 	}
 
 	// Address indicate the remote cluster's service name and address
@@ -209,6 +236,33 @@ type (
 		RPCName string `yaml:"rpcName"`
 		// Address indicate the remote service IP address
 		RPCAddress string `yaml:"rpcAddress"`
+		// TLS is the TLS configuration of the connections to the remote cluster
+		TLS TLS `yaml:"tls"`
+	}
+
+	// TLS describes the TLS configuration of a tchannel transport. The certificate, key and CA bundle
+	// are reloaded when their files change.
+	TLS struct {
+		// Enabled is true if the connections are encrypted
+		Enabled bool `yaml:"enabled"`
+		// CertFile is the PEM encoded certificate presented to the peers, required for inbound connections
+		CertFile string `yaml:"certFile"`
+		// KeyFile is the PEM encoded private key of the certificate
+		KeyFile string `yaml:"keyFile"`
+		// CAFile is the PEM encoded CA bundle the certificates of the peers are verified with,
+		// the system roots are used for the servers if empty
+		CAFile string `yaml:"caFile"`
+		// RequireClientCert is true if inbound connections have to present a client certificate
+		// which is signed by the CA bundle
+		RequireClientCert bool `yaml:"requireClientCert"`
+		// InternalIdentities are the common names of the client certificates of the cadence services,
+		// only the calls from these services are trusted to pass on the identity of their own callers
+		InternalIdentities []string `yaml:"internalIdentities"`
+		// ServerName is the name the certificates of the servers are verified against,
+		// the host of the address is used if empty
+		ServerName string `yaml:"serverName"`
+		// ReloadInterval is how often the files are checked for changes, 1m by default
+		ReloadInterval time.Duration `yaml:"reloadInterval"`
 	}
 
 	// Metrics contains the config items for metrics subsystem
@@ -217,6 +271,8 @@ type (
 		M3 *m3.Configuration `yaml:"m3"`
 		// Statsd is the configuration for statsd reporter
 		Statsd *Statsd `yaml:"statsd"`
+		// Prometheus is the configuration for prometheus reporter
+		Prometheus *prometheus.Configuration `yaml:"prometheus"`
 		// Tags is the set of key-value pairs to be reported
 		// as part of every metric
 		Tags map[string]string `yaml:"tags"`
@@ -249,9 +305,42 @@ type (
 	BootstrapMode int
 )
 
+const (
+	// ReplicationModeKafka is the replication mode where the history replication tasks are published to kafka
+	ReplicationModeKafka = "kafka"
+	// ReplicationModePull is the replication mode where the replication tasks are pulled by the other clusters
+	ReplicationModePull = "pull"
+)
+
 // Validate validates this config
 func (c *Config) Validate() error {
-	return c.Persistence.Validate()
+	if err := c.Persistence.Validate(); err != nil {
+		return err
+	}
+	if c.Authorization != nil {
+		if err := c.Authorization.Validate(); err != nil {
+			return err
+		}
+	}
+	for name, svc := range c.Services {
+		if err := svc.RPC.TLS.Validate(); err != nil {
+			return fmt.Errorf("services: %v: %v", name, err)
+		}
+		if svc.RPC.TLS.Enabled && svc.RPC.TLS.CertFile == "" {
+			return fmt.Errorf("services: %v: tls: certFile must be provided for inbound connections", name)
+		}
+	}
+	switch c.ClustersInfo.ReplicationMode {
+	case "", ReplicationModeKafka, ReplicationModePull:
+	default:
+		return fmt.Errorf("clustersInfo: unknown replicationMode %q", c.ClustersInfo.ReplicationMode)
+	}
+	for name, address := range c.ClustersInfo.ClusterAddress {
+		if err := address.TLS.Validate(); err != nil {
+			return fmt.Errorf("clustersInfo: %v: %v", name, err)
+		}
+	}
+	return nil
 }
 
 // String converts the config object into a string
@@ -22,13 +22,40 @@ package config
 
 import (
 	"github.com/cactus/go-statsd-client/statsd"
+	prom "github.com/prometheus/client_golang/prometheus"
 	"github.com/uber-go/tally"
+	"github.com/uber-go/tally/prometheus"
 	tallystatsdreporter "github.com/uber-go/tally/statsd"
+	prometheusreporter "github.com/uber/cadence/common/metrics/tally/prometheus"
 	statsdreporter "github.com/uber/cadence/common/metrics/tally/statsd"
 	"log"
 	"time"
 )
 
+const (
+	// prometheusHistogramTimerType reports tally timers as prometheus histograms
+	prometheusHistogramTimerType = "histogram"
+)
+
+// prometheusSanitizeOptions turns metric names such as cadence.errors.bad-request
+// into valid prometheus names such as cadence_errors_bad_request. Tag values keep
+// dashes and dots since they often carry domain and task list names
+var prometheusSanitizeOptions = tally.SanitizeOptions{
+	NameCharacters: tally.ValidCharacters{
+		Ranges:     tally.AlphanumericRange,
+		Characters: tally.UnderscoreCharacters,
+	},
+	KeyCharacters: tally.ValidCharacters{
+		Ranges:     tally.AlphanumericRange,
+		Characters: tally.UnderscoreCharacters,
+	},
+	ValueCharacters: tally.ValidCharacters{
+		Ranges:     tally.AlphanumericRange,
+		Characters: tally.UnderscoreDashDotCharacters,
+	},
+	ReplacementCharacter: tally.DefaultReplacementCharacter,
+}
+
 // NewScope builds a new tally scope
 // for this metrics configuration
 //
@@ -36,7 +63,8 @@ import (
 // valid for multiple reporter types,
 // only one of them will be used for
 // reporting. Currently, m3 is preferred
-// over statsd
+// over statsd, which is preferred over
+// prometheus
 func (c *Metrics) NewScope() tally.Scope {
 	if c.M3 != nil {
 		return c.newM3Scope()
@@ -44,6 +72,9 @@ func (c *Metrics) NewScope() tally.Scope {
 	if c.Statsd != nil {
 		return c.newStatsdScope()
 	}
+	if c.Prometheus != nil {
+		return c.newPrometheusScope()
+	}
 	return tally.NoopScope
 }
 
@@ -62,7 +93,7 @@ func (c *Metrics) newM3Scope() tally.Scope {
 	return scope
 }
 
-// newM3Scope returns a new statsd scope with
+// newStatsdScope returns a new statsd scope with
 // a default reporting interval of a second
 func (c *Metrics) newStatsdScope() tally.Scope {
 	config := c.Statsd
@@ -83,3 +114,34 @@ func (c *Metrics) newStatsdScope() tally.Scope {
 	scope, _ := tally.NewRootScope(scopeOpts, time.Second)
 	return scope
 }
+
+// newPrometheusScope returns a new prometheus scope which serves
+// its metrics over http, timers are reported as histograms unless
+// configured otherwise
+func (c *Metrics) newPrometheusScope() tally.Scope {
+	config := *c.Prometheus
+	if len(config.TimerType) == 0 {
+		config.TimerType = prometheusHistogramTimerType
+	}
+	reporter, err := config.NewReporter(
+		prometheus.ConfigurationOptions{
+			// every service gets its own registry, so that services
+			// running in the same process don't collide on metric names
+			Registry: prom.NewRegistry(),
+			OnError: func(err error) {
+				log.Printf("error in prometheus reporter, err=%v", err)
+			},
+		},
+	)
+	if err != nil {
+		log.Fatalf("error creating prometheus reporter, err=%v", err)
+	}
+	scopeOpts := tally.ScopeOptions{
+		Tags:            c.Tags,
+		CachedReporter:  prometheusreporter.NewReporter(reporter),
+		Separator:       prometheus.DefaultSeparator,
+		SanitizeOptions: &prometheusSanitizeOptions,
+	}
+	scope, _ := tally.NewRootScope(scopeOpts, time.Second)
+	return scope
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 	"github.com/uber-go/tally/m3"
+	"github.com/uber-go/tally/prometheus"
 	"testing"
 )
 
@@ -65,6 +66,23 @@ func (s *MetricsSuite) TestM3() {
 	s.NotNil(scope)
 }
 
+func (s *MetricsSuite) TestPrometheus() {
+	prometheus := &prometheus.Configuration{
+		ListenAddress: "127.0.0.1:0",
+		DefaultHistogramBuckets: []prometheus.HistogramObjective{
+			{Upper: 0.01},
+			{Upper: 0.1},
+			{Upper: 1},
+		},
+	}
+	config := new(Metrics)
+	config.Prometheus = prometheus
+	scope := config.NewScope()
+	s.NotNil(scope)
+	s.NotEqual(tally.NoopScope, scope)
+	s.Empty(prometheus.TimerType, "the configuration must not be modified")
+}
+
 func (s *MetricsSuite) TestNoop() {
 	config := &Metrics{}
 	scope := config.NewScope()
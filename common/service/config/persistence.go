@@ -32,7 +32,9 @@ func (c *Persistence) SetMaxQPS(key string, qps int) {
 		ds.Cassandra.MaxQPS = qps
 		return
 	}
-	ds.SQL.MaxQPS = qps
+	if ds.SQL != nil {
+		ds.SQL.MaxQPS = qps
+	}
 }
 
 // Validate validates the persistence config
@@ -43,11 +45,17 @@ func (c *Persistence) Validate() error {
 		if !ok {
 			return fmt.Errorf("persistence: missing config for datastore %v", st)
 		}
-		if ds.SQL == nil && ds.Cassandra == nil {
-			return fmt.Errorf("persistence: datastore %v: must provide config for one of cassandra or sql stores", st)
+		numStores := 0
+		for _, configured := range []bool{ds.Cassandra != nil, ds.SQL != nil, ds.Memory != nil} {
+			if configured {
+				numStores++
+			}
+		}
+		if numStores == 0 {
+			return fmt.Errorf("persistence: datastore %v: must provide config for one of cassandra, sql or memory stores", st)
 		}
-		if ds.SQL != nil && ds.Cassandra != nil {
-			return fmt.Errorf("persistce: datastore %v: only one of SQL or cassandra can be specified", st)
+		if numStores > 1 {
+			return fmt.Errorf("persistce: datastore %v: only one of SQL, cassandra or memory can be specified", st)
 		}
 	}
 	return nil
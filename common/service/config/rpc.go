@@ -53,14 +53,12 @@ func (d *RPCFactory) CreateDispatcher() *yarpc.Dispatcher {
 	// Setup dispatcher for onebox
 	var err error
 	hostAddress := fmt.Sprintf("%v:%v", d.getListenIP(), d.config.Port)
-	d.ch, err = tchannel.NewChannelTransport(
-		tchannel.ServiceName(d.serviceName),
-		tchannel.ListenAddr(hostAddress))
+	d.ch, err = d.config.TLS.NewChannelTransport(d.serviceName, hostAddress, d.logger)
 	if err != nil {
 		d.logger.WithField("error", err).Fatal("Failed to create transport channel")
 	}
-	d.logger.Infof("Created RPC dispatcher for '%v' and listening at '%v'",
-		d.serviceName, hostAddress)
+	d.logger.Infof("Created RPC dispatcher for '%v' and listening at '%v', TLS enabled: %v",
+		d.serviceName, hostAddress, d.config.TLS.Enabled)
 	return yarpc.NewDispatcher(yarpc.Config{
 		Name:     d.serviceName,
 		Inbounds: yarpc.Inbounds{d.ch.NewInbound()},
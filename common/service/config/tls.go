@@ -0,0 +1,279 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/uber-common/bark"
+	tcg "github.com/uber/tchannel-go"
+	"go.uber.org/yarpc/transport/tchannel"
+)
+
+const (
+	defaultTLSReloadInterval = time.Minute
+)
+
+var (
+	errTLSNoCertificate = errors.New("tls: no certificate is configured for inbound connections")
+)
+
+type (
+	// tlsProvider builds the tls configs of the connections from the TLS config, the certificate,
+	// key and CA bundle are reloaded when the modification time of their files change
+	tlsProvider struct {
+		sync.Mutex
+		config      *TLS
+		logger      bark.Logger
+		timeSource  func() time.Time
+		lastChecked time.Time
+		modTimes    map[string]time.Time
+		cert        *tls.Certificate
+		caPool      *x509.CertPool
+	}
+)
+
+// Validate validates the TLS config
+func (t *TLS) Validate() error {
+	if !t.Enabled {
+		return nil
+	}
+	if (t.CertFile == "") != (t.KeyFile == "") {
+		return errors.New("tls: certFile and keyFile must be provided together")
+	}
+	if t.RequireClientCert && t.CAFile == "" {
+		return errors.New("tls: caFile must be provided when client certificates are required")
+	}
+	if t.ReloadInterval < 0 {
+		return errors.New("tls: reloadInterval must not be negative")
+	}
+	return nil
+}
+
+// NewChannelTransport creates a tchannel transport listening at the host address, the inbound and
+// outbound connections of the transport are encrypted if TLS is enabled
+func (t *TLS) NewChannelTransport(
+	serviceName string,
+	hostAddress string,
+	logger bark.Logger,
+) (*tchannel.ChannelTransport, error) {
+	if !t.Enabled {
+		return tchannel.NewChannelTransport(
+			tchannel.ServiceName(serviceName),
+			tchannel.ListenAddr(hostAddress))
+	}
+
+	provider, err := newTLSProvider(t, logger)
+	if err != nil {
+		return nil, err
+	}
+	ch, err := tcg.NewChannel(serviceName, &tcg.ChannelOptions{Dialer: provider.dial})
+	if err != nil {
+		return nil, err
+	}
+	listener, err := net.Listen("tcp", hostAddress)
+	if err != nil {
+		ch.Close()
+		return nil, err
+	}
+	peerListener := newPeerListener(listener, provider.serverConfig(), t.InternalIdentities, logger)
+	if err := ch.Serve(peerListener); err != nil {
+		listener.Close()
+		ch.Close()
+		return nil, err
+	}
+	return tchannel.NewChannelTransport(tchannel.WithChannel(ch))
+}
+
+func newTLSProvider(config *TLS, logger bark.Logger) (*tlsProvider, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	p := &tlsProvider{
+		config:     config,
+		logger:     logger,
+		timeSource: time.Now,
+	}
+	modTimes, err := p.readModTimes()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.load(modTimes); err != nil {
+		return nil, err
+	}
+	p.lastChecked = p.timeSource()
+	return p, nil
+}
+
+// serverConfig returns the tls config of the inbound connections
+func (p *tlsProvider) serverConfig() *tls.Config {
+	return &tls.Config{
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, caPool := p.getMaterial()
+			if cert == nil {
+				return nil, errTLSNoCertificate
+			}
+			clientAuth := tls.VerifyClientCertIfGiven
+			if p.config.RequireClientCert {
+				clientAuth = tls.RequireAndVerifyClientCert
+			}
+			return &tls.Config{
+				Certificates: []tls.Certificate{*cert},
+				ClientAuth:   clientAuth,
+				ClientCAs:    caPool,
+				MinVersion:   tls.VersionTLS12,
+			}, nil
+		},
+	}
+}
+
+// clientConfig returns the tls config of an outbound connection to the server
+func (p *tlsProvider) clientConfig(serverName string) *tls.Config {
+	cert, caPool := p.getMaterial()
+	config := &tls.Config{
+		RootCAs:    caPool,
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if cert != nil {
+		config.Certificates = []tls.Certificate{*cert}
+	}
+	return config
+}
+
+// dial opens an outbound connection and completes the tls handshake before handing it to tchannel
+func (p *tlsProvider) dial(ctx context.Context, network, hostPort string) (net.Conn, error) {
+	serverName := p.config.ServerName
+	if serverName == "" {
+		host, _, err := net.SplitHostPort(hostPort)
+		if err != nil {
+			return nil, err
+		}
+		serverName = host
+	}
+
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, network, hostPort)
+	if err != nil {
+		return nil, err
+	}
+	tlsConn := tls.Client(conn, p.clientConfig(serverName))
+	if deadline, ok := ctx.Deadline(); ok {
+		tlsConn.SetDeadline(deadline)
+	}
+	if err := tlsConn.Handshake(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	tlsConn.SetDeadline(time.Time{})
+	return tlsConn, nil
+}
+
+// getMaterial returns the current certificate and CA bundle, reloading them first
+// if the reload interval elapsed and their files changed
+func (p *tlsProvider) getMaterial() (*tls.Certificate, *x509.CertPool) {
+	p.Lock()
+	defer p.Unlock()
+
+	interval := p.config.ReloadInterval
+	if interval == 0 {
+		interval = defaultTLSReloadInterval
+	}
+	now := p.timeSource()
+	if now.Sub(p.lastChecked) < interval {
+		return p.cert, p.caPool
+	}
+	p.lastChecked = now
+
+	modTimes, err := p.readModTimes()
+	if err == nil && p.changed(modTimes) {
+		err = p.load(modTimes)
+		if err == nil {
+			p.logger.Info("Reloaded TLS certificates")
+		}
+	}
+	if err != nil {
+		// keep serving the certificates which were loaded last, the files could be in the middle of being rotated
+		p.logger.WithField("error", err).Warn("Failed to reload TLS certificates")
+	}
+	return p.cert, p.caPool
+}
+
+func (p *tlsProvider) load(modTimes map[string]time.Time) error {
+	var cert *tls.Certificate
+	if p.config.CertFile != "" {
+		keyPair, err := tls.LoadX509KeyPair(p.config.CertFile, p.config.KeyFile)
+		if err != nil {
+			return fmt.Errorf("tls: failed to load key pair: %v", err)
+		}
+		cert = &keyPair
+	}
+
+	var caPool *x509.CertPool
+	if p.config.CAFile != "" {
+		caBundle, err := ioutil.ReadFile(p.config.CAFile)
+		if err != nil {
+			return fmt.Errorf("tls: failed to read CA bundle: %v", err)
+		}
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(caBundle) {
+			return fmt.Errorf("tls: no certificates found in CA bundle %v", p.config.CAFile)
+		}
+	}
+
+	p.cert = cert
+	p.caPool = caPool
+	p.modTimes = modTimes
+	return nil
+}
+
+func (p *tlsProvider) readModTimes() (map[string]time.Time, error) {
+	modTimes := make(map[string]time.Time)
+	for _, file := range []string{p.config.CertFile, p.config.KeyFile, p.config.CAFile} {
+		if file == "" {
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, fmt.Errorf("tls: %v", err)
+		}
+		modTimes[file] = info.ModTime()
+	}
+	return modTimes, nil
+}
+
+func (p *tlsProvider) changed(modTimes map[string]time.Time) bool {
+	for file, modTime := range modTimes {
+		if !modTime.Equal(p.modTimes[file]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,217 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"net"
+	"sync"
+
+	"github.com/uber-common/bark"
+
+	"github.com/uber/cadence/common/authorization"
+)
+
+const (
+	// the tchannel frame header is the size, type, a reserved byte, the id and 8 reserved bytes
+	tchannelFrameHeaderSize = 16
+	tchannelInitReqType     = 0x01
+	tchannelHostPortParam   = "host_port"
+	tchannelEphemeralPeer   = "0.0.0.0:0"
+)
+
+var (
+	errMalformedInitReq = errors.New("tls: malformed tchannel init request")
+)
+
+type (
+	// peerListener accepts the TLS connections of a tchannel and records the identities in the verified
+	// client certificates of the peers, so the calls of the peers can be authorized with their identities
+	peerListener struct {
+		net.Listener
+		config             *tls.Config
+		internalIdentities map[string]struct{}
+		logger             bark.Logger
+		addPeer            func(hostPort string, identity string, internal bool) func()
+	}
+
+	// peerConn reads the tchannel init request of an inbound connection to learn the host port
+	// tchannel attributes the calls of the connection to
+	peerConn struct {
+		*tls.Conn
+		listener *peerListener
+		initReq  []byte
+		initDone bool
+
+		sync.Mutex
+		closed     bool
+		removePeer func()
+	}
+)
+
+func newPeerListener(listener net.Listener, config *tls.Config, internalIdentities []string, logger bark.Logger) *peerListener {
+	identities := make(map[string]struct{}, len(internalIdentities))
+	for _, identity := range internalIdentities {
+		identities[identity] = struct{}{}
+	}
+	return &peerListener{
+		Listener:           listener,
+		config:             config,
+		internalIdentities: identities,
+		logger:             logger,
+		addPeer:            authorization.AddPeer,
+	}
+}
+
+// Accept waits for the next connection and wraps it in a TLS connection
+func (l *peerListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &peerConn{Conn: tls.Server(conn, l.config), listener: l}, nil
+}
+
+// Read reads from the TLS connection, the first frame read is the tchannel init request
+func (c *peerConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 && !c.initDone {
+		c.readInitReq(b[:n])
+	}
+	return n, err
+}
+
+// Close removes the peer and closes the TLS connection
+func (c *peerConn) Close() error {
+	c.Lock()
+	c.closed = true
+	if c.removePeer != nil {
+		c.removePeer()
+		c.removePeer = nil
+	}
+	c.Unlock()
+	return c.Conn.Close()
+}
+
+func (c *peerConn) readInitReq(b []byte) {
+	c.initReq = append(c.initReq, b...)
+	if len(c.initReq) < 2 {
+		return
+	}
+	size := int(binary.BigEndian.Uint16(c.initReq))
+	if len(c.initReq) < size {
+		return
+	}
+	initReq := c.initReq[:size]
+	c.initReq = nil
+	c.initDone = true
+
+	hostPort, err := parseInitReqHostPort(initReq)
+	if err != nil {
+		c.listener.logger.WithField("error", err).Warn("Failed to read the host port of a tchannel peer")
+		return
+	}
+	c.addPeer(hostPort)
+}
+
+func (c *peerConn) addPeer(hostPort string) {
+	state := c.ConnectionState()
+	if len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return
+	}
+	identity := state.VerifiedChains[0][0].Subject.CommonName
+	if identity == "" {
+		return
+	}
+
+	remoteAddr := c.RemoteAddr().String()
+	if hostPort == tchannelEphemeralPeer {
+		// tchannel attributes the calls of the peers which do not listen to the address of the connection
+		hostPort = remoteAddr
+	} else if !sameHost(hostPort, remoteAddr) {
+		// the host port of a peer which listens is not verified by tchannel, a peer on another host could
+		// claim it to have its calls attributed to the certificate of the connections of that peer
+		c.listener.logger.WithFields(bark.Fields{
+			"hostPort":   hostPort,
+			"remoteAddr": remoteAddr,
+			"identity":   identity,
+		}).Warn("Host port of tchannel peer does not match the address of its connection")
+		return
+	}
+	_, internal := c.listener.internalIdentities[identity]
+
+	c.Lock()
+	defer c.Unlock()
+	if !c.closed {
+		c.removePeer = c.listener.addPeer(hostPort, identity, internal)
+	}
+}
+
+// parseInitReqHostPort returns the host port param of a tchannel init request frame
+func parseInitReqHostPort(frame []byte) (string, error) {
+	// the payload is the version and the number of params followed by the params as key and value pairs
+	if len(frame) < tchannelFrameHeaderSize+4 || frame[2] != tchannelInitReqType {
+		return "", errMalformedInitReq
+	}
+	payload := frame[tchannelFrameHeaderSize:]
+	numParams := int(binary.BigEndian.Uint16(payload[2:4]))
+	payload = payload[4:]
+	for i := 0; i < numParams; i++ {
+		var key, value string
+		var err error
+		if key, payload, err = readInitReqString(payload); err != nil {
+			return "", err
+		}
+		if value, payload, err = readInitReqString(payload); err != nil {
+			return "", err
+		}
+		if key == tchannelHostPortParam {
+			return value, nil
+		}
+	}
+	return "", errMalformedInitReq
+}
+
+func readInitReqString(b []byte) (string, []byte, error) {
+	if len(b) < 2 {
+		return "", nil, errMalformedInitReq
+	}
+	n := int(binary.BigEndian.Uint16(b))
+	if len(b) < 2+n {
+		return "", nil, errMalformedInitReq
+	}
+	return string(b[2 : 2+n]), b[2+n:], nil
+}
+
+func sameHost(hostPort string, remoteAddr string) bool {
+	host, _, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return false
+	}
+	remoteHost, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.Equal(net.ParseIP(remoteHost))
+}
@@ -0,0 +1,368 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+)
+
+type (
+	TLSSuite struct {
+		*require.Assertions
+		suite.Suite
+		dir    string
+		caCert *x509.Certificate
+		caKey  *ecdsa.PrivateKey
+		serial int64
+	}
+
+	testPeer struct {
+		hostPort string
+		identity string
+		internal bool
+	}
+)
+
+func TestTLSSuite(t *testing.T) {
+	suite.Run(t, new(TLSSuite))
+}
+
+func (s *TLSSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	var err error
+	s.dir, err = ioutil.TempDir("", "config.TestTLS")
+	s.NoError(err)
+	s.caCert, s.caKey = s.newCA("test-ca")
+	s.writeCert("ca.pem", s.caCert)
+}
+
+func (s *TLSSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *TLSSuite) TestValidate() {
+	s.NoError((&TLS{}).Validate())
+	s.NoError((&TLS{Enabled: true, CAFile: "ca.pem"}).Validate())
+	s.NoError((&TLS{Enabled: true, CertFile: "cert.pem", KeyFile: "key.pem", CAFile: "ca.pem", RequireClientCert: true}).Validate())
+	s.Error((&TLS{Enabled: true, CertFile: "cert.pem"}).Validate())
+	s.Error((&TLS{Enabled: true, CertFile: "cert.pem", KeyFile: "key.pem", RequireClientCert: true}).Validate())
+	s.Error((&TLS{Enabled: true, ReloadInterval: -time.Second}).Validate())
+}
+
+func (s *TLSSuite) TestMutualTLS() {
+	server := s.newProvider("server", true)
+	client := s.newProvider("client", false)
+
+	state, err := s.handshake(server, client)
+	s.NoError(err)
+	s.Len(state.PeerCertificates, 1)
+	s.Equal("server", state.PeerCertificates[0].Subject.CommonName)
+}
+
+func (s *TLSSuite) TestRequireClientCert() {
+	server := s.newProvider("server", true)
+	client, err := newTLSProvider(&TLS{Enabled: true, CAFile: s.path("ca.pem")}, bark.NewNopLogger())
+	s.NoError(err)
+
+	_, err = s.handshake(server, client)
+	s.Error(err)
+
+	server.config.RequireClientCert = false
+	_, err = s.handshake(server, client)
+	s.NoError(err)
+}
+
+func (s *TLSSuite) TestUntrustedServer() {
+	server := s.newProvider("server", true)
+	s.caCert, s.caKey = s.newCA("other-ca")
+	s.writeCert("other-ca.pem", s.caCert)
+	client, err := newTLSProvider(&TLS{Enabled: true, CAFile: s.path("other-ca.pem")}, bark.NewNopLogger())
+	s.NoError(err)
+
+	_, err = s.handshake(server, client)
+	s.Error(err)
+}
+
+func (s *TLSSuite) TestReload() {
+	server := s.newProvider("server", true)
+	client := s.newProvider("client", false)
+	now := time.Now()
+	server.timeSource = func() time.Time { return now }
+
+	state, err := s.handshake(server, client)
+	s.NoError(err)
+	serial := state.PeerCertificates[0].SerialNumber
+
+	// rotate the certificate, the mod time is moved explicitly since the file system may not be precise enough
+	s.writeKeyPair("server", "server-rotated")
+	future := now.Add(time.Hour)
+	for _, file := range []string{"server.pem", "server-key.pem"} {
+		s.NoError(os.Chtimes(s.path(file), future, future))
+	}
+
+	state, err = s.handshake(server, client)
+	s.NoError(err)
+	s.Equal(serial, state.PeerCertificates[0].SerialNumber)
+
+	now = now.Add(2 * time.Minute)
+	state, err = s.handshake(server, client)
+	s.NoError(err)
+	s.NotEqual(serial, state.PeerCertificates[0].SerialNumber)
+	s.Equal("server-rotated", state.PeerCertificates[0].Subject.CommonName)
+}
+
+func (s *TLSSuite) TestReloadFailureKeepsCertificate() {
+	server := s.newProvider("server", true)
+	client := s.newProvider("client", false)
+	now := time.Now()
+	server.timeSource = func() time.Time { return now }
+
+	s.NoError(ioutil.WriteFile(s.path("server.pem"), []byte("garbage"), 0644))
+	future := now.Add(time.Hour)
+	s.NoError(os.Chtimes(s.path("server.pem"), future, future))
+	now = now.Add(2 * time.Minute)
+
+	state, err := s.handshake(server, client)
+	s.NoError(err)
+	s.Equal("server", state.PeerCertificates[0].Subject.CommonName)
+}
+
+func (s *TLSSuite) TestPeerIdentity() {
+	server := s.newProvider("server", true)
+	client := s.newProvider("client", false)
+
+	localAddr, added, removed := s.connectPeer(server, client, tchannelEphemeralPeer, nil)
+	s.Equal([]testPeer{{hostPort: localAddr, identity: "client"}}, added)
+	s.Equal(added, removed)
+
+	localAddr, added, removed = s.connectPeer(server, client, tchannelEphemeralPeer, []string{"client"})
+	s.Equal([]testPeer{{hostPort: localAddr, identity: "client", internal: true}}, added)
+	s.Equal(added, removed)
+}
+
+func (s *TLSSuite) TestPeerIdentityListeningPeer() {
+	server := s.newProvider("server", true)
+	client := s.newProvider("client", false)
+
+	_, added, removed := s.connectPeer(server, client, "127.0.0.1:7933", nil)
+	s.Equal([]testPeer{{hostPort: "127.0.0.1:7933", identity: "client"}}, added)
+	s.Equal(added, removed)
+
+	// a peer can't claim the host port of a peer on another host
+	_, added, removed = s.connectPeer(server, client, "10.0.0.1:7933", nil)
+	s.Empty(added)
+	s.Empty(removed)
+}
+
+func (s *TLSSuite) TestPeerIdentityNoClientCert() {
+	server := s.newProvider("server", false)
+	client, err := newTLSProvider(&TLS{Enabled: true, CAFile: s.path("ca.pem")}, bark.NewNopLogger())
+	s.NoError(err)
+
+	_, added, _ := s.connectPeer(server, client, tchannelEphemeralPeer, nil)
+	s.Empty(added)
+}
+
+func (s *TLSSuite) TestParseInitReqHostPort() {
+	frame := initReqFrame("127.0.0.1:7933")
+	hostPort, err := parseInitReqHostPort(frame)
+	s.NoError(err)
+	s.Equal("127.0.0.1:7933", hostPort)
+
+	_, err = parseInitReqHostPort(frame[:len(frame)-1])
+	s.Error(err)
+	frame[2] = 0x03
+	_, err = parseInitReqHostPort(frame)
+	s.Error(err)
+}
+
+// connectPeer opens a connection to a peer listener which sends the tchannel init request with the host port,
+// it returns the local address of the connection and the peers added and removed by the listener
+func (s *TLSSuite) connectPeer(server *tlsProvider, client *tlsProvider, initHostPort string, internalIdentities []string) (string, []testPeer, []testPeer) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.NoError(err)
+	peerListener := newPeerListener(listener, server.serverConfig(), internalIdentities, bark.NewNopLogger())
+	defer peerListener.Close()
+
+	var added, removed []testPeer
+	peerListener.addPeer = func(hostPort string, identity string, internal bool) func() {
+		added = append(added, testPeer{hostPort: hostPort, identity: identity, internal: internal})
+		return func() {
+			removed = append(removed, testPeer{hostPort: hostPort, identity: identity, internal: internal})
+		}
+	}
+
+	frame := initReqFrame(initHostPort)
+	serverErrC := make(chan error, 1)
+	go func() {
+		conn, err := peerListener.Accept()
+		if err != nil {
+			serverErrC <- err
+			return
+		}
+		_, err = io.ReadFull(conn, make([]byte, len(frame)))
+		conn.Close()
+		serverErrC <- err
+	}()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	s.NoError(err)
+	tlsConn := tls.Client(conn, client.clientConfig("localhost"))
+	defer tlsConn.Close()
+	tlsConn.SetDeadline(time.Now().Add(5 * time.Second))
+	s.NoError(tlsConn.Handshake())
+	// the frame is written in two records to make the listener read it in parts
+	_, err = tlsConn.Write(frame[:5])
+	s.NoError(err)
+	_, err = tlsConn.Write(frame[5:])
+	s.NoError(err)
+	s.NoError(<-serverErrC)
+	return conn.LocalAddr().String(), added, removed
+}
+
+func initReqFrame(hostPort string) []byte {
+	var payload []byte
+	params := [][]string{{"process_name", "cadence-test"}, {tchannelHostPortParam, hostPort}}
+	payload = append(payload, 0, 2, 0, byte(len(params)))
+	for _, param := range params {
+		for _, value := range param {
+			payload = append(payload, byte(len(value)>>8), byte(len(value)))
+			payload = append(payload, value...)
+		}
+	}
+	frame := make([]byte, tchannelFrameHeaderSize, tchannelFrameHeaderSize+len(payload))
+	binary.BigEndian.PutUint16(frame, uint16(tchannelFrameHeaderSize+len(payload)))
+	frame[2] = tchannelInitReqType
+	return append(frame, payload...)
+}
+
+func (s *TLSSuite) handshake(server *tlsProvider, client *tlsProvider) (tls.ConnectionState, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.NoError(err)
+	tlsListener := tls.NewListener(listener, server.serverConfig())
+	defer tlsListener.Close()
+
+	serverErrC := make(chan error, 1)
+	go func() {
+		conn, err := tlsListener.Accept()
+		if err != nil {
+			serverErrC <- err
+			return
+		}
+		defer conn.Close()
+		serverErrC <- conn.(*tls.Conn).Handshake()
+	}()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	s.NoError(err)
+	tlsConn := tls.Client(conn, client.clientConfig("localhost"))
+	defer tlsConn.Close()
+	tlsConn.SetDeadline(time.Now().Add(5 * time.Second))
+	err = tlsConn.Handshake()
+	if err == nil {
+		// the server only verifies the client certificate after the client completed its side of the handshake
+		err = <-serverErrC
+	}
+	return tlsConn.ConnectionState(), err
+}
+
+func (s *TLSSuite) newProvider(name string, requireClientCert bool) *tlsProvider {
+	s.writeKeyPair(name, name)
+	provider, err := newTLSProvider(&TLS{
+		Enabled:           true,
+		CertFile:          s.path(name + ".pem"),
+		KeyFile:           s.path(name + "-key.pem"),
+		CAFile:            s.path("ca.pem"),
+		RequireClientCert: requireClientCert,
+	}, bark.NewNopLogger())
+	s.NoError(err)
+	return provider
+}
+
+func (s *TLSSuite) newCA(commonName string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	template := s.template(commonName)
+	template.IsCA = true
+	template.KeyUsage = x509.KeyUsageCertSign
+	template.BasicConstraintsValid = true
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	s.NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	s.NoError(err)
+	return cert, key
+}
+
+func (s *TLSSuite) writeKeyPair(name string, commonName string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	template := s.template(commonName)
+	template.DNSNames = []string{"localhost"}
+	template.KeyUsage = x509.KeyUsageDigitalSignature
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	der, err := x509.CreateCertificate(rand.Reader, template, s.caCert, &key.PublicKey, s.caKey)
+	s.NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	s.NoError(err)
+	s.writeCert(name+".pem", cert)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	s.NoError(err)
+	s.NoError(ioutil.WriteFile(s.path(name+"-key.pem"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+}
+
+func (s *TLSSuite) writeCert(file string, cert *x509.Certificate) {
+	s.NoError(ioutil.WriteFile(s.path(file), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}), 0644))
+}
+
+func (s *TLSSuite) template(commonName string) *x509.Certificate {
+	s.serial++
+	return &x509.Certificate{
+		SerialNumber: big.NewInt(s.serial),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+}
+
+func (s *TLSSuite) path(file string) string {
+	return filepath.Join(s.dir, file)
+}
@@ -63,3 +63,8 @@ func GetDurationPropertyFn(value time.Duration) func(opts ...FilterOption) time.
 func GetDurationPropertyFnFilteredByTaskListInfo(value time.Duration) func(domain string, taskList string, taskType int) time.Duration {
 	return func(domain string, taskList string, taskType int) time.Duration { return value }
 }
+
+// GetMapPropertyFn returns value as MapPropertyFn
+func GetMapPropertyFn(value map[string]interface{}) func(opts ...FilterOption) map[string]interface{} {
+	return func(...FilterOption) map[string]interface{} { return value }
+}
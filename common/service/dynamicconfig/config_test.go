@@ -230,6 +230,19 @@ func (s *configSuite) TestGetDurationPropertyFilteredByTaskListInfo() {
 	s.Equal(time.Minute, value(domain, taskList, taskType))
 }
 
+func (s *configSuite) TestGetMapProperty() {
+	key := testGetMapPropertyKey
+	val := map[string]interface{}{
+		"testKey": 123,
+	}
+	value := s.cln.GetMapProperty(key, val)
+	s.Equal(val, value())
+	val["testKey"] = "321"
+	s.client.SetValue(key, val)
+	s.Equal(val, value())
+	s.Equal("321", value()["testKey"])
+}
+
 func TestDynamicConfigKeyIsMapped(t *testing.T) {
 	for i := unknownKey; i < lastKeyForTest; i++ {
 		key, ok := keys[i]
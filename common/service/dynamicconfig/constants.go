@@ -46,6 +46,7 @@ var keys = map[Key]string{
 	testGetIntPropertyFilteredByTaskListInfoKey:      "testGetIntPropertyFilteredByTaskListInfoKey",
 	testGetDurationPropertyFilteredByTaskListInfoKey: "testGetDurationPropertyFilteredByTaskListInfoKey",
 	testGetBoolPropertyFilteredByTaskListInfoKey:     "testGetBoolPropertyFilteredByTaskListInfoKey",
+	testGetMapPropertyKey:                            "testGetMapPropertyKey",
 
 	// system settings
 	EnableGlobalDomain:       "system.enableGlobalDomain",
@@ -63,15 +64,24 @@ var keys = map[Key]string{
 	HistoryCountLimitWarn:  "limit.historyCount.warn",
 	MaxIDLengthLimit:       "limit.maxIDLength",
 
+	// search attributes settings
+	ValidSearchAttributes:             "system.validSearchAttributes",
+	SearchAttributesNumberOfKeysLimit: "limit.numberOfSearchAttributesKeys",
+	SearchAttributesSizeOfValueLimit:  "limit.searchAttributesSizeOfValue",
+	SearchAttributesTotalSizeLimit:    "limit.searchAttributesTotalSize",
+
 	// frontend settings
 	FrontendPersistenceMaxQPS:      "frontend.persistenceMaxQPS",
 	FrontendVisibilityMaxPageSize:  "frontend.visibilityMaxPageSize",
 	FrontendVisibilityListMaxQPS:   "frontend.visibilityListMaxQPS",
 	FrontendHistoryMaxPageSize:     "frontend.historyMaxPageSize",
 	FrontendRPS:                    "frontend.rps",
+	FrontendDomainRPS:              "frontend.domainRPS",
+	FrontendDomainPollRPS:          "frontend.domainPollRPS",
 	FrontendHistoryMgrNumConns:     "frontend.historyMgrNumConns",
 	MaxDecisionStartToCloseTimeout: "frontend.maxDecisionStartToCloseTimeout",
 	DisableListVisibilityByFilter:  "frontend.disableListVisibilityByFilter",
+	EnableReadVisibilityFromSearch: "frontend.enableReadVisibilityFromSearch",
 
 	// matching settings
 	MatchingRPS:                             "matching.rps",
@@ -85,6 +95,8 @@ var keys = map[Key]string{
 	MaxTasklistIdleTime:                     "matching.maxTasklistIdleTime",
 	MatchingOutstandingTaskAppendsThreshold: "matching.outstandingTaskAppendsThreshold",
 	MatchingMaxTaskBatchSize:                "matching.maxTaskBatchSize",
+	MatchingNumTasklistWritePartitions:      "matching.numTasklistWritePartitions",
+	MatchingNumTasklistReadPartitions:       "matching.numTasklistReadPartitions",
 
 	// history settings
 	EnableSyncActivityHeartbeat:                           "history.enableSyncActivityHeartbeat",
@@ -138,6 +150,8 @@ var keys = map[Key]string{
 	ReplicatorProcessorMaxPollIntervalJitterCoefficient:   "history.replicatorProcessorMaxPollIntervalJitterCoefficient",
 	ReplicatorProcessorUpdateAckInterval:                  "history.replicatorProcessorUpdateAckInterval",
 	ReplicatorProcessorUpdateAckIntervalJitterCoefficient: "history.replicatorProcessorUpdateAckIntervalJitterCoefficient",
+	ReplicationTaskLongPollTimeout:                        "history.replicationTaskLongPollTimeout",
+	ReplicationTaskPullerMaxRetryCount:                    "history.replicationTaskPullerMaxRetryCount",
 	ExecutionMgrNumConns:                                  "history.executionMgrNumConns",
 	HistoryMgrNumConns:                                    "history.historyMgrNumConns",
 	MaximumBufferedEventsBatch:                            "history.maximumBufferedEventsBatch",
@@ -150,9 +164,29 @@ var keys = map[Key]string{
 	EnableEventsV2:                                        "history.enableEventsV2",
 	NumSystemWorkflows:                                    "history.numSystemWorkflows",
 
-	WorkerPersistenceMaxQPS:       "worker.persistenceMaxQPS",
-	WorkerReplicatorConcurrency:   "worker.replicatorConcurrency",
-	WorkerReplicationTaskMaxRetry: "worker.replicationTaskMaxRetry",
+	WorkerPersistenceMaxQPS:                              "worker.persistenceMaxQPS",
+	WorkerReplicatorConcurrency:                          "worker.replicatorConcurrency",
+	WorkerReplicationTaskMaxRetry:                        "worker.replicationTaskMaxRetry",
+	WorkerEnableBatcher:                                  "worker.enableBatcher",
+	WorkerIndexerConcurrency:                             "worker.indexerConcurrency",
+	WorkerIndexerBatchSize:                               "worker.indexerBatchSize",
+	WorkerIndexerFlushInterval:                           "worker.indexerFlushInterval",
+	WorkerEnableDomainDeleter:                            "worker.enableDomainDeleter",
+	WorkerEnableReplicationVerifier:                      "worker.enableReplicationVerifier",
+	WorkerReplicationVerifierInterval:                    "worker.replicationVerifierInterval",
+	WorkerReplicationVerifierSampleSize:                  "worker.replicationVerifierSampleSize",
+	WorkerReplicationVerifierRepair:                      "worker.replicationVerifierRepair",
+	WorkerEnableExecutionScanner:                         "worker.enableExecutionScanner",
+	WorkerExecutionScannerInterval:                       "worker.executionScannerInterval",
+	WorkerExecutionScannerConcurrency:                    "worker.executionScannerConcurrency",
+	WorkerExecutionScannerFixEnabled:                     "worker.executionScannerFixEnabled",
+	WorkerExecutionScannerBranchMinAge:                   "worker.executionScannerHistoryBranchMinAge",
+	WorkerExecutionScannerDeleteExecutionsWithoutHistory: "worker.executionScannerDeleteExecutionsWithoutHistory",
+	WorkerEnableTaskListScavenger:                        "worker.enableTaskListScavenger",
+	WorkerTaskListScavengerInterval:                      "worker.taskListScavengerInterval",
+	WorkerTaskListScavengerTaskMaxAge:                    "worker.taskListScavengerTaskMaxAge",
+	WorkerTaskListScavengerIdleTime:                      "worker.taskListScavengerTaskListIdleTime",
+	WorkerTaskListScavengerMaxRPS:                        "worker.taskListScavengerPersistenceMaxRPS",
 }
 
 const (
@@ -169,6 +203,7 @@ const (
 	testGetIntPropertyFilteredByTaskListInfoKey
 	testGetDurationPropertyFilteredByTaskListInfoKey
 	testGetBoolPropertyFilteredByTaskListInfoKey
+	testGetMapPropertyKey
 
 	// EnableGlobalDomain is key for enable global domain
 	EnableGlobalDomain
@@ -200,6 +235,16 @@ const (
 	// WorkflowType, ActivityType, SignalName, MarkerName, ErrorReason/FailureReason/CancelCause, Identity, RequestID
 	MaxIDLengthLimit
 
+	// ValidSearchAttributes is the map of search attribute keys that are allowed in the cluster, to the
+	// name of their IndexedValueType
+	ValidSearchAttributes
+	// SearchAttributesNumberOfKeysLimit is the limit of number of keys in the search attributes of a workflow
+	SearchAttributesNumberOfKeysLimit
+	// SearchAttributesSizeOfValueLimit is the size limit of each value in the search attributes of a workflow
+	SearchAttributesSizeOfValueLimit
+	// SearchAttributesTotalSizeLimit is the size limit of the whole search attributes of a workflow
+	SearchAttributesTotalSizeLimit
+
 	// key for frontend
 
 	// FrontendPersistenceMaxQPS is the max qps frontend host can query DB
@@ -212,10 +257,16 @@ const (
 	FrontendHistoryMaxPageSize
 	// FrontendRPS is workflow rate limit per second
 	FrontendRPS
+	// FrontendDomainRPS is workflow rate limit per second for each domain, poll APIs excluded
+	FrontendDomainRPS
+	// FrontendDomainPollRPS is poll APIs rate limit per second for each domain
+	FrontendDomainPollRPS
 	// FrontendHistoryMgrNumConns is for persistence cluster.NumConns
 	FrontendHistoryMgrNumConns
 	// MaxDecisionStartToCloseTimeout is max decision timeout in seconds
 	MaxDecisionStartToCloseTimeout
+	// EnableReadVisibilityFromSearch is whether the executions of a domain are listed from the search backend
+	EnableReadVisibilityFromSearch
 
 	// key for matching
 
@@ -241,6 +292,12 @@ const (
 	MatchingOutstandingTaskAppendsThreshold
 	// MatchingMaxTaskBatchSize is max batch size for task writer
 	MatchingMaxTaskBatchSize
+	// MatchingNumTasklistWritePartitions is the number of partitions the tasks of a task list are added to
+	MatchingNumTasklistWritePartitions
+	// MatchingNumTasklistReadPartitions is the number of partitions the pollers of a task list are spread
+	// across, it is never lower than the number of write partitions. Partitions are removed by lowering the
+	// number of write partitions first, and the number of read partitions once their backlog is drained.
+	MatchingNumTasklistReadPartitions
 
 	// key for history
 
@@ -346,6 +403,10 @@ const (
 	ReplicatorProcessorUpdateAckInterval
 	// ReplicatorProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient
 	ReplicatorProcessorUpdateAckIntervalJitterCoefficient
+	// ReplicationTaskLongPollTimeout is the max time GetReplicationMessages waits for new replication tasks
+	ReplicationTaskLongPollTimeout
+	// ReplicationTaskPullerMaxRetryCount is max times of retry of a pulled replication task which keeps failing
+	ReplicationTaskPullerMaxRetryCount
 	// ExecutionMgrNumConns is persistence connections number for ExecutionManager
 	ExecutionMgrNumConns
 	// HistoryMgrNumConns is persistence connections number for HistoryManager
@@ -378,6 +439,52 @@ const (
 	WorkerReplicatorConcurrency
 	// WorkerReplicationTaskMaxRetry is the max retry for any task
 	WorkerReplicationTaskMaxRetry
+	// WorkerEnableBatcher decides whether the worker runs the batch operations
+	WorkerEnableBatcher
+	// WorkerIndexerConcurrency is the number of workers which index visibility messages into the search backend
+	WorkerIndexerConcurrency
+	// WorkerIndexerBatchSize is the max number of documents written to the search backend in one bulk request
+	WorkerIndexerBatchSize
+	// WorkerIndexerFlushInterval is the max time a visibility message waits for its batch to be written
+	WorkerIndexerFlushInterval
+	// WorkerEnableDomainDeleter decides whether the worker runs the deletions of deprecated domains
+	WorkerEnableDomainDeleter
+	// WorkerEnableReplicationVerifier decides whether the worker periodically verifies that the workflows of the
+	// global domains are consistent across clusters
+	WorkerEnableReplicationVerifier
+	// WorkerReplicationVerifierInterval is the interval between two runs of the replication verifier
+	WorkerReplicationVerifierInterval
+	// WorkerReplicationVerifierSampleSize is the number of open workflows the replication verifier checks per domain
+	WorkerReplicationVerifierSampleSize
+	// WorkerReplicationVerifierRepair decides whether the replication verifier repairs the workflows it finds behind
+	WorkerReplicationVerifierRepair
+	// WorkerEnableExecutionScanner decides whether the worker periodically scans the persisted workflows for
+	// corrupted or orphaned records
+	WorkerEnableExecutionScanner
+	// WorkerExecutionScannerInterval is the interval between two scans of the execution scanner
+	WorkerExecutionScannerInterval
+	// WorkerExecutionScannerConcurrency is the number of activities the execution scanner scans the shards with
+	WorkerExecutionScannerConcurrency
+	// WorkerExecutionScannerFixEnabled decides whether the execution scanner fixes its findings
+	WorkerExecutionScannerFixEnabled
+	// WorkerExecutionScannerBranchMinAge is the age under which the execution scanner does not check history branches
+	WorkerExecutionScannerBranchMinAge
+	// WorkerExecutionScannerDeleteExecutionsWithoutHistory decides whether the fix of the execution scanner deletes
+	// the open executions whose history branch is missing, the findings are only reported otherwise
+	WorkerExecutionScannerDeleteExecutionsWithoutHistory
+	// WorkerEnableTaskListScavenger decides whether the worker periodically deletes the expired tasks and the idle
+	// task lists
+	WorkerEnableTaskListScavenger
+	// WorkerTaskListScavengerInterval is the interval between two scavenges of the task list scavenger
+	WorkerTaskListScavengerInterval
+	// WorkerTaskListScavengerTaskMaxAge is the age over which the task list scavenger deletes the undispatched tasks
+	// whose workflow or activity no longer exists
+	WorkerTaskListScavengerTaskMaxAge
+	// WorkerTaskListScavengerIdleTime is the time a task list without backlog is not updated for before the task
+	// list scavenger deletes it
+	WorkerTaskListScavengerIdleTime
+	// WorkerTaskListScavengerMaxRPS is the max rate of the persistence requests of the task list scavenger
+	WorkerTaskListScavengerMaxRPS
 
 	// lastKeyForTest must be the last one in this const group for testing purpose
 	lastKeyForTest
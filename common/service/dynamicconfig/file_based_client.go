@@ -0,0 +1,359 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	minPollInterval     = 5 * time.Second
+	defaultPollInterval = time.Minute
+)
+
+type (
+	// FileBasedClientConfig is the config for the file based dynamic config client.
+	// It specifies where the config file is stored and how often the config should be
+	// updated by checking the config file again.
+	FileBasedClientConfig struct {
+		// Filepath is the path to the yaml file with the dynamic config values
+		Filepath string `yaml:"filepath"`
+		// PollInterval is how often the file is checked for changes
+		PollInterval time.Duration `yaml:"pollInterval"`
+	}
+
+	// constrainedValue is a single value of a dynamic config key, which applies only
+	// when all its constraints match the filters of the lookup
+	constrainedValue struct {
+		Value       interface{}            `yaml:"value"`
+		Constraints map[string]interface{} `yaml:"constraints"`
+	}
+
+	fileBasedClient struct {
+		values          atomic.Value // map[string][]*constrainedValue
+		lastUpdatedTime time.Time
+		config          *FileBasedClientConfig
+		doneCh          chan struct{}
+		logger          bark.Logger
+	}
+)
+
+var errKeyNotFound = errors.New("unable to find key")
+
+// NewFileBasedClient creates a dynamic config client that reads the values from a yaml file
+// and reloads them every PollInterval, until doneCh is closed.
+//
+// The file maps key names to a list of values. A value without constraints is used for every
+// lookup, a value with constraints only when all of them match the filters of the lookup. When
+// several values match, the one with the most constraints wins:
+//
+//   matching.enableSyncMatch:
+//   - value: true
+//   - value: false
+//     constraints:
+//       domainName: "samples-domain"
+//       taskListName: "slow-tasklist"
+func NewFileBasedClient(config *FileBasedClientConfig, logger bark.Logger, doneCh chan struct{}) (Client, error) {
+	if err := validateConfig(config); err != nil {
+		return nil, err
+	}
+
+	client := &fileBasedClient{
+		config: config,
+		doneCh: doneCh,
+		logger: logger,
+	}
+	if err := client.update(); err != nil {
+		return nil, err
+	}
+
+	go func() {
+		ticker := time.NewTicker(client.config.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := client.update(); err != nil {
+					client.logger.WithFields(bark.Fields{
+						"filepath": client.config.Filepath,
+						"error":    err,
+					}).Error("Failed to update dynamic config, keep using the previous values")
+				}
+			case <-client.doneCh:
+				return
+			}
+		}
+	}()
+
+	return client, nil
+}
+
+func (fc *fileBasedClient) GetValue(name Key, defaultValue interface{}) (interface{}, error) {
+	return fc.getValueWithFilters(name, nil, defaultValue)
+}
+
+func (fc *fileBasedClient) GetValueWithFilters(
+	name Key, filters map[Filter]interface{}, defaultValue interface{},
+) (interface{}, error) {
+	return fc.getValueWithFilters(name, filters, defaultValue)
+}
+
+func (fc *fileBasedClient) GetIntValue(name Key, filters map[Filter]interface{}, defaultValue int) (int, error) {
+	val, err := fc.getValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+
+	if intVal, ok := val.(int); ok {
+		return intVal, nil
+	}
+	return defaultValue, typeMismatchError(name, "int", val)
+}
+
+func (fc *fileBasedClient) GetFloatValue(name Key, filters map[Filter]interface{}, defaultValue float64) (float64, error) {
+	val, err := fc.getValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+
+	switch v := val.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	}
+	return defaultValue, typeMismatchError(name, "float64", val)
+}
+
+func (fc *fileBasedClient) GetBoolValue(name Key, filters map[Filter]interface{}, defaultValue bool) (bool, error) {
+	val, err := fc.getValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+
+	if boolVal, ok := val.(bool); ok {
+		return boolVal, nil
+	}
+	return defaultValue, typeMismatchError(name, "bool", val)
+}
+
+func (fc *fileBasedClient) GetStringValue(name Key, filters map[Filter]interface{}, defaultValue string) (string, error) {
+	val, err := fc.getValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+
+	if stringVal, ok := val.(string); ok {
+		return stringVal, nil
+	}
+	return defaultValue, typeMismatchError(name, "string", val)
+}
+
+func (fc *fileBasedClient) GetMapValue(
+	name Key, filters map[Filter]interface{}, defaultValue map[string]interface{},
+) (map[string]interface{}, error) {
+	val, err := fc.getValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+
+	if mapVal, ok := val.(map[string]interface{}); ok {
+		return mapVal, nil
+	}
+	return defaultValue, typeMismatchError(name, "map[string]interface{}", val)
+}
+
+func (fc *fileBasedClient) GetDurationValue(
+	name Key, filters map[Filter]interface{}, defaultValue time.Duration,
+) (time.Duration, error) {
+	val, err := fc.getValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+
+	switch v := val.(type) {
+	case string:
+		durationVal, err := time.ParseDuration(v)
+		if err != nil {
+			return defaultValue, fmt.Errorf("failed to parse duration for key %v: %v", name, err)
+		}
+		return durationVal, nil
+	case int:
+		// plain numbers are interpreted as seconds
+		return time.Duration(v) * time.Second, nil
+	}
+	return defaultValue, typeMismatchError(name, "time.Duration", val)
+}
+
+func (fc *fileBasedClient) getValueWithFilters(
+	name Key, filters map[Filter]interface{}, defaultValue interface{},
+) (interface{}, error) {
+	values := fc.values.Load().(map[string][]*constrainedValue)
+	candidates, ok := values[name.String()]
+	if !ok {
+		return defaultValue, errKeyNotFound
+	}
+
+	var match *constrainedValue
+	for _, candidate := range candidates {
+		if !matchConstraints(candidate.Constraints, filters) {
+			continue
+		}
+		if match == nil || len(candidate.Constraints) > len(match.Constraints) {
+			match = candidate
+		}
+	}
+	if match == nil {
+		return defaultValue, errKeyNotFound
+	}
+	return match.Value, nil
+}
+
+func (fc *fileBasedClient) update() error {
+	info, err := os.Stat(fc.config.Filepath)
+	if err != nil {
+		return fmt.Errorf("failed to get status of dynamic config file: %v", err)
+	}
+	if !info.ModTime().After(fc.lastUpdatedTime) {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(fc.config.Filepath)
+	if err != nil {
+		return fmt.Errorf("failed to read dynamic config file: %v", err)
+	}
+	values, err := parseValues(data)
+	if err != nil {
+		return err
+	}
+
+	fc.values.Store(values)
+	fc.lastUpdatedTime = info.ModTime()
+	fc.logger.WithFields(bark.Fields{
+		"filepath":  fc.config.Filepath,
+		"keysCount": len(values),
+	}).Info("Updated dynamic config")
+	return nil
+}
+
+func parseValues(data []byte) (map[string][]*constrainedValue, error) {
+	raw := make(map[string][]*constrainedValue)
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to decode dynamic config: %v", err)
+	}
+
+	knownKeys := make(map[string]struct{}, len(keys))
+	for _, keyName := range keys {
+		knownKeys[keyName] = struct{}{}
+	}
+
+	values := make(map[string][]*constrainedValue, len(raw))
+	for keyName, candidates := range raw {
+		if _, ok := knownKeys[keyName]; !ok {
+			return nil, fmt.Errorf("unknown dynamic config key: %v", keyName)
+		}
+		for _, candidate := range candidates {
+			if candidate == nil {
+				return nil, fmt.Errorf("empty value for dynamic config key: %v", keyName)
+			}
+			for filterName := range candidate.Constraints {
+				if parseFilter(filterName) == unknownFilter {
+					return nil, fmt.Errorf("unknown constraint %v for dynamic config key: %v", filterName, keyName)
+				}
+			}
+			candidate.Value = convertYamlValue(candidate.Value)
+		}
+		values[keyName] = candidates
+	}
+	return values, nil
+}
+
+// matchConstraints returns true if every constraint has a filter with the same value
+func matchConstraints(constraints map[string]interface{}, filters map[Filter]interface{}) bool {
+	for filterName, expected := range constraints {
+		actual, ok := filters[parseFilter(filterName)]
+		if !ok || actual != expected {
+			return false
+		}
+	}
+	return true
+}
+
+func parseFilter(filterName string) Filter {
+	for i, name := range filters {
+		if i > int(unknownFilter) && name == filterName {
+			return Filter(i)
+		}
+	}
+	return unknownFilter
+}
+
+// convertYamlValue turns the map[interface{}]interface{} produced by the yaml decoder into
+// map[string]interface{}, which is what the Client interface returns for map values
+func convertYamlValue(val interface{}) interface{} {
+	switch v := val.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[fmt.Sprintf("%v", key)] = convertYamlValue(value)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, value := range v {
+			l[i] = convertYamlValue(value)
+		}
+		return l
+	default:
+		return v
+	}
+}
+
+func validateConfig(config *FileBasedClientConfig) error {
+	if config == nil {
+		return errors.New("no config found for file based dynamic config client")
+	}
+	if len(config.Filepath) == 0 {
+		return errors.New("empty file path for file based dynamic config client")
+	}
+	if _, err := os.Stat(config.Filepath); err != nil {
+		return fmt.Errorf("failed to get status of dynamic config file: %v", err)
+	}
+	if config.PollInterval == 0 {
+		config.PollInterval = defaultPollInterval
+	}
+	if config.PollInterval < minPollInterval {
+		return fmt.Errorf("poll interval should be at least %v", minPollInterval)
+	}
+	return nil
+}
+
+func typeMismatchError(name Key, expectedType string, val interface{}) error {
+	return fmt.Errorf("value of key %v is %T, expected %v", name, val, expectedType)
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+)
+
+const testDynamicConfig = `
+testGetPropertyKey:
+- value: a
+testGetIntPropertyKey:
+- value: 1000
+- value: 1001
+  constraints:
+    domainName: global-samples-domain
+- value: 1002
+  constraints:
+    domainName: global-samples-domain
+    taskListName: test-tasklist
+    taskType: 1
+testGetFloat64PropertyKey:
+- value: 12
+- value: 12.5
+  constraints:
+    domainName: samples-domain
+testGetBoolPropertyKey:
+- value: false
+- value: true
+  constraints:
+    domainName: global-samples-domain
+testGetDurationPropertyKey:
+- value: 1m
+- value: 30
+  constraints:
+    domainName: samples-domain
+- value: not-a-duration
+  constraints:
+    domainName: broken-domain
+testGetIntPropertyFilteredByDomainKey:
+- value:
+    key1: 1
+    key2:
+      nested: value
+`
+
+type fileBasedClientSuite struct {
+	suite.Suite
+	client Client
+	file   *os.File
+	doneCh chan struct{}
+}
+
+func TestFileBasedClientSuite(t *testing.T) {
+	s := new(fileBasedClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *fileBasedClientSuite) SetupTest() {
+	var err error
+	s.file, err = ioutil.TempFile("", "dynamicconfig")
+	s.NoError(err)
+	_, err = s.file.WriteString(testDynamicConfig)
+	s.NoError(err)
+
+	s.doneCh = make(chan struct{})
+	s.client, err = NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     s.file.Name(),
+		PollInterval: time.Second * 5,
+	}, bark.NewLoggerFromLogrus(logrus.New()), s.doneCh)
+	s.NoError(err)
+}
+
+func (s *fileBasedClientSuite) TearDownTest() {
+	close(s.doneCh)
+	os.Remove(s.file.Name())
+}
+
+func (s *fileBasedClientSuite) TestGetValue() {
+	v, err := s.client.GetValue(testGetPropertyKey, "b")
+	s.NoError(err)
+	s.Equal("a", v)
+}
+
+func (s *fileBasedClientSuite) TestGetValue_NonExistKey() {
+	v, err := s.client.GetValue(testGetDurationPropertyFilteredByDomainKey, "b")
+	s.Error(err)
+	s.Equal("b", v)
+}
+
+func (s *fileBasedClientSuite) TestGetIntValue() {
+	v, err := s.client.GetIntValue(testGetIntPropertyKey, nil, 1)
+	s.NoError(err)
+	s.Equal(1000, v)
+
+	v, err = s.client.GetIntValue(testGetIntPropertyKey, map[Filter]interface{}{DomainName: "samples-domain"}, 1)
+	s.NoError(err)
+	s.Equal(1000, v)
+
+	v, err = s.client.GetIntValue(testGetIntPropertyKey, map[Filter]interface{}{DomainName: "global-samples-domain"}, 1)
+	s.NoError(err)
+	s.Equal(1001, v)
+}
+
+func (s *fileBasedClientSuite) TestGetIntValue_MostSpecificMatchWins() {
+	filters := map[Filter]interface{}{
+		DomainName:   "global-samples-domain",
+		TaskListName: "test-tasklist",
+		TaskType:     1,
+	}
+	v, err := s.client.GetIntValue(testGetIntPropertyKey, filters, 1)
+	s.NoError(err)
+	s.Equal(1002, v)
+
+	filters[TaskType] = 0
+	v, err = s.client.GetIntValue(testGetIntPropertyKey, filters, 1)
+	s.NoError(err)
+	s.Equal(1001, v)
+}
+
+func (s *fileBasedClientSuite) TestGetIntValue_WrongType() {
+	v, err := s.client.GetIntValue(testGetPropertyKey, nil, 1)
+	s.Error(err)
+	s.Equal(1, v)
+}
+
+func (s *fileBasedClientSuite) TestGetFloatValue() {
+	v, err := s.client.GetFloatValue(testGetFloat64PropertyKey, nil, 1)
+	s.NoError(err)
+	s.Equal(12.0, v)
+
+	v, err = s.client.GetFloatValue(testGetFloat64PropertyKey, map[Filter]interface{}{DomainName: "samples-domain"}, 1)
+	s.NoError(err)
+	s.Equal(12.5, v)
+}
+
+func (s *fileBasedClientSuite) TestGetBoolValue() {
+	v, err := s.client.GetBoolValue(testGetBoolPropertyKey, nil, true)
+	s.NoError(err)
+	s.Equal(false, v)
+
+	v, err = s.client.GetBoolValue(testGetBoolPropertyKey, map[Filter]interface{}{DomainName: "global-samples-domain"}, false)
+	s.NoError(err)
+	s.Equal(true, v)
+}
+
+func (s *fileBasedClientSuite) TestGetDurationValue() {
+	v, err := s.client.GetDurationValue(testGetDurationPropertyKey, nil, time.Second)
+	s.NoError(err)
+	s.Equal(time.Minute, v)
+
+	v, err = s.client.GetDurationValue(testGetDurationPropertyKey, map[Filter]interface{}{DomainName: "samples-domain"}, time.Second)
+	s.NoError(err)
+	s.Equal(30*time.Second, v)
+
+	v, err = s.client.GetDurationValue(testGetDurationPropertyKey, map[Filter]interface{}{DomainName: "broken-domain"}, time.Second)
+	s.Error(err)
+	s.Equal(time.Second, v)
+}
+
+func (s *fileBasedClientSuite) TestGetMapValue() {
+	v, err := s.client.GetMapValue(testGetIntPropertyFilteredByDomainKey, nil, nil)
+	s.NoError(err)
+	s.Equal(map[string]interface{}{
+		"key1": 1,
+		"key2": map[string]interface{}{"nested": "value"},
+	}, v)
+}
+
+func (s *fileBasedClientSuite) TestUpdate() {
+	client := s.client.(*fileBasedClient)
+	s.NoError(ioutil.WriteFile(s.file.Name(), []byte("testGetPropertyKey:\n- value: c\n"), 0644))
+	s.NoError(os.Chtimes(s.file.Name(), time.Now(), client.lastUpdatedTime.Add(time.Second)))
+	s.NoError(client.update())
+
+	v, err := s.client.GetValue(testGetPropertyKey, "b")
+	s.NoError(err)
+	s.Equal("c", v)
+
+	_, err = s.client.GetIntValue(testGetIntPropertyKey, nil, 1)
+	s.Error(err)
+}
+
+func (s *fileBasedClientSuite) TestUpdate_InvalidFileKeepsValues() {
+	client := s.client.(*fileBasedClient)
+	s.NoError(ioutil.WriteFile(s.file.Name(), []byte("unknownConfigKey:\n- value: c\n"), 0644))
+	s.NoError(os.Chtimes(s.file.Name(), time.Now(), client.lastUpdatedTime.Add(time.Second)))
+	s.Error(client.update())
+
+	v, err := s.client.GetValue(testGetPropertyKey, "b")
+	s.NoError(err)
+	s.Equal("a", v)
+}
+
+func (s *fileBasedClientSuite) TestNewFileBasedClient_InvalidConfig() {
+	logger := bark.NewLoggerFromLogrus(logrus.New())
+	_, err := NewFileBasedClient(nil, logger, nil)
+	s.Error(err)
+
+	_, err = NewFileBasedClient(&FileBasedClientConfig{}, logger, nil)
+	s.Error(err)
+
+	_, err = NewFileBasedClient(&FileBasedClientConfig{Filepath: "/no/such/file.yaml"}, logger, nil)
+	s.Error(err)
+
+	_, err = NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     s.file.Name(),
+		PollInterval: time.Millisecond,
+	}, logger, nil)
+	s.Error(err)
+}
+
+func (s *fileBasedClientSuite) TestNewFileBasedClient_InvalidConstraint() {
+	s.NoError(ioutil.WriteFile(s.file.Name(), []byte("testGetPropertyKey:\n- value: c\n  constraints:\n    zone: dca\n"), 0644))
+	_, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     s.file.Name(),
+		PollInterval: time.Second * 5,
+	}, bark.NewLoggerFromLogrus(logrus.New()), nil)
+	s.Error(err)
+}
@@ -26,6 +26,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/blobstore"
 
 	"github.com/uber/cadence/client"
@@ -35,6 +36,7 @@ import (
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/search"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 
@@ -69,6 +71,8 @@ type (
 		DynamicConfig      dynamicconfig.Client
 		DispatcherProvider client.DispatcherProvider
 		BlobstoreClient    blobstore.Client
+		SearchClient       search.Client
+		Authorizer         authorization.Authorizer
 	}
 
 	// RingpopFactory provides a bootstrapped ringpop
@@ -22,6 +22,8 @@ package common
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -80,6 +82,9 @@ const (
 var (
 	// ErrBlobSizeExceedsLimit is error for event blob size exceeds limit
 	ErrBlobSizeExceedsLimit = &workflow.BadRequestError{Message: "Blob data size exceeds limit."}
+
+	// cronTimezonePrefixes are the prefixes a cron schedule can use to name the location it is evaluated in
+	cronTimezonePrefixes = []string{"CRON_TZ=", "TZ="}
 )
 
 // AwaitWaitGroup calls Wait on the given wait
@@ -329,12 +334,55 @@ func ValidateCronSchedule(cronSchedule string) error {
 	if cronSchedule == "" {
 		return nil
 	}
-	if _, err := cron.Parse(cronSchedule); err != nil {
+	if _, _, err := ParseCronSchedule(cronSchedule); err != nil {
 		return &workflow.BadRequestError{Message: "Invalid CronSchedule."}
 	}
 	return nil
 }
 
+// ParseCronSchedule parses a standard cron schedule spec, which may be prefixed with CRON_TZ=<location> or
+// TZ=<location>, e.g. "CRON_TZ=America/New_York 0 9 * * *". It returns the schedule along with the location
+// the schedule is evaluated in, which is UTC when no location is given.
+func ParseCronSchedule(cronSchedule string) (cron.Schedule, *time.Location, error) {
+	spec := strings.TrimSpace(cronSchedule)
+	location := time.UTC
+	for _, prefix := range cronTimezonePrefixes {
+		if !strings.HasPrefix(spec, prefix) {
+			continue
+		}
+		fields := strings.SplitN(spec[len(prefix):], " ", 2)
+		if len(fields) != 2 {
+			return nil, nil, fmt.Errorf("missing schedule after %v%v", prefix, fields[0])
+		}
+		loc, err := time.LoadLocation(fields[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		location = loc
+		spec = strings.TrimSpace(fields[1])
+		break
+	}
+
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	return schedule, location, nil
+}
+
+// GetNextCronScheduleTime returns the first time after the given time at which the cron schedule fires,
+// or the zero time if the cron schedule is empty or invalid
+func GetNextCronScheduleTime(cronSchedule string, after time.Time) time.Time {
+	if cronSchedule == "" {
+		return time.Time{}
+	}
+	schedule, location, err := ParseCronSchedule(cronSchedule)
+	if err != nil {
+		return time.Time{}
+	}
+	return schedule.Next(after.In(location))
+}
+
 // CreateHistoryStartWorkflowRequest create a start workflow request for history
 func CreateHistoryStartWorkflowRequest(domainID string, startRequest *workflow.StartWorkflowExecutionRequest) *h.StartWorkflowExecutionRequest {
 	histRequest := &h.StartWorkflowExecutionRequest{
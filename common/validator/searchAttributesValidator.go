@@ -0,0 +1,167 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+// SearchAttributesValidator is used to validate search attributes
+type SearchAttributesValidator struct {
+	logger bark.Logger
+
+	validSearchAttributes             dynamicconfig.MapPropertyFn
+	searchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter
+	searchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithDomainFilter
+	searchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithDomainFilter
+}
+
+// NewSearchAttributesValidator create SearchAttributesValidator
+func NewSearchAttributesValidator(
+	logger bark.Logger,
+	validSearchAttributes dynamicconfig.MapPropertyFn,
+	searchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter,
+	searchAttributesSizeOfValueLimit dynamicconfig.IntPropertyFnWithDomainFilter,
+	searchAttributesTotalSizeLimit dynamicconfig.IntPropertyFnWithDomainFilter,
+) *SearchAttributesValidator {
+	return &SearchAttributesValidator{
+		logger:                            logger,
+		validSearchAttributes:             validSearchAttributes,
+		searchAttributesNumberOfKeysLimit: searchAttributesNumberOfKeysLimit,
+		searchAttributesSizeOfValueLimit:  searchAttributesSizeOfValueLimit,
+		searchAttributesTotalSizeLimit:    searchAttributesTotalSizeLimit,
+	}
+}
+
+// ValidateSearchAttributes validate search attributes are valid for writing and not exceed limits.
+// Each value is expected to be the json encoding of a value of the whitelisted type of its key.
+func (sv *SearchAttributesValidator) ValidateSearchAttributes(input *workflow.SearchAttributes, domain string) error {
+	if input == nil {
+		return nil
+	}
+
+	fields := input.GetIndexedFields()
+	lengthOfFields := len(fields)
+	if lengthOfFields > sv.searchAttributesNumberOfKeysLimit(domain) {
+		sv.logger.WithFields(bark.Fields{
+			"domain":       domain,
+			"numberOfKeys": lengthOfFields,
+		}).Error("number of keys in search attributes exceed limit")
+		return &workflow.BadRequestError{
+			Message: fmt.Sprintf("number of keys %d exceed limit", lengthOfFields),
+		}
+	}
+
+	validAttr := sv.validSearchAttributes()
+	sizeOfValueLimit := sv.searchAttributesSizeOfValueLimit(domain)
+	totalSize := 0
+	for key, val := range fields {
+		if definition.IsSystemIndexedKey(key) {
+			return &workflow.BadRequestError{
+				Message: fmt.Sprintf("%s is a system attribute and can not be set", key),
+			}
+		}
+		valueType, ok := validAttr[key]
+		if !ok {
+			return &workflow.BadRequestError{
+				Message: fmt.Sprintf("%s is not a valid search attribute key", key),
+			}
+		}
+		indexedValueType, err := definition.ConvertIndexedValueType(valueType)
+		if err != nil {
+			sv.logger.WithFields(bark.Fields{
+				"searchAttributeKey": key,
+				logging.TagErr:       err,
+			}).Error("invalid type of search attribute in dynamic config")
+			return &workflow.BadRequestError{
+				Message: fmt.Sprintf("%s is not a valid search attribute key", key),
+			}
+		}
+		if len(val) > sizeOfValueLimit {
+			return &workflow.BadRequestError{
+				Message: fmt.Sprintf("size limit exceed for key %s", key),
+			}
+		}
+		if err := validateValueType(val, indexedValueType); err != nil {
+			return &workflow.BadRequestError{
+				Message: fmt.Sprintf("invalid value for key %s: %v", key, err),
+			}
+		}
+		totalSize += len(key) + len(val)
+	}
+
+	if totalSize > sv.searchAttributesTotalSizeLimit(domain) {
+		return &workflow.BadRequestError{
+			Message: fmt.Sprintf("total size of search attributes %d exceed limit", totalSize),
+		}
+	}
+
+	return nil
+}
+
+func validateValueType(val []byte, valueType workflow.IndexedValueType) error {
+	decoder := json.NewDecoder(bytes.NewReader(val))
+	decoder.UseNumber()
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return fmt.Errorf("value is not json encoded")
+	}
+
+	switch valueType {
+	case workflow.IndexedValueTypeString, workflow.IndexedValueTypeKeyword:
+		if _, ok := decoded.(string); ok {
+			return nil
+		}
+	case workflow.IndexedValueTypeInt:
+		if n, ok := decoded.(json.Number); ok {
+			if _, err := n.Int64(); err == nil {
+				return nil
+			}
+		}
+	case workflow.IndexedValueTypeDouble:
+		if n, ok := decoded.(json.Number); ok {
+			if f, err := n.Float64(); err == nil && !math.IsInf(f, 0) {
+				return nil
+			}
+		}
+	case workflow.IndexedValueTypeBool:
+		if _, ok := decoded.(bool); ok {
+			return nil
+		}
+	case workflow.IndexedValueTypeDatetime:
+		if s, ok := decoded.(string); ok {
+			if _, err := time.Parse(time.RFC3339Nano, s); err == nil {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("value is not of type %v", valueType)
+}
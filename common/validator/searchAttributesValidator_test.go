@@ -0,0 +1,113 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package validator
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type searchAttributesValidatorSuite struct {
+	suite.Suite
+	validator *SearchAttributesValidator
+}
+
+func TestSearchAttributesValidatorSuite(t *testing.T) {
+	s := new(searchAttributesValidatorSuite)
+	suite.Run(t, s)
+}
+
+func (s *searchAttributesValidatorSuite) SetupTest() {
+	s.validator = NewSearchAttributesValidator(
+		bark.NewLoggerFromLogrus(logrus.New()),
+		dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
+		dynamicconfig.GetIntPropertyFilteredByDomain(3),
+		dynamicconfig.GetIntPropertyFilteredByDomain(24),
+		dynamicconfig.GetIntPropertyFilteredByDomain(100),
+	)
+}
+
+func (s *searchAttributesValidatorSuite) validate(fields map[string][]byte) error {
+	return s.validator.ValidateSearchAttributes(&workflow.SearchAttributes{IndexedFields: fields}, "domain")
+}
+
+func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes() {
+	s.NoError(s.validator.ValidateSearchAttributes(nil, "domain"))
+	s.NoError(s.validate(nil))
+	s.NoError(s.validate(map[string][]byte{
+		definition.CustomKeywordField: []byte(`"keyword"`),
+		definition.CustomIntField:     []byte(`1`),
+		definition.CustomBoolField:    []byte(`true`),
+	}))
+	s.NoError(s.validate(map[string][]byte{
+		definition.CustomDoubleField:   []byte(`1.5`),
+		definition.CustomDatetimeField: []byte(`"2019-01-01T00:00:00Z"`),
+	}))
+}
+
+func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes_ExceedLimits() {
+	err := s.validate(map[string][]byte{
+		definition.CustomKeywordField: []byte(`"keyword"`),
+		definition.CustomStringField:  []byte(`"string"`),
+		definition.CustomIntField:     []byte(`1`),
+		definition.CustomBoolField:    []byte(`true`),
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+
+	err = s.validate(map[string][]byte{
+		definition.CustomStringField: []byte(`"a string value over the limit"`),
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+
+	err = s.validate(map[string][]byte{
+		definition.CustomStringField:  []byte(`"string value 1"`),
+		definition.CustomKeywordField: []byte(`"string value 2"`),
+		definition.CustomDoubleField:  []byte(`1234567890.1234567890`),
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes_InvalidKey() {
+	err := s.validate(map[string][]byte{"UnknownField": []byte(`1`)})
+	s.IsType(&workflow.BadRequestError{}, err)
+
+	err = s.validate(map[string][]byte{definition.WorkflowID: []byte(`"wid"`)})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes_InvalidValue() {
+	for key, val := range map[string]string{
+		definition.CustomIntField:      `1.5`,
+		definition.CustomDoubleField:   `"1.5"`,
+		definition.CustomBoolField:     `1`,
+		definition.CustomKeywordField:  `keyword`,
+		definition.CustomDatetimeField: `"yesterday"`,
+	} {
+		err := s.validate(map[string][]byte{key: []byte(val)})
+		s.IsType(&workflow.BadRequestError{}, err, key)
+	}
+}
@@ -3119,6 +3119,11 @@ func (s *integrationSuite) TestDescribeWorkflowExecution() {
 	s.Nil(err)
 	s.True(nil == dweResponse.WorkflowExecutionInfo.CloseTime)
 	s.Equal(int64(2), *dweResponse.WorkflowExecutionInfo.HistoryLength) // WorkflowStarted, DecisionScheduled
+	s.NotNil(dweResponse.PendingDecision)
+	s.Equal(workflow.PendingDecisionStateScheduled, dweResponse.PendingDecision.GetState())
+	s.True(dweResponse.PendingDecision.GetScheduledTimestamp() > 0)
+	s.Nil(dweResponse.PendingDecision.StartedTimestamp)
+	s.Equal(int64(0), dweResponse.PendingDecision.GetAttempt())
 
 	// decider logic
 	workflowComplete := false
@@ -3181,6 +3186,10 @@ func (s *integrationSuite) TestDescribeWorkflowExecution() {
 	s.Equal(1, len(dweResponse.PendingActivities))
 	s.Equal("test-activity-type", dweResponse.PendingActivities[0].ActivityType.GetName())
 	s.Equal(int64(0), dweResponse.PendingActivities[0].GetLastHeartbeatTimestamp())
+	s.Equal(workflow.PendingActivityStateScheduled, dweResponse.PendingActivities[0].GetState())
+	s.True(dweResponse.PendingActivities[0].GetScheduledTimestamp() > 0)
+	s.Nil(dweResponse.PendingActivities[0].LastStartedTimestamp)
+	s.Nil(dweResponse.PendingDecision)
 
 	// process activity task
 	err = poller.PollAndProcessActivityTask(false)
@@ -3190,6 +3199,8 @@ func (s *integrationSuite) TestDescribeWorkflowExecution() {
 	s.True(nil == dweResponse.WorkflowExecutionInfo.CloseStatus)
 	s.Equal(int64(8), *dweResponse.WorkflowExecutionInfo.HistoryLength) // ActivityTaskStarted, ActivityTaskCompleted, DecisionTaskScheduled
 	s.Equal(0, len(dweResponse.PendingActivities))
+	s.NotNil(dweResponse.PendingDecision)
+	s.Equal(workflow.PendingDecisionStateScheduled, dweResponse.PendingDecision.GetState())
 
 	// Process signal in decider
 	_, err = poller.PollAndProcessDecisionTask(true, false)
@@ -21,6 +21,7 @@
 namespace java com.uber.cadence.admin
 
 include "shared.thrift"
+include "replicator.thrift"
 
 /**
 * AdminService provides advanced APIs for debugging and analysis with admin privillege
@@ -57,6 +58,147 @@ service AdminService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
+      5: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * ReadHistoryBranch returns the raw history batches of a history branch, identified by its tree and branch ID.
+  **/
+  ReadHistoryBranchResponse ReadHistoryBranch(1: ReadHistoryBranchRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * DeleteWorkflowExecution deletes the mutable state of a workflow execution, and the current execution record
+  * if it points to that execution. History and visibility records are left untouched.
+  **/
+  void DeleteWorkflowExecution(1: DeleteWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * GetDomainIDOrName maps a domain name to its ID, or a domain ID to its name.
+  **/
+  GetDomainIDOrNameResponse GetDomainIDOrName(1: GetDomainIDOrNameRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * DeleteDomain starts a system workflow which deletes the executions, history and task lists of a deprecated
+  * domain, and the domain itself once they are gone. The domain has to be deprecated first, no workflow can be
+  * started in a deprecated domain. The call is idempotent and returns the deletion workflow that is already
+  * running if there is one. The executions are only deleted from the visibility database, their documents in
+  * the search backend the indexer writes to are not purged.
+  **/
+  DeleteDomainResponse DeleteDomain(1: DeleteDomainRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * StartBatch starts a system workflow which terminates, cancels or signals the open workflows of a domain
+  * matching the filters of the request. The caller is authorized against the domain of the workflows.
+  **/
+  StartBatchResponse StartBatch(1: StartBatchRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * GetReplicationMessages returns the replication tasks of a history shard after the last retrieved message ID.
+  * It is long polled by the other clusters when they pull replication tasks instead of consuming them from kafka.
+  **/
+  replicator.ReplicationMessages GetReplicationMessages(1: replicator.GetReplicationMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * GetDomainReplicationMessages returns the global domains replicated to a cluster which changed after the last
+  * retrieved message ID, which is the notification version of the domains in this cluster. It is polled by the
+  * other clusters when they pull replication tasks instead of consuming them from kafka.
+  **/
+  replicator.ReplicationMessages GetDomainReplicationMessages(1: replicator.GetDomainReplicationMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * ReadDLQMessages returns a page of the replication tasks from a source cluster that failed to be applied
+  * on a history shard and were put into its dead letter queue, optionally filtered by domain and workflow.
+  **/
+  replicator.ReadDLQMessagesResponse ReadDLQMessages(1: replicator.ReadDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.EntityNotExistsError entityNotExistError,
+      5: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * MergeDLQMessages applies a page of the replication tasks in the dead letter queue of a history shard and
+  * removes the applied ones from the queue. It stops at the first task that still fails to be applied.
+  **/
+  replicator.MergeDLQMessagesResponse MergeDLQMessages(1: replicator.MergeDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.EntityNotExistsError entityNotExistError,
+      5: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * PurgeDLQMessages removes the replication tasks in the dead letter queue of a history shard without applying them.
+  **/
+  void PurgeDLQMessages(1: replicator.PurgeDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.EntityNotExistsError entityNotExistError,
+      5: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * VerifyWorkflowExecution compares the mutable state of a workflow execution of a global domain in all the
+  * clusters the domain is replicated to and reports where they diverge from the active cluster. When repair is
+  * set and the cluster serving the request is a standby cluster behind the active one, the missing history is
+  * re-replicated from the active cluster.
+  **/
+  VerifyWorkflowExecutionResponse VerifyWorkflowExecution(1: VerifyWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.EntityNotExistsError entityNotExistError,
+      5: shared.AccessDeniedError accessDeniedError,
     )
 }
 
@@ -86,4 +228,87 @@ struct GetWorkflowExecutionRawHistoryResponse {
   20: optional list<shared.DataBlob> historyBatches
   30: optional map<string, shared.ReplicationInfo> replicationInfo
   40: optional i32 eventStoreVersion
-}
\ No newline at end of file
+}
+struct ReadHistoryBranchRequest {
+  10: optional string domain
+  20: optional string treeId
+  30: optional string branchId
+  40: optional i64 (js.type = "Long") minEventId
+  50: optional i64 (js.type = "Long") maxEventId
+  60: optional i32 maximumPageSize
+  70: optional binary nextPageToken
+}
+
+struct ReadHistoryBranchResponse {
+  10: optional binary nextPageToken
+  20: optional list<shared.DataBlob> historyBatches
+}
+
+struct DeleteWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+}
+
+struct GetDomainIDOrNameRequest {
+  10: optional string domain
+  20: optional string domainId
+}
+
+struct GetDomainIDOrNameResponse {
+  10: optional string domain
+  20: optional string domainId
+}
+
+struct DeleteDomainRequest {
+  10: optional string domain
+}
+
+struct DeleteDomainResponse {
+  10: optional string workflowId
+  20: optional string runId
+}
+
+struct StartBatchRequest {
+  10: optional string domain
+  // batchType is one of terminate, cancel or signal
+  20: optional string batchType
+  30: optional string reason
+  40: optional string workflowType
+  50: optional string workflowIdPrefix
+  60: optional i64 (js.type = "Long") startTimeEarliest
+  70: optional i64 (js.type = "Long") startTimeLatest
+  80: optional string signalName
+  90: optional string signalInput
+  100: optional i32 rps
+  110: optional i32 concurrency
+}
+
+struct StartBatchResponse {
+  10: optional string jobId
+  20: optional string runId
+}
+
+struct VerifyWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+  30: optional bool repair
+}
+
+struct ClusterWorkflowState {
+  10: optional string clusterName
+  20: optional bool exists
+  30: optional string runId
+  40: optional i64 (js.type = "Long") nextEventId
+  50: optional i64 (js.type = "Long") lastWriteVersion
+  60: optional map<string, shared.ReplicationInfo> replicationInfo
+  70: optional list<i64> pendingActivityIds
+  80: optional list<string> pendingTimerIds
+  90: optional string error
+}
+
+struct VerifyWorkflowExecutionResponse {
+  10: optional string activeCluster
+  20: optional list<ClusterWorkflowState> clusterStates
+  30: optional list<string> divergences
+  40: optional bool repaired
+}
@@ -43,6 +43,7 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.DomainAlreadyExistsError domainExistsError,
       4: shared.ServiceBusyError serviceBusyError,
+      5: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -54,6 +55,7 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
+      5: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -65,6 +67,7 @@ service WorkflowService {
         2: shared.InternalServiceError internalServiceError,
         3: shared.EntityNotExistsError entityNotExistError,
         4: shared.ServiceBusyError serviceBusyError,
+        5: shared.AccessDeniedError accessDeniedError,
       )
 
   /**
@@ -77,6 +80,7 @@ service WorkflowService {
         3: shared.EntityNotExistsError entityNotExistError,
         4: shared.ServiceBusyError serviceBusyError,
         5: shared.DomainNotActiveError domainNotActiveError,
+        6: shared.AccessDeniedError accessDeniedError,
       )
 
   /**
@@ -91,6 +95,7 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -108,6 +113,7 @@ service WorkflowService {
       5: shared.DomainNotActiveError domainNotActiveError,
       6: shared.LimitExceededError limitExceededError,
       7: shared.EntityNotExistsError entityNotExistError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -120,6 +126,7 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
+      5: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -137,6 +144,7 @@ service WorkflowService {
       4: shared.LimitExceededError limitExceededError,
       5: shared.EntityNotExistsError entityNotExistError,
       6: shared.DomainNotActiveError domainNotActiveError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -155,6 +163,7 @@ service WorkflowService {
       4: shared.DomainNotActiveError domainNotActiveError,
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -171,6 +180,7 @@ service WorkflowService {
       4: shared.DomainNotActiveError domainNotActiveError,
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -190,6 +200,7 @@ service WorkflowService {
       4: shared.LimitExceededError limitExceededError,
       5: shared.EntityNotExistsError entityNotExistError,
       6: shared.DomainNotActiveError domainNotActiveError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -207,6 +218,7 @@ service WorkflowService {
       4: shared.DomainNotActiveError domainNotActiveError,
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -224,6 +236,7 @@ service WorkflowService {
       4: shared.DomainNotActiveError domainNotActiveError,
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -241,6 +254,7 @@ service WorkflowService {
       4: shared.DomainNotActiveError domainNotActiveError,
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -258,6 +272,7 @@ service WorkflowService {
       4: shared.DomainNotActiveError domainNotActiveError,
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -275,6 +290,7 @@ service WorkflowService {
       4: shared.DomainNotActiveError domainNotActiveError,
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -292,6 +308,7 @@ service WorkflowService {
       4: shared.DomainNotActiveError domainNotActiveError,
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -309,6 +326,7 @@ service WorkflowService {
       4: shared.DomainNotActiveError domainNotActiveError,
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -326,6 +344,7 @@ service WorkflowService {
       4: shared.DomainNotActiveError domainNotActiveError,
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -343,6 +362,7 @@ service WorkflowService {
       5: shared.ServiceBusyError serviceBusyError,
       6: shared.DomainNotActiveError domainNotActiveError,
       7: shared.LimitExceededError limitExceededError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -357,6 +377,7 @@ service WorkflowService {
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.DomainNotActiveError domainNotActiveError,
       6: shared.LimitExceededError limitExceededError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -375,6 +396,7 @@ service WorkflowService {
       5: shared.DomainNotActiveError domainNotActiveError,
       6: shared.LimitExceededError limitExceededError,
       7: shared.WorkflowExecutionAlreadyStartedError workflowAlreadyStartedError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -389,6 +411,38 @@ service WorkflowService {
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.DomainNotActiveError domainNotActiveError,
       6: shared.LimitExceededError limitExceededError,
+      7: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * ResetWorkflowExecution resets an existing workflow execution to the point right before the given DecisionTaskCompleted,
+  * DecisionTaskFailed or DecisionTaskTimedOut event. The current run of the workflow is terminated and a new run is
+  * started from the history forked at that point, with a DecisionTaskFailed event recording the reset.
+  **/
+  shared.ResetWorkflowExecutionResponse ResetWorkflowExecution(1: shared.ResetWorkflowExecutionRequest resetRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * TriggerCronWorkflowExecution starts the next run of a cron workflow right away. The current run of the workflow
+  * must be waiting for its cron schedule, i.e. it has not scheduled its first decision yet.
+  **/
+  void TriggerCronWorkflowExecution(1: shared.TriggerCronWorkflowExecutionRequest triggerRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -401,6 +455,7 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.LimitExceededError limitExceededError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -412,6 +467,32 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
+      5: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * ListWorkflowExecutions is a visibility API to list workflow executions in a specific domain, both open and closed,
+  * that match the given query. The query is a SQL-like WHERE clause over the built-in and custom search attributes,
+  * for example "WorkflowType = 'order' AND CustomKeywordField = 'customer-1' AND CloseTime = missing".
+  **/
+  shared.ListWorkflowExecutionsResponse ListWorkflowExecutions(1: shared.ListWorkflowExecutionsRequest listRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * GetSearchAttributes is a visibility API to get the search attribute keys, and their value types, that are allowed
+  * in this cluster.
+  **/
+  shared.GetSearchAttributesResponse GetSearchAttributes()
+    throws (
+      1: shared.InternalServiceError internalServiceError,
+      2: shared.ServiceBusyError serviceBusyError,
+      3: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -427,6 +508,7 @@ service WorkflowService {
       4: shared.LimitExceededError limitExceededError,
       5: shared.ServiceBusyError serviceBusyError,
       6: shared.DomainNotActiveError domainNotActiveError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -446,6 +528,7 @@ service WorkflowService {
       4: shared.LimitExceededError limitExceededError,
       5: shared.ServiceBusyError serviceBusyError,
       6: shared.DomainNotActiveError domainNotActiveError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -459,6 +542,7 @@ service WorkflowService {
 	  4: shared.QueryFailedError queryFailedError,
 	  5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
+      7: shared.AccessDeniedError accessDeniedError,
 	)
 
   /**
@@ -471,6 +555,7 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.LimitExceededError limitExceededError,
       5: shared.ServiceBusyError serviceBusyError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -484,6 +569,7 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.LimitExceededError limitExceededError,
       5: shared.ServiceBusyError serviceBusyError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 
 }
@@ -19,6 +19,7 @@
 // THE SOFTWARE.
 
 include "shared.thrift"
+include "replicator.thrift"
 
 namespace java com.uber.cadence.history
 
@@ -51,6 +52,21 @@ struct StartWorkflowExecutionRequest {
   60: optional i32 firstDecisionTaskBackoffSeconds
 }
 
+struct ReadDLQMessagesRequest {
+  10: optional string domainUUID
+  20: optional replicator.ReadDLQMessagesRequest request
+}
+
+struct MergeDLQMessagesRequest {
+  10: optional string domainUUID
+  20: optional replicator.MergeDLQMessagesRequest request
+}
+
+struct PurgeDLQMessagesRequest {
+  10: optional string domainUUID
+  20: optional replicator.PurgeDLQMessagesRequest request
+}
+
 struct DescribeMutableStateRequest{
   10: optional string domainUUID
   20: optional shared.WorkflowExecution execution
@@ -194,6 +210,16 @@ struct TerminateWorkflowExecutionRequest {
   20: optional shared.TerminateWorkflowExecutionRequest terminateRequest
 }
 
+struct ResetWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.ResetWorkflowExecutionRequest resetRequest
+}
+
+struct TriggerCronWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.TriggerCronWorkflowExecutionRequest triggerRequest
+}
+
 struct RequestCancelWorkflowExecutionRequest {
   10: optional string domainUUID
   20: optional shared.RequestCancelWorkflowExecutionRequest cancelRequest
@@ -531,6 +557,37 @@ service HistoryService {
       7: shared.ServiceBusyError serviceBusyError,
     )
 
+  /**
+  * ResetWorkflowExecution resets an existing workflow execution to the point right before the given DecisionTaskCompleted,
+  * DecisionTaskFailed or DecisionTaskTimedOut event. The current run of the workflow is terminated and a new run is
+  * started from the history forked at that point, with a DecisionTaskFailed event recording the reset.
+  **/
+  shared.ResetWorkflowExecutionResponse ResetWorkflowExecution(1: ResetWorkflowExecutionRequest resetRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * TriggerCronWorkflowExecution starts the next run of a cron workflow right away. The current run of the workflow
+  * must be waiting for its cron schedule, i.e. it has not scheduled its first decision yet.
+  **/
+  void TriggerCronWorkflowExecution(1: TriggerCronWorkflowExecutionRequest triggerRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
   /**
   * RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
   * It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
@@ -641,6 +698,54 @@ service HistoryService {
       6: shared.RetryTaskError retryTaskError,
     )
 
+  /**
+  * GetReplicationMessages returns the replication tasks of a shard after the last retrieved message ID, it is
+  * long polled by the history hosts of the other clusters when they pull replication tasks instead of consuming
+  * them from kafka.
+  **/
+  replicator.ReplicationMessages GetReplicationMessages(1: replicator.GetReplicationMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.LimitExceededError limitExceededError,
+      5: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ReadDLQMessages returns a page of the replication tasks in the dead letter queue of a shard.
+  **/
+  replicator.ReadDLQMessagesResponse ReadDLQMessages(1: ReadDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * MergeDLQMessages applies a page of the replication tasks in the dead letter queue of a shard and removes
+  * them from the queue.
+  **/
+  replicator.MergeDLQMessagesResponse MergeDLQMessages(1: MergeDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * PurgeDLQMessages removes the replication tasks in the dead letter queue of a shard.
+  **/
+  void PurgeDLQMessages(1: PurgeDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
   /**
   * DescribeMutableState returns information about the internal states of workflow mutable state.
   **/
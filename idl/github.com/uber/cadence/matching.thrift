@@ -22,10 +22,15 @@ include "shared.thrift"
 
 namespace java com.uber.cadence.matching
 
+exception RemoteSyncMatchFailedError {
+  1: required string message
+}
+
 struct PollForDecisionTaskRequest {
   10: optional string domainUUID
   15: optional string pollerID
   20: optional shared.PollForDecisionTaskRequest pollRequest
+  30: optional string forwardedFrom
 }
 
 struct PollForDecisionTaskResponse {
@@ -49,6 +54,7 @@ struct PollForActivityTaskRequest {
   10: optional string domainUUID
   15: optional string pollerID
   20: optional shared.PollForActivityTaskRequest pollRequest
+  30: optional string forwardedFrom
 }
 
 struct AddDecisionTaskRequest {
@@ -57,6 +63,7 @@ struct AddDecisionTaskRequest {
   30: optional shared.TaskList taskList
   40: optional i64 (js.type = "Long") scheduleId
   50: optional i32 scheduleToStartTimeoutSeconds
+  60: optional string forwardedFrom
 }
 
 struct AddActivityTaskRequest {
@@ -66,6 +73,7 @@ struct AddActivityTaskRequest {
   40: optional shared.TaskList taskList
   50: optional i64 (js.type = "Long") scheduleId
   60: optional i32 scheduleToStartTimeoutSeconds
+  70: optional string forwardedFrom
 }
 
 struct QueryWorkflowRequest {
@@ -136,6 +144,7 @@ service MatchingService {
       3: shared.ServiceBusyError serviceBusyError,
       4: shared.LimitExceededError limitExceededError,
       5: shared.DomainNotActiveError domainNotActiveError,
+      6: RemoteSyncMatchFailedError remoteSyncMatchFailedError,
     )
 
   /**
@@ -149,6 +158,7 @@ service MatchingService {
       3: shared.ServiceBusyError serviceBusyError,
       4: shared.LimitExceededError limitExceededError,
       5: shared.DomainNotActiveError domainNotActiveError,
+      6: RemoteSyncMatchFailedError remoteSyncMatchFailedError,
     )
 
   /**
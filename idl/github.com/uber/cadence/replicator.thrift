@@ -21,7 +21,6 @@
 namespace java com.uber.cadence.replicator
 
 include "shared.thrift"
-include "history.thrift"
 
 enum ReplicationTaskType {
   Domain
@@ -86,5 +85,68 @@ struct ReplicationTask {
   30: optional HistoryTaskAttributes historyTaskAttributes
   40: optional SyncShardStatusTaskAttributes syncShardStatusTaskAttributes
   50: optional SyncActicvityTaskAttributes syncActicvityTaskAttributes
+  // the ID of the task in the replication queue of the source shard
+  60: optional i64 (js.type = "Long") sourceTaskId
 }
 
+struct ReplicationMessages {
+  10: optional list<ReplicationTask> replicationTasks
+  // the ID of the last replication task returned, to be passed in the next GetReplicationMessagesRequest
+  20: optional i64 (js.type = "Long") lastRetrievedMessageId
+  30: optional bool hasMore
+}
+
+struct GetReplicationMessagesRequest {
+  10: optional i32 shardId
+  // all replication tasks up to and including this ID have been applied by the requesting cluster,
+  // -1 to start from the replication ack level of the shard
+  20: optional i64 (js.type = "Long") lastRetrievedMessageId
+  30: optional string clusterName
+}
+
+struct GetDomainReplicationMessagesRequest {
+  // all the domain changes up to and including this notification version have been applied by the requesting
+  // cluster, -1 to start from the first one
+  10: optional i64 (js.type = "Long") lastRetrievedMessageId
+  20: optional string clusterName
+}
+
+struct ReplicationDLQTask {
+  10: optional i64 (js.type = "Long") taskId
+  20: optional ReplicationTask task
+}
+
+struct ReadDLQMessagesRequest {
+  10: optional string sourceCluster
+  20: optional i32 shardId
+  30: optional string domain
+  40: optional string workflowId
+  50: optional i32 maximumPageSize
+  60: optional binary nextPageToken
+}
+
+struct ReadDLQMessagesResponse {
+  10: optional list<ReplicationDLQTask> replicationTasks
+  20: optional binary nextPageToken
+}
+
+struct MergeDLQMessagesRequest {
+  10: optional string sourceCluster
+  20: optional i32 shardId
+  30: optional string domain
+  40: optional string workflowId
+  50: optional i32 maximumPageSize
+  60: optional binary nextPageToken
+}
+
+struct MergeDLQMessagesResponse {
+  10: optional i32 mergedCount
+  20: optional binary nextPageToken
+}
+
+struct PurgeDLQMessagesRequest {
+  10: optional string sourceCluster
+  20: optional i32 shardId
+  30: optional string domain
+  40: optional string workflowId
+}
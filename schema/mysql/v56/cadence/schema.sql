@@ -40,6 +40,7 @@ CREATE TABLE shards (
 	timer_ack_level DATETIME(6) NOT NULL,
 	cluster_transfer_ack_level BLOB NOT NULL,
 	cluster_timer_ack_level BLOB NOT NULL,
+	cluster_replication_level BLOB,
 	domain_notification_version BIGINT NOT NULL,
 	PRIMARY KEY (shard_id)
 );
@@ -101,6 +102,8 @@ CREATE TABLE executions(
 	decision_timeout INT NOT NULL, -- 4.
 	decision_attempt BIGINT NOT NULL, -- 5.
 	decision_timestamp BIGINT NOT NULL, -- 6.
+	decision_scheduled_timestamp BIGINT NOT NULL DEFAULT 0,
+	decision_started_timestamp BIGINT NOT NULL DEFAULT 0,
 	cancel_requested TINYINT(1), -- a.
 	cancel_request_id VARCHAR(255), -- b. default values not checked
 	sticky_task_list VARCHAR(255) NOT NULL, -- 1. defualt value is checked
@@ -110,6 +113,8 @@ CREATE TABLE executions(
 	client_impl VARCHAR(255) NOT NULL, -- 5.
 	signal_count INT NOT NULL,
 	cron_schedule VARCHAR(255),
+	memo BLOB,
+	search_attributes BLOB,
 	-- TODO: fix sql to support workflow retry. https://github.com/uber/cadence/issues/1339
 	PRIMARY KEY (shard_id, domain_id, workflow_id, run_id)
 );
@@ -152,6 +157,7 @@ CREATE TABLE tasks (
   task_list_type TINYINT NOT NULL,
   task_id BIGINT NOT NULL,
   expiry_ts DATETIME(6) NOT NULL,
+  created_time DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
   PRIMARY KEY (shard_id, domain_id, task_list_name, task_list_type, task_id)
 );
 
@@ -163,6 +169,7 @@ CREATE TABLE task_lists (
 	ack_level BIGINT NOT NULL DEFAULT 0,
 	kind TINYINT NOT NULL, -- {Normal, Sticky}
 	expiry_ts DATETIME(6) NOT NULL,
+	last_updated DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
 	PRIMARY KEY (domain_id, name, task_type)
 );
 
@@ -182,6 +189,19 @@ CREATE TABLE replication_tasks (
 	PRIMARY KEY (shard_id, task_id)
 );
 
+CREATE TABLE replication_tasks_dlq (
+	source_cluster_name VARCHAR(255) NOT NULL,
+	shard_id INT NOT NULL,
+	task_id BIGINT NOT NULL,
+	--
+	domain_id CHAR(64) NOT NULL,
+	workflow_id VARCHAR(255) NOT NULL,
+	run_id CHAR(64) NOT NULL,
+	data BLOB NOT NULL,
+	data_encoding VARCHAR(16) NOT NULL,
+	PRIMARY KEY (source_cluster_name, shard_id, task_id)
+);
+
 CREATE TABLE timer_tasks (
 	shard_id INT NOT NULL,
 	visibility_timestamp DATETIME(6) NOT NULL,
@@ -248,6 +268,7 @@ max_interval                INT NOT NULL,
 expiration_time             DATETIME(6) NOT NULL,
 max_attempts                INT NOT NULL,
 non_retriable_errors        BLOB, -- this was a list<text>. The use pattern is to replace, no modifications.
+last_failure_reason         TEXT,
 	PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, schedule_id)
 );
 
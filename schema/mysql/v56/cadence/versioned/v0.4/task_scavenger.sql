@@ -0,0 +1,3 @@
+-- the rows which exist already get the time of the upgrade
+ALTER TABLE tasks ADD created_time DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6);
+ALTER TABLE task_lists ADD last_updated DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6);
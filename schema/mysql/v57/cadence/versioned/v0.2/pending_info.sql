@@ -0,0 +1,3 @@
+ALTER TABLE executions ADD COLUMN decision_scheduled_timestamp BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE executions ADD COLUMN decision_started_timestamp BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE activity_info_maps ADD COLUMN last_failure_reason TEXT;
@@ -0,0 +1,3 @@
+-- the rows which exist already get the time of the upgrade
+ALTER TABLE tasks ADD created_time TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3);
+ALTER TABLE task_lists ADD last_updated TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3);
@@ -0,0 +1,12 @@
+CREATE TABLE replication_tasks_dlq (
+	source_cluster_name VARCHAR(255) NOT NULL,
+	shard_id INT NOT NULL,
+	task_id BIGINT NOT NULL,
+	--
+	domain_id VARCHAR(64) NOT NULL,
+	workflow_id VARCHAR(255) NOT NULL,
+	run_id VARCHAR(64) NOT NULL,
+	data BYTEA NOT NULL,
+	data_encoding VARCHAR(16) NOT NULL,
+	PRIMARY KEY (source_cluster_name, shard_id, task_id)
+);
@@ -0,0 +1,3 @@
+-- the rows which exist already get the time of the upgrade
+ALTER TABLE tasks ADD created_time TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE task_lists ADD last_updated TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP;
@@ -0,0 +1 @@
+ALTER TABLE shards ADD cluster_replication_level BYTEA;
@@ -0,0 +1 @@
+CREATE DATABASE cadence_visibility;
\ No newline at end of file
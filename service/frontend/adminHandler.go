@@ -22,6 +22,8 @@ package frontend
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -34,45 +36,65 @@ import (
 	"github.com/uber/cadence/.gen/go/admin/adminserviceserver"
 	h "github.com/uber/cadence/.gen/go/history"
 	hist "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/xdc"
 	historyService "github.com/uber/cadence/service/history"
+	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/uber/cadence/service/worker/domaindeleter"
 )
 
 var _ adminserviceserver.Interface = (*AdminHandler)(nil)
 
+const (
+	// verifyRepairTimeout is the timeout of replicating each batch of history events when repairing a workflow
+	verifyRepairTimeout = 30 * time.Second
+	// domainReplicationMessagesPageSize is the maximum number of domain replication tasks returned at once
+	domainReplicationMessagesPageSize = 100
+)
+
 type (
 	// AdminHandler - Thrift handler inteface for admin service
 	AdminHandler struct {
 		status                int32
 		numberOfHistoryShards int
 		service.Service
-		history       history.Client
-		domainCache   cache.DomainCache
-		metricsClient metrics.Client
-		historyMgr    persistence.HistoryManager
-		historyV2Mgr  persistence.HistoryV2Manager
-		startWG       sync.WaitGroup
+		history             history.Client
+		domainCache         cache.DomainCache
+		metricsClient       metrics.Client
+		metadataMgr         persistence.MetadataManager
+		historyMgr          persistence.HistoryManager
+		historyV2Mgr        persistence.HistoryV2Manager
+		executionMgrFactory persistence.ExecutionManagerFactory
+		authorizer          authorization.Authorizer
+		startWG             sync.WaitGroup
 	}
 )
 
 // NewAdminHandler creates a thrift handler for the cadence admin service
 func NewAdminHandler(
 	sVice service.Service, numberOfHistoryShards int, metadataMgr persistence.MetadataManager,
-	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager) *AdminHandler {
+	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
+	executionMgrFactory persistence.ExecutionManagerFactory, authorizer authorization.Authorizer) *AdminHandler {
 	handler := &AdminHandler{
 		status:                common.DaemonStatusInitialized,
 		numberOfHistoryShards: numberOfHistoryShards,
 		Service:               sVice,
 		domainCache:           cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetMetricsClient(), sVice.GetLogger()),
+		metadataMgr:           metadataMgr,
 		historyMgr:            historyMgr,
 		historyV2Mgr:          historyV2Mgr,
+		executionMgrFactory:   executionMgrFactory,
+		authorizer:            authorizer,
 	}
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
@@ -111,6 +133,10 @@ func (adh *AdminHandler) DescribeWorkflowExecution(ctx context.Context, request
 		return nil, adh.error(errRequestNotSet, scope)
 	}
 
+	if err := adh.authorize(ctx, "DescribeWorkflowExecution", request.GetDomain(), request, scope); err != nil {
+		return nil, err
+	}
+
 	if err := validateExecution(request.Execution); err != nil {
 		return nil, adh.error(err, scope)
 	}
@@ -125,6 +151,9 @@ func (adh *AdminHandler) DescribeWorkflowExecution(ctx context.Context, request
 	}
 
 	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
 
 	historyAddr := historyHost.GetAddress()
 	resp, err := adh.history.DescribeMutableState(ctx, &hist.DescribeMutableStateRequest{
@@ -149,6 +178,10 @@ func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *gen.D
 		return nil, adh.error(errRequestNotSet, scope)
 	}
 
+	if err := adh.authorize(ctx, "DescribeHistoryHost", "", request, scope); err != nil {
+		return nil, err
+	}
+
 	if request.ExecutionForHost != nil {
 		if err := validateExecution(request.ExecutionForHost); err != nil {
 			return nil, adh.error(err, scope)
@@ -166,6 +199,14 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	scope := metrics.AdminGetWorkflowExecutionRawHistoryScope
 	sw := adh.startRequestProfile(scope)
 	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.authorize(ctx, "GetWorkflowExecutionRawHistory", request.GetDomain(), request, scope); err != nil {
+		return nil, err
+	}
 	var err error
 	var size int
 
@@ -312,6 +353,694 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	return result, nil
 }
 
+// ReadHistoryBranch returns the raw history batches of a history branch, identified by its tree and branch ID
+func (adh *AdminHandler) ReadHistoryBranch(
+	ctx context.Context, request *admin.ReadHistoryBranchRequest) (*admin.ReadHistoryBranchResponse, error) {
+
+	scope := metrics.AdminReadHistoryBranchScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.authorize(ctx, "ReadHistoryBranch", request.GetDomain(), request, scope); err != nil {
+		return nil, err
+	}
+
+	if uuid.Parse(request.GetTreeId()) == nil {
+		return nil, adh.error(&gen.BadRequestError{Message: "Invalid TreeID."}, scope)
+	}
+	if uuid.Parse(request.GetBranchId()) == nil {
+		return nil, adh.error(&gen.BadRequestError{Message: "Invalid BranchID."}, scope)
+	}
+
+	minEventID := request.GetMinEventId()
+	if minEventID == 0 {
+		minEventID = common.FirstEventID
+	}
+	maxEventID := request.GetMaxEventId()
+	if maxEventID == 0 {
+		maxEventID = common.EndEventID
+	}
+	if minEventID < common.FirstEventID || minEventID >= maxEventID {
+		return nil, adh.error(&gen.BadRequestError{Message: "Invalid MinEventID && MaxEventID combination."}, scope)
+	}
+	pageSize := int(request.GetMaximumPageSize())
+	if pageSize < 0 {
+		return nil, adh.error(&gen.BadRequestError{Message: "Invalid PageSize."}, scope)
+	}
+	if pageSize == 0 {
+		pageSize = common.GetHistoryMaxPageSize
+	}
+
+	// the branch is looked up from its tree so that the events inherited from ancestor branches are read as well
+	tree, err := adh.historyV2Mgr.GetHistoryTree(&persistence.GetHistoryTreeRequest{TreeID: request.GetTreeId()})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	var branch *gen.HistoryBranch
+	for _, b := range tree.Branches {
+		if b.GetBranchID() == request.GetBranchId() {
+			branch = b
+			break
+		}
+	}
+	if branch == nil {
+		return nil, adh.error(&gen.EntityNotExistsError{
+			Message: fmt.Sprintf("History branch %v does not exist in tree %v.", request.GetBranchId(), request.GetTreeId()),
+		}, scope)
+	}
+	branchToken, err := codec.NewThriftRWEncoder().Encode(branch)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.historyV2Mgr.ReadHistoryBranchByBatch(&persistence.ReadHistoryBranchRequest{
+		BranchToken:   branchToken,
+		MinEventID:    minEventID,
+		MaxEventID:    maxEventID,
+		PageSize:      pageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		if _, ok := err.(*persistence.InvalidPersistenceRequestError); ok {
+			return nil, adh.error(&gen.BadRequestError{Message: err.Error()}, scope)
+		}
+		return nil, adh.error(err, scope)
+	}
+
+	adh.metricsClient.RecordTimer(scope, metrics.HistorySize, time.Duration(resp.Size))
+
+	serializer := persistence.NewHistorySerializer()
+	blobs := []*gen.DataBlob{}
+	for _, historyBatch := range resp.History {
+		blob, err := serializer.SerializeBatchEvents(historyBatch.Events, common.EncodingTypeThriftRW)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		blobs = append(blobs, &gen.DataBlob{
+			EncodingType: gen.EncodingTypeThriftRW.Ptr(),
+			Data:         blob.Data,
+		})
+	}
+
+	result := &admin.ReadHistoryBranchResponse{
+		HistoryBatches: blobs,
+	}
+	if len(resp.NextPageToken) != 0 {
+		result.NextPageToken = resp.NextPageToken
+	}
+	return result, nil
+}
+
+// DeleteWorkflowExecution deletes the mutable state of a workflow execution, together with the current execution
+// record if it still points to the execution. History and visibility records are not touched.
+func (adh *AdminHandler) DeleteWorkflowExecution(ctx context.Context, request *admin.DeleteWorkflowExecutionRequest) error {
+	scope := metrics.AdminDeleteWorkflowExecutionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.authorize(ctx, "DeleteWorkflowExecution", request.GetDomain(), request, scope); err != nil {
+		return err
+	}
+
+	if request.GetDomain() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.Execution.GetRunId() == "" {
+		return adh.error(errInvalidRunID, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	workflowID := request.Execution.GetWorkflowId()
+	runID := request.Execution.GetRunId()
+	shardID := common.WorkflowIDToHistoryShard(workflowID, adh.numberOfHistoryShards)
+	// the execution manager shares the store session of the factory, so it is not closed here
+	executionMgr, err := adh.executionMgrFactory.NewExecutionManager(shardID)
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := executionMgr.DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      runID,
+	}); err != nil {
+		return adh.error(err, scope)
+	}
+	if err := executionMgr.DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      runID,
+	}); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// GetDomainIDOrName returns the ID of the domain with the given name, or the name of the domain with the given ID
+func (adh *AdminHandler) GetDomainIDOrName(
+	ctx context.Context, request *admin.GetDomainIDOrNameRequest) (*admin.GetDomainIDOrNameResponse, error) {
+
+	scope := metrics.AdminGetDomainIDOrNameScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.authorize(ctx, "GetDomainIDOrName", request.GetDomain(), request, scope); err != nil {
+		return nil, err
+	}
+
+	var entry *cache.DomainCacheEntry
+	var err error
+	switch {
+	case request.GetDomainId() != "":
+		entry, err = adh.domainCache.GetDomainByID(request.GetDomainId())
+	case request.GetDomain() != "":
+		entry, err = adh.domainCache.GetDomain(request.GetDomain())
+	default:
+		return nil, adh.error(&gen.BadRequestError{Message: "Either Domain or DomainId must be set on request."}, scope)
+	}
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	return &admin.GetDomainIDOrNameResponse{
+		Domain:   common.StringPtr(entry.GetInfo().Name),
+		DomainId: common.StringPtr(entry.GetInfo().ID),
+	}, nil
+}
+
+// DeleteDomain starts the system workflow which deletes a deprecated domain together with its executions, history
+// and task lists, it returns the deletion workflow which is already running if there is one
+func (adh *AdminHandler) DeleteDomain(
+	ctx context.Context, request *admin.DeleteDomainRequest) (*admin.DeleteDomainResponse, error) {
+
+	scope := metrics.AdminDeleteDomainScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.authorize(ctx, "DeleteDomain", request.GetDomain(), request, scope); err != nil {
+		return nil, err
+	}
+
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetDomain() == domaindeleter.Domain {
+		return nil, adh.error(&gen.BadRequestError{Message: "The system domain cannot be deleted."}, scope)
+	}
+
+	// the domain is read from the database, the cache may not have seen the deprecation yet
+	domain, err := adh.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: request.GetDomain()})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if domain.Info.Status != persistence.DomainStatusDeprecated {
+		return nil, adh.error(&gen.BadRequestError{
+			Message: fmt.Sprintf("Domain %v has to be deprecated before it is deleted.", request.GetDomain()),
+		}, scope)
+	}
+	if domain.IsGlobalDomain {
+		return nil, adh.error(&gen.BadRequestError{
+			Message: fmt.Sprintf("Domain %v is a global domain, only local domains can be deleted.", request.GetDomain()),
+		}, scope)
+	}
+
+	systemDomainID, err := adh.domainCache.GetDomainID(domaindeleter.Domain)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	input, err := json.Marshal(domaindeleter.Params{
+		DomainID:   domain.Info.ID,
+		DomainName: domain.Info.Name,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	workflowID := domaindeleter.WorkflowID(domain.Info.ID)
+	startRequest := &gen.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr(domaindeleter.Domain),
+		WorkflowId: common.StringPtr(workflowID),
+		WorkflowType: &gen.WorkflowType{
+			Name: common.StringPtr(domaindeleter.DeleteDomainWorkflowFnName),
+		},
+		TaskList: &gen.TaskList{
+			Name: common.StringPtr(domaindeleter.TaskList),
+		},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(domaindeleter.WorkflowStartToCloseTimeout.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(domaindeleter.DecisionTaskStartToCloseTimeout.Seconds())),
+		Identity:                            common.StringPtr(authorization.GetCallerIdentity(ctx)),
+		RequestId:                           common.StringPtr(uuid.New()),
+		// a deletion which failed or was terminated can be started again
+		WorkflowIdReusePolicy: gen.WorkflowIdReusePolicyAllowDuplicateFailedOnly.Ptr(),
+	}
+	resp, err := adh.history.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(systemDomainID, startRequest))
+	if err != nil {
+		if alreadyStarted, ok := err.(*gen.WorkflowExecutionAlreadyStartedError); ok {
+			return &admin.DeleteDomainResponse{
+				WorkflowId: common.StringPtr(workflowID),
+				RunId:      alreadyStarted.RunId,
+			}, nil
+		}
+		return nil, adh.error(err, scope)
+	}
+
+	return &admin.DeleteDomainResponse{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      resp.RunId,
+	}, nil
+}
+
+// StartBatch starts the system workflow which terminates, cancels or signals the open workflows of a domain
+// matching the filters of the request, the caller is authorized against the domain of the workflows
+func (adh *AdminHandler) StartBatch(
+	ctx context.Context, request *admin.StartBatchRequest) (*admin.StartBatchResponse, error) {
+
+	scope := metrics.AdminStartBatchScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.authorize(ctx, "StartBatch", request.GetDomain(), request, scope); err != nil {
+		return nil, err
+	}
+
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetDomain() == batcher.Domain {
+		return nil, adh.error(&gen.BadRequestError{Message: "Batch operations cannot run on the system domain."}, scope)
+	}
+	if _, err := adh.domainCache.GetDomain(request.GetDomain()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	params, err := batcher.ValidateParams(batcher.BatchParams{
+		DomainName:        request.GetDomain(),
+		WorkflowType:      request.GetWorkflowType(),
+		WorkflowIDPrefix:  request.GetWorkflowIdPrefix(),
+		StartTimeEarliest: request.GetStartTimeEarliest(),
+		StartTimeLatest:   request.GetStartTimeLatest(),
+		BatchType:         request.GetBatchType(),
+		Reason:            request.GetReason(),
+		SignalName:        request.GetSignalName(),
+		SignalInput:       request.GetSignalInput(),
+		RPS:               int(request.GetRps()),
+		Concurrency:       int(request.GetConcurrency()),
+	})
+	if err != nil {
+		return nil, adh.error(&gen.BadRequestError{Message: fmt.Sprintf("Invalid batch: %v.", err)}, scope)
+	}
+	input, err := json.Marshal(params)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	systemDomainID, err := adh.domainCache.GetDomainID(batcher.Domain)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	jobID := fmt.Sprintf("%v-%v", batcher.WorkflowIDPrefix, uuid.New())
+	startRequest := &gen.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr(batcher.Domain),
+		WorkflowId: common.StringPtr(jobID),
+		WorkflowType: &gen.WorkflowType{
+			Name: common.StringPtr(batcher.BatchWorkflowFnName),
+		},
+		TaskList: &gen.TaskList{
+			Name: common.StringPtr(batcher.TaskList),
+		},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(batcher.WorkflowStartToCloseTimeout.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(batcher.DecisionTaskStartToCloseTimeout.Seconds())),
+		Identity:                            common.StringPtr(authorization.GetCallerIdentity(ctx)),
+		RequestId:                           common.StringPtr(uuid.New()),
+	}
+	resp, err := adh.history.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(systemDomainID, startRequest))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	return &admin.StartBatchResponse{
+		JobId: common.StringPtr(jobID),
+		RunId: resp.RunId,
+	}, nil
+}
+
+// GetReplicationMessages returns the replication tasks of a history shard after the last retrieved message ID,
+// it is long polled by the history hosts of the other clusters in pull replication mode
+func (adh *AdminHandler) GetReplicationMessages(
+	ctx context.Context, request *replicator.GetReplicationMessagesRequest) (*replicator.ReplicationMessages, error) {
+
+	scope := metrics.AdminGetReplicationMessagesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.authorize(ctx, "GetReplicationMessages", "", request, scope); err != nil {
+		return nil, err
+	}
+
+	if request.ShardId == nil || request.GetShardId() < 0 || int(request.GetShardId()) >= adh.numberOfHistoryShards {
+		return nil, adh.error(&gen.BadRequestError{
+			Message: fmt.Sprintf("ShardId must be between 0 and %v.", adh.numberOfHistoryShards-1),
+		}, scope)
+	}
+	if request.GetClusterName() == "" {
+		return nil, adh.error(&gen.BadRequestError{Message: "ClusterName is not set on request."}, scope)
+	}
+
+	resp, err := adh.history.GetReplicationMessages(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// GetDomainReplicationMessages returns the replication tasks of the global domains replicated to a cluster which
+// changed after the last retrieved notification version
+func (adh *AdminHandler) GetDomainReplicationMessages(
+	ctx context.Context, request *replicator.GetDomainReplicationMessagesRequest) (*replicator.ReplicationMessages, error) {
+
+	scope := metrics.AdminGetDomainReplicationMessagesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.authorize(ctx, "GetDomainReplicationMessages", "", request, scope); err != nil {
+		return nil, err
+	}
+
+	if request.GetClusterName() == "" {
+		return nil, adh.error(&gen.BadRequestError{Message: "ClusterName is not set on request."}, scope)
+	}
+	clusterMetadata := adh.GetClusterMetadata()
+	if !clusterMetadata.IsGlobalDomainEnabled() || !clusterMetadata.IsPullReplicationEnabled() {
+		return nil, adh.error(&gen.BadRequestError{Message: "Pull replication is not enabled."}, scope)
+	}
+
+	lastRetrievedMessageID := int64(-1)
+	if request.LastRetrievedMessageId != nil {
+		lastRetrievedMessageID = request.GetLastRetrievedMessageId()
+	}
+
+	// the domains are few, the changed ones are found by listing all of them
+	var domains []*persistence.GetDomainResponse
+	var pageToken []byte
+	for {
+		resp, err := adh.metadataMgr.ListDomains(&persistence.ListDomainsRequest{
+			PageSize:      domainReplicationMessagesPageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		domains = append(domains, resp.Domains...)
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	resp, err := newDomainReplicationMessages(domains, request.GetClusterName(), lastRetrievedMessageID,
+		domainReplicationMessagesPageSize)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// ReadDLQMessages returns a page of the replication tasks in the dead letter queue of a history shard
+func (adh *AdminHandler) ReadDLQMessages(
+	ctx context.Context, request *replicator.ReadDLQMessagesRequest) (*replicator.ReadDLQMessagesResponse, error) {
+
+	scope := metrics.AdminReadDLQMessagesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.authorize(ctx, "ReadDLQMessages", request.GetDomain(), request, scope); err != nil {
+		return nil, err
+	}
+
+	domainID, err := adh.validateDLQRequest(request.GetSourceCluster(), request.ShardId, request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.history.ReadDLQMessages(ctx, &h.ReadDLQMessagesRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request:    request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// MergeDLQMessages applies a page of the replication tasks in the dead letter queue of a history shard
+func (adh *AdminHandler) MergeDLQMessages(
+	ctx context.Context, request *replicator.MergeDLQMessagesRequest) (*replicator.MergeDLQMessagesResponse, error) {
+
+	scope := metrics.AdminMergeDLQMessagesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.authorize(ctx, "MergeDLQMessages", request.GetDomain(), request, scope); err != nil {
+		return nil, err
+	}
+
+	domainID, err := adh.validateDLQRequest(request.GetSourceCluster(), request.ShardId, request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.history.MergeDLQMessages(ctx, &h.MergeDLQMessagesRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request:    request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// PurgeDLQMessages removes the replication tasks in the dead letter queue of a history shard
+func (adh *AdminHandler) PurgeDLQMessages(ctx context.Context, request *replicator.PurgeDLQMessagesRequest) error {
+
+	scope := metrics.AdminPurgeDLQMessagesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.authorize(ctx, "PurgeDLQMessages", request.GetDomain(), request, scope); err != nil {
+		return err
+	}
+
+	domainID, err := adh.validateDLQRequest(request.GetSourceCluster(), request.ShardId, request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.history.PurgeDLQMessages(ctx, &h.PurgeDLQMessagesRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request:    request,
+	}); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// validateDLQRequest checks the shard and source cluster of a DLQ request and returns the ID of the domain the
+// messages are filtered by, which is empty when the messages of all domains are requested
+func (adh *AdminHandler) validateDLQRequest(sourceCluster string, shardID *int32, domain string) (string, error) {
+	if shardID == nil || *shardID < 0 || int(*shardID) >= adh.numberOfHistoryShards {
+		return "", &gen.BadRequestError{
+			Message: fmt.Sprintf("ShardId must be between 0 and %v.", adh.numberOfHistoryShards-1),
+		}
+	}
+	if sourceCluster == "" {
+		return "", &gen.BadRequestError{Message: "SourceCluster is not set on request."}
+	}
+	if domain == "" {
+		return "", nil
+	}
+	return adh.domainCache.GetDomainID(domain)
+}
+
+// VerifyWorkflowExecution compares the mutable state of a workflow execution of a global domain in all the clusters
+// the domain is replicated to, and re-replicates the missing history from the active cluster when asked to and the
+// current cluster is a standby cluster behind it
+func (adh *AdminHandler) VerifyWorkflowExecution(
+	ctx context.Context, request *admin.VerifyWorkflowExecutionRequest) (*admin.VerifyWorkflowExecutionResponse, error) {
+
+	scope := metrics.AdminVerifyWorkflowExecutionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.authorize(ctx, "VerifyWorkflowExecution", request.GetDomain(), request, scope); err != nil {
+		return nil, err
+	}
+
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	domainEntry, err := adh.domainCache.GetDomain(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if !domainEntry.IsGlobalDomain() {
+		return nil, adh.error(&gen.BadRequestError{
+			Message: fmt.Sprintf("Domain %v is not a global domain.", request.GetDomain()),
+		}, scope)
+	}
+
+	activeCluster := domainEntry.GetReplicationConfig().ActiveClusterName
+	currentCluster := adh.GetClusterMetadata().GetCurrentClusterName()
+	execution := &gen.WorkflowExecution{
+		WorkflowId: request.Execution.WorkflowId,
+		RunId:      request.Execution.RunId,
+	}
+
+	// the active cluster is described first so that all the clusters are compared on the same run
+	active := adh.describeClusterWorkflowState(ctx, activeCluster, currentCluster, domainEntry, execution)
+	if execution.GetRunId() == "" && active.GetExists() {
+		execution.RunId = active.RunId
+	}
+	states := []*admin.ClusterWorkflowState{active}
+	var current *admin.ClusterWorkflowState
+	for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
+		if cluster.ClusterName == activeCluster {
+			continue
+		}
+		state := adh.describeClusterWorkflowState(ctx, cluster.ClusterName, currentCluster, domainEntry, execution)
+		if cluster.ClusterName == currentCluster {
+			current = state
+		}
+		states = append(states, state)
+	}
+
+	resp := &admin.VerifyWorkflowExecutionResponse{
+		ActiveCluster: common.StringPtr(activeCluster),
+		ClusterStates: states,
+		Divergences:   compareClusterWorkflowStates(active, states),
+		Repaired:      common.BoolPtr(false),
+	}
+	if !request.GetRepair() || current == nil || !needsRereplication(active, current) {
+		return resp, nil
+	}
+
+	// history can only be pulled from the active cluster into the current one, so the standby clusters
+	// other than the current cluster have to be verified from their own frontends to be repaired
+	beginningRunID := ""
+	beginningFirstEventID := common.FirstEventID
+	if current.GetExists() {
+		beginningRunID = current.GetRunId()
+		beginningFirstEventID = current.GetNextEventId()
+	}
+	rereplicator := xdc.NewHistoryRereplicator(
+		adh.domainCache,
+		adh.GetClientBean().GetRemoteAdminClient(activeCluster),
+		func(ctx context.Context, request *h.ReplicateRawEventsRequest) error {
+			return adh.history.ReplicateRawEvents(ctx, request)
+		},
+		persistence.NewHistorySerializer(),
+		verifyRepairTimeout,
+		adh.GetLogger(),
+	)
+	if err := rereplicator.SendMultiWorkflowHistory(
+		domainEntry.GetInfo().ID,
+		execution.GetWorkflowId(),
+		beginningRunID,
+		beginningFirstEventID,
+		active.GetRunId(),
+		active.GetNextEventId(),
+	); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	resp.Repaired = common.BoolPtr(true)
+	return resp, nil
+}
+
+// describeClusterWorkflowState describes the mutable state of a workflow execution in a cluster, through the
+// history service for the current cluster and through the frontend of the cluster otherwise
+func (adh *AdminHandler) describeClusterWorkflowState(ctx context.Context, cluster string, currentCluster string,
+	domainEntry *cache.DomainCacheEntry, execution *gen.WorkflowExecution) *admin.ClusterWorkflowState {
+
+	if cluster == currentCluster {
+		resp, err := adh.history.DescribeMutableState(ctx, &hist.DescribeMutableStateRequest{
+			DomainUUID: common.StringPtr(domainEntry.GetInfo().ID),
+			Execution:  execution,
+		})
+		if err != nil {
+			return newClusterWorkflowState(cluster, "", err)
+		}
+		return newClusterWorkflowState(cluster, resp.GetMutableStateInDatabase(), nil)
+	}
+
+	if _, ok := adh.GetClusterMetadata().GetAllClientAddress()[cluster]; !ok {
+		return newClusterWorkflowState(cluster, "", fmt.Errorf("no frontend address is configured for cluster %v", cluster))
+	}
+	resp, err := adh.GetClientBean().GetRemoteAdminClient(cluster).DescribeWorkflowExecution(ctx, &admin.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr(domainEntry.GetInfo().Name),
+		Execution: execution,
+	})
+	if err != nil {
+		return newClusterWorkflowState(cluster, "", err)
+	}
+	return newClusterWorkflowState(cluster, resp.GetMutableStateInDatabase(), nil)
+}
+
 // startRequestProfile initiates recording of request metrics
 func (adh *AdminHandler) startRequestProfile(scope int) tally.Stopwatch {
 	adh.startWG.Wait()
@@ -320,6 +1049,27 @@ func (adh *AdminHandler) startRequestProfile(scope int) tally.Stopwatch {
 	return sw
 }
 
+// authorize checks that the caller is allowed to call the admin API, all admin APIs require the admin permission
+func (adh *AdminHandler) authorize(ctx context.Context, apiName string, domain string, request interface{}, scope int) error {
+	actor := authorization.GetCallerIdentity(ctx)
+	decision, err := adh.authorizer.Authorize(ctx, &authorization.Attributes{
+		Actor:      actor,
+		APIName:    apiName,
+		DomainName: domain,
+		Permission: authorization.PermissionAdmin,
+		Request:    request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	if decision != authorization.DecisionAllow {
+		return adh.error(&gen.AccessDeniedError{
+			Message: fmt.Sprintf("Caller %q does not have admin permission to call %v on domain %q.", actor, apiName, domain),
+		}, scope)
+	}
+	return nil
+}
+
 func (adh *AdminHandler) error(err error, scope int) error {
 	switch err.(type) {
 	case *gen.InternalServiceError:
@@ -331,6 +1081,8 @@ func (adh *AdminHandler) error(err error, scope int) error {
 		return err
 	case *gen.EntityNotExistsError:
 		return err
+	case *gen.AccessDeniedError:
+		return err
 	default:
 		logging.LogUncategorizedError(adh.Service.GetLogger(), err)
 		return &gen.InternalServiceError{Message: err.Error()}
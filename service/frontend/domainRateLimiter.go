@@ -0,0 +1,124 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// domainRateLimiter keeps a token bucket per domain ID, the rate of each bucket is read from a domain filtered
+	// dynamic config property and the bucket is recreated when it changes, buckets not used for a while are evicted
+	domainRateLimiter struct {
+		sync.RWMutex
+		rps           dynamicconfig.IntPropertyFnWithDomainFilter
+		timeSource    common.TimeSource
+		metricsClient metrics.Client
+		buckets       map[string]*domainTokenBucket
+		// lastEviction is the time the idle buckets were last evicted
+		lastEviction time.Time
+	}
+
+	domainTokenBucket struct {
+		rps         int
+		tokenBucket common.TokenBucket
+		// metricsClient is tagged with the domain name once when the bucket is created
+		metricsClient metrics.Client
+		// lastAccess is the unix nano time the bucket was last used
+		lastAccess int64
+	}
+)
+
+const (
+	domainRateLimiterIdleTimeout = 10 * time.Minute
+)
+
+func newDomainRateLimiter(rps dynamicconfig.IntPropertyFnWithDomainFilter, timeSource common.TimeSource,
+	metricsClient metrics.Client) *domainRateLimiter {
+	return &domainRateLimiter{
+		rps:           rps,
+		timeSource:    timeSource,
+		metricsClient: metricsClient,
+		buckets:       make(map[string]*domainTokenBucket),
+		lastEviction:  timeSource.Now(),
+	}
+}
+
+// Allow consumes a token from the bucket of the domain, it returns false and counts the throttled request in the
+// metrics scope if the domain is throttled
+func (r *domainRateLimiter) Allow(domainID, domainName string, scope int) bool {
+	bucket := r.getTokenBucket(domainID, domainName)
+	if ok, _ := bucket.tokenBucket.TryConsume(1); !ok {
+		bucket.metricsClient.IncCounter(scope, metrics.CadenceErrDomainServiceBusyCounter)
+		return false
+	}
+	return true
+}
+
+func (r *domainRateLimiter) getTokenBucket(domainID, domainName string) *domainTokenBucket {
+	rps := r.rps(domainName)
+	now := r.timeSource.Now()
+
+	r.RLock()
+	bucket, exist := r.buckets[domainID]
+	r.RUnlock()
+
+	if exist && bucket.rps == rps {
+		atomic.StoreInt64(&bucket.lastAccess, now.UnixNano())
+		return bucket
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	if bucket, ok := r.buckets[domainID]; ok && bucket.rps == rps { // read again to ensure no duplicate create
+		atomic.StoreInt64(&bucket.lastAccess, now.UnixNano())
+		return bucket
+	}
+	r.evictIdleBuckets(now)
+	bucket = &domainTokenBucket{
+		rps:           rps,
+		tokenBucket:   common.NewTokenBucket(rps, r.timeSource),
+		metricsClient: r.metricsClient.Tagged(map[string]string{metrics.DomainTagName: domainName}),
+		lastAccess:    now.UnixNano(),
+	}
+	r.buckets[domainID] = bucket
+	return bucket
+}
+
+// evictIdleBuckets removes the buckets not used within the idle timeout, it runs at most once per idle timeout
+// and must be called with the lock held
+func (r *domainRateLimiter) evictIdleBuckets(now time.Time) {
+	if now.Sub(r.lastEviction) < domainRateLimiterIdleTimeout {
+		return
+	}
+	r.lastEviction = now
+	for domainID, bucket := range r.buckets {
+		if now.UnixNano()-atomic.LoadInt64(&bucket.lastAccess) >= int64(domainRateLimiterIdleTimeout) {
+			delete(r.buckets, domainID)
+		}
+	}
+}
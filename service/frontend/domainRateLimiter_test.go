@@ -0,0 +1,84 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type domainRateLimiterSuite struct {
+	suite.Suite
+	rps        map[string]int
+	timeSource *common.EventTimeSource
+	limiter    *domainRateLimiter
+}
+
+func TestDomainRateLimiterSuite(t *testing.T) {
+	s := new(domainRateLimiterSuite)
+	suite.Run(t, s)
+}
+
+func (s *domainRateLimiterSuite) SetupTest() {
+	s.rps = map[string]int{
+		"throttled-domain": 0,
+		"test-domain":      10,
+	}
+	s.timeSource = common.NewEventTimeSource().Update(time.Now())
+	s.limiter = newDomainRateLimiter(func(domain string) int {
+		return s.rps[domain]
+	}, s.timeSource, metrics.NewClient(tally.NoopScope, metrics.Frontend))
+}
+
+func (s *domainRateLimiterSuite) TestAllow() {
+	s.False(s.limiter.Allow("throttled-domain-id", "throttled-domain", metrics.FrontendStartWorkflowExecutionScope))
+	s.True(s.limiter.Allow("test-domain-id", "test-domain", metrics.FrontendStartWorkflowExecutionScope))
+}
+
+func (s *domainRateLimiterSuite) TestAllow_DomainsHaveSeparateBuckets() {
+	s.rps["other-domain"] = 10
+	s.True(s.limiter.Allow("test-domain-id", "test-domain", metrics.FrontendStartWorkflowExecutionScope))
+	s.False(s.limiter.Allow("test-domain-id", "test-domain", metrics.FrontendStartWorkflowExecutionScope))
+	s.True(s.limiter.Allow("other-domain-id", "other-domain", metrics.FrontendStartWorkflowExecutionScope))
+}
+
+func (s *domainRateLimiterSuite) TestAllow_RPSUpdated() {
+	s.False(s.limiter.Allow("throttled-domain-id", "throttled-domain", metrics.FrontendStartWorkflowExecutionScope))
+
+	s.rps["throttled-domain"] = 10
+	s.True(s.limiter.Allow("throttled-domain-id", "throttled-domain", metrics.FrontendStartWorkflowExecutionScope))
+	s.Equal(10, s.limiter.buckets["throttled-domain-id"].rps)
+}
+
+func (s *domainRateLimiterSuite) TestAllow_IdleBucketsEvicted() {
+	s.rps["other-domain"] = 10
+	s.True(s.limiter.Allow("test-domain-id", "test-domain", metrics.FrontendStartWorkflowExecutionScope))
+
+	s.timeSource.Update(s.timeSource.Now().Add(domainRateLimiterIdleTimeout))
+	s.True(s.limiter.Allow("other-domain-id", "other-domain", metrics.FrontendStartWorkflowExecutionScope))
+	s.Len(s.limiter.buckets, 1)
+	s.Contains(s.limiter.buckets, "other-domain-id")
+}
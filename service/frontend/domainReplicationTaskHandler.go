@@ -22,6 +22,7 @@ package frontend
 
 import (
 	"errors"
+	"sort"
 
 	"github.com/uber/cadence/common/logging"
 
@@ -49,6 +50,7 @@ type (
 	}
 
 	domainReplicatorImpl struct {
+		// kafka is nil in pull replication mode, where the domains are pulled by the other clusters
 		kafka  messaging.Producer
 		logger bark.Logger
 	}
@@ -71,11 +73,27 @@ func (domainReplicator *domainReplicatorImpl) HandleTransmissionTask(domainOpera
 		domainReplicator.logger.WithField(logging.TagDomainID, info.ID).Warn("Should not replicate non global domain")
 		return nil
 	}
+	if domainReplicator.kafka == nil {
+		return nil
+	}
 
-	status, err := domainReplicator.convertDomainStatusToThrift(info.Status)
+	task, err := newDomainReplicationTask(domainOperation, info, config, replicationConfig, configVersion, failoverVersion)
 	if err != nil {
 		return err
 	}
+	return domainReplicator.kafka.Publish(task)
+}
+
+// newDomainReplicationTask creates the replication task of a global domain, it is published to kafka or pulled by
+// the other clusters depending on the replication mode
+func newDomainReplicationTask(domainOperation replicator.DomainOperation, info *persistence.DomainInfo,
+	config *persistence.DomainConfig, replicationConfig *persistence.DomainReplicationConfig,
+	configVersion int64, failoverVersion int64) (*replicator.ReplicationTask, error) {
+
+	status, err := convertDomainStatusToThrift(info.Status)
+	if err != nil {
+		return nil, err
+	}
 
 	taskType := replicator.ReplicationTaskTypeDomain
 	task := &replicator.DomainTaskAttributes{
@@ -94,19 +112,67 @@ func (domainReplicator *domainReplicatorImpl) HandleTransmissionTask(domainOpera
 		},
 		ReplicationConfig: &shared.DomainReplicationConfiguration{
 			ActiveClusterName: common.StringPtr(replicationConfig.ActiveClusterName),
-			Clusters:          domainReplicator.convertClusterReplicationConfigToThrift(replicationConfig.Clusters),
+			Clusters:          convertClusterReplicationConfigToThrift(replicationConfig.Clusters),
 		},
 		ConfigVersion:   common.Int64Ptr(configVersion),
 		FailoverVersion: common.Int64Ptr(failoverVersion),
 	}
 
-	return domainReplicator.kafka.Publish(&replicator.ReplicationTask{
+	return &replicator.ReplicationTask{
 		TaskType:             &taskType,
 		DomainTaskAttributes: task,
+	}, nil
+}
+
+// newDomainReplicationMessages returns the replication tasks of the global domains replicated to a cluster which
+// changed after the last retrieved notification version, in the order of their notification versions
+func newDomainReplicationMessages(domains []*persistence.GetDomainResponse, clusterName string,
+	lastRetrievedMessageID int64, pageSize int) (*replicator.ReplicationMessages, error) {
+
+	var changedDomains []*persistence.GetDomainResponse
+	for _, domain := range domains {
+		if domain.IsGlobalDomain && domain.NotificationVersion > lastRetrievedMessageID &&
+			isDomainReplicatedTo(domain.ReplicationConfig, clusterName) {
+			changedDomains = append(changedDomains, domain)
+		}
+	}
+	sort.Slice(changedDomains, func(i, j int) bool {
+		return changedDomains[i].NotificationVersion < changedDomains[j].NotificationVersion
 	})
+
+	hasMore := len(changedDomains) > pageSize
+	if hasMore {
+		changedDomains = changedDomains[:pageSize]
+	}
+	replicationTasks := make([]*replicator.ReplicationTask, 0, len(changedDomains))
+	for _, domain := range changedDomains {
+		// the receiving side creates the domain when it does not exist yet
+		task, err := newDomainReplicationTask(replicator.DomainOperationUpdate, domain.Info, domain.Config,
+			domain.ReplicationConfig, domain.ConfigVersion, domain.FailoverVersion)
+		if err != nil {
+			return nil, err
+		}
+		replicationTasks = append(replicationTasks, task)
+		lastRetrievedMessageID = domain.NotificationVersion
+	}
+
+	return &replicator.ReplicationMessages{
+		ReplicationTasks:       replicationTasks,
+		LastRetrievedMessageId: common.Int64Ptr(lastRetrievedMessageID),
+		HasMore:                common.BoolPtr(hasMore),
+	}, nil
+}
+
+func isDomainReplicatedTo(replicationConfig *persistence.DomainReplicationConfig, clusterName string) bool {
+	for _, cluster := range replicationConfig.Clusters {
+		if cluster.ClusterName == clusterName {
+			return true
+		}
+	}
+	return false
 }
 
-func (domainReplicator *domainReplicatorImpl) convertClusterReplicationConfigToThrift(
+func convertClusterReplicationConfigToThrift(
 	input []*persistence.ClusterReplicationConfig) []*shared.ClusterReplicationConfiguration {
 	output := []*shared.ClusterReplicationConfiguration{}
 	for _, cluster := range input {
@@ -116,7 +182,7 @@ func (domainReplicator *domainReplicatorImpl) convertClusterReplicationConfigToT
 	return output
 }
 
-func (domainReplicator *domainReplicatorImpl) convertDomainStatusToThrift(input int) (*shared.DomainStatus, error) {
+func convertDomainStatusToThrift(input int) (*shared.DomainStatus, error) {
 	switch input {
 	case persistence.DomainStatusRegistered:
 		output := shared.DomainStatusRegistered
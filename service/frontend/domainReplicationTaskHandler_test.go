@@ -132,7 +132,7 @@ func (s *domainReplicatorSuite) TestHandleTransmissionTask_RegisterDomainTask_Is
 			},
 			ReplicationConfig: &shared.DomainReplicationConfiguration{
 				ActiveClusterName: common.StringPtr(clusterActive),
-				Clusters:          s.domainReplicator.convertClusterReplicationConfigToThrift(clusters),
+				Clusters:          convertClusterReplicationConfigToThrift(clusters),
 			},
 			ConfigVersion:   common.Int64Ptr(configVersion),
 			FailoverVersion: common.Int64Ptr(failoverVersion),
@@ -247,7 +247,7 @@ func (s *domainReplicatorSuite) TestHandleTransmissionTask_UpdateDomainTask_IsGl
 			},
 			ReplicationConfig: &shared.DomainReplicationConfiguration{
 				ActiveClusterName: common.StringPtr(clusterActive),
-				Clusters:          s.domainReplicator.convertClusterReplicationConfigToThrift(clusters),
+				Clusters:          convertClusterReplicationConfigToThrift(clusters),
 			},
 			ConfigVersion:   common.Int64Ptr(configVersion),
 			FailoverVersion: common.Int64Ptr(failoverVersion),
@@ -301,3 +301,71 @@ func (s *domainReplicatorSuite) TestHandleTransmissionTask_UpdateDomainTask_NotG
 	err := s.domainReplicator.HandleTransmissionTask(domainOperation, info, config, replicationConfig, configVersion, failoverVersion, isGlobalDomain)
 	s.Nil(err)
 }
+
+func (s *domainReplicatorSuite) TestNewDomainReplicationMessages() {
+	clusterActive := "some random active cluster name"
+	clusterStandby := "some random standby cluster name"
+	newDomain := func(notificationVersion int64, isGlobalDomain bool, clusters ...string) *p.GetDomainResponse {
+		replicationConfig := &p.DomainReplicationConfig{ActiveClusterName: clusterActive}
+		for _, clusterName := range clusters {
+			replicationConfig.Clusters = append(replicationConfig.Clusters, &p.ClusterReplicationConfig{ClusterName: clusterName})
+		}
+		return &p.GetDomainResponse{
+			Info: &p.DomainInfo{
+				ID:     uuid.New(),
+				Name:   uuid.New(),
+				Status: p.DomainStatusRegistered,
+			},
+			Config:              &p.DomainConfig{Retention: 1},
+			ReplicationConfig:   replicationConfig,
+			IsGlobalDomain:      isGlobalDomain,
+			ConfigVersion:       notificationVersion,
+			FailoverVersion:     notificationVersion,
+			NotificationVersion: notificationVersion,
+		}
+	}
+	domains := []*p.GetDomainResponse{
+		newDomain(7, true, clusterActive, clusterStandby),
+		newDomain(3, true, clusterActive, clusterStandby),
+		newDomain(5, true, clusterActive, clusterStandby),
+		newDomain(1, true, clusterActive, clusterStandby),
+		newDomain(6, false, clusterActive),
+		newDomain(8, true, clusterActive),
+	}
+
+	resp, err := newDomainReplicationMessages(domains, clusterStandby, 1, 2)
+	s.Nil(err)
+	s.True(resp.GetHasMore())
+	s.Equal(int64(5), resp.GetLastRetrievedMessageId())
+	s.Equal(2, len(resp.ReplicationTasks))
+	s.Equal(domains[1].Info.ID, resp.ReplicationTasks[0].DomainTaskAttributes.GetID())
+	s.Equal(domains[2].Info.ID, resp.ReplicationTasks[1].DomainTaskAttributes.GetID())
+	s.Equal(replicator.DomainOperationUpdate, resp.ReplicationTasks[0].DomainTaskAttributes.GetDomainOperation())
+
+	resp, err = newDomainReplicationMessages(domains, clusterStandby, 5, 2)
+	s.Nil(err)
+	s.False(resp.GetHasMore())
+	s.Equal(int64(7), resp.GetLastRetrievedMessageId())
+	s.Equal(1, len(resp.ReplicationTasks))
+	s.Equal(domains[0].Info.ID, resp.ReplicationTasks[0].DomainTaskAttributes.GetID())
+
+	resp, err = newDomainReplicationMessages(domains, clusterStandby, 7, 2)
+	s.Nil(err)
+	s.False(resp.GetHasMore())
+	s.Equal(int64(7), resp.GetLastRetrievedMessageId())
+	s.Empty(resp.ReplicationTasks)
+}
+
+func (s *domainReplicatorSuite) TestHandleTransmissionTask_PullReplication() {
+	s.domainReplicator.kafka = nil
+	info := &p.DomainInfo{
+		ID:     uuid.New(),
+		Name:   "some random domain test name",
+		Status: p.DomainStatusRegistered,
+	}
+	config := &p.DomainConfig{Retention: 10}
+	replicationConfig := &p.DomainReplicationConfig{ActiveClusterName: "some random active cluster name"}
+
+	err := s.domainReplicator.HandleTransmissionTask(replicator.DomainOperationCreate, info, config, replicationConfig, 0, 59, true)
+	s.Nil(err)
+}
@@ -22,8 +22,10 @@ package frontend
 
 import (
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
 	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/dynamicconfig"
@@ -37,8 +39,18 @@ type Config struct {
 	VisibilityListMaxQPS     dynamicconfig.IntPropertyFnWithDomainFilter
 	HistoryMaxPageSize       dynamicconfig.IntPropertyFnWithDomainFilter
 	RPS                      dynamicconfig.IntPropertyFn
+	DomainRPS                dynamicconfig.IntPropertyFnWithDomainFilter
+	DomainPollRPS            dynamicconfig.IntPropertyFnWithDomainFilter
 	MaxIDLengthLimit         dynamicconfig.IntPropertyFn
 
+	// NumTasklistWritePartitions and NumTasklistReadPartitions are the number of partitions the tasks and
+	// the pollers of a task list are spread across
+	NumTasklistWritePartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+	NumTasklistReadPartitions  dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+
+	// EnableReadVisibilityFromSearch lists the executions of a domain from the search backend if one is configured
+	EnableReadVisibilityFromSearch dynamicconfig.BoolPropertyFnWithDomainFilter
+
 	// Persistence settings
 	HistoryMgrNumConns dynamicconfig.IntPropertyFn
 
@@ -52,6 +64,12 @@ type Config struct {
 	// size limit system protection
 	BlobSizeLimitError dynamicconfig.IntPropertyFnWithDomainFilter
 	BlobSizeLimitWarn  dynamicconfig.IntPropertyFnWithDomainFilter
+
+	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
+	ValidSearchAttributes             dynamicconfig.MapPropertyFn
+	SearchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter
+	SearchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithDomainFilter
+	SearchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithDomainFilter
 }
 
 // NewConfig returns new service config with default values
@@ -61,9 +79,14 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		VisibilityMaxPageSize:          dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendVisibilityMaxPageSize, 1000),
 		EnableVisibilitySampling:       dc.GetBoolProperty(dynamicconfig.EnableVisibilitySampling, true),
 		VisibilityListMaxQPS:           dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendVisibilityListMaxQPS, 1),
+		EnableReadVisibilityFromSearch: dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableReadVisibilityFromSearch, false),
 		HistoryMaxPageSize:             dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendHistoryMaxPageSize, common.GetHistoryMaxPageSize),
 		RPS:                            dc.GetIntProperty(dynamicconfig.FrontendRPS, 1200),
+		DomainRPS:                      dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendDomainRPS, 1200),
+		DomainPollRPS:                  dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendDomainPollRPS, 1200),
 		MaxIDLengthLimit:               dc.GetIntProperty(dynamicconfig.MaxIDLengthLimit, 1000),
+		NumTasklistWritePartitions:     dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions, 1),
+		NumTasklistReadPartitions:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions, 1),
 		HistoryMgrNumConns:             dc.GetIntProperty(dynamicconfig.FrontendHistoryMgrNumConns, 10),
 		MaxDecisionStartToCloseTimeout: dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaxDecisionStartToCloseTimeout, 600),
 		EnableAdminProtection:          dc.GetBoolProperty(dynamicconfig.EnableAdminProtection, false),
@@ -71,6 +94,11 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		DisableListVisibilityByFilter:  dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.DisableListVisibilityByFilter, false),
 		BlobSizeLimitError:             dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
 		BlobSizeLimitWarn:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitWarn, 256*1204),
+
+		ValidSearchAttributes:             dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
+		SearchAttributesNumberOfKeysLimit: dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesNumberOfKeysLimit, 100),
+		SearchAttributesSizeOfValueLimit:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesSizeOfValueLimit, 2*1024),
+		SearchAttributesTotalSizeLimit:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesTotalSizeLimit, 40*1024),
 	}
 }
 
@@ -116,6 +144,10 @@ func (s *Service) Start() {
 	if err != nil {
 		log.Fatalf("failed to create visibility manager: %v", err)
 	}
+	if params.SearchClient != nil {
+		searchVisibility := persistence.NewSearchVisibilityManager(params.SearchClient)
+		visibility = persistence.NewVisibilityManagerWrapper(visibility, searchVisibility, s.config.EnableReadVisibilityFromSearch)
+	}
 
 	history, err := pFactory.NewHistoryManager()
 	if err != nil {
@@ -127,20 +159,23 @@ func (s *Service) Start() {
 	}
 
 	// TODO when global domain is enabled, uncomment the line below and remove the line after
+	// the domains are pulled by the other clusters instead of being published in pull replication mode
 	var kafkaProducer messaging.Producer
 	if base.GetClusterMetadata().IsGlobalDomainEnabled() {
-		kafkaProducer, err = base.GetMessagingClient().NewProducerWithClusterName(base.GetClusterMetadata().GetCurrentClusterName())
-		if err != nil {
-			log.Fatalf("Creating kafka producer failed: %v", err)
+		if !base.GetClusterMetadata().IsPullReplicationEnabled() {
+			kafkaProducer, err = base.GetMessagingClient().NewProducerWithClusterName(base.GetClusterMetadata().GetCurrentClusterName())
+			if err != nil {
+				log.Fatalf("Creating kafka producer failed: %v", err)
+			}
 		}
 	} else {
 		kafkaProducer = &mocks.KafkaProducer{}
 	}
 
-	wfHandler := NewWorkflowHandler(base, s.config, metadata, history, historyV2, visibility, kafkaProducer, params.BlobstoreClient)
+	wfHandler := NewWorkflowHandler(base, s.config, metadata, history, historyV2, visibility, kafkaProducer, params.BlobstoreClient, params.Authorizer)
 	wfHandler.Start()
 
-	adminHandler := NewAdminHandler(base, pConfig.NumHistoryShards, metadata, history, historyV2)
+	adminHandler := NewAdminHandler(base, pConfig.NumHistoryShards, metadata, history, historyV2, pFactory, params.Authorizer)
 	adminHandler.Start()
 
 	log.Infof("%v started", common.FrontendServiceName)
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -41,15 +42,20 @@ import (
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/validator"
+	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/uber/cadence/service/worker/sysworkflow"
 	"go.uber.org/yarpc/yarpcerrors"
 )
 
@@ -70,10 +76,15 @@ type (
 		metricsClient     metrics.Client
 		startWG           sync.WaitGroup
 		rateLimiter       common.TokenBucket
+		domainRateLimiter *domainRateLimiter
+		domainPollLimiter *domainRateLimiter
 		config            *Config
 		domainReplicator  DomainReplicator
 		blobstoreClient   blobstore.Client
+		authorizer        authorization.Authorizer
 		service.Service
+
+		searchAttributesValidator *validator.SearchAttributesValidator
 	}
 
 	getHistoryContinuationToken struct {
@@ -86,6 +97,9 @@ type (
 		EventStoreVersion int32
 		BranchToken       []byte
 		ReplicationInfo   map[string]*gen.ReplicationInfo
+		// IsArchived is set when the history is read from the archival bucket of the domain
+		IsArchived         bool
+		ArchivedPageNumber int
 	}
 )
 
@@ -96,6 +110,7 @@ var (
 	errInvalidRequestType                         = &gen.BadRequestError{Message: "Invalid request type."}
 	errTaskListNotSet                             = &gen.BadRequestError{Message: "TaskList is not set on request."}
 	errTaskListTypeNotSet                         = &gen.BadRequestError{Message: "TaskListType is not set on request."}
+	errTaskListReserved                           = &gen.BadRequestError{Message: fmt.Sprintf("TaskList name cannot start with %v.", common.ReservedTaskListPrefix)}
 	errExecutionNotSet                            = &gen.BadRequestError{Message: "Execution is not set on request."}
 	errWorkflowIDNotSet                           = &gen.BadRequestError{Message: "WorkflowId is not set on request."}
 	errRunIDNotSet                                = &gen.BadRequestError{Message: "RunId is not set on request."}
@@ -109,8 +124,10 @@ var (
 	errNoPermission                               = &gen.BadRequestError{Message: "No permission to do this operation."}
 	errRequestIDNotSet                            = &gen.BadRequestError{Message: "RequestId is not set on request."}
 	errWorkflowTypeNotSet                         = &gen.BadRequestError{Message: "WorkflowType is not set on request."}
+	errBatchWorkflowNotAllowed                    = &gen.BadRequestError{Message: "Batch workflows are started with the StartBatch admin API."}
 	errInvalidExecutionStartToCloseTimeoutSeconds = &gen.BadRequestError{Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}
 	errInvalidTaskStartToCloseTimeoutSeconds      = &gen.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}
+	errInvalidDecisionFinishEventID               = &gen.BadRequestError{Message: "A valid DecisionFinishEventId is not set on request."}
 
 	// archival errors
 	errSettingBucketNameWithoutEnabling = &gen.BadRequestError{Message: "Request specifies custom bucket without enabling archival."}
@@ -118,6 +135,7 @@ var (
 	errDisallowedBucketMetadata         = &gen.BadRequestError{Message: "Cannot set bucket owner or bucket retention (must update bucket manually)."}
 	errBucketNameUpdate                 = &gen.BadRequestError{Message: "Cannot update bucket name after after archival has been enabled for the first time."}
 	errUnknownArchivalStatus            = &gen.BadRequestError{Message: "Got unknown archival status."}
+	errHistoryNotFound                  = &gen.EntityNotExistsError{Message: "Workflow execution history not found."}
 
 	// err for string too long
 	errDomainTooLong       = &gen.BadRequestError{Message: "Domain length exceeds limit."}
@@ -141,19 +159,29 @@ var (
 // NewWorkflowHandler creates a thrift handler for the cadence service
 func NewWorkflowHandler(sVice service.Service, config *Config, metadataMgr persistence.MetadataManager,
 	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, visibilityMgr persistence.VisibilityManager,
-	kafkaProducer messaging.Producer, blobstoreClient blobstore.Client) *WorkflowHandler {
+	kafkaProducer messaging.Producer, blobstoreClient blobstore.Client, authorizer authorization.Authorizer) *WorkflowHandler {
 	handler := &WorkflowHandler{
-		Service:          sVice,
-		config:           config,
-		metadataMgr:      metadataMgr,
-		historyMgr:       historyMgr,
-		historyV2Mgr:     historyV2Mgr,
-		visibitiltyMgr:   visibilityMgr,
-		tokenSerializer:  common.NewJSONTaskTokenSerializer(),
-		domainCache:      cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetMetricsClient(), sVice.GetLogger()),
-		rateLimiter:      common.NewTokenBucket(config.RPS(), common.NewRealTimeSource()),
-		domainReplicator: NewDomainReplicator(kafkaProducer, sVice.GetLogger()),
-		blobstoreClient:  blobstoreClient,
+		Service:           sVice,
+		config:            config,
+		metadataMgr:       metadataMgr,
+		historyMgr:        historyMgr,
+		historyV2Mgr:      historyV2Mgr,
+		visibitiltyMgr:    visibilityMgr,
+		tokenSerializer:   common.NewJSONTaskTokenSerializer(),
+		domainCache:       cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetMetricsClient(), sVice.GetLogger()),
+		rateLimiter:       common.NewTokenBucket(config.RPS(), common.NewRealTimeSource()),
+		domainRateLimiter: newDomainRateLimiter(config.DomainRPS, common.NewRealTimeSource(), sVice.GetMetricsClient()),
+		domainPollLimiter: newDomainRateLimiter(config.DomainPollRPS, common.NewRealTimeSource(), sVice.GetMetricsClient()),
+		domainReplicator:  NewDomainReplicator(kafkaProducer, sVice.GetLogger()),
+		blobstoreClient:   blobstoreClient,
+		authorizer:        authorizer,
+		searchAttributesValidator: validator.NewSearchAttributesValidator(
+			sVice.GetLogger(),
+			config.ValidSearchAttributes,
+			config.SearchAttributesNumberOfKeysLimit,
+			config.SearchAttributesSizeOfValueLimit,
+			config.SearchAttributesTotalSizeLimit,
+		),
 	}
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
@@ -168,7 +196,10 @@ func (wh *WorkflowHandler) Start() error {
 	wh.domainCache.Start()
 
 	wh.history = wh.Service.GetClientBean().GetHistoryClient()
-	wh.matchingRawClient = wh.Service.GetClientBean().GetMatchingClient()
+	wh.matchingRawClient = matching.NewPartitionedClient(
+		wh.Service.GetClientBean().GetMatchingClient(), wh.domainCache,
+		wh.config.NumTasklistWritePartitions, wh.config.NumTasklistReadPartitions,
+	)
 	wh.matching = matching.NewRetryableClient(wh.matchingRawClient, common.CreateMatchingServiceRetryPolicy(),
 		common.IsWhitelistServiceTransientError)
 	wh.metricsClient = wh.Service.GetMetricsClient()
@@ -206,6 +237,42 @@ func (wh *WorkflowHandler) checkPermission(securityToken *string, scope int) err
 	return nil
 }
 
+// authorize checks that the caller is allowed to call the API on the domain
+func (wh *WorkflowHandler) authorize(ctx context.Context, apiName string, permission authorization.Permission,
+	domain string, request interface{}, scope int) error {
+	actor := authorization.GetCallerIdentity(ctx)
+	decision, err := wh.authorizer.Authorize(ctx, &authorization.Attributes{
+		Actor:      actor,
+		APIName:    apiName,
+		DomainName: domain,
+		Permission: permission,
+		Request:    request,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+	if decision != authorization.DecisionAllow {
+		return wh.error(&gen.AccessDeniedError{
+			Message: fmt.Sprintf("Caller %q does not have %v permission to call %v on domain %q.", actor, permission, apiName, domain),
+		}, scope)
+	}
+	return nil
+}
+
+// authorizeDomainID checks that the caller is allowed to call the API on the domain referenced by a task token
+// and returns the domain cache entry of that domain
+func (wh *WorkflowHandler) authorizeDomainID(ctx context.Context, apiName string, permission authorization.Permission,
+	domainID string, request interface{}, scope int) (*cache.DomainCacheEntry, error) {
+	domainEntry, err := wh.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	if err := wh.authorize(ctx, apiName, permission, domainEntry.GetInfo().Name, request, scope); err != nil {
+		return nil, err
+	}
+	return domainEntry, nil
+}
+
 // RegisterDomain creates a new domain which can be used as a container for all resources.  Domain is a top level
 // entity within Cadence, used as a container for all resources like workflow executions, tasklists, etc.  Domain
 // acts as a sandbox and provides isolation for all resources within the domain.  All resources belongs to exactly one
@@ -219,6 +286,10 @@ func (wh *WorkflowHandler) RegisterDomain(ctx context.Context, registerRequest *
 		return wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "RegisterDomain", authorization.PermissionAdmin, registerRequest.GetName(), registerRequest, scope); err != nil {
+		return err
+	}
+
 	if wh.customBucketNameProvided(registerRequest.CustomArchivalBucketName) && !registerRequest.GetEnableArchival() {
 		return wh.error(errSettingBucketNameWithoutEnabling, scope)
 	}
@@ -344,6 +415,10 @@ func (wh *WorkflowHandler) ListDomains(ctx context.Context,
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "ListDomains", authorization.PermissionRead, "", listRequest, scope); err != nil {
+		return nil, err
+	}
+
 	pageSize := 100
 	if listRequest.GetPageSize() != 0 {
 		pageSize = int(listRequest.GetPageSize())
@@ -388,6 +463,10 @@ func (wh *WorkflowHandler) DescribeDomain(ctx context.Context,
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "DescribeDomain", authorization.PermissionRead, describeRequest.GetName(), describeRequest, scope); err != nil {
+		return nil, err
+	}
+
 	if describeRequest.GetName() == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
@@ -420,6 +499,10 @@ func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "UpdateDomain", authorization.PermissionAdmin, updateRequest.GetName(), updateRequest, scope); err != nil {
+		return nil, err
+	}
+
 	if updateRequest.Configuration != nil {
 		cfg := updateRequest.Configuration
 
@@ -699,6 +782,10 @@ func (wh *WorkflowHandler) DeprecateDomain(ctx context.Context, deprecateRequest
 		return wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "DeprecateDomain", authorization.PermissionAdmin, deprecateRequest.GetName(), deprecateRequest, scope); err != nil {
+		return err
+	}
+
 	if err := wh.checkPermission(deprecateRequest.SecurityToken, scope); err != nil {
 		return err
 	}
@@ -772,8 +859,12 @@ func (wh *WorkflowHandler) PollForActivityTask(
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "PollForActivityTask", authorization.PermissionWrite, pollRequest.GetDomain(), pollRequest, scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(pollRequest.GetDomain(), true, scope); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	wh.Service.GetLogger().Debug("Received PollForActivityTask")
@@ -835,8 +926,12 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "PollForDecisionTask", authorization.PermissionWrite, pollRequest.GetDomain(), pollRequest, scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(pollRequest.GetDomain(), true, scope); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	wh.Service.GetLogger().Debug("Received PollForDecisionTask")
@@ -950,9 +1045,9 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	domainEntry, err := wh.domainCache.GetDomainByID(taskToken.DomainID)
+	domainEntry, err := wh.authorizeDomainID(ctx, "RecordActivityTaskHeartbeat", authorization.PermissionWrite, taskToken.DomainID, heartbeatRequest, scope)
 	if err != nil {
-		return nil, wh.error(err, scope)
+		return nil, err
 	}
 
 	sizeLimitError := wh.config.BlobSizeLimitError(domainEntry.GetInfo().Name)
@@ -1002,6 +1097,10 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeatByID(
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "RecordActivityTaskHeartbeatByID", authorization.PermissionWrite, heartbeatRequest.GetDomain(), heartbeatRequest, scope); err != nil {
+		return nil, err
+	}
+
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
 	wh.rateLimiter.TryConsume(1)
 
@@ -1108,9 +1207,9 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 		return wh.error(errDomainNotSet, scope)
 	}
 
-	domainEntry, err := wh.domainCache.GetDomainByID(taskToken.DomainID)
+	domainEntry, err := wh.authorizeDomainID(ctx, "RespondActivityTaskCompleted", authorization.PermissionWrite, taskToken.DomainID, completeRequest, scope)
 	if err != nil {
-		return wh.error(err, scope)
+		return err
 	}
 	if len(completeRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
 		return wh.error(errIdentityTooLong, scope)
@@ -1162,6 +1261,10 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedByID(
 		return wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "RespondActivityTaskCompletedByID", authorization.PermissionWrite, completeRequest.GetDomain(), completeRequest, scope); err != nil {
+		return err
+	}
+
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
 	wh.rateLimiter.TryConsume(1)
 
@@ -1270,9 +1373,9 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 		return wh.error(errDomainNotSet, scope)
 	}
 
-	domainEntry, err := wh.domainCache.GetDomainByID(taskToken.DomainID)
+	domainEntry, err := wh.authorizeDomainID(ctx, "RespondActivityTaskFailed", authorization.PermissionWrite, taskToken.DomainID, failedRequest, scope)
 	if err != nil {
-		return wh.error(err, scope)
+		return err
 	}
 	if len(failedRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
 		return wh.error(errIdentityTooLong, scope)
@@ -1312,6 +1415,10 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedByID(
 		return wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "RespondActivityTaskFailedByID", authorization.PermissionWrite, failedRequest.GetDomain(), failedRequest, scope); err != nil {
+		return err
+	}
+
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
 	wh.rateLimiter.TryConsume(1)
 
@@ -1408,9 +1515,9 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 		return wh.error(errDomainNotSet, scope)
 	}
 
-	domainEntry, err := wh.domainCache.GetDomainByID(taskToken.DomainID)
+	domainEntry, err := wh.authorizeDomainID(ctx, "RespondActivityTaskCanceled", authorization.PermissionWrite, taskToken.DomainID, cancelRequest, scope)
 	if err != nil {
-		return wh.error(err, scope)
+		return err
 	}
 
 	if len(cancelRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
@@ -1463,6 +1570,10 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledByID(
 		return wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "RespondActivityTaskCanceledByID", authorization.PermissionWrite, cancelRequest.GetDomain(), cancelRequest, scope); err != nil {
+		return err
+	}
+
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
 	wh.rateLimiter.TryConsume(1)
 
@@ -1570,6 +1681,10 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if _, err := wh.authorizeDomainID(ctx, "RespondDecisionTaskCompleted", authorization.PermissionWrite, taskToken.DomainID, completeRequest, scope); err != nil {
+		return nil, err
+	}
+
 	histResp, err := wh.history.RespondDecisionTaskCompleted(ctx, &h.RespondDecisionTaskCompletedRequest{
 		DomainUUID:      common.StringPtr(taskToken.DomainID),
 		CompleteRequest: completeRequest},
@@ -1636,9 +1751,9 @@ func (wh *WorkflowHandler) RespondDecisionTaskFailed(
 		return wh.error(errDomainNotSet, scope)
 	}
 
-	domainEntry, err := wh.domainCache.GetDomainByID(taskToken.DomainID)
+	domainEntry, err := wh.authorizeDomainID(ctx, "RespondDecisionTaskFailed", authorization.PermissionWrite, taskToken.DomainID, failedRequest, scope)
 	if err != nil {
-		return wh.error(err, scope)
+		return err
 	}
 
 	if len(failedRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
@@ -1692,6 +1807,10 @@ func (wh *WorkflowHandler) RespondQueryTaskCompleted(
 		return wh.error(errInvalidTaskToken, scope)
 	}
 
+	if _, err := wh.authorizeDomainID(ctx, "RespondQueryTaskCompleted", authorization.PermissionWrite, queryTaskToken.DomainID, completeRequest, scope); err != nil {
+		return err
+	}
+
 	matchingRequest := &m.RespondQueryTaskCompletedRequest{
 		DomainUUID:       common.StringPtr(queryTaskToken.DomainID),
 		TaskList:         &gen.TaskList{Name: common.StringPtr(queryTaskToken.TaskList)},
@@ -1719,8 +1838,12 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "StartWorkflowExecution", authorization.PermissionWrite, startRequest.GetDomain(), startRequest, scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(startRequest.GetDomain(), false, scope); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if startRequest.GetDomain() == "" {
@@ -1759,6 +1882,11 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errWorkflowTypeTooLong, scope)
 	}
 
+	if startRequest.GetDomain() == batcher.Domain && startRequest.WorkflowType.GetName() == batcher.BatchWorkflowFnName {
+		// a batch acts on the workflows of another domain, the caller has to be authorized against that domain
+		return nil, wh.error(errBatchWorkflowNotAllowed, scope)
+	}
+
 	if err := wh.validateTaskList(startRequest.TaskList, scope); err != nil {
 		return nil, err
 	}
@@ -1805,6 +1933,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 			Message: fmt.Sprintf("TaskStartToCloseTimeoutSeconds is larger than ExecutionStartToCloseTimeout or MaxDecisionStartToCloseTimeout (%ds).", maxDecisionTimeout)}, scope)
 	}
 
+	if err := wh.searchAttributesValidator.ValidateSearchAttributes(startRequest.SearchAttributes, startRequest.GetDomain()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	domainName := startRequest.GetDomain()
 	wh.Service.GetLogger().Debugf("Start workflow execution request domain: %v", domainName)
 	domainID, err := wh.domainCache.GetDomainID(domainName)
@@ -1818,6 +1950,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		startRequest.GetWorkflowId(), "", wh.metricsClient, scope, wh.GetLogger()); err != nil {
 		return nil, wh.error(err, scope)
 	}
+	if err := common.CheckEventBlobSizeLimit(getMemoSize(startRequest.Memo), sizeLimitWarn, sizeLimitError, domainID,
+		startRequest.GetWorkflowId(), "", wh.metricsClient, scope, wh.GetLogger()); err != nil {
+		return nil, wh.error(err, scope)
+	}
 
 	wh.Service.GetLogger().Debugf("Start workflow execution request domainID: %v", domainID)
 
@@ -1842,8 +1978,12 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "GetWorkflowExecutionHistory", authorization.PermissionRead, getRequest.GetDomain(), getRequest, scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(getRequest.GetDomain(), false, scope); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if getRequest.GetDomain() == "" {
@@ -1905,6 +2045,25 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 	var nextEventID int64
 	var isWorkflowRunning bool
 
+	// once a closed run is archived, its history is removed from the primary store and
+	// has to be read from the archival bucket of the domain instead
+	isHistoryArchived := func(err error, history *gen.History) bool {
+		if getRequest.NextPageToken != nil || execution.GetRunId() == "" {
+			return false
+		}
+		switch err.(type) {
+		case nil:
+			if isWorkflowRunning || len(history.Events) > 0 {
+				return false
+			}
+		case *gen.EntityNotExistsError:
+		default:
+			return false
+		}
+		_, ok := wh.getArchivalBucket(domainID)
+		return ok
+	}
+
 	// process the token for paging
 	queryNextEventID := common.EndEventID
 	if getRequest.NextPageToken != nil {
@@ -1918,6 +2077,10 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 
 		execution.RunId = common.StringPtr(token.RunID)
 
+		if token.IsArchived {
+			return wh.getArchivedHistory(ctx, getRequest, domainID, token.ArchivedPageNumber, scope)
+		}
+
 		// we need to update the current next event ID and whether workflow is running
 		if len(token.PersistenceToken) == 0 && isLongPoll && token.IsWorkflowRunning {
 			if !isCloseEventOnly {
@@ -1938,6 +2101,9 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		}
 		token.EventStoreVersion, token.BranchToken, runID, lastFirstEventID, nextEventID, isWorkflowRunning, err = queryHistory(domainID, execution, queryNextEventID)
 		if err != nil {
+			if isHistoryArchived(err, nil) {
+				return wh.getArchivedHistory(ctx, getRequest, domainID, 0, scope)
+			}
 			return nil, wh.error(err, scope)
 		}
 
@@ -1956,6 +2122,9 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		if !isWorkflowRunning {
 			history, _, err = wh.getHistory(scope, domainID, *execution, lastFirstEventID, nextEventID,
 				getRequest.GetMaximumPageSize(), nil, token.TransientDecision, token.EventStoreVersion, token.BranchToken)
+			if isHistoryArchived(err, history) {
+				return wh.getArchivedHistory(ctx, getRequest, domainID, 0, scope)
+			}
 			if err != nil {
 				return nil, wh.error(err, scope)
 			}
@@ -1980,6 +2149,9 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 			history, token.PersistenceToken, err =
 				wh.getHistory(scope, domainID, *execution, token.FirstEventID, token.NextEventID,
 					getRequest.GetMaximumPageSize(), token.PersistenceToken, token.TransientDecision, token.EventStoreVersion, token.BranchToken)
+			if isHistoryArchived(err, history) {
+				return wh.getArchivedHistory(ctx, getRequest, domainID, 0, scope)
+			}
 			if err != nil {
 				return nil, wh.error(err, scope)
 			}
@@ -2013,8 +2185,12 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context,
 		return wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "SignalWorkflowExecution", authorization.PermissionWrite, signalRequest.GetDomain(), signalRequest, scope); err != nil {
+		return err
+	}
+
+	if err := wh.checkRateLimit(signalRequest.GetDomain(), false, scope); err != nil {
+		return wh.error(err, scope)
 	}
 
 	if signalRequest.GetDomain() == "" {
@@ -2081,8 +2257,12 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "SignalWithStartWorkflowExecution", authorization.PermissionWrite, signalWithStartRequest.GetDomain(), signalWithStartRequest, scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(signalWithStartRequest.GetDomain(), false, scope); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if signalWithStartRequest.GetDomain() == "" {
@@ -2169,6 +2349,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 			Message: fmt.Sprintf("TaskStartToCloseTimeoutSeconds is larger than ExecutionStartToCloseTimeout or MaxDecisionStartToCloseTimeout (%ds).", maxDecisionTimeout)}, scope)
 	}
 
+	if err := wh.searchAttributesValidator.ValidateSearchAttributes(signalWithStartRequest.SearchAttributes, signalWithStartRequest.GetDomain()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	domainID, err := wh.domainCache.GetDomainID(signalWithStartRequest.GetDomain())
 	if err != nil {
 		return nil, wh.error(err, scope)
@@ -2184,6 +2368,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		signalWithStartRequest.GetWorkflowId(), "", wh.metricsClient, scope, wh.GetLogger()); err != nil {
 		return nil, wh.error(err, scope)
 	}
+	if err := common.CheckEventBlobSizeLimit(getMemoSize(signalWithStartRequest.Memo), sizeLimitWarn, sizeLimitError, domainID,
+		signalWithStartRequest.GetWorkflowId(), "", wh.metricsClient, scope, wh.GetLogger()); err != nil {
+		return nil, wh.error(err, scope)
+	}
 
 	var resp *gen.StartWorkflowExecutionResponse
 	op := func() error {
@@ -2216,8 +2404,12 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx context.Context,
 		return wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "TerminateWorkflowExecution", authorization.PermissionWrite, terminateRequest.GetDomain(), terminateRequest, scope); err != nil {
+		return err
+	}
+
+	if err := wh.checkRateLimit(terminateRequest.GetDomain(), false, scope); err != nil {
+		return wh.error(err, scope)
 	}
 
 	if terminateRequest.GetDomain() == "" {
@@ -2244,6 +2436,108 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// ResetWorkflowExecution resets an existing workflow execution to the decision identified by DecisionFinishEventId.
+// The current run of the workflow is terminated and a new run is started from a fork of the history at that point.
+func (wh *WorkflowHandler) ResetWorkflowExecution(ctx context.Context,
+	resetRequest *gen.ResetWorkflowExecutionRequest) (*gen.ResetWorkflowExecutionResponse, error) {
+
+	scope := metrics.FrontendResetWorkflowExecutionScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if resetRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.authorize(ctx, "ResetWorkflowExecution", authorization.PermissionWrite, resetRequest.GetDomain(), resetRequest, scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(resetRequest.GetDomain(), false, scope); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if resetRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(resetRequest.WorkflowExecution, scope); err != nil {
+		return nil, err
+	}
+
+	if resetRequest.GetDecisionFinishEventId() <= common.FirstEventID {
+		return nil, wh.error(errInvalidDecisionFinishEventID, scope)
+	}
+
+	if len(resetRequest.GetRequestId()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errRequestIDTooLong, scope)
+	}
+
+	if resetRequest.GetRequestId() == "" {
+		resetRequest.RequestId = common.StringPtr(uuid.New())
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(resetRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp, err := wh.history.ResetWorkflowExecution(ctx, &h.ResetWorkflowExecutionRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		ResetRequest: resetRequest,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return resp, nil
+}
+
+// TriggerCronWorkflowExecution starts the next run of a cron workflow right away, instead of waiting for the backoff
+// timer of its cron schedule to fire.
+func (wh *WorkflowHandler) TriggerCronWorkflowExecution(ctx context.Context,
+	triggerRequest *gen.TriggerCronWorkflowExecutionRequest) error {
+
+	scope := metrics.FrontendTriggerCronWorkflowExecutionScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if triggerRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.authorize(ctx, "TriggerCronWorkflowExecution", authorization.PermissionWrite, triggerRequest.GetDomain(), triggerRequest, scope); err != nil {
+		return err
+	}
+
+	if err := wh.checkRateLimit(triggerRequest.GetDomain(), false, scope); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if triggerRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(triggerRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(triggerRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	err = wh.history.TriggerCronWorkflowExecution(ctx, &h.TriggerCronWorkflowExecutionRequest{
+		DomainUUID:     common.StringPtr(domainID),
+		TriggerRequest: triggerRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
 // RequestCancelWorkflowExecution - requests to cancel a workflow execution
 func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 	ctx context.Context,
@@ -2257,8 +2551,12 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 		return wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "RequestCancelWorkflowExecution", authorization.PermissionWrite, cancelRequest.GetDomain(), cancelRequest, scope); err != nil {
+		return err
+	}
+
+	if err := wh.checkRateLimit(cancelRequest.GetDomain(), false, scope); err != nil {
+		return wh.error(err, scope)
 	}
 
 	if cancelRequest.GetDomain() == "" {
@@ -2297,8 +2595,12 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context,
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "ListOpenWorkflowExecutions", authorization.PermissionRead, listRequest.GetDomain(), listRequest, scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(listRequest.GetDomain(), false, scope); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if listRequest.GetDomain() == "" {
@@ -2389,8 +2691,12 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "ListClosedWorkflowExecutions", authorization.PermissionRead, listRequest.GetDomain(), listRequest, scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(listRequest.GetDomain(), false, scope); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if listRequest.GetDomain() == "" {
@@ -2490,6 +2796,88 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 	return resp, nil
 }
 
+// ListWorkflowExecutions - retrieves info for open and closed workflow executions in a domain which match a query
+func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context,
+	listRequest *gen.ListWorkflowExecutionsRequest) (*gen.ListWorkflowExecutionsResponse, error) {
+
+	scope := metrics.FrontendListWorkflowExecutionsScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if listRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.authorize(ctx, "ListWorkflowExecutions", authorization.PermissionRead, listRequest.GetDomain(), listRequest, scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(listRequest.GetDomain(), false, scope); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if listRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	domain := listRequest.GetDomain()
+	maxPageSize := int32(wh.config.VisibilityMaxPageSize(domain))
+	if listRequest.GetPageSize() <= 0 || listRequest.GetPageSize() > maxPageSize {
+		listRequest.PageSize = common.Int32Ptr(maxPageSize)
+	}
+
+	validKeys, err := definition.GetIndexedKeys(wh.config.ValidSearchAttributes())
+	if err != nil {
+		return nil, wh.error(&gen.InternalServiceError{
+			Message: fmt.Sprintf("Invalid search attributes in dynamic config: %v", err)}, scope)
+	}
+	if _, err := persistence.ParseVisibilityQuery(listRequest.GetQuery(), validKeys); err != nil {
+		return nil, wh.error(&gen.BadRequestError{Message: fmt.Sprintf("Invalid query: %v", err)}, scope)
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(domain)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	persistenceResp, err := wh.visibitiltyMgr.ListWorkflowExecutions(&persistence.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID:    domainID,
+		Domain:        domain,
+		Query:         listRequest.GetQuery(),
+		PageSize:      int(listRequest.GetPageSize()),
+		NextPageToken: listRequest.NextPageToken,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp := &gen.ListWorkflowExecutionsResponse{}
+	resp.Executions = persistenceResp.Executions
+	resp.NextPageToken = persistenceResp.NextPageToken
+	return resp, nil
+}
+
+// GetSearchAttributes - return the search attribute keys and their types which can be used in
+// list queries and set on workflow executions
+func (wh *WorkflowHandler) GetSearchAttributes(ctx context.Context) (*gen.GetSearchAttributesResponse, error) {
+
+	scope := metrics.FrontendGetSearchAttributesScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if err := wh.authorize(ctx, "GetSearchAttributes", authorization.PermissionRead, "", nil, scope); err != nil {
+		return nil, err
+	}
+
+	keys, err := definition.GetIndexedKeys(wh.config.ValidSearchAttributes())
+	if err != nil {
+		return nil, wh.error(&gen.InternalServiceError{
+			Message: fmt.Sprintf("Invalid search attributes in dynamic config: %v", err)}, scope)
+	}
+
+	return &gen.GetSearchAttributesResponse{Keys: keys}, nil
+}
+
 // ResetStickyTaskList reset the volatile information in mutable state of a given workflow.
 func (wh *WorkflowHandler) ResetStickyTaskList(ctx context.Context, resetRequest *gen.ResetStickyTaskListRequest) (*gen.ResetStickyTaskListResponse, error) {
 	scope := metrics.FrontendResetStickyTaskListScope
@@ -2500,6 +2888,10 @@ func (wh *WorkflowHandler) ResetStickyTaskList(ctx context.Context, resetRequest
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "ResetStickyTaskList", authorization.PermissionWrite, resetRequest.GetDomain(), resetRequest, scope); err != nil {
+		return nil, err
+	}
+
 	if resetRequest.GetDomain() == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
@@ -2535,6 +2927,10 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context,
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "QueryWorkflow", authorization.PermissionRead, queryRequest.GetDomain(), queryRequest, scope); err != nil {
+		return nil, err
+	}
+
 	if queryRequest.GetDomain() == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
@@ -2634,8 +3030,12 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx context.Context, reques
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "DescribeWorkflowExecution", authorization.PermissionRead, request.GetDomain(), request, scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(request.GetDomain(), false, scope); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if request.GetDomain() == "" {
@@ -2674,8 +3074,12 @@ func (wh *WorkflowHandler) DescribeTaskList(ctx context.Context, request *gen.De
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+	if err := wh.authorize(ctx, "DescribeTaskList", authorization.PermissionRead, request.GetDomain(), request, scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkRateLimit(request.GetDomain(), false, scope); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if request.GetDomain() == "" {
@@ -2712,6 +3116,80 @@ func (wh *WorkflowHandler) DescribeTaskList(ctx context.Context, request *gen.De
 	return response, nil
 }
 
+// getArchivedHistory returns a page of the history of a run from the archival bucket of the domain,
+// the pages are the ones the history was archived in, so the maximum page size of the request is ignored
+func (wh *WorkflowHandler) getArchivedHistory(
+	ctx context.Context,
+	getRequest *gen.GetWorkflowExecutionHistoryRequest,
+	domainID string,
+	pageNumber int,
+	scope int,
+) (*gen.GetWorkflowExecutionHistoryResponse, error) {
+
+	bucket, ok := wh.getArchivalBucket(domainID)
+	if !ok {
+		return nil, wh.error(errHistoryNotFound, scope)
+	}
+
+	execution := getRequest.Execution
+	isCloseEventOnly := getRequest.GetHistoryEventFilterType() == gen.HistoryEventFilterTypeCloseEvent
+	var blob *sysworkflow.HistoryBlob
+	for {
+		var err error
+		blob, err = sysworkflow.DownloadHistoryBlob(ctx, wh.blobstoreClient, bucket, domainID,
+			execution.GetWorkflowId(), execution.GetRunId(), pageNumber)
+		if err == blobstore.ErrBlobNotExists {
+			return nil, wh.error(errHistoryNotFound, scope)
+		}
+		if err != nil {
+			return nil, wh.error(err, scope)
+		}
+		// the close event is in the last page
+		if !isCloseEventOnly || blob.Header.IsLast {
+			break
+		}
+		pageNumber++
+	}
+
+	history := blob.Body
+	if isCloseEventOnly {
+		if len(history.Events) == 0 {
+			return nil, wh.error(errHistoryNotFound, scope)
+		}
+		history.Events = history.Events[len(history.Events)-1:]
+	}
+
+	var nextToken []byte
+	if !blob.Header.IsLast && !isCloseEventOnly {
+		var err error
+		nextToken, err = serializeHistoryToken(&getHistoryContinuationToken{
+			RunID:              execution.GetRunId(),
+			IsArchived:         true,
+			ArchivedPageNumber: pageNumber + 1,
+		})
+		if err != nil {
+			return nil, wh.error(err, scope)
+		}
+	}
+	return createGetWorkflowExecutionHistoryResponse(history, nextToken), nil
+}
+
+// getArchivalBucket returns the archival bucket of the domain, if archival has ever been enabled for it
+func (wh *WorkflowHandler) getArchivalBucket(domainID string) (string, bool) {
+	if !wh.GetClusterMetadata().IsArchivalEnabled() {
+		return "", false
+	}
+	domainEntry, err := wh.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return "", false
+	}
+	config := domainEntry.GetConfig()
+	if config.ArchivalStatus == gen.ArchivalStatusNeverEnabled || len(config.ArchivalBucket) == 0 {
+		return "", false
+	}
+	return config.ArchivalBucket, true
+}
+
 func (wh *WorkflowHandler) getHistory(scope int, domainID string, execution gen.WorkflowExecution,
 	firstEventID, nextEventID int64, pageSize int32, nextPageToken []byte,
 	transientDecision *gen.TransientDecisionInfo, eventStoreVersion int32, branchToken []byte) (*gen.History, []byte, error) {
@@ -2795,6 +3273,32 @@ func (wh *WorkflowHandler) startRequestProfile(scope int) tally.Stopwatch {
 	return sw
 }
 
+// checkRateLimit consumes a token from the global rate limiter and from the rate limiter of the domain,
+// poll APIs have a separate budget per domain so that long polls do not starve the other APIs
+func (wh *WorkflowHandler) checkRateLimit(domain string, isPoll bool, scope int) error {
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return createServiceBusyError()
+	}
+	if domain == "" {
+		// requests without domain are rejected later by the validation of the request
+		return nil
+	}
+
+	// the domain is resolved first so only the domains which exist get a rate limiter
+	domainEntry, err := wh.domainCache.GetDomain(domain)
+	if err != nil {
+		return err
+	}
+	limiter := wh.domainRateLimiter
+	if isPoll {
+		limiter = wh.domainPollLimiter
+	}
+	if !limiter.Allow(domainEntry.GetInfo().ID, domain, scope) {
+		return createDomainServiceBusyError(domain)
+	}
+	return nil
+}
+
 func (wh *WorkflowHandler) error(err error, scope int) error {
 	switch err := err.(type) {
 	case *gen.InternalServiceError:
@@ -2830,6 +3334,9 @@ func (wh *WorkflowHandler) error(err error, scope int) error {
 	case *gen.LimitExceededError:
 		wh.metricsClient.IncCounter(scope, metrics.CadenceErrLimitExceededCounter)
 		return err
+	case *gen.AccessDeniedError:
+		wh.metricsClient.IncCounter(scope, metrics.CadenceErrAccessDeniedCounter)
+		return err
 	case *yarpcerrors.Status:
 		if err.Code() == yarpcerrors.CodeDeadlineExceeded {
 			wh.metricsClient.IncCounter(scope, metrics.CadenceErrContextTimeoutCounter)
@@ -2856,6 +3363,9 @@ func (wh *WorkflowHandler) validateTaskList(t *gen.TaskList, scope int) error {
 	if len(t.GetName()) > wh.config.MaxIDLengthLimit() {
 		return wh.error(errTaskListTooLong, scope)
 	}
+	if strings.HasPrefix(t.GetName(), common.ReservedTaskListPrefix) {
+		return wh.error(errTaskListReserved, scope)
+	}
 	return nil
 }
 
@@ -3051,6 +3561,25 @@ func createServiceBusyError() *gen.ServiceBusyError {
 	return err
 }
 
+func createDomainServiceBusyError(domain string) *gen.ServiceBusyError {
+	err := &gen.ServiceBusyError{}
+	err.Message = fmt.Sprintf("Too many outstanding requests to the cadence service for domain: %v", domain)
+	return err
+}
+
+// getMemoSize returns the total size of the keys and values of the memo
+func getMemoSize(memo *gen.Memo) int {
+	if memo == nil {
+		return 0
+	}
+
+	size := 0
+	for key, value := range memo.Fields {
+		size += len(key) + len(value)
+	}
+	return size
+}
+
 func isFailoverRequest(updateRequest *gen.UpdateDomainRequest) bool {
 	return updateRequest.ReplicationConfiguration != nil && updateRequest.ReplicationConfiguration.ActiveClusterName != nil
 }
@@ -21,6 +21,7 @@
 package frontend
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"log"
@@ -37,6 +38,7 @@ import (
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/messaging"
@@ -45,6 +47,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	cs "github.com/uber/cadence/common/service"
 	dc "github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/service/worker/sysworkflow"
 )
 
 type (
@@ -180,12 +183,13 @@ func (s *workflowHandlerSuite) TestDisableListVisibilityByFilter() {
 	config.DisableListVisibilityByFilter = dc.GetBoolPropertyFnFilteredByDomain(true)
 
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr,
-		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	mockDomainCache := &cache.DomainCacheMock{}
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.domainCache = mockDomainCache
 	wh.startWG.Done()
 
+	mockDomainCache.On("GetDomain", domain).Return(s.newDomainCacheEntry(domain), nil)
 	mockDomainCache.On("GetDomainID", mock.Anything).Return(domainID, nil)
 
 	// test list open by wid
@@ -245,12 +249,52 @@ func (s *workflowHandlerSuite) TestDisableListVisibilityByFilter() {
 	assert.Equal(s.T(), errNoPermission, err)
 }
 
+func (s *workflowHandlerSuite) TestListWorkflowExecutions() {
+	domain := "test-domain"
+	domainID := uuid.New()
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+
+	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr,
+		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
+	mockDomainCache := &cache.DomainCacheMock{}
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = mockDomainCache
+	wh.startWG.Done()
+
+	mockDomainCache.On("GetDomain", domain).Return(s.newDomainCacheEntry(domain), nil)
+	mockDomainCache.On("GetDomainID", domain).Return(domainID, nil)
+	s.mockVisibilityMgr.On("ListWorkflowExecutions", mock.MatchedBy(func(request *persistence.ListWorkflowExecutionsByQueryRequest) bool {
+		return request.DomainUUID == domainID && request.Query == "WorkflowType = 'wtype'" &&
+			request.PageSize == config.VisibilityMaxPageSize(domain)
+	})).Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+
+	listRequest := &shared.ListWorkflowExecutionsRequest{
+		Domain: common.StringPtr(domain),
+		Query:  common.StringPtr("WorkflowType = 'wtype'"),
+	}
+	_, err := wh.ListWorkflowExecutions(context.Background(), listRequest)
+	assert.NoError(s.T(), err)
+
+	// unknown search attribute
+	listRequest.Query = common.StringPtr("CustomUnknownField = 'value'")
+	_, err = wh.ListWorkflowExecutions(context.Background(), listRequest)
+	assert.Error(s.T(), err)
+	assert.IsType(s.T(), &shared.BadRequestError{}, err)
+
+	// malformed query
+	listRequest.Query = common.StringPtr("WorkflowType = ")
+	_, err = wh.ListWorkflowExecutions(context.Background(), listRequest)
+	assert.Error(s.T(), err)
+	assert.IsType(s.T(), &shared.BadRequestError{}, err)
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_RequestIdNotSet() {
 	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
 	config.RPS = dc.GetIntPropertyFn(10)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr,
-		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = s.newDomainCacheMock("test-domain")
 	wh.startWG.Done()
 
 	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
@@ -281,7 +325,7 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_StartRequestNot
 	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
 	config.RPS = dc.GetIntPropertyFn(10)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr,
-		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -294,7 +338,7 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_DomainNotSet()
 	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
 	config.RPS = dc.GetIntPropertyFn(10)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr,
-		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -322,12 +366,64 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_DomainNotSet()
 	assert.Equal(s.T(), errDomainNotSet, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_DomainThrottled() {
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config.RPS = dc.GetIntPropertyFn(10)
+	config.DomainRPS = dc.GetIntPropertyFilteredByDomain(0)
+	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr,
+		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = s.newDomainCacheMock("test-domain")
+	wh.startWG.Done()
+
+	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr("test-domain"),
+		WorkflowId: common.StringPtr("workflow-id"),
+		WorkflowType: &shared.WorkflowType{
+			Name: common.StringPtr("workflow-type"),
+		},
+		TaskList: &shared.TaskList{
+			Name: common.StringPtr("task-list"),
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestId:                           common.StringPtr(uuid.New()),
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	assert.Error(s.T(), err)
+	assert.IsType(s.T(), &shared.ServiceBusyError{}, err)
+	assert.Contains(s.T(), err.(*shared.ServiceBusyError).Message, "test-domain")
+}
+
+func (s *workflowHandlerSuite) TestPollForDecisionTask_Failed_DomainPollThrottled() {
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config.RPS = dc.GetIntPropertyFn(10)
+	config.DomainPollRPS = dc.GetIntPropertyFilteredByDomain(0)
+	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr,
+		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = s.newDomainCacheMock("test-domain")
+	wh.startWG.Done()
+
+	pollRequest := &shared.PollForDecisionTaskRequest{
+		Domain: common.StringPtr("test-domain"),
+		TaskList: &shared.TaskList{
+			Name: common.StringPtr("task-list"),
+		},
+	}
+	_, err := wh.PollForDecisionTask(context.Background(), pollRequest)
+	assert.Error(s.T(), err)
+	assert.IsType(s.T(), &shared.ServiceBusyError{}, err)
+	assert.Contains(s.T(), err.(*shared.ServiceBusyError).Message, "test-domain")
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_WorkflowIdNotSet() {
 	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
 	config.RPS = dc.GetIntPropertyFn(10)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = s.newDomainCacheMock("test-domain")
 	wh.startWG.Done()
 
 	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
@@ -358,8 +454,9 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_WorkflowTypeNot
 	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
 	config.RPS = dc.GetIntPropertyFn(10)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = s.newDomainCacheMock("test-domain")
 	wh.startWG.Done()
 
 	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
@@ -391,8 +488,9 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_TaskListNotSet(
 	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
 	config.RPS = dc.GetIntPropertyFn(10)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = s.newDomainCacheMock("test-domain")
 	wh.startWG.Done()
 
 	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
@@ -424,8 +522,9 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidExecutio
 	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
 	config.RPS = dc.GetIntPropertyFn(10)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = s.newDomainCacheMock("test-domain")
 	wh.startWG.Done()
 
 	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
@@ -457,8 +556,9 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskStar
 	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
 	config.RPS = dc.GetIntPropertyFn(10)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = s.newDomainCacheMock("test-domain")
 	wh.startWG.Done()
 
 	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
@@ -486,10 +586,106 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskStar
 	assert.Equal(s.T(), errInvalidTaskStartToCloseTimeoutSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_MemoTooLarge() {
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config.RPS = dc.GetIntPropertyFn(10)
+	config.BlobSizeLimitWarn = dc.GetIntPropertyFilteredByDomain(10)
+	config.BlobSizeLimitError = dc.GetIntPropertyFilteredByDomain(20)
+	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
+	mockDomainCache := &cache.DomainCacheMock{}
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = mockDomainCache
+	wh.startWG.Done()
+
+	mockDomainCache.On("GetDomain", "test-domain").Return(s.newDomainCacheEntry("test-domain"), nil)
+	mockDomainCache.On("GetDomainID", "test-domain").Return(uuid.New(), nil)
+
+	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr("test-domain"),
+		WorkflowId: common.StringPtr("workflow-id"),
+		WorkflowType: &shared.WorkflowType{
+			Name: common.StringPtr("workflow-type"),
+		},
+		TaskList: &shared.TaskList{
+			Name: common.StringPtr("task-list"),
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestId:                           common.StringPtr(uuid.New()),
+		Memo: &shared.Memo{
+			Fields: map[string][]byte{"customer": []byte(`"a customer name which is too long"`)},
+		},
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), common.ErrBlobSizeExceedsLimit, err)
+}
+
+func (s *workflowHandlerSuite) TestGetWorkflowExecutionHistory_Archived() {
+	domainID := uuid.New()
+	execution := &shared.WorkflowExecution{
+		WorkflowId: common.StringPtr("workflow-id"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
+	mockDomainCache := &cache.DomainCacheMock{}
+	mockHistoryClient := &mocks.HistoryClient{}
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = mockDomainCache
+	wh.history = mockHistoryClient
+	wh.startWG.Done()
+
+	mockDomainCache.On("GetDomain", "test-domain").Return(s.newDomainCacheEntry("test-domain"), nil)
+	s.mockClusterMetadata.On("IsArchivalEnabled").Return(true)
+	mockDomainCache.On("GetDomainID", "test-domain").Return(domainID, nil)
+	mockDomainCache.On("GetDomainByID", domainID).Return(cache.NewDomainCacheEntryWithConfig(
+		&persistence.DomainInfo{ID: domainID, Name: "test-domain"},
+		&persistence.DomainConfig{ArchivalStatus: shared.ArchivalStatusEnabled, ArchivalBucket: "bucket-name"},
+	), nil)
+	mockHistoryClient.On("GetMutableState", mock.Anything, mock.Anything).Return(nil, &shared.EntityNotExistsError{})
+	for pageNumber := 0; pageNumber < 2; pageNumber++ {
+		data, err := sysworkflow.EncodeHistoryBlob(&sysworkflow.HistoryBlob{
+			Header: &sysworkflow.HistoryBlobHeader{
+				DomainID:   domainID,
+				WorkflowID: execution.GetWorkflowId(),
+				RunID:      execution.GetRunId(),
+				PageNumber: pageNumber,
+				IsLast:     pageNumber == 1,
+			},
+			Body: &shared.History{
+				Events: []*shared.HistoryEvent{{EventId: common.Int64Ptr(int64(pageNumber + 1))}},
+			},
+		})
+		assert.NoError(s.T(), err)
+		filename := sysworkflow.HistoryBlobFilename(domainID, execution.GetWorkflowId(), execution.GetRunId(), pageNumber)
+		s.mockBlobstoreClient.On("DownloadBlob", mock.Anything, "bucket-name", filename).
+			Return(&blobstore.Blob{Body: bytes.NewReader(data)}, nil).Once()
+	}
+
+	request := &shared.GetWorkflowExecutionHistoryRequest{
+		Domain:    common.StringPtr("test-domain"),
+		Execution: execution,
+	}
+	resp, err := wh.GetWorkflowExecutionHistory(context.Background(), request)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), resp.History.Events[0].GetEventId())
+	assert.NotNil(s.T(), resp.NextPageToken)
+
+	request.NextPageToken = resp.NextPageToken
+	resp, err = wh.GetWorkflowExecutionHistory(context.Background(), request)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(2), resp.History.Events[0].GetEventId())
+	assert.Nil(s.T(), resp.NextPageToken)
+}
+
 func (s *workflowHandlerSuite) TestRegisterDomain_Failed_CustomBucketGivenButArchivalNotEnabled() {
 	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -514,7 +710,7 @@ func (s *workflowHandlerSuite) TestRegisterDomain_Success_CustomBucketAndArchiva
 
 	mService := cs.NewTestService(clusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.logger)
 	wh := NewWorkflowHandler(mService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -540,7 +736,7 @@ func (s *workflowHandlerSuite) TestRegisterDomain_Success_ArchivalEnabledWithout
 
 	mService := cs.NewTestService(clusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.logger)
 	wh := NewWorkflowHandler(mService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -565,7 +761,7 @@ func (s *workflowHandlerSuite) TestRegisterDomain_Success_ArchivalNotEnabled() {
 
 	mService := cs.NewTestService(clusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.logger)
 	wh := NewWorkflowHandler(mService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -582,7 +778,7 @@ func (s *workflowHandlerSuite) TestDescribeDomain_Success_ArchivalNeverEnabled()
 	mMetadataManager.On("GetDomain", mock.Anything).Return(persistenceGetDomainResponse("", shared.ArchivalStatusNeverEnabled), nil)
 	mBlobstore := &mocks.Client{}
 	wh := NewWorkflowHandler(s.mockService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, mBlobstore)
+		s.mockVisibilityMgr, s.mockProducer, mBlobstore, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -608,7 +804,7 @@ func (s *workflowHandlerSuite) TestDescribeDomain_Success_ArchivalEnabled() {
 	mBlobstore := &mocks.Client{}
 	mBlobstore.On("BucketMetadata", mock.Anything, mock.Anything).Return(bucketMetadataResponse("test-owner", 10), nil)
 	wh := NewWorkflowHandler(s.mockService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, mBlobstore)
+		s.mockVisibilityMgr, s.mockProducer, mBlobstore, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -634,7 +830,7 @@ func (s *workflowHandlerSuite) TestDescribeDomain_Success_ArchivalDisabled() {
 	mBlobstore := &mocks.Client{}
 	mBlobstore.On("BucketMetadata", mock.Anything, mock.Anything).Return(bucketMetadataResponse("test-owner", 10), nil)
 	wh := NewWorkflowHandler(s.mockService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, mBlobstore)
+		s.mockVisibilityMgr, s.mockProducer, mBlobstore, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -660,7 +856,7 @@ func (s *workflowHandlerSuite) TestDescribeDomain_Success_BlobstoreReturnsError(
 	mBlobstore := &mocks.Client{}
 	mBlobstore.On("BucketMetadata", mock.Anything, mock.Anything).Return(nil, errors.New("blobstore error"))
 	wh := NewWorkflowHandler(s.mockService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, mBlobstore)
+		s.mockVisibilityMgr, s.mockProducer, mBlobstore, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -682,7 +878,7 @@ func (s *workflowHandlerSuite) TestDescribeDomain_Success_BlobstoreReturnsError(
 func (s *workflowHandlerSuite) TestUpdateDomain_Failure_StatusChangeToNeverEnabled() {
 	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -694,7 +890,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Failure_StatusChangeToNeverEnabl
 func (s *workflowHandlerSuite) TestUpdateDomain_Failure_IllegalBucketOwnerUpdate() {
 	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -706,7 +902,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Failure_IllegalBucketOwnerUpdate
 func (s *workflowHandlerSuite) TestUpdateDomain_Failure_IllegalArchivalRetentionUpdate() {
 	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -718,7 +914,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Failure_IllegalArchivalRetention
 func (s *workflowHandlerSuite) TestUpdateDomain_Failure_ProvidedBucketWithoutEnabling() {
 	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -738,7 +934,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Failure_UpdateExistingBucketName
 	clusterMetadata.On("IsGlobalDomainEnabled").Return(false)
 	mService := cs.NewTestService(clusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.logger)
 	wh := NewWorkflowHandler(mService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -759,7 +955,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Failure_ArchivalEnabledToArchiva
 	clusterMetadata.On("IsGlobalDomainEnabled").Return(false)
 	mService := cs.NewTestService(clusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.logger)
 	wh := NewWorkflowHandler(mService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -780,7 +976,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Failure_ArchivalDisabledToArchiv
 	clusterMetadata.On("IsGlobalDomainEnabled").Return(false)
 	mService := cs.NewTestService(clusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.logger)
 	wh := NewWorkflowHandler(mService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -801,7 +997,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Failure_ArchivalNeverEnabledToAr
 	clusterMetadata.On("IsGlobalDomainEnabled").Return(false)
 	mService := cs.NewTestService(clusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.logger)
 	wh := NewWorkflowHandler(mService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -824,7 +1020,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Success_ArchivalEnabledToArchiva
 	mBlobstore := &mocks.Client{}
 	mBlobstore.On("BucketMetadata", mock.Anything, mock.Anything).Return(bucketMetadataResponse("test-owner", 10), nil)
 	wh := NewWorkflowHandler(mService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, mBlobstore)
+		s.mockVisibilityMgr, s.mockProducer, mBlobstore, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -852,7 +1048,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Success_ArchivalDisabledToArchiv
 	mBlobstore := &mocks.Client{}
 	mBlobstore.On("BucketMetadata", mock.Anything, mock.Anything).Return(bucketMetadataResponse("test-owner", 10), nil)
 	wh := NewWorkflowHandler(mService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, mBlobstore)
+		s.mockVisibilityMgr, s.mockProducer, mBlobstore, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -881,7 +1077,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Success_ArchivalNeverEnabledToEn
 	mBlobstore := &mocks.Client{}
 	mBlobstore.On("BucketMetadata", mock.Anything, mock.Anything).Return(bucketMetadataResponse("test-owner", 10), nil)
 	wh := NewWorkflowHandler(mService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, mBlobstore)
+		s.mockVisibilityMgr, s.mockProducer, mBlobstore, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -909,7 +1105,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Success_ArchivalNeverEnabledToEn
 	mBlobstore := &mocks.Client{}
 	mBlobstore.On("BucketMetadata", mock.Anything, mock.Anything).Return(bucketMetadataResponse("test-owner", 10), nil)
 	wh := NewWorkflowHandler(mService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, mBlobstore)
+		s.mockVisibilityMgr, s.mockProducer, mBlobstore, authorization.NewNopAuthorizer())
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	wh.startWG.Done()
 
@@ -1002,3 +1198,72 @@ func registerDomainRequest(enableArchival bool, customBucketName *string) *share
 		CustomArchivalBucketName: customBucketName,
 	}
 }
+
+func (s *workflowHandlerSuite) TestAuthorization_Denied() {
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	authorizer, err := authorization.NewRoleAuthorizer(&authorization.Config{
+		Roles: map[string]map[string]authorization.Role{
+			authorization.Wildcard: {authorization.Wildcard: authorization.RoleRead},
+		},
+	})
+	s.NoError(err)
+	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorizer)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	_, err = wh.StartWorkflowExecution(context.Background(), &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr("test-domain"),
+		WorkflowId: common.StringPtr("wid"),
+	})
+	s.IsType(&shared.AccessDeniedError{}, err)
+
+	err = wh.RegisterDomain(context.Background(), &shared.RegisterDomainRequest{
+		Name: common.StringPtr("test-domain"),
+	})
+	s.IsType(&shared.AccessDeniedError{}, err)
+}
+
+func (s *workflowHandlerSuite) TestAuthorization_TaskTokenDomain() {
+	domain := "test-domain"
+	domainID := uuid.New()
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	authorizer, err := authorization.NewRoleAuthorizer(&authorization.Config{
+		Roles: map[string]map[string]authorization.Role{
+			authorization.Wildcard: {domain: authorization.RoleRead},
+		},
+	})
+	s.NoError(err)
+	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, authorizer)
+	mockDomainCache := &cache.DomainCacheMock{}
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = mockDomainCache
+	wh.startWG.Done()
+
+	mockDomainCache.On("GetDomainByID", domainID).Return(
+		cache.NewDomainCacheEntryWithInfo(&persistence.DomainInfo{ID: domainID, Name: domain}), nil)
+	taskToken, err := wh.tokenSerializer.Serialize(&common.TaskToken{
+		DomainID:   domainID,
+		WorkflowID: "wid",
+		RunID:      uuid.New(),
+		ScheduleID: 1,
+	})
+	s.NoError(err)
+
+	err = wh.RespondDecisionTaskFailed(context.Background(), &shared.RespondDecisionTaskFailedRequest{
+		TaskToken: taskToken,
+	})
+	s.IsType(&shared.AccessDeniedError{}, err)
+	mockDomainCache.AssertExpectations(s.T())
+}
+
+func (s *workflowHandlerSuite) newDomainCacheMock(domain string) *cache.DomainCacheMock {
+	mockDomainCache := &cache.DomainCacheMock{}
+	mockDomainCache.On("GetDomain", domain).Return(s.newDomainCacheEntry(domain), nil)
+	return mockDomainCache
+}
+
+func (s *workflowHandlerSuite) newDomainCacheEntry(domain string) *cache.DomainCacheEntry {
+	return cache.NewDomainCacheEntryWithInfo(&persistence.DomainInfo{ID: uuid.New(), Name: domain})
+}
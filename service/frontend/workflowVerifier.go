@@ -0,0 +1,183 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/uber/cadence/.gen/go/admin"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// newClusterWorkflowState builds the verification state of a workflow execution in a cluster from the result of
+// describing its mutable state there, a workflow missing in the cluster is not treated as a failure
+func newClusterWorkflowState(clusterName string, mutableStateJSON string, err error) *admin.ClusterWorkflowState {
+	state := &admin.ClusterWorkflowState{
+		ClusterName: common.StringPtr(clusterName),
+		Exists:      common.BoolPtr(false),
+	}
+	if err != nil {
+		if _, ok := err.(*gen.EntityNotExistsError); !ok {
+			state.Error = common.StringPtr(err.Error())
+		}
+		return state
+	}
+
+	ms := &persistence.WorkflowMutableState{}
+	if err := json.Unmarshal([]byte(mutableStateJSON), ms); err != nil {
+		state.Error = common.StringPtr(fmt.Sprintf("unable to decode mutable state: %v", err))
+		return state
+	}
+	if ms.ExecutionInfo == nil {
+		return state
+	}
+
+	state.Exists = common.BoolPtr(true)
+	state.RunId = common.StringPtr(ms.ExecutionInfo.RunID)
+	state.NextEventId = common.Int64Ptr(ms.ExecutionInfo.NextEventID)
+	if ms.ReplicationState != nil {
+		state.LastWriteVersion = common.Int64Ptr(ms.ReplicationState.LastWriteVersion)
+		state.ReplicationInfo = make(map[string]*gen.ReplicationInfo, len(ms.ReplicationState.LastReplicationInfo))
+		for cluster, info := range ms.ReplicationState.LastReplicationInfo {
+			state.ReplicationInfo[cluster] = &gen.ReplicationInfo{
+				Version:     common.Int64Ptr(info.Version),
+				LastEventId: common.Int64Ptr(info.LastEventID),
+			}
+		}
+	}
+
+	state.PendingActivityIds = make([]int64, 0, len(ms.ActivityInfos))
+	for scheduleID := range ms.ActivityInfos {
+		state.PendingActivityIds = append(state.PendingActivityIds, scheduleID)
+	}
+	sort.Slice(state.PendingActivityIds, func(i, j int) bool {
+		return state.PendingActivityIds[i] < state.PendingActivityIds[j]
+	})
+	state.PendingTimerIds = make([]string, 0, len(ms.TimerInfos))
+	for timerID := range ms.TimerInfos {
+		state.PendingTimerIds = append(state.PendingTimerIds, timerID)
+	}
+	sort.Strings(state.PendingTimerIds)
+	return state
+}
+
+// compareClusterWorkflowStates returns the divergences of the workflow execution in the standby clusters from its
+// state in the active cluster, the clusters which could not be described are skipped
+func compareClusterWorkflowStates(active *admin.ClusterWorkflowState, states []*admin.ClusterWorkflowState) []string {
+	divergences := []string{}
+	if active.Error != nil {
+		return divergences
+	}
+
+	for _, standby := range states {
+		if standby.GetClusterName() == active.GetClusterName() || standby.Error != nil {
+			continue
+		}
+		prefix := fmt.Sprintf("cluster %v", standby.GetClusterName())
+		if active.GetExists() != standby.GetExists() {
+			if active.GetExists() {
+				divergences = append(divergences, fmt.Sprintf("%v: workflow run %v does not exist", prefix, active.GetRunId()))
+			} else {
+				divergences = append(divergences, fmt.Sprintf("%v: workflow run %v does not exist in the active cluster %v",
+					prefix, standby.GetRunId(), active.GetClusterName()))
+			}
+			continue
+		}
+		if !active.GetExists() {
+			continue
+		}
+
+		if active.GetNextEventId() != standby.GetNextEventId() {
+			divergences = append(divergences, fmt.Sprintf("%v: next event ID %v, active cluster has %v",
+				prefix, standby.GetNextEventId(), active.GetNextEventId()))
+		}
+		if active.GetLastWriteVersion() != standby.GetLastWriteVersion() {
+			divergences = append(divergences, fmt.Sprintf("%v: last write version %v, active cluster has %v",
+				prefix, standby.GetLastWriteVersion(), active.GetLastWriteVersion()))
+		}
+		if missing, extra := diffInt64s(active.PendingActivityIds, standby.PendingActivityIds); len(missing)+len(extra) > 0 {
+			divergences = append(divergences, fmt.Sprintf("%v: pending activities missing %v, unexpected %v",
+				prefix, missing, extra))
+		}
+		if missing, extra := diffStrings(active.PendingTimerIds, standby.PendingTimerIds); len(missing)+len(extra) > 0 {
+			divergences = append(divergences, fmt.Sprintf("%v: pending timers missing %v, unexpected %v",
+				prefix, missing, extra))
+		}
+	}
+	return divergences
+}
+
+// needsRereplication checks whether the standby copy of a workflow execution is behind the active one in a way
+// re-replicating the history from the active cluster can repair
+func needsRereplication(active *admin.ClusterWorkflowState, standby *admin.ClusterWorkflowState) bool {
+	if active.Error != nil || standby.Error != nil || !active.GetExists() {
+		return false
+	}
+	return !standby.GetExists() || standby.GetNextEventId() < active.GetNextEventId()
+}
+
+func diffInt64s(expected []int64, actual []int64) ([]int64, []int64) {
+	actualSet := make(map[int64]struct{}, len(actual))
+	for _, id := range actual {
+		actualSet[id] = struct{}{}
+	}
+	missing := []int64{}
+	for _, id := range expected {
+		if _, ok := actualSet[id]; ok {
+			delete(actualSet, id)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	extra := []int64{}
+	for _, id := range actual {
+		if _, ok := actualSet[id]; ok {
+			extra = append(extra, id)
+		}
+	}
+	return missing, extra
+}
+
+func diffStrings(expected []string, actual []string) ([]string, []string) {
+	actualSet := make(map[string]struct{}, len(actual))
+	for _, id := range actual {
+		actualSet[id] = struct{}{}
+	}
+	missing := []string{}
+	for _, id := range expected {
+		if _, ok := actualSet[id]; ok {
+			delete(actualSet, id)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	extra := []string{}
+	for _, id := range actual {
+		if _, ok := actualSet[id]; ok {
+			extra = append(extra, id)
+		}
+	}
+	return missing, extra
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/admin"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type workflowVerifierSuite struct {
+	suite.Suite
+}
+
+func TestWorkflowVerifierSuite(t *testing.T) {
+	s := new(workflowVerifierSuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowVerifierSuite) newState(cluster string, nextEventID int64, lastWriteVersion int64,
+	activityIDs []int64, timerIDs []string) *admin.ClusterWorkflowState {
+
+	ms := &persistence.WorkflowMutableState{
+		ActivityInfos: map[int64]*persistence.ActivityInfo{},
+		TimerInfos:    map[string]*persistence.TimerInfo{},
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			RunID:       "some random run ID",
+			NextEventID: nextEventID,
+		},
+		ReplicationState: &persistence.ReplicationState{
+			LastWriteVersion: lastWriteVersion,
+			LastReplicationInfo: map[string]*persistence.ReplicationInfo{
+				"standby": {Version: 1, LastEventID: 5},
+			},
+		},
+	}
+	for _, id := range activityIDs {
+		ms.ActivityInfos[id] = &persistence.ActivityInfo{ScheduleID: id}
+	}
+	for _, id := range timerIDs {
+		ms.TimerInfos[id] = &persistence.TimerInfo{TimerID: id}
+	}
+	msJSON, err := json.Marshal(ms)
+	s.NoError(err)
+	return newClusterWorkflowState(cluster, string(msJSON), nil)
+}
+
+func (s *workflowVerifierSuite) TestNewClusterWorkflowState() {
+	state := s.newState("active", 10, 2, []int64{7, 5}, []string{"b", "a"})
+	s.True(state.GetExists())
+	s.Equal("some random run ID", state.GetRunId())
+	s.Equal(int64(10), state.GetNextEventId())
+	s.Equal(int64(2), state.GetLastWriteVersion())
+	s.Equal(int64(5), state.ReplicationInfo["standby"].GetLastEventId())
+	s.Equal([]int64{5, 7}, state.PendingActivityIds)
+	s.Equal([]string{"a", "b"}, state.PendingTimerIds)
+	s.Nil(state.Error)
+}
+
+func (s *workflowVerifierSuite) TestNewClusterWorkflowState_Error() {
+	state := newClusterWorkflowState("standby", "", &gen.EntityNotExistsError{})
+	s.False(state.GetExists())
+	s.Nil(state.Error)
+
+	state = newClusterWorkflowState("standby", "", errors.New("some random error"))
+	s.False(state.GetExists())
+	s.Equal("some random error", state.GetError())
+
+	state = newClusterWorkflowState("standby", "not json", nil)
+	s.False(state.GetExists())
+	s.NotNil(state.Error)
+}
+
+func (s *workflowVerifierSuite) TestCompareClusterWorkflowStates_Consistent() {
+	active := s.newState("active", 10, 2, []int64{5}, []string{"a"})
+	standby := s.newState("standby", 10, 2, []int64{5}, []string{"a"})
+	s.Empty(compareClusterWorkflowStates(active, []*admin.ClusterWorkflowState{active, standby}))
+	s.False(needsRereplication(active, standby))
+}
+
+func (s *workflowVerifierSuite) TestCompareClusterWorkflowStates_Diverged() {
+	active := s.newState("active", 10, 2, []int64{5, 7}, []string{"a"})
+	standby := s.newState("standby", 8, 1, []int64{5, 6}, []string{"a", "b"})
+	divergences := compareClusterWorkflowStates(active, []*admin.ClusterWorkflowState{active, standby})
+	s.Equal([]string{
+		"cluster standby: next event ID 8, active cluster has 10",
+		"cluster standby: last write version 1, active cluster has 2",
+		"cluster standby: pending activities missing [7], unexpected [6]",
+		"cluster standby: pending timers missing [], unexpected [b]",
+	}, divergences)
+	s.True(needsRereplication(active, standby))
+	s.False(needsRereplication(standby, active))
+}
+
+func (s *workflowVerifierSuite) TestCompareClusterWorkflowStates_Missing() {
+	active := s.newState("active", 10, 2, nil, nil)
+	standby := newClusterWorkflowState("standby", "", &gen.EntityNotExistsError{})
+	divergences := compareClusterWorkflowStates(active, []*admin.ClusterWorkflowState{active, standby})
+	s.Equal([]string{"cluster standby: workflow run some random run ID does not exist"}, divergences)
+	s.True(needsRereplication(active, standby))
+
+	divergences = compareClusterWorkflowStates(standby, []*admin.ClusterWorkflowState{standby, active})
+	s.Equal([]string{"cluster active: workflow run some random run ID does not exist in the active cluster standby"}, divergences)
+	s.False(needsRereplication(standby, active))
+}
+
+func (s *workflowVerifierSuite) TestCompareClusterWorkflowStates_SkipFailedClusters() {
+	active := s.newState("active", 10, 2, nil, nil)
+	standby := newClusterWorkflowState("standby", "", errors.New("some random error"))
+	s.Empty(compareClusterWorkflowStates(active, []*admin.ClusterWorkflowState{active, standby}))
+	s.Empty(compareClusterWorkflowStates(standby, []*admin.ClusterWorkflowState{standby, active}))
+	s.False(needsRereplication(active, standby))
+}
@@ -366,6 +366,22 @@ func (_m *mockMutableState) AddDecisionTaskFailedEvent(_a0 int64, _a1 int64, _a2
 	return r0
 }
 
+// AddDecisionTaskResetFailedEvent provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
+func (_m *mockMutableState) AddDecisionTaskResetFailedEvent(_a0 int64, _a1 int64, _a2 string, _a3 string, _a4 string, _a5 string) *shared.HistoryEvent {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(int64, int64, string, string, string, string) *shared.HistoryEvent); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	return r0
+}
+
 // AddDecisionTaskScheduleToStartTimeoutEvent provides a mock function with given fields: _a0
 func (_m *mockMutableState) AddDecisionTaskScheduleToStartTimeoutEvent(_a0 int64) *shared.HistoryEvent {
 	ret := _m.Called(_a0)
@@ -720,6 +736,22 @@ func (_m *mockMutableState) AddTimerStartedEvent(_a0 int64, _a1 *shared.StartTim
 	return r0, r1
 }
 
+// AddUpsertWorkflowSearchAttributesEvent provides a mock function with given fields: _a0, _a1
+func (_m *mockMutableState) AddUpsertWorkflowSearchAttributesEvent(_a0 int64, _a1 *shared.UpsertWorkflowSearchAttributesDecisionAttributes) *shared.HistoryEvent {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(int64, *shared.UpsertWorkflowSearchAttributesDecisionAttributes) *shared.HistoryEvent); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	return r0
+}
+
 // AddWorkflowExecutionCancelRequestedEvent provides a mock function with given fields: _a0, _a1
 func (_m *mockMutableState) AddWorkflowExecutionCancelRequestedEvent(_a0 string, _a1 *h.RequestCancelWorkflowExecutionRequest) *shared.HistoryEvent {
 	ret := _m.Called(_a0, _a1)
@@ -1732,6 +1764,20 @@ func (_m *mockMutableState) HasPendingDecisionTask() bool {
 	return r0
 }
 
+// HasProcessedOrPendingDecisionTask provides a mock function with given fields:
+func (_m *mockMutableState) HasProcessedOrPendingDecisionTask() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // IncrementHistorySize provides a mock function with given fields: appendSize
 func (_m *mockMutableState) IncrementHistorySize(appendSize int) {
 	_m.Called(appendSize)
@@ -2102,6 +2148,11 @@ func (_m *mockMutableState) ReplicateTransientDecisionTaskScheduled() *decisionI
 	return r0
 }
 
+// ReplicateUpsertWorkflowSearchAttributesEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateUpsertWorkflowSearchAttributesEvent(_a0 *shared.HistoryEvent) {
+	_m.Called(_a0)
+}
+
 // ReplicateWorkflowExecutionCancelRequestedEvent provides a mock function with given fields: _a0
 func (_m *mockMutableState) ReplicateWorkflowExecutionCancelRequestedEvent(_a0 *shared.HistoryEvent) {
 	_m.Called(_a0)
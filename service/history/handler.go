@@ -33,6 +33,7 @@ import (
 	"github.com/uber/cadence/.gen/go/health/metaserver"
 	hist "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyserviceserver"
+	"github.com/uber/cadence/.gen/go/replicator"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/frontend"
 	hc "github.com/uber/cadence/client/history"
@@ -118,12 +119,6 @@ func (h *Handler) Start() error {
 	h.Service.GetDispatcher().Register(metaserver.New(h))
 	h.Service.Start()
 
-	h.matchingServiceClient = matching.NewRetryableClient(
-		h.Service.GetClientBean().GetMatchingClient(),
-		common.CreateMatchingServiceRetryPolicy(),
-		common.IsWhitelistServiceTransientError,
-	)
-
 	h.historyServiceClient = hc.NewRetryableClient(
 		h.Service.GetClientBean().GetHistoryClient(),
 		common.CreateHistoryServiceRetryPolicy(),
@@ -143,7 +138,8 @@ func (h *Handler) Start() error {
 	h.hServiceResolver = hServiceResolver
 
 	// TODO when global domain is enabled, uncomment the line below and remove the line after
-	if h.GetClusterMetadata().IsGlobalDomainEnabled() {
+	// the replication tasks are pulled by the other clusters instead of being published in pull replication mode
+	if h.GetClusterMetadata().IsGlobalDomainEnabled() && !h.GetClusterMetadata().IsPullReplicationEnabled() {
 		var err error
 		h.publisher, err = h.GetMessagingClient().NewProducerWithClusterName(h.GetClusterMetadata().GetCurrentClusterName())
 		if err != nil {
@@ -153,6 +149,12 @@ func (h *Handler) Start() error {
 
 	h.domainCache = cache.NewDomainCache(h.metadataMgr, h.GetClusterMetadata(), h.GetMetricsClient(), h.GetLogger())
 	h.domainCache.Start()
+	h.matchingServiceClient = matching.NewRetryableClient(
+		matching.NewPartitionedClient(h.Service.GetClientBean().GetMatchingClient(), h.domainCache,
+			h.config.NumTasklistWritePartitions, h.config.NumTasklistReadPartitions),
+		common.CreateMatchingServiceRetryPolicy(),
+		common.IsWhitelistServiceTransientError,
+	)
 	h.controller = newShardController(h.Service, h.GetHostInfo(), hServiceResolver, h.shardManager, h.historyMgr, h.historyV2Mgr,
 		h.domainCache, h.executionMgrFactory, h, h.config, h.GetLogger(), h.GetMetricsClient())
 	h.metricsClient = h.GetMetricsClient()
@@ -903,6 +905,75 @@ func (h *Handler) TerminateWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// ResetWorkflowExecution terminates the current run of a workflow and starts a new run from a decision finish event of
+// a previous run
+func (h *Handler) ResetWorkflowExecution(ctx context.Context,
+	wrappedRequest *hist.ResetWorkflowExecutionRequest) (*gen.ResetWorkflowExecutionResponse, error) {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryResetWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.ResetRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	resp, err2 := engine.ResetWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+
+	return resp, nil
+}
+
+// TriggerCronWorkflowExecution schedules the first decision of a cron run which is still waiting for its backoff timer
+func (h *Handler) TriggerCronWorkflowExecution(ctx context.Context,
+	wrappedRequest *hist.TriggerCronWorkflowExecutionRequest) error {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryTriggerCronWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.TriggerRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.TriggerCronWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
 // ScheduleDecisionTask is used for creating a decision task for already started workflow execution.  This is mainly
 // used by transfer queue processor during the processing of StartChildWorkflowExecution task, where it first starts
 // child execution without creating the decision task and then calls this API after updating the mutable state of
@@ -1164,6 +1235,135 @@ func (h *Handler) SyncActivity(ctx context.Context, syncActivityRequest *hist.Sy
 	return nil
 }
 
+// GetReplicationMessages is called by the other clusters to pull the replication tasks of a shard
+func (h *Handler) GetReplicationMessages(ctx context.Context,
+	request *replicator.GetReplicationMessagesRequest) (*replicator.ReplicationMessages, error) {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryGetReplicationMessagesScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	if request.ShardId == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	if request.ClusterName == nil {
+		return nil, h.error(errSourceClusterNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardId()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	response, err := engine.GetReplicationMessages(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	return response, nil
+}
+
+// ReadDLQMessages returns a page of the replication tasks in the dead letter queue of a shard
+func (h *Handler) ReadDLQMessages(ctx context.Context,
+	request *hist.ReadDLQMessagesRequest) (*replicator.ReadDLQMessagesResponse, error) {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryReadDLQMessagesScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	dlqRequest := request.Request
+	if dlqRequest == nil || dlqRequest.ShardId == nil {
+		return nil, h.error(errShardIDNotSet, scope, domainID, "")
+	}
+	if dlqRequest.GetSourceCluster() == "" {
+		return nil, h.error(errSourceClusterNotSet, scope, domainID, "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(dlqRequest.GetShardId()))
+	if err != nil {
+		return nil, h.error(err, scope, domainID, dlqRequest.GetWorkflowId())
+	}
+
+	response, err := engine.ReadDLQMessages(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, dlqRequest.GetWorkflowId())
+	}
+
+	return response, nil
+}
+
+// MergeDLQMessages applies a page of the replication tasks in the dead letter queue of a shard
+func (h *Handler) MergeDLQMessages(ctx context.Context,
+	request *hist.MergeDLQMessagesRequest) (*replicator.MergeDLQMessagesResponse, error) {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryMergeDLQMessagesScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	dlqRequest := request.Request
+	if dlqRequest == nil || dlqRequest.ShardId == nil {
+		return nil, h.error(errShardIDNotSet, scope, domainID, "")
+	}
+	if dlqRequest.GetSourceCluster() == "" {
+		return nil, h.error(errSourceClusterNotSet, scope, domainID, "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(dlqRequest.GetShardId()))
+	if err != nil {
+		return nil, h.error(err, scope, domainID, dlqRequest.GetWorkflowId())
+	}
+
+	response, err := engine.MergeDLQMessages(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, domainID, dlqRequest.GetWorkflowId())
+	}
+
+	return response, nil
+}
+
+// PurgeDLQMessages removes the replication tasks in the dead letter queue of a shard
+func (h *Handler) PurgeDLQMessages(ctx context.Context, request *hist.PurgeDLQMessagesRequest) error {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryPurgeDLQMessagesScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	dlqRequest := request.Request
+	if dlqRequest == nil || dlqRequest.ShardId == nil {
+		return h.error(errShardIDNotSet, scope, domainID, "")
+	}
+	if dlqRequest.GetSourceCluster() == "" {
+		return h.error(errSourceClusterNotSet, scope, domainID, "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(dlqRequest.GetShardId()))
+	if err != nil {
+		return h.error(err, scope, domainID, dlqRequest.GetWorkflowId())
+	}
+
+	if err := engine.PurgeDLQMessages(ctx, request); err != nil {
+		return h.error(err, scope, domainID, dlqRequest.GetWorkflowId())
+	}
+
+	return nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
@@ -126,6 +126,18 @@ func (b *historyBuilder) AddDecisionTaskFailedEvent(scheduleEventID int64, start
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddDecisionTaskResetFailedEvent(scheduleEventID int64, startedEventID int64,
+	reason, identity, baseRunID, newRunID string) *workflow.HistoryEvent {
+	event := b.newDecisionTaskFailedEvent(scheduleEventID, startedEventID, workflow.DecisionTaskFailedCauseResetWorkflow,
+		nil, identity)
+	attributes := event.DecisionTaskFailedEventAttributes
+	attributes.Reason = common.StringPtr(reason)
+	attributes.BaseRunId = common.StringPtr(baseRunID)
+	attributes.NewRunId = common.StringPtr(newRunID)
+
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) AddActivityTaskScheduledEvent(decisionCompletedEventID int64,
 	attributes *workflow.ScheduleActivityTaskDecisionAttributes) *workflow.HistoryEvent {
 	event := b.newActivityTaskScheduledEvent(decisionCompletedEventID, attributes)
@@ -362,6 +374,13 @@ func (b *historyBuilder) AddMarkerRecordedEvent(decisionCompletedEventID int64,
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddUpsertWorkflowSearchAttributesEvent(decisionCompletedEventID int64,
+	attributes *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) *workflow.HistoryEvent {
+	event := b.newUpsertWorkflowSearchAttributesEvent(decisionCompletedEventID, attributes)
+
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) AddWorkflowExecutionSignaledEvent(
 	signalName string, input []byte, identity string) *workflow.HistoryEvent {
 	event := b.newWorkflowExecutionSignaledEvent(signalName, input, identity)
@@ -468,6 +487,8 @@ func (b *historyBuilder) newWorkflowExecutionStartedEvent(
 	attributes.ContinuedFailureDetails = startRequest.ContinuedFailureDetails
 	attributes.Initiator = startRequest.ContinueAsNewInitiator
 	attributes.FirstDecisionTaskBackoffSeconds = startRequest.FirstDecisionTaskBackoffSeconds
+	attributes.Memo = request.Memo
+	attributes.SearchAttributes = request.SearchAttributes
 
 	parentInfo := startRequest.ParentExecutionInfo
 	if parentInfo != nil {
@@ -688,6 +709,17 @@ func (b *historyBuilder) newMarkerRecordedEventAttributes(decisionTaskCompletedE
 	return historyEvent
 }
 
+func (b *historyBuilder) newUpsertWorkflowSearchAttributesEvent(decisionTaskCompletedEventID int64,
+	request *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeUpsertWorkflowSearchAttributes)
+	attributes := &workflow.UpsertWorkflowSearchAttributesEventAttributes{}
+	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	attributes.SearchAttributes = request.SearchAttributes
+	historyEvent.UpsertWorkflowSearchAttributesEventAttributes = attributes
+
+	return historyEvent
+}
+
 func (b *historyBuilder) newWorkflowExecutionCancelRequestedEvent(cause string,
 	request *h.RequestCancelWorkflowExecutionRequest) *workflow.HistoryEvent {
 	event := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionCancelRequested)
@@ -858,6 +890,8 @@ func (b *historyBuilder) newStartChildWorkflowExecutionInitiatedEvent(decisionTa
 	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
 	attributes.WorkflowIdReusePolicy = startAttributes.WorkflowIdReusePolicy
 	attributes.RetryPolicy = startAttributes.RetryPolicy
+	attributes.Memo = startAttributes.Memo
+	attributes.SearchAttributes = startAttributes.SearchAttributes
 	historyEvent.StartChildWorkflowExecutionInitiatedEventAttributes = attributes
 
 	return historyEvent
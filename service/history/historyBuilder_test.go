@@ -328,6 +328,53 @@ func (s *historyBuilderSuite) TestHistoryBuilderDecisionStartedFailures() {
 	s.Equal(common.EmptyEventID, s.getPreviousDecisionStartedEventID())
 }
 
+func (s *historyBuilderSuite) TestHistoryBuilderDecisionResetFailed() {
+	id := "historybuilder-decisionresetfailed-test-workflow-id"
+	rid := "historybuilder-decisionresetfailed-test-run-id"
+	wt := "historybuilder-decisionresetfailed-type"
+	tl := "historybuilder-decisionresetfailed-tasklist"
+	identity := "historybuilder-decisionresetfailed-worker"
+	input := []byte("historybuilder-decisionresetfailed-input")
+	execTimeout := int32(60)
+	taskTimeout := int32(10)
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(id),
+		RunId:      common.StringPtr(rid),
+	}
+
+	workflowStartedEvent := s.addWorkflowExecutionStartedEvent(we, wt, tl, input, execTimeout, taskTimeout, identity)
+	s.validateWorkflowExecutionStartedEvent(workflowStartedEvent, wt, tl, input, execTimeout, taskTimeout, identity)
+
+	di := s.addDecisionTaskScheduledEvent()
+	s.validateDecisionTaskScheduledEvent(di, 2, tl, taskTimeout)
+	decisionStartedEvent := s.addDecisionTaskStartedEvent(2, tl, identity)
+	s.validateDecisionTaskStartedEvent(decisionStartedEvent, 3, 2, identity)
+	s.Equal(int64(4), s.getNextEventID())
+
+	resetFailedEvent0 := s.msBuilder.AddDecisionTaskResetFailedEvent(2, 100, "reset-reason", identity, "base-run-id",
+		"new-run-id")
+	s.Nil(resetFailedEvent0)
+	s.Equal(int64(4), s.getNextEventID())
+
+	resetFailedEvent := s.msBuilder.AddDecisionTaskResetFailedEvent(2, 3, "reset-reason", identity, "base-run-id",
+		"new-run-id")
+	s.NotNil(resetFailedEvent)
+	s.Equal(int64(4), resetFailedEvent.GetEventId())
+	s.Equal(workflow.EventTypeDecisionTaskFailed, resetFailedEvent.GetEventType())
+	attributes := resetFailedEvent.DecisionTaskFailedEventAttributes
+	s.NotNil(attributes)
+	s.Equal(int64(2), attributes.GetScheduledEventId())
+	s.Equal(int64(3), attributes.GetStartedEventId())
+	s.Equal(workflow.DecisionTaskFailedCauseResetWorkflow, attributes.GetCause())
+	s.Equal("reset-reason", attributes.GetReason())
+	s.Equal("base-run-id", attributes.GetBaseRunId())
+	s.Equal("new-run-id", attributes.GetNewRunId())
+	s.Equal(identity, attributes.GetIdentity())
+	s.Equal(int64(5), s.getNextEventID())
+	_, decisionRunning := s.msBuilder.GetPendingDecision(2)
+	s.False(decisionRunning)
+}
+
 func (s *historyBuilderSuite) TestHistoryBuilderFlushBufferedEvents() {
 	id := "flush-buffered-events-test-workflow-id"
 	rid := "flush-buffered-events-test-run-id"
@@ -31,6 +31,7 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/uber-common/bark"
 	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/frontend"
 	hc "github.com/uber/cadence/client/history"
@@ -44,6 +45,7 @@ import (
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/validator"
 	"go.uber.org/yarpc"
 )
 
@@ -65,7 +67,9 @@ type (
 		timerProcessor       timerQueueProcessor
 		taskAllocator        taskAllocator
 		replicator           *historyReplicator
-		replicatorProcessor  queueProcessor
+		replicatorProcessor  replicatorQueueProcessor
+		replicationPullers   []*replicationTaskPuller
+		replicationDLQ       *replicationDLQHandler
 		historyEventNotifier historyEventNotifier
 		tokenSerializer      common.TaskTokenSerializer
 		historyCache         *historyCache
@@ -73,6 +77,9 @@ type (
 		logger               bark.Logger
 		config               *Config
 		archivalClient       sysworkflow.ArchivalClient
+		resetor              workflowResetor
+
+		searchAttributesValidator *validator.SearchAttributesValidator
 	}
 
 	// shardContextWrapper wraps ShardContext to notify transferQueueProcessor on new tasks.
@@ -81,7 +88,7 @@ type (
 		currentClusterName string
 		ShardContext
 		txProcessor          transferQueueProcessor
-		replcatorProcessor   queueProcessor
+		replcatorProcessor   replicatorQueueProcessor
 		historyEventNotifier historyEventNotifier
 	}
 )
@@ -117,6 +124,12 @@ var (
 	ErrBufferedEventsLimitExceeded = &workflow.LimitExceededError{Message: "Exceeded workflow execution limit for buffered events"}
 	// ErrSignalsLimitExceeded is the error indicating limit reached for maximum number of signal events
 	ErrSignalsLimitExceeded = &workflow.LimitExceededError{Message: "Exceeded workflow execution limit for signal events"}
+	// ErrWorkflowNotCron is the error indicating the workflow execution does not have a cron schedule
+	ErrWorkflowNotCron = &workflow.BadRequestError{Message: "Workflow execution does not have a cron schedule."}
+	// ErrCronRunAlreadyStarted is the error indicating the cron run is not waiting for its cron schedule anymore
+	ErrCronRunAlreadyStarted = &workflow.BadRequestError{Message: "Workflow execution is not waiting for its next cron run."}
+	// ErrDomainDeprecated is the error indicating no new workflow execution can be started in a deprecated domain
+	ErrDomainDeprecated = &workflow.BadRequestError{Message: "Domain is deprecated."}
 	// FailedWorkflowCloseState is a set of failed workflow close states, used for start workflow policy
 	// for start workflow execution API
 	FailedWorkflowCloseState = map[int]bool{
@@ -166,18 +179,43 @@ func NewEngineWithShardContext(
 		historyEventNotifier: historyEventNotifier,
 		config:               config,
 		archivalClient:       sysworkflow.NewArchivalClient(frontendClient, shard.GetConfig().NumSysWorkflows),
+		searchAttributesValidator: validator.NewSearchAttributesValidator(
+			logger,
+			config.ValidSearchAttributes,
+			config.SearchAttributesNumberOfKeysLimit,
+			config.SearchAttributesSizeOfValueLimit,
+			config.SearchAttributesTotalSizeLimit,
+		),
 	}
 	var visibilityProducer messaging.Producer
 	if config.EnableVisibilityToKafka() {
 		visibilityProducer = getVisibilityProducer(messagingClient)
 	}
+	historyEngImpl.resetor = newWorkflowResetor(historyEngImpl)
 	txProcessor := newTransferQueueProcessor(shard, historyEngImpl, visibilityMgr, visibilityProducer, matching, historyClient, logger)
 	historyEngImpl.timerProcessor = newTimerQueueProcessor(shard, historyEngImpl, matching, logger)
 	historyEngImpl.txProcessor = txProcessor
 	shardWrapper.txProcessor = txProcessor
 
-	// Only start the replicator processor if valid publisher is passed in
-	if publisher != nil {
+	historyEngImpl.replicationDLQ = newReplicationDLQHandler(shard, historyEngImpl, logger)
+
+	clusterMetadata := shard.GetService().GetClusterMetadata()
+	if clusterMetadata.IsGlobalDomainEnabled() && clusterMetadata.IsPullReplicationEnabled() {
+		// The replication tasks are pulled by the other clusters instead of being published, so the replicator
+		// processor is only used to serve the pulls and the tasks of the other clusters are pulled by this shard
+		replicatorProcessor := newReplicatorQueueProcessor(shard, historyEngImpl.historyCache, nil, executionManager, historyManager, historyV2Manager, logger)
+		historyEngImpl.replicatorProcessor = replicatorProcessor
+		shardWrapper.replcatorProcessor = replicatorProcessor
+		historyEngImpl.replicator = newHistoryReplicator(shard, historyEngImpl, historyCache, shard.GetDomainCache(), historyManager, historyV2Manager,
+			logger)
+		for clusterName := range clusterMetadata.GetAllClusterFailoverVersions() {
+			if clusterName != currentClusterName {
+				historyEngImpl.replicationPullers = append(historyEngImpl.replicationPullers,
+					newReplicationTaskPuller(shard, historyEngImpl, historyEngImpl.replicationDLQ, clusterName, logger))
+			}
+		}
+	} else if publisher != nil {
+		// Only start the replicator processor if valid publisher is passed in
 		replicatorProcessor := newReplicatorQueueProcessor(shard, historyEngImpl.historyCache, publisher, executionManager, historyManager, historyV2Manager, logger)
 		historyEngImpl.replicatorProcessor = replicatorProcessor
 		shardWrapper.replcatorProcessor = replicatorProcessor
@@ -199,9 +237,13 @@ func (e *historyEngineImpl) Start() {
 
 	e.txProcessor.Start()
 	e.timerProcessor.Start()
-	if e.replicatorProcessor != nil {
+	if e.replicatorProcessor != nil && !e.shard.GetService().GetClusterMetadata().IsPullReplicationEnabled() {
 		e.replicatorProcessor.Start()
 	}
+	e.replicationDLQ.Start()
+	for _, puller := range e.replicationPullers {
+		puller.Start()
+	}
 }
 
 // Stop the service.
@@ -211,9 +253,13 @@ func (e *historyEngineImpl) Stop() {
 
 	e.txProcessor.Stop()
 	e.timerProcessor.Stop()
-	if e.replicatorProcessor != nil {
+	if e.replicatorProcessor != nil && !e.shard.GetService().GetClusterMetadata().IsPullReplicationEnabled() {
 		e.replicatorProcessor.Stop()
 	}
+	for _, puller := range e.replicationPullers {
+		puller.Stop()
+	}
+	e.replicationDLQ.Stop()
 
 	// unset the failover callback
 	e.shard.GetDomainCache().UnregisterDomainChangeCallback(e.shard.GetShardID())
@@ -337,6 +383,7 @@ func (e *historyEngineImpl) appendFirstBatchHistoryEvents(msBuilder mutableState
 			// It is ok to use 0 for TransactionID because RunID is unique so there are
 			// no potential duplicates to override.
 			TransactionID: 0,
+			Info:          persistence.BuildHistoryGarbageCleanupInfo(domainID, execution.GetWorkflowId(), execution.GetRunId()),
 		}, domainID)
 	} else {
 		historySize, err = e.shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
@@ -428,6 +475,7 @@ func (e *historyEngineImpl) createWorkflow(startRequest *h.StartWorkflowExecutio
 		DecisionScheduleID:          firstDecisionTask.ScheduleID,
 		DecisionStartedID:           firstDecisionTask.StartedID,
 		DecisionStartToCloseTimeout: firstDecisionTask.DecisionTimeout,
+		DecisionScheduledTimestamp:  firstDecisionTask.ScheduledTimestamp,
 		TimerTasks:                  timerTasks,
 		PreviousRunID:               prevRunID,
 		PreviousLastWriteVersion:    prevLastWriteVersion,
@@ -437,6 +485,8 @@ func (e *historyEngineImpl) createWorkflow(startRequest *h.StartWorkflowExecutio
 		BranchToken:                 msBuilder.GetCurrentBranch(),
 		CreateWorkflowMode:          createMode,
 		CronSchedule:                request.GetCronSchedule(),
+		Memo:                        currExeInfo.Memo,
+		SearchAttributes:            currExeInfo.SearchAttributes,
 	}
 
 	if createRequest.HasRetryPolicy {
@@ -464,6 +514,10 @@ func (e *historyEngineImpl) StartWorkflowExecution(ctx context.Context, startReq
 	if retError != nil {
 		return
 	}
+	if domainEntry.GetInfo().Status == persistence.DomainStatusDeprecated {
+		// the executions of a deprecated domain are terminated before the domain is deleted
+		return nil, ErrDomainDeprecated
+	}
 	domainID := domainEntry.GetInfo().ID
 
 	request := startRequest.StartRequest
@@ -719,7 +773,10 @@ func (e *historyEngineImpl) DescribeMutableState(ctx context.Context,
 		retResp.MutableStateInCache, retError = e.toMutableStateJSON(msb)
 	}
 
-	msb, retError := dbCtx.loadWorkflowExecution()
+	msb, err := dbCtx.loadWorkflowExecution()
+	if err != nil {
+		return nil, err
+	}
 	retResp.MutableStateInDatabase, retError = e.toMutableStateJSON(msb)
 
 	return
@@ -806,6 +863,24 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(ctx context.Context,
 		result.WorkflowExecutionInfo.CloseStatus = &closeStatus
 		result.WorkflowExecutionInfo.CloseTime = common.Int64Ptr(msBuilder.GetLastUpdatedTimestamp())
 	}
+	if len(executionInfo.Memo) > 0 {
+		result.WorkflowExecutionInfo.Memo = &workflow.Memo{Fields: executionInfo.Memo}
+	}
+	if executionInfo.CronSchedule != "" {
+		result.WorkflowExecutionInfo.CronSchedule = common.StringPtr(executionInfo.CronSchedule)
+		if msBuilder.IsWorkflowExecutionRunning() {
+			// a cron run which has not scheduled its first decision yet is waiting for the cron schedule following its
+			// start, otherwise the next run starts at the first cron schedule after the current run closes
+			after := time.Now()
+			if !msBuilder.HasProcessedOrPendingDecisionTask() && executionInfo.Attempt == 0 {
+				after = executionInfo.StartTimestamp
+			}
+			nextCronScheduleTime := common.GetNextCronScheduleTime(executionInfo.CronSchedule, after)
+			if !nextCronScheduleTime.IsZero() {
+				result.WorkflowExecutionInfo.NextCronScheduleTime = common.Int64Ptr(nextCronScheduleTime.UnixNano())
+			}
+		}
+	}
 
 	if len(msBuilder.GetPendingActivityInfos()) > 0 {
 		for _, ai := range msBuilder.GetPendingActivityInfos() {
@@ -825,15 +900,72 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(ctx context.Context,
 				p.HeartbeatDetails = ai.Details
 			}
 			p.ActivityType = ai.ScheduledEvent.ActivityTaskScheduledEventAttributes.ActivityType
-			p.LastStartedTimestamp = common.Int64Ptr(ai.StartedTime.UnixNano())
+			p.ScheduledTimestamp = common.Int64Ptr(ai.ScheduledTime.UnixNano())
+			if state != workflow.PendingActivityStateScheduled {
+				p.LastStartedTimestamp = common.Int64Ptr(ai.StartedTime.UnixNano())
+			}
 			p.Attempt = common.Int32Ptr(ai.Attempt)
+			if ai.HasRetryPolicy {
+				p.MaximumAttempts = common.Int32Ptr(ai.MaximumAttempts)
+				if !ai.ExpirationTime.IsZero() {
+					p.ExpirationTimestamp = common.Int64Ptr(ai.ExpirationTime.UnixNano())
+				}
+			}
+			if ai.LastFailureReason != "" {
+				p.LastFailureReason = common.StringPtr(ai.LastFailureReason)
+			}
+			if ai.StartedIdentity != "" {
+				p.LastWorkerIdentity = common.StringPtr(ai.StartedIdentity)
+			}
 			result.PendingActivities = append(result.PendingActivities, p)
 		}
 	}
 
+	for _, ci := range msBuilder.GetPendingChildExecutionInfos() {
+		result.PendingChildren = append(result.PendingChildren, getPendingChildExecutionInfo(ci))
+	}
+
+	if di, ok := msBuilder.GetPendingDecision(executionInfo.DecisionScheduleID); ok {
+		result.PendingDecision = getPendingDecisionInfo(di)
+	}
+
 	return result, nil
 }
 
+func getPendingChildExecutionInfo(ci *persistence.ChildExecutionInfo) *workflow.PendingChildExecutionInfo {
+	p := &workflow.PendingChildExecutionInfo{
+		InitiatedID: common.Int64Ptr(ci.InitiatedID),
+	}
+	if ci.InitiatedEvent != nil {
+		attributes := ci.InitiatedEvent.StartChildWorkflowExecutionInitiatedEventAttributes
+		p.Domain = attributes.Domain
+		p.WorkflowID = attributes.WorkflowId
+		p.WorkflowTypeName = attributes.WorkflowType.Name
+	}
+	if ci.StartedEvent != nil {
+		// the run ID is only known once the child has started
+		p.RunID = ci.StartedEvent.ChildWorkflowExecutionStartedEventAttributes.WorkflowExecution.RunId
+	}
+	return p
+}
+
+func getPendingDecisionInfo(di *decisionInfo) *workflow.PendingDecisionInfo {
+	p := &workflow.PendingDecisionInfo{
+		State:   workflow.PendingDecisionStateScheduled.Ptr(),
+		Attempt: common.Int64Ptr(di.Attempt),
+	}
+	if di.ScheduledTimestamp > 0 {
+		p.ScheduledTimestamp = common.Int64Ptr(di.ScheduledTimestamp)
+	}
+	if di.StartedID != common.EmptyEventID {
+		p.State = workflow.PendingDecisionStateStarted.Ptr()
+		if di.StartedTimestamp > 0 {
+			p.StartedTimestamp = common.Int64Ptr(di.StartedTimestamp)
+		}
+	}
+	return p
+}
+
 func (e *historyEngineImpl) RecordDecisionTaskStarted(ctx context.Context,
 	request *h.RecordDecisionTaskStartedRequest) (retResp *h.RecordDecisionTaskStartedResponse, retError error) {
 
@@ -1465,6 +1597,26 @@ Update_History_Loop:
 
 				msBuilder.AddRecordMarkerEvent(completedID, attributes)
 
+			case workflow.DecisionTypeUpsertWorkflowSearchAttributes:
+				e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope,
+					metrics.DecisionTypeUpsertWorkflowSearchAttributesCounter)
+				attributes := d.UpsertWorkflowSearchAttributesDecisionAttributes
+				if err = validateUpsertWorkflowSearchAttributes(attributes); err != nil {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCauseBadSearchAttributes
+					failMessage = err.Error()
+					break Process_Decision_Loop
+				}
+				if err = e.searchAttributesValidator.ValidateSearchAttributes(attributes.SearchAttributes, domainEntry.GetInfo().Name); err != nil {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCauseBadSearchAttributes
+					failMessage = err.Error()
+					break Process_Decision_Loop
+				}
+
+				msBuilder.AddUpsertWorkflowSearchAttributesEvent(completedID, attributes)
+				transferTasks = append(transferTasks, &persistence.UpsertWorkflowSearchAttributesTask{})
+
 			case workflow.DecisionTypeRequestCancelExternalWorkflowExecution:
 				e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope,
 					metrics.DecisionTypeCancelExternalWorkflowCounter)
@@ -1572,6 +1724,12 @@ Update_History_Loop:
 					failMessage = err.Error()
 					break Process_Decision_Loop
 				}
+				if err = e.searchAttributesValidator.ValidateSearchAttributes(attributes.SearchAttributes, domainEntry.GetInfo().Name); err != nil {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCauseBadSearchAttributes
+					failMessage = err.Error()
+					break Process_Decision_Loop
+				}
 				failWorkflow, err := sizeChecker.failWorkflowIfBlobSizeExceedsLimit(attributes.Input, "ContinueAsNewWorkflowExecutionDecisionAttributes.Input exceeds size limit.")
 				if err != nil {
 					return nil, err
@@ -1610,6 +1768,12 @@ Update_History_Loop:
 					failMessage = err.Error()
 					break Process_Decision_Loop
 				}
+				if err = e.searchAttributesValidator.ValidateSearchAttributes(attributes.SearchAttributes, domainEntry.GetInfo().Name); err != nil {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCauseBadSearchAttributes
+					failMessage = err.Error()
+					break Process_Decision_Loop
+				}
 				failWorkflow, err := sizeChecker.failWorkflowIfBlobSizeExceedsLimit(attributes.Input, "StartChildWorkflowExecutionDecisionAttributes.Input exceeds size limit.")
 				if err != nil {
 					return nil, err
@@ -1720,6 +1884,10 @@ Update_History_Loop:
 					WorkflowID: workflowExecution.GetWorkflowId(),
 					RunID:      workflowExecution.GetRunId(),
 					Bucket:     domainCfg.ArchivalBucket,
+					// the close event is already added, so the next event ID is the final one
+					EventStoreVersion: msBuilder.GetEventStoreVersion(),
+					BranchToken:       msBuilder.GetCurrentBranch(),
+					NextEventID:       msBuilder.GetNextEventID(),
 				}
 
 				// TODO: this will actually be scheduling a timer to do archival
@@ -2282,6 +2450,9 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 	}
 
 	// Start workflow and signal
+	if domainEntry.GetInfo().Status == persistence.DomainStatusDeprecated {
+		return nil, ErrDomainDeprecated
+	}
 	startRequest := getStartRequest(domainID, sRequest)
 	request := startRequest.StartRequest
 	retError = validateStartWorkflowExecutionRequest(request, e.config.MaxIDLengthLimit())
@@ -2453,6 +2624,102 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(ctx context.Context, term
 		})
 }
 
+// ResetWorkflowExecution terminates the current run of a workflow and starts a new run from a decision finish event of
+// a previous run
+func (e *historyEngineImpl) ResetWorkflowExecution(ctx context.Context,
+	resetRequest *h.ResetWorkflowExecutionRequest) (response *workflow.ResetWorkflowExecutionResponse, retError error) {
+
+	domainEntry, retError := e.getActiveDomainEntry(resetRequest.DomainUUID)
+	if retError != nil {
+		return
+	}
+	domainID := domainEntry.GetInfo().ID
+	if domainEntry.IsGlobalDomain() {
+		return nil, &workflow.BadRequestError{Message: "Reset is not supported for global domains."}
+	}
+
+	request := resetRequest.ResetRequest
+	baseExecution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+	baseContext, baseRelease, retError := e.historyCache.getOrCreateWorkflowExecutionWithTimeout(ctx, domainID, baseExecution)
+	if retError != nil {
+		return
+	}
+	defer func() { baseRelease(retError) }()
+
+	baseMutableState, retError := baseContext.loadWorkflowExecution()
+	if retError != nil {
+		return
+	}
+
+	// the run to reset from is not necessarily the current run of the workflow
+	currResp, retError := e.executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: request.WorkflowExecution.GetWorkflowId(),
+	})
+	if retError != nil {
+		return
+	}
+
+	currContext := baseContext
+	currMutableState := baseMutableState
+	if currResp.RunID != baseContext.getExecution().GetRunId() {
+		currExecution := workflow.WorkflowExecution{
+			WorkflowId: request.WorkflowExecution.WorkflowId,
+			RunId:      common.StringPtr(currResp.RunID),
+		}
+		var currRelease releaseWorkflowExecutionFunc
+		currContext, currRelease, retError = e.historyCache.getOrCreateWorkflowExecutionWithTimeout(ctx, domainID, currExecution)
+		if retError != nil {
+			return
+		}
+		defer func() { currRelease(retError) }()
+
+		currMutableState, retError = currContext.loadWorkflowExecution()
+		if retError != nil {
+			return
+		}
+	}
+
+	return e.resetor.ResetWorkflowExecution(ctx, request, baseContext, baseMutableState, currContext, currMutableState)
+}
+
+// TriggerCronWorkflowExecution schedules the first decision of a cron run which is still waiting for the backoff
+// timer of its cron schedule, so the run starts right away. The backoff timer is dropped once it fires.
+func (e *historyEngineImpl) TriggerCronWorkflowExecution(ctx context.Context, triggerRequest *h.TriggerCronWorkflowExecutionRequest) error {
+
+	domainEntry, err := e.getActiveDomainEntry(triggerRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := triggerRequest.TriggerRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecution(ctx, domainID, execution, false, true,
+		func(msBuilder mutableState, tBuilder *timerBuilder) ([]persistence.Task, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			if msBuilder.GetExecutionInfo().CronSchedule == "" {
+				return nil, ErrWorkflowNotCron
+			}
+
+			if msBuilder.HasProcessedOrPendingDecisionTask() {
+				return nil, ErrCronRunAlreadyStarted
+			}
+
+			return nil, nil
+		})
+}
+
 // ScheduleDecisionTask schedules a decision if no outstanding decision found
 func (e *historyEngineImpl) ScheduleDecisionTask(ctx context.Context, scheduleRequest *h.ScheduleDecisionTaskRequest) error {
 
@@ -2557,6 +2824,71 @@ func (e *historyEngineImpl) SyncActivity(ctx context.Context, request *h.SyncAct
 	return e.replicator.SyncActivity(ctx, request)
 }
 
+// GetReplicationMessages returns the replication tasks of the shard to a cluster pulling them
+func (e *historyEngineImpl) GetReplicationMessages(ctx context.Context,
+	request *replicator.GetReplicationMessagesRequest) (*replicator.ReplicationMessages, error) {
+	if e.replicatorProcessor == nil || !e.shard.GetService().GetClusterMetadata().IsPullReplicationEnabled() {
+		return nil, &workflow.BadRequestError{Message: "Pull replication is not enabled."}
+	}
+	return e.replicatorProcessor.getTasks(ctx, request.GetClusterName(), request.GetLastRetrievedMessageId())
+}
+
+// ReadDLQMessages returns a page of the replication tasks in the dead letter queue of the shard
+func (e *historyEngineImpl) ReadDLQMessages(ctx context.Context,
+	request *h.ReadDLQMessagesRequest) (*replicator.ReadDLQMessagesResponse, error) {
+	if err := e.validateReplicationDLQEnabled(); err != nil {
+		return nil, err
+	}
+
+	readRequest := request.Request
+	tasks, nextPageToken, err := e.replicationDLQ.readMessages(readRequest.GetSourceCluster(), request.GetDomainUUID(),
+		readRequest.GetWorkflowId(), int(readRequest.GetMaximumPageSize()), readRequest.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	return &replicator.ReadDLQMessagesResponse{
+		ReplicationTasks: tasks,
+		NextPageToken:    nextPageToken,
+	}, nil
+}
+
+// MergeDLQMessages applies a page of the replication tasks in the dead letter queue of the shard
+func (e *historyEngineImpl) MergeDLQMessages(ctx context.Context,
+	request *h.MergeDLQMessagesRequest) (*replicator.MergeDLQMessagesResponse, error) {
+	if err := e.validateReplicationDLQEnabled(); err != nil {
+		return nil, err
+	}
+
+	mergeRequest := request.Request
+	merged, nextPageToken, err := e.replicationDLQ.mergeMessages(mergeRequest.GetSourceCluster(), request.GetDomainUUID(),
+		mergeRequest.GetWorkflowId(), int(mergeRequest.GetMaximumPageSize()), mergeRequest.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	return &replicator.MergeDLQMessagesResponse{
+		MergedCount:   common.Int32Ptr(int32(merged)),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// PurgeDLQMessages removes the replication tasks in the dead letter queue of the shard
+func (e *historyEngineImpl) PurgeDLQMessages(ctx context.Context, request *h.PurgeDLQMessagesRequest) error {
+	if err := e.validateReplicationDLQEnabled(); err != nil {
+		return err
+	}
+
+	purgeRequest := request.Request
+	return e.replicationDLQ.purgeMessages(purgeRequest.GetSourceCluster(), request.GetDomainUUID(),
+		purgeRequest.GetWorkflowId())
+}
+
+func (e *historyEngineImpl) validateReplicationDLQEnabled() error {
+	if e.replicator == nil {
+		return &workflow.BadRequestError{Message: "Replication is not enabled."}
+	}
+	return nil
+}
+
 type updateWorkflowAction struct {
 	deleteWorkflow bool
 	createDecision bool
@@ -2792,6 +3124,14 @@ func (s *shardContextWrapper) CreateWorkflowExecution(request *persistence.Creat
 	return resp, err
 }
 
+func (s *shardContextWrapper) ResetWorkflowExecution(request *persistence.ResetWorkflowExecutionRequest) error {
+	err := s.ShardContext.ResetWorkflowExecution(request)
+	if err == nil {
+		s.txProcessor.NotifyNewTask(s.currentClusterName, request.TransferTasks)
+	}
+	return err
+}
+
 func (s *shardContextWrapper) NotifyNewHistoryEvent(event *historyEventNotification) error {
 	s.historyEventNotifier.NotifyNewHistoryEvent(event)
 	err := s.ShardContext.NotifyNewHistoryEvent(event)
@@ -2944,6 +3284,17 @@ func validateRecordMarkerAttributes(attributes *workflow.RecordMarkerDecisionAtt
 	return nil
 }
 
+func validateUpsertWorkflowSearchAttributes(attributes *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) error {
+	if attributes == nil {
+		return &workflow.BadRequestError{Message: "UpsertWorkflowSearchAttributesDecisionAttributes is not set on decision."}
+	}
+	if attributes.SearchAttributes == nil || len(attributes.SearchAttributes.IndexedFields) == 0 {
+		return &workflow.BadRequestError{Message: "SearchAttributes is not set on decision."}
+	}
+
+	return nil
+}
+
 func validateCompleteWorkflowExecutionAttributes(attributes *workflow.CompleteWorkflowExecutionDecisionAttributes) error {
 	if attributes == nil {
 		return &workflow.BadRequestError{Message: "CompleteWorkflowExecutionDecisionAttributes is not set on decision."}
@@ -3199,6 +3550,8 @@ func getStartRequest(domainID string,
 		WorkflowIdReusePolicy:               request.WorkflowIdReusePolicy,
 		RetryPolicy:                         request.RetryPolicy,
 		CronSchedule:                        request.CronSchedule,
+		Memo:                                request.Memo,
+		SearchAttributes:                    request.SearchAttributes,
 	}
 
 	startRequest := common.CreateHistoryStartWorkflowRequest(domainID, req)
@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -48,6 +49,7 @@ import (
 	"github.com/uber/cadence/common/messaging"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/validator"
 )
 
 type (
@@ -167,6 +169,13 @@ func (s *engineSuite) SetupTest() {
 		config:               NewDynamicConfigForTest(),
 		archivalClient:       s.mockArchivalClient,
 	}
+	h.searchAttributesValidator = validator.NewSearchAttributesValidator(
+		s.logger,
+		h.config.ValidSearchAttributes,
+		h.config.SearchAttributesNumberOfKeysLimit,
+		h.config.SearchAttributesSizeOfValueLimit,
+		h.config.SearchAttributesTotalSizeLimit,
+	)
 	h.txProcessor = newTransferQueueProcessor(shardContextWrapper, h, s.mockVisibilityMgr, s.mockProducer, s.mockMatchingClient, s.mockHistoryClient, s.logger)
 	h.timerProcessor = newTimerQueueProcessor(shardContextWrapper, h, s.mockMatchingClient, s.logger)
 	h.historyEventNotifier.Start()
@@ -3553,6 +3562,98 @@ func (s *engineSuite) TestRespondActivityTaskCanceledIfNoAidFound() {
 	s.EqualError(err, "BadRequestError{Message: No such activityID: aid\n}")
 }
 
+func (s *engineSuite) TestDescribeWorkflowExecution_PendingInfo() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, *decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, ai := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, "activity1_id",
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+	addActivityTaskStartedEvent(msBuilder, *activityScheduledEvent.EventId, tl, "activityWorker")
+	ai.HasRetryPolicy = true
+	ai.MaximumAttempts = 3
+	ai.LastFailureReason = "test-reason"
+	childInitiatedEvent, _ := addStartChildWorkflowExecutionInitiatedEvent(msBuilder, *decisionCompletedEvent.EventId,
+		uuid.New(), "child-domain", "child-workflow-id", "child-workflow-type", tl, nil, 100, 10)
+	addChildWorkflowExecutionStartedEvent(msBuilder, *childInitiatedEvent.EventId, "child-domain", "child-workflow-id",
+		"child-run-id", "child-workflow-type")
+	di = addDecisionTaskScheduledEvent(msBuilder)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	resp, err := s.mockHistoryEngine.DescribeWorkflowExecution(context.Background(), &history.DescribeWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request: &workflow.DescribeWorkflowExecutionRequest{
+			Execution: &we,
+		},
+	})
+	s.Nil(err)
+
+	s.Equal(1, len(resp.PendingActivities))
+	pa := resp.PendingActivities[0]
+	s.Equal(workflow.PendingActivityStateStarted, pa.GetState())
+	s.Equal(int32(3), pa.GetMaximumAttempts())
+	s.Equal("test-reason", pa.GetLastFailureReason())
+	s.Equal("activityWorker", pa.GetLastWorkerIdentity())
+	s.True(pa.GetScheduledTimestamp() > 0)
+	s.Nil(pa.ExpirationTimestamp)
+
+	s.Equal(1, len(resp.PendingChildren))
+	pc := resp.PendingChildren[0]
+	s.Equal("child-domain", pc.GetDomain())
+	s.Equal("child-workflow-id", pc.GetWorkflowID())
+	s.Equal("child-run-id", pc.GetRunID())
+	s.Equal("child-workflow-type", pc.GetWorkflowTypeName())
+	s.Equal(*childInitiatedEvent.EventId, pc.GetInitiatedID())
+
+	s.NotNil(resp.PendingDecision)
+	s.Equal(workflow.PendingDecisionStateScheduled, resp.PendingDecision.GetState())
+	s.Equal(di.Attempt, resp.PendingDecision.GetAttempt())
+	s.True(resp.PendingDecision.GetScheduledTimestamp() > 0)
+	s.Nil(resp.PendingDecision.StartedTimestamp)
+}
+
+func (s *engineSuite) TestDescribeWorkflowExecution_Cron() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	cronSchedule := "CRON_TZ=America/New_York 0 10 * * *"
+	startTime, _ := time.Parse(time.RFC3339, "2018-12-17T08:08:00+00:00")
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 100, "testIdentity")
+	msBuilder.GetExecutionInfo().CronSchedule = cronSchedule
+	msBuilder.GetExecutionInfo().StartTimestamp = startTime
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	resp, err := s.mockHistoryEngine.DescribeWorkflowExecution(context.Background(), &history.DescribeWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request: &workflow.DescribeWorkflowExecutionRequest{
+			Execution: &we,
+		},
+	})
+	s.Nil(err)
+	s.Equal(cronSchedule, resp.WorkflowExecutionInfo.GetCronSchedule())
+	// the run is still waiting for its first decision, so it starts at the cron schedule following its start
+	s.Equal(startTime.Add(time.Hour*6+time.Minute*52).UnixNano(), resp.WorkflowExecutionInfo.GetNextCronScheduleTime())
+}
+
 func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_NotScheduled() {
 	domainID := validDomainID
 	we := workflow.WorkflowExecution{
@@ -3952,6 +4053,145 @@ func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_Success() {
 	s.True(executionBuilder.HasPendingDecisionTask())
 }
 
+func (s *engineSuite) TestRespondDecisionTaskCompletedUpsertWorkflowSearchAttributes() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: *we.WorkflowId,
+		RunID:      *we.RunId,
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	searchAttributes := map[string][]byte{definition.CustomKeywordField: []byte(`"keyword"`)}
+	decisions := []*workflow.Decision{{
+		DecisionType: common.DecisionTypePtr(workflow.DecisionTypeUpsertWorkflowSearchAttributes),
+		UpsertWorkflowSearchAttributesDecisionAttributes: &workflow.UpsertWorkflowSearchAttributesDecisionAttributes{
+			SearchAttributes: &workflow.SearchAttributes{IndexedFields: searchAttributes},
+		},
+	}}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(&p.AppendHistoryEventsResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		for _, task := range request.TransferTasks {
+			if task.GetType() == p.TransferTaskTypeUpsertWorkflowSearchAttributes {
+				return true
+			}
+		}
+		return false
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(context.Background(), &history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:        taskToken,
+			Decisions:        decisions,
+			ExecutionContext: []byte("context"),
+			Identity:         &identity,
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
+	s.Equal(searchAttributes, executionBuilder.GetExecutionInfo().SearchAttributes)
+	s.False(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engineSuite) TestRespondDecisionTaskCompletedUpsertWorkflowSearchAttributes_InvalidKey() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: *we.WorkflowId,
+		RunID:      *we.RunId,
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	decisions := []*workflow.Decision{{
+		DecisionType: common.DecisionTypePtr(workflow.DecisionTypeUpsertWorkflowSearchAttributes),
+		UpsertWorkflowSearchAttributesDecisionAttributes: &workflow.UpsertWorkflowSearchAttributesDecisionAttributes{
+			SearchAttributes: &workflow.SearchAttributes{
+				IndexedFields: map[string][]byte{"UnknownKey": []byte(`"keyword"`)},
+			},
+		},
+	}}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Twice()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(&p.AppendHistoryEventsResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(context.Background(), &history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:        taskToken,
+			Decisions:        decisions,
+			ExecutionContext: []byte("context"),
+			Identity:         &identity,
+		},
+	})
+	s.Nil(err)
+
+	// the decision is failed and a new decision is scheduled
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
+	s.Empty(executionBuilder.GetExecutionInfo().SearchAttributes)
+	s.True(executionBuilder.HasPendingDecisionTask())
+}
+
 func (s *engineSuite) TestStarTimer_DuplicateTimerID() {
 	domainID := validDomainID
 	we := workflow.WorkflowExecution{
@@ -4251,6 +4491,99 @@ func (s *engineSuite) TestSignalWorkflowExecution() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestTriggerCronWorkflowExecution() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	triggerRequest := &history.TriggerCronWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		TriggerRequest: &workflow.TriggerCronWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr("testIdentity"),
+		},
+	}
+
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 100, "testIdentity")
+	msBuilder.GetExecutionInfo().CronSchedule = "@every 1h"
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(&p.AppendHistoryEventsResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return len(request.TransferTasks) == 1 && request.TransferTasks[0].GetType() == persistence.TransferTaskTypeDecisionTask
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	err := s.mockHistoryEngine.TriggerCronWorkflowExecution(context.Background(), triggerRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestTriggerCronWorkflowExecution_NotWaiting() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	triggerRequest := &history.TriggerCronWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		TriggerRequest: &workflow.TriggerCronWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr("testIdentity"),
+		},
+	}
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	// not a cron workflow
+	msBuilder := newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 100, "testIdentity")
+	ms := createMutableState(msBuilder)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	err := s.mockHistoryEngine.TriggerCronWorkflowExecution(context.Background(), triggerRequest)
+	s.Equal(ErrWorkflowNotCron, err)
+
+	// the cron run already has its decision scheduled
+	msBuilder = newMutableStateBuilder(s.mockClusterMetadata.GetCurrentClusterName(), s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 100, "testIdentity")
+	addDecisionTaskScheduledEvent(msBuilder)
+	msBuilder.GetExecutionInfo().CronSchedule = "@every 1h"
+	ms = createMutableState(msBuilder)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	err = s.mockHistoryEngine.TriggerCronWorkflowExecution(context.Background(), triggerRequest)
+	s.Equal(ErrCronRunAlreadyStarted, err)
+}
+
 // Test signal decision by adding request ID
 func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest() {
 	signalRequest := &history.SignalWorkflowExecutionRequest{}
@@ -4736,6 +5069,7 @@ func copyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		CloseStatus:                  sourceInfo.CloseStatus,
 		NextEventID:                  sourceInfo.NextEventID,
 		LastProcessedEvent:           sourceInfo.LastProcessedEvent,
+		StartTimestamp:               sourceInfo.StartTimestamp,
 		LastUpdatedTimestamp:         sourceInfo.LastUpdatedTimestamp,
 		CreateRequestID:              sourceInfo.CreateRequestID,
 		DecisionVersion:              sourceInfo.DecisionVersion,
@@ -4743,8 +5077,13 @@ func copyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		DecisionStartedID:            sourceInfo.DecisionStartedID,
 		DecisionRequestID:            sourceInfo.DecisionRequestID,
 		DecisionTimeout:              sourceInfo.DecisionTimeout,
+		DecisionScheduledTimestamp:   sourceInfo.DecisionScheduledTimestamp,
+		DecisionStartedTimestamp:     sourceInfo.DecisionStartedTimestamp,
 		EventStoreVersion:            sourceInfo.EventStoreVersion,
 		BranchToken:                  sourceInfo.BranchToken,
+		CronSchedule:                 sourceInfo.CronSchedule,
+		Memo:                         sourceInfo.Memo,
+		SearchAttributes:             sourceInfo.SearchAttributes,
 	}
 }
 
@@ -4777,6 +5116,7 @@ func copyActivityInfo(sourceInfo *persistence.ActivityInfo) *persistence.Activit
 		MaximumInterval:          sourceInfo.MaximumInterval,
 		ExpirationTime:           sourceInfo.ExpirationTime,
 		MaximumAttempts:          sourceInfo.MaximumAttempts,
+		LastFailureReason:        sourceInfo.LastFailureReason,
 	}
 }
 
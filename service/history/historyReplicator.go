@@ -787,6 +787,7 @@ func (r *historyReplicator) replicateWorkflowStarted(ctx context.Context, contex
 			BranchToken:   msBuilder.GetCurrentBranch(),
 			Events:        history.Events,
 			TransactionID: transactionID,
+			Info:          persistence.BuildHistoryGarbageCleanupInfo(domainID, executionInfo.WorkflowID, executionInfo.RunID),
 		}, msBuilder.GetExecutionInfo().DomainID)
 	} else {
 		historySize, err = r.shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
@@ -815,11 +816,13 @@ func (r *historyReplicator) replicateWorkflowStarted(ctx context.Context, contex
 	decisionScheduleID := common.EmptyEventID
 	decisionStartID := common.EmptyEventID
 	decisionTimeout := int32(0)
+	decisionScheduledTimestamp := int64(0)
 	if di != nil {
 		decisionVersionID = di.Version
 		decisionScheduleID = di.ScheduleID
 		decisionStartID = di.StartedID
 		decisionTimeout = di.DecisionTimeout
+		decisionScheduledTimestamp = di.ScheduledTimestamp
 	}
 	transferTasks := sBuilder.getTransferTasks()
 	timerTasks := sBuilder.getTimerTasks()
@@ -852,12 +855,15 @@ func (r *historyReplicator) replicateWorkflowStarted(ctx context.Context, contex
 			DecisionScheduleID:          decisionScheduleID,
 			DecisionStartedID:           decisionStartID,
 			DecisionStartToCloseTimeout: decisionTimeout,
+			DecisionScheduledTimestamp:  decisionScheduledTimestamp,
 			TimerTasks:                  timerTasks,
 			PreviousRunID:               prevRunID,
 			PreviousLastWriteVersion:    prevLastWriteVersion,
 			ReplicationState:            replicationState,
 			EventStoreVersion:           msBuilder.GetEventStoreVersion(),
 			BranchToken:                 msBuilder.GetCurrentBranch(),
+			Memo:                        executionInfo.Memo,
+			SearchAttributes:            executionInfo.SearchAttributes,
 		}
 		createRequest.CreateWorkflowMode = persistence.CreateWorkflowModeBrandNew
 		if !isBrandNew {
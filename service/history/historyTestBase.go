@@ -222,6 +222,30 @@ func (s *TestShardContext) UpdateReplicatorAckLevel(ackLevel int64) error {
 	return nil
 }
 
+// GetReplicatorClusterAckLevels test implementation
+func (s *TestShardContext) GetReplicatorClusterAckLevels() map[string]int64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	ackLevels := make(map[string]int64, len(s.shardInfo.ClusterReplicationLevel))
+	for k, v := range s.shardInfo.ClusterReplicationLevel {
+		ackLevels[k] = v
+	}
+	return ackLevels
+}
+
+// UpdateReplicatorClusterAckLevel test implementation
+func (s *TestShardContext) UpdateReplicatorClusterAckLevel(cluster string, ackLevel int64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.shardInfo.ClusterReplicationLevel == nil {
+		s.shardInfo.ClusterReplicationLevel = make(map[string]int64)
+	}
+	s.shardInfo.ClusterReplicationLevel[cluster] = ackLevel
+	return nil
+}
+
 // GetTimerAckLevel test implementation
 func (s *TestShardContext) GetTimerAckLevel() time.Time {
 	s.RLock()
@@ -400,6 +424,11 @@ func (s *TestShardContext) ResetMutableState(request *persistence.ResetMutableSt
 	return s.executionMgr.ResetMutableState(request)
 }
 
+// ResetWorkflowExecution test implementation
+func (s *TestShardContext) ResetWorkflowExecution(request *persistence.ResetWorkflowExecutionRequest) error {
+	return s.executionMgr.ResetWorkflowExecution(request)
+}
+
 // AppendHistoryEvents test implementation
 func (s *TestShardContext) AppendHistoryEvents(request *persistence.AppendHistoryEventsRequest) (int, error) {
 	resp, err := s.historyMgr.AppendHistoryEvents(request)
@@ -40,6 +40,9 @@ type (
 		TaskList        string // This is only needed to communicate tasklist used after AddDecisionTaskScheduledEvent
 		Attempt         int64
 		Timestamp       int64
+		// ScheduledTimestamp and StartedTimestamp are only used to describe the pending decision
+		ScheduledTimestamp int64
+		StartedTimestamp   int64
 	}
 
 	mutableState interface {
@@ -61,6 +64,7 @@ type (
 		AddContinueAsNewEvent(int64, *cache.DomainCacheEntry, string, *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes, int32) (*workflow.HistoryEvent, mutableState, error)
 		AddDecisionTaskCompletedEvent(int64, int64, *workflow.RespondDecisionTaskCompletedRequest) *workflow.HistoryEvent
 		AddDecisionTaskFailedEvent(int64, int64, workflow.DecisionTaskFailedCause, []uint8, string) *workflow.HistoryEvent
+		AddDecisionTaskResetFailedEvent(int64, int64, string, string, string, string) *workflow.HistoryEvent
 		AddDecisionTaskScheduleToStartTimeoutEvent(int64) *workflow.HistoryEvent
 		AddDecisionTaskScheduledEvent() *decisionInfo
 		AddDecisionTaskStartedEvent(int64, string, *workflow.PollForDecisionTaskRequest) (*workflow.HistoryEvent, *decisionInfo)
@@ -81,6 +85,7 @@ type (
 		AddTimerCanceledEvent(int64, *workflow.CancelTimerDecisionAttributes, string) *workflow.HistoryEvent
 		AddTimerFiredEvent(int64, string) *workflow.HistoryEvent
 		AddTimerStartedEvent(int64, *workflow.StartTimerDecisionAttributes) (*workflow.HistoryEvent, *persistence.TimerInfo)
+		AddUpsertWorkflowSearchAttributesEvent(int64, *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) *workflow.HistoryEvent
 		AddWorkflowExecutionCancelRequestedEvent(string, *h.RequestCancelWorkflowExecutionRequest) *workflow.HistoryEvent
 		AddWorkflowExecutionCanceledEvent(int64, *workflow.CancelWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent
 		AddWorkflowExecutionSignaled(signalName string, input []byte, identity string) *workflow.HistoryEvent
@@ -147,6 +152,7 @@ type (
 		HasInFlightDecisionTask() bool
 		HasParentExecution() bool
 		HasPendingDecisionTask() bool
+		HasProcessedOrPendingDecisionTask() bool
 		IncrementHistorySize(int)
 		IsCancelRequested() (bool, string)
 		IsSignalRequested(requestID string) bool
@@ -184,6 +190,7 @@ type (
 		ReplicateTimerFiredEvent(*workflow.HistoryEvent)
 		ReplicateTimerStartedEvent(*workflow.HistoryEvent) *persistence.TimerInfo
 		ReplicateTransientDecisionTaskScheduled() *decisionInfo
+		ReplicateUpsertWorkflowSearchAttributesEvent(*workflow.HistoryEvent)
 		ReplicateWorkflowExecutionCancelRequestedEvent(*workflow.HistoryEvent)
 		ReplicateWorkflowExecutionCanceledEvent(*workflow.HistoryEvent)
 		ReplicateWorkflowExecutionCompletedEvent(*workflow.HistoryEvent)
@@ -718,6 +718,7 @@ func (e *mutableStateBuilder) shouldBufferEvent(eventType workflow.EventType) bo
 		workflow.EventTypeCancelTimerFailed,
 		workflow.EventTypeRequestCancelExternalWorkflowExecutionInitiated,
 		workflow.EventTypeMarkerRecorded,
+		workflow.EventTypeUpsertWorkflowSearchAttributes,
 		workflow.EventTypeStartChildWorkflowExecutionInitiated,
 		workflow.EventTypeSignalExternalWorkflowExecutionInitiated:
 		// do not buffer event if event is directly generated from a corresponding decision
@@ -975,13 +976,15 @@ func (e *mutableStateBuilder) DeleteUserTimer(timerID string) {
 
 func (e *mutableStateBuilder) getDecisionInfo() *decisionInfo {
 	return &decisionInfo{
-		Version:         e.executionInfo.DecisionVersion,
-		ScheduleID:      e.executionInfo.DecisionScheduleID,
-		StartedID:       e.executionInfo.DecisionStartedID,
-		RequestID:       e.executionInfo.DecisionRequestID,
-		DecisionTimeout: e.executionInfo.DecisionTimeout,
-		Attempt:         e.executionInfo.DecisionAttempt,
-		Timestamp:       e.executionInfo.DecisionTimestamp,
+		Version:            e.executionInfo.DecisionVersion,
+		ScheduleID:         e.executionInfo.DecisionScheduleID,
+		StartedID:          e.executionInfo.DecisionStartedID,
+		RequestID:          e.executionInfo.DecisionRequestID,
+		DecisionTimeout:    e.executionInfo.DecisionTimeout,
+		Attempt:            e.executionInfo.DecisionAttempt,
+		Timestamp:          e.executionInfo.DecisionTimestamp,
+		ScheduledTimestamp: e.executionInfo.DecisionScheduledTimestamp,
+		StartedTimestamp:   e.executionInfo.DecisionStartedTimestamp,
 	}
 }
 
@@ -1010,6 +1013,12 @@ func (e *mutableStateBuilder) HasPendingDecisionTask() bool {
 	return e.executionInfo.DecisionScheduleID != common.EmptyEventID
 }
 
+// HasProcessedOrPendingDecisionTask returns false only while a run is still waiting for its first decision to be
+// scheduled, e.g. because of the backoff before a retry or the next cron run
+func (e *mutableStateBuilder) HasProcessedOrPendingDecisionTask() bool {
+	return e.HasPendingDecisionTask() || e.GetPreviousStartedEventID() != common.EmptyEventID
+}
+
 func (e *mutableStateBuilder) HasInFlightDecisionTask() bool {
 	return e.executionInfo.DecisionStartedID > 0
 }
@@ -1055,6 +1064,8 @@ func (e *mutableStateBuilder) UpdateDecision(di *decisionInfo) {
 	e.executionInfo.DecisionTimeout = di.DecisionTimeout
 	e.executionInfo.DecisionAttempt = di.Attempt
 	e.executionInfo.DecisionTimestamp = di.Timestamp
+	e.executionInfo.DecisionScheduledTimestamp = di.ScheduledTimestamp
+	e.executionInfo.DecisionStartedTimestamp = di.StartedTimestamp
 
 	e.logger.Debugf("Decision Updated: {Schedule: %v, Started: %v, ID: %v, Timeout: %v, Attempt: %v, Timestamp: %v}",
 		di.ScheduleID, di.StartedID, di.RequestID, di.DecisionTimeout, di.Attempt, di.Timestamp)
@@ -1173,6 +1184,16 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(d
 		decisionTimeout = attributes.GetTaskStartToCloseTimeoutSeconds()
 	}
 
+	// the new run inherits the memo and the search attributes unless the decision overrides them
+	memo := attributes.Memo
+	if memo == nil && len(previousExecutionInfo.Memo) > 0 {
+		memo = &workflow.Memo{Fields: previousExecutionInfo.Memo}
+	}
+	searchAttributes := attributes.SearchAttributes
+	if searchAttributes == nil && len(previousExecutionInfo.SearchAttributes) > 0 {
+		searchAttributes = &workflow.SearchAttributes{IndexedFields: previousExecutionInfo.SearchAttributes}
+	}
+
 	createRequest := &workflow.StartWorkflowExecutionRequest{
 		RequestId:                           common.StringPtr(uuid.New()),
 		Domain:                              common.StringPtr(domainID),
@@ -1183,6 +1204,8 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(d
 		ExecutionStartToCloseTimeoutSeconds: attributes.ExecutionStartToCloseTimeoutSeconds,
 		Input:                               attributes.Input,
 		RetryPolicy:                         attributes.RetryPolicy,
+		Memo:                                memo,
+		SearchAttributes:                    searchAttributes,
 	}
 
 	req := &h.StartWorkflowExecutionRequest{
@@ -1276,6 +1299,12 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(domainID st
 	if event.ParentInitiatedEventId != nil {
 		e.executionInfo.InitiatedID = event.GetParentInitiatedEventId()
 	}
+	if event.Memo != nil {
+		e.executionInfo.Memo = event.Memo.Fields
+	}
+	if event.SearchAttributes != nil {
+		e.executionInfo.SearchAttributes = event.SearchAttributes.IndexedFields
+	}
 }
 
 func (e *mutableStateBuilder) AddDecisionTaskScheduledEvent() *decisionInfo {
@@ -1337,13 +1366,14 @@ func (e *mutableStateBuilder) ReplicateTransientDecisionTaskScheduled() *decisio
 	// then ReplicateDecisionTaskScheduledEvent will overwrite evenything
 	// including the decision schedule ID
 	di := &decisionInfo{
-		Version:         e.GetCurrentVersion(),
-		ScheduleID:      e.GetNextEventID(),
-		StartedID:       common.EmptyEventID,
-		RequestID:       emptyUUID,
-		DecisionTimeout: e.GetExecutionInfo().DecisionTimeoutValue,
-		TaskList:        e.GetExecutionInfo().TaskList,
-		Attempt:         e.GetExecutionInfo().DecisionAttempt,
+		Version:            e.GetCurrentVersion(),
+		ScheduleID:         e.GetNextEventID(),
+		StartedID:          common.EmptyEventID,
+		RequestID:          emptyUUID,
+		DecisionTimeout:    e.GetExecutionInfo().DecisionTimeoutValue,
+		TaskList:           e.GetExecutionInfo().TaskList,
+		Attempt:            e.GetExecutionInfo().DecisionAttempt,
+		ScheduledTimestamp: time.Now().UnixNano(),
 	}
 
 	e.UpdateDecision(di)
@@ -1353,13 +1383,14 @@ func (e *mutableStateBuilder) ReplicateTransientDecisionTaskScheduled() *decisio
 func (e *mutableStateBuilder) ReplicateDecisionTaskScheduledEvent(version, scheduleID int64, taskList string,
 	startToCloseTimeoutSeconds int32, attempt int64) *decisionInfo {
 	di := &decisionInfo{
-		Version:         version,
-		ScheduleID:      scheduleID,
-		StartedID:       common.EmptyEventID,
-		RequestID:       emptyUUID,
-		DecisionTimeout: startToCloseTimeoutSeconds,
-		TaskList:        taskList,
-		Attempt:         attempt,
+		Version:            version,
+		ScheduleID:         scheduleID,
+		StartedID:          common.EmptyEventID,
+		RequestID:          emptyUUID,
+		DecisionTimeout:    startToCloseTimeoutSeconds,
+		TaskList:           taskList,
+		Attempt:            attempt,
+		ScheduledTimestamp: time.Now().UnixNano(),
 	}
 
 	e.UpdateDecision(di)
@@ -1412,13 +1443,15 @@ func (e *mutableStateBuilder) ReplicateDecisionTaskStartedEvent(di *decisionInfo
 	e.executionInfo.State = persistence.WorkflowStateRunning
 	// Update mutable decision state
 	di = &decisionInfo{
-		Version:         version,
-		ScheduleID:      scheduleID,
-		StartedID:       startedID,
-		RequestID:       requestID,
-		DecisionTimeout: di.DecisionTimeout,
-		Attempt:         di.Attempt,
-		Timestamp:       timestamp,
+		Version:            version,
+		ScheduleID:         scheduleID,
+		StartedID:          startedID,
+		RequestID:          requestID,
+		DecisionTimeout:    di.DecisionTimeout,
+		Attempt:            di.Attempt,
+		Timestamp:          timestamp,
+		ScheduledTimestamp: di.ScheduledTimestamp,
+		StartedTimestamp:   time.Now().UnixNano(),
 	}
 
 	e.UpdateDecision(di)
@@ -1550,6 +1583,23 @@ func (e *mutableStateBuilder) ReplicateDecisionTaskFailedEvent() {
 	e.FailDecision(true)
 }
 
+// AddDecisionTaskResetFailedEvent fails the started decision a workflow is reset to. The event is always written to
+// history and the decision attempt is not incremented, so the next decision is a regular one.
+func (e *mutableStateBuilder) AddDecisionTaskResetFailedEvent(scheduleEventID int64, startedEventID int64,
+	reason, identity, baseRunID, newRunID string) *workflow.HistoryEvent {
+	dt, ok := e.GetPendingDecision(scheduleEventID)
+	if !ok || dt.StartedID != startedEventID {
+		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionDecisionTaskFailed, e.GetNextEventID(), fmt.Sprintf(
+			"{ScheduleID: %v, StartedID: %v, Exist: %v}", scheduleEventID, startedEventID, ok))
+		return nil
+	}
+
+	event := e.hBuilder.AddDecisionTaskResetFailedEvent(scheduleEventID, startedEventID, reason, identity, baseRunID,
+		newRunID)
+	e.FailDecision(false)
+	return event
+}
+
 func (e *mutableStateBuilder) AddActivityTaskScheduledEvent(decisionCompletedEventID int64,
 	attributes *workflow.ScheduleActivityTaskDecisionAttributes) (*workflow.HistoryEvent, *persistence.ActivityInfo) {
 	if ai, ok := e.GetActivityInfo(e.GetNextEventID()); ok {
@@ -2148,6 +2198,33 @@ func (e *mutableStateBuilder) AddRecordMarkerEvent(decisionCompletedEventID int6
 	return e.hBuilder.AddMarkerRecordedEvent(decisionCompletedEventID, attributes)
 }
 
+func (e *mutableStateBuilder) AddUpsertWorkflowSearchAttributesEvent(decisionCompletedEventID int64,
+	attributes *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) *workflow.HistoryEvent {
+
+	event := e.hBuilder.AddUpsertWorkflowSearchAttributesEvent(decisionCompletedEventID, attributes)
+	e.ReplicateUpsertWorkflowSearchAttributesEvent(event)
+	return event
+}
+
+// ReplicateUpsertWorkflowSearchAttributesEvent merges the search attributes of the event into the
+// search attributes of the execution, existing keys are overwritten
+func (e *mutableStateBuilder) ReplicateUpsertWorkflowSearchAttributesEvent(event *workflow.HistoryEvent) {
+	attributes := event.UpsertWorkflowSearchAttributesEventAttributes
+	if attributes.SearchAttributes == nil || len(attributes.SearchAttributes.IndexedFields) == 0 {
+		return
+	}
+	upsertSearchAttributes := attributes.SearchAttributes.IndexedFields
+
+	searchAttributes := make(map[string][]byte, len(e.executionInfo.SearchAttributes)+len(upsertSearchAttributes))
+	for key, value := range e.executionInfo.SearchAttributes {
+		searchAttributes[key] = value
+	}
+	for key, value := range upsertSearchAttributes {
+		searchAttributes[key] = value
+	}
+	e.executionInfo.SearchAttributes = searchAttributes
+}
+
 func (e *mutableStateBuilder) AddWorkflowExecutionTerminatedEvent(
 	request *workflow.TerminateWorkflowExecutionRequest) *workflow.HistoryEvent {
 	if e.executionInfo.State == persistence.WorkflowStateCompleted {
@@ -2306,6 +2383,8 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionContinuedAsNewEvent(sour
 		BranchToken:          newStateBuilder.GetCurrentBranch(),
 		CronSchedule:         e.executionInfo.CronSchedule,
 		ExpirationSeconds:    e.executionInfo.ExpirationSeconds,
+		Memo:                 newExecutionInfo.Memo,
+		SearchAttributes:     newExecutionInfo.SearchAttributes,
 	}
 	if continueAsNewAttributes.GetInitiator() == workflow.ContinueAsNewInitiatorRetryPolicy {
 		// retry
@@ -2342,6 +2421,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionContinuedAsNewEvent(sour
 		continueAsNew.DecisionScheduleID = di.ScheduleID
 		continueAsNew.DecisionStartedID = di.StartedID
 		continueAsNew.DecisionStartToCloseTimeout = di.DecisionTimeout
+		continueAsNew.DecisionScheduledTimestamp = di.ScheduledTimestamp
 
 		if newStateBuilder.GetReplicationState() != nil {
 			newStateBuilder.UpdateReplicationStateLastEventID(sourceClusterName, startedEvent.GetVersion(), di.ScheduleID)
@@ -0,0 +1,297 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// replicationDLQHandler manages the replication dead letter queue of a shard, which holds the replication tasks
+	// from the other clusters that still failed to be applied after all retries
+	replicationDLQHandler struct {
+		currentCluster   string
+		shard            ShardContext
+		historyEngine    Engine
+		executionManager persistence.ExecutionManager
+		logger           bark.Logger
+		// metricsClients holds the metrics client tagged with the shard and the source cluster of each remote cluster
+		metricsClients map[string]metrics.Client
+
+		status     int32
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+
+		sync.Mutex
+		// executors are created on first merge of the tasks of each source cluster
+		executors map[string]*replicationTaskExecutor
+	}
+)
+
+const (
+	replicationDLQPurgeBatchSize     = 100
+	replicationDLQSizeReportInterval = time.Minute
+)
+
+func newReplicationDLQHandler(shard ShardContext, historyEngine Engine, logger bark.Logger) *replicationDLQHandler {
+	currentCluster := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
+	metricsClients := make(map[string]metrics.Client)
+	for clusterName := range shard.GetService().GetClusterMetadata().GetAllClusterFailoverVersions() {
+		if clusterName != currentCluster {
+			metricsClients[clusterName] = shard.GetMetricsClient().Tagged(map[string]string{
+				metrics.ShardTagName:         strconv.Itoa(shard.GetShardID()),
+				metrics.SourceClusterTagName: clusterName,
+			})
+		}
+	}
+
+	return &replicationDLQHandler{
+		currentCluster:   currentCluster,
+		shard:            shard,
+		historyEngine:    historyEngine,
+		executionManager: shard.GetExecutionManager(),
+		logger:           logger.WithField(logging.TagWorkflowComponent, logging.TagValueReplicationDLQComponent),
+		metricsClients:   metricsClients,
+		status:           common.DaemonStatusInitialized,
+		shutdownCh:       make(chan struct{}),
+		executors:        make(map[string]*replicationTaskExecutor),
+	}
+}
+
+func (d *replicationDLQHandler) Start() {
+	if !atomic.CompareAndSwapInt32(&d.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	d.shutdownWG.Add(1)
+	go d.reportSizeLoop()
+}
+
+func (d *replicationDLQHandler) Stop() {
+	if !atomic.CompareAndSwapInt32(&d.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(d.shutdownCh)
+	if success := common.AwaitWaitGroup(&d.shutdownWG, time.Minute); !success {
+		d.logger.Warn("Replication DLQ handler timed out on shutdown.")
+	}
+}
+
+// putTask adds a replication task from the source cluster to the dead letter queue, the task is keyed by its ID in
+// the replication queue of the source shard so putting the same task again overwrites it
+func (d *replicationDLQHandler) putTask(sourceCluster string, task *replicator.ReplicationTask) error {
+	if task.SourceTaskId == nil {
+		return &shared.BadRequestError{Message: "Replication task has no source task ID."}
+	}
+	taskInfo, err := persistence.NewReplicationDLQTaskInfo(task.GetSourceTaskId(), task)
+	if err != nil {
+		return err
+	}
+	if err := d.executionManager.PutReplicationTaskToDLQ(&persistence.PutReplicationTaskToDLQRequest{
+		SourceClusterName: sourceCluster,
+		TaskInfo:          taskInfo,
+	}); err != nil {
+		return err
+	}
+
+	d.getMetricsClient(sourceCluster).IncCounter(metrics.ReplicationDLQScope, metrics.ReplicationDLQEnqueuedCounter)
+	return nil
+}
+
+// readMessages returns a page of the tasks in the dead letter queue of the source cluster, the tasks are filtered by
+// domain and workflow after being read so a page can hold less tasks than the page size even when there are more
+func (d *replicationDLQHandler) readMessages(sourceCluster, domainID, workflowID string, pageSize int,
+	pageToken []byte) ([]*replicator.ReplicationDLQTask, []byte, error) {
+
+	if err := d.validateSourceCluster(sourceCluster); err != nil {
+		return nil, nil, err
+	}
+	if pageSize <= 0 {
+		return nil, nil, &shared.BadRequestError{Message: "Page size must be greater than 0."}
+	}
+
+	response, err := d.executionManager.GetReplicationTasksFromDLQ(&persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: sourceCluster,
+		BatchSize:         pageSize,
+		NextPageToken:     pageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tasks []*replicator.ReplicationDLQTask
+	for _, taskInfo := range response.Tasks {
+		if (domainID != "" && taskInfo.DomainID != domainID) || (workflowID != "" && taskInfo.WorkflowID != workflowID) {
+			continue
+		}
+		task, err := persistence.DecodeReplicationDLQTask(taskInfo)
+		if err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, &replicator.ReplicationDLQTask{
+			TaskId: common.Int64Ptr(taskInfo.TaskID),
+			Task:   task,
+		})
+	}
+	return tasks, response.NextPageToken, nil
+}
+
+// mergeMessages applies a page of the tasks in the dead letter queue of the source cluster and deletes the applied
+// ones, it stops at the first task which still fails so the remaining tasks stay in the queue
+func (d *replicationDLQHandler) mergeMessages(sourceCluster, domainID, workflowID string, pageSize int,
+	pageToken []byte) (int, []byte, error) {
+
+	tasks, nextPageToken, err := d.readMessages(sourceCluster, domainID, workflowID, pageSize, pageToken)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	executor := d.getExecutor(sourceCluster)
+	merged := 0
+	for _, task := range tasks {
+		err := executor.execute(task.Task, false)
+		if _, ok := err.(*shared.RetryTaskError); ok {
+			// the missing history has been resent, retry with buffering enabled
+			err = executor.execute(task.Task, true)
+		}
+		if err != nil {
+			d.logger.WithFields(bark.Fields{
+				logging.TagSourceCluster: sourceCluster,
+				logging.TagTaskID:        task.GetTaskId(),
+				logging.TagErr:           err,
+			}).Warn("Unable to merge replication task from DLQ.")
+			return merged, nil, err
+		}
+
+		if err := d.executionManager.DeleteReplicationTaskFromDLQ(&persistence.DeleteReplicationTaskFromDLQRequest{
+			SourceClusterName: sourceCluster,
+			TaskID:            task.GetTaskId(),
+		}); err != nil {
+			return merged, nil, err
+		}
+		merged++
+	}
+	return merged, nextPageToken, nil
+}
+
+// purgeMessages deletes all the tasks in the dead letter queue of the source cluster matching the domain and workflow
+func (d *replicationDLQHandler) purgeMessages(sourceCluster, domainID, workflowID string) error {
+	var pageToken []byte
+	for {
+		tasks, nextPageToken, err := d.readMessages(sourceCluster, domainID, workflowID,
+			replicationDLQPurgeBatchSize, pageToken)
+		if err != nil {
+			return err
+		}
+		for _, task := range tasks {
+			if err := d.executionManager.DeleteReplicationTaskFromDLQ(&persistence.DeleteReplicationTaskFromDLQRequest{
+				SourceClusterName: sourceCluster,
+				TaskID:            task.GetTaskId(),
+			}); err != nil {
+				return err
+			}
+		}
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+// reportSizeLoop periodically reports the number of tasks in the dead letter queue of each remote cluster
+func (d *replicationDLQHandler) reportSizeLoop() {
+	defer d.shutdownWG.Done()
+
+	ticker := time.NewTicker(replicationDLQSizeReportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.shutdownCh:
+			return
+		case <-ticker.C:
+			for sourceCluster := range d.metricsClients {
+				d.emitDLQSize(sourceCluster)
+			}
+		}
+	}
+}
+
+// emitDLQSize reports the number of tasks in the dead letter queue of the source cluster
+func (d *replicationDLQHandler) emitDLQSize(sourceCluster string) {
+	response, err := d.executionManager.GetReplicationDLQSize(&persistence.GetReplicationDLQSizeRequest{
+		SourceClusterName: sourceCluster,
+	})
+	if err != nil {
+		d.logger.WithFields(bark.Fields{
+			logging.TagSourceCluster: sourceCluster,
+			logging.TagErr:           err,
+		}).Warn("Unable to get replication DLQ size.")
+		return
+	}
+	d.getMetricsClient(sourceCluster).UpdateGauge(metrics.ReplicationDLQScope, metrics.ReplicationDLQSizeGauge,
+		float64(response.Size))
+}
+
+func (d *replicationDLQHandler) getExecutor(sourceCluster string) *replicationTaskExecutor {
+	d.Lock()
+	defer d.Unlock()
+
+	if executor, ok := d.executors[sourceCluster]; ok {
+		return executor
+	}
+	adminClient := admin.NewRetryableClient(
+		d.shard.GetService().GetClientBean().GetRemoteAdminClient(sourceCluster),
+		common.CreateAdminServiceRetryPolicy(),
+		common.IsWhitelistServiceTransientError,
+	)
+	executor := newReplicationTaskExecutor(d.shard, d.historyEngine, sourceCluster, adminClient,
+		metrics.ReplicationDLQScope, d.logger.WithField(logging.TagSourceCluster, sourceCluster))
+	d.executors[sourceCluster] = executor
+	return executor
+}
+
+func (d *replicationDLQHandler) validateSourceCluster(sourceCluster string) error {
+	if sourceCluster == d.currentCluster {
+		return &shared.BadRequestError{Message: "Source cluster cannot be the current cluster."}
+	}
+	if _, ok := d.shard.GetService().GetClusterMetadata().GetAllClusterFailoverVersions()[sourceCluster]; !ok {
+		return &shared.BadRequestError{Message: fmt.Sprintf("Unknown source cluster: %v.", sourceCluster)}
+	}
+	return nil
+}
+
+func (d *replicationDLQHandler) getMetricsClient(sourceCluster string) metrics.Client {
+	return d.metricsClients[sourceCluster]
+}
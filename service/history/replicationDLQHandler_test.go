@@ -0,0 +1,253 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"os"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/xdc"
+)
+
+type (
+	replicationDLQHandlerSuite struct {
+		suite.Suite
+		logger                  bark.Logger
+		mockExecutionMgr        *mocks.ExecutionManager
+		mockClusterMetadata     *mocks.ClusterMetadata
+		mockHistoryEngine       *MockHistoryEngine
+		mockHistoryRereplicator *xdc.MockHistoryRereplicator
+
+		dlqHandler *replicationDLQHandler
+	}
+)
+
+func TestReplicationDLQHandlerSuite(t *testing.T) {
+	s := new(replicationDLQHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *replicationDLQHandlerSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+}
+
+func (s *replicationDLQHandlerSuite) SetupTest() {
+	log2 := log.New()
+	log2.Level = log.DebugLevel
+	s.logger = bark.NewLoggerFromLogrus(log2)
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
+	s.mockClusterMetadata = &mocks.ClusterMetadata{}
+	s.mockHistoryEngine = &MockHistoryEngine{}
+	s.mockHistoryRereplicator = &xdc.MockHistoryRereplicator{}
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.History)
+	mockService := service.NewTestService(s.mockClusterMetadata, nil, metricsClient, &client.MockClientBean{}, s.logger)
+
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(cluster.TestAllClusterFailoverVersions)
+	shard := &shardContextImpl{
+		service:          mockService,
+		shardID:          5,
+		shardInfo:        &persistence.ShardInfo{ShardID: 5, RangeID: 1},
+		executionManager: s.mockExecutionMgr,
+		config:           NewDynamicConfigForTest(),
+		logger:           s.logger,
+		metricsClient:    metricsClient,
+	}
+	s.dlqHandler = &replicationDLQHandler{
+		currentCluster:   cluster.TestCurrentClusterName,
+		shard:            shard,
+		historyEngine:    s.mockHistoryEngine,
+		executionManager: s.mockExecutionMgr,
+		logger:           s.logger,
+		metricsClients:   map[string]metrics.Client{cluster.TestAlternativeClusterName: metricsClient},
+		executors: map[string]*replicationTaskExecutor{
+			cluster.TestAlternativeClusterName: {
+				currentCluster:      cluster.TestCurrentClusterName,
+				sourceCluster:       cluster.TestAlternativeClusterName,
+				historyEngine:       s.mockHistoryEngine,
+				historyRereplicator: s.mockHistoryRereplicator,
+				metricsClient:       metricsClient,
+				metricsScope:        metrics.ReplicationDLQScope,
+				logger:              s.logger,
+			},
+		},
+	}
+}
+
+func (s *replicationDLQHandlerSuite) TearDownTest() {
+	s.mockExecutionMgr.AssertExpectations(s.T())
+	s.mockHistoryEngine.AssertExpectations(s.T())
+	s.mockHistoryRereplicator.AssertExpectations(s.T())
+}
+
+func (s *replicationDLQHandlerSuite) TestReadMessages_FilterByWorkflow() {
+	task1 := newReplicationHistoryTask(cluster.TestCurrentClusterName)
+	task2 := newReplicationHistoryTask(cluster.TestCurrentClusterName)
+	task2.HistoryTaskAttributes.WorkflowId = task1.HistoryTaskAttributes.WorkflowId
+	task3 := newReplicationHistoryTask(cluster.TestCurrentClusterName)
+	task3.HistoryTaskAttributes.WorkflowId = nil
+	s.mockExecutionMgr.On("GetReplicationTasksFromDLQ", &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: cluster.TestAlternativeClusterName,
+		BatchSize:         3,
+		NextPageToken:     []byte("token"),
+	}).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks:         s.newDLQTaskInfos(task1, task2, task3),
+		NextPageToken: []byte("next token"),
+	}, nil).Once()
+
+	tasks, nextPageToken, err := s.dlqHandler.readMessages(cluster.TestAlternativeClusterName, validDomainID,
+		task1.HistoryTaskAttributes.GetWorkflowId(), 3, []byte("token"))
+	s.Nil(err)
+	s.Equal([]byte("next token"), nextPageToken)
+	s.Equal(2, len(tasks))
+	s.Equal(int64(1), tasks[0].GetTaskId())
+	s.Equal(task1, tasks[0].Task)
+	s.Equal(int64(2), tasks[1].GetTaskId())
+	s.Equal(task2, tasks[1].Task)
+}
+
+func (s *replicationDLQHandlerSuite) TestReadMessages_InvalidSourceCluster() {
+	_, _, err := s.dlqHandler.readMessages(cluster.TestCurrentClusterName, "", "", 10, nil)
+	s.IsType(&shared.BadRequestError{}, err)
+
+	_, _, err = s.dlqHandler.readMessages("unknown cluster", "", "", 10, nil)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *replicationDLQHandlerSuite) TestMergeMessages_StopOnFailure() {
+	task1 := newReplicationHistoryTask(cluster.TestCurrentClusterName)
+	task2 := newReplicationHistoryTask(cluster.TestCurrentClusterName)
+	s.mockExecutionMgr.On("GetReplicationTasksFromDLQ", mock.Anything).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks:         s.newDLQTaskInfos(task1, task2),
+		NextPageToken: []byte("next token"),
+	}, nil).Once()
+	s.mockHistoryEngine.On("ReplicateEvents", mock.MatchedBy(func(request *h.ReplicateEventsRequest) bool {
+		return request.WorkflowExecution.GetRunId() == task1.HistoryTaskAttributes.GetRunId()
+	})).Return(nil).Once()
+	s.mockHistoryEngine.On("ReplicateEvents", mock.MatchedBy(func(request *h.ReplicateEventsRequest) bool {
+		return request.WorkflowExecution.GetRunId() == task2.HistoryTaskAttributes.GetRunId()
+	})).Return(&shared.BadRequestError{}).Once()
+	s.mockExecutionMgr.On("DeleteReplicationTaskFromDLQ", &persistence.DeleteReplicationTaskFromDLQRequest{
+		SourceClusterName: cluster.TestAlternativeClusterName,
+		TaskID:            1,
+	}).Return(nil).Once()
+
+	merged, nextPageToken, err := s.dlqHandler.mergeMessages(cluster.TestAlternativeClusterName, "", "", 10, nil)
+	s.IsType(&shared.BadRequestError{}, err)
+	s.Equal(1, merged)
+	s.Nil(nextPageToken)
+}
+
+func (s *replicationDLQHandlerSuite) TestMergeMessages_Success() {
+	task := newReplicationHistoryTask(cluster.TestCurrentClusterName)
+	s.mockExecutionMgr.On("GetReplicationTasksFromDLQ", mock.Anything).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks:         s.newDLQTaskInfos(task),
+		NextPageToken: []byte("next token"),
+	}, nil).Once()
+	s.mockHistoryEngine.On("ReplicateEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteReplicationTaskFromDLQ", mock.Anything).Return(nil).Once()
+
+	merged, nextPageToken, err := s.dlqHandler.mergeMessages(cluster.TestAlternativeClusterName, "", "", 10, nil)
+	s.Nil(err)
+	s.Equal(1, merged)
+	s.Equal([]byte("next token"), nextPageToken)
+}
+
+func (s *replicationDLQHandlerSuite) TestPurgeMessages() {
+	task1 := newReplicationHistoryTask(cluster.TestCurrentClusterName)
+	task2 := newReplicationHistoryTask(cluster.TestCurrentClusterName)
+	task2.HistoryTaskAttributes.DomainId = nil
+	task3 := newReplicationHistoryTask(cluster.TestCurrentClusterName)
+	s.mockExecutionMgr.On("GetReplicationTasksFromDLQ", &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: cluster.TestAlternativeClusterName,
+		BatchSize:         replicationDLQPurgeBatchSize,
+	}).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks:         s.newDLQTaskInfos(task1, task2),
+		NextPageToken: []byte("next token"),
+	}, nil).Once()
+	s.mockExecutionMgr.On("GetReplicationTasksFromDLQ", &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: cluster.TestAlternativeClusterName,
+		BatchSize:         replicationDLQPurgeBatchSize,
+		NextPageToken:     []byte("next token"),
+	}).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks: s.newDLQTaskInfos(task3),
+	}, nil).Once()
+	// the task IDs start from 1 on both pages, task2 does not belong to the domain
+	s.mockExecutionMgr.On("DeleteReplicationTaskFromDLQ", &persistence.DeleteReplicationTaskFromDLQRequest{
+		SourceClusterName: cluster.TestAlternativeClusterName,
+		TaskID:            1,
+	}).Return(nil).Twice()
+
+	err := s.dlqHandler.purgeMessages(cluster.TestAlternativeClusterName, validDomainID, "")
+	s.Nil(err)
+}
+
+func (s *replicationDLQHandlerSuite) TestPutTask() {
+	task := newReplicationHistoryTask(cluster.TestCurrentClusterName)
+	s.mockExecutionMgr.On("PutReplicationTaskToDLQ", mock.MatchedBy(func(request *persistence.PutReplicationTaskToDLQRequest) bool {
+		return request.SourceClusterName == cluster.TestAlternativeClusterName &&
+			request.TaskInfo.TaskID == task.GetSourceTaskId()
+	})).Return(nil).Once()
+
+	err := s.dlqHandler.putTask(cluster.TestAlternativeClusterName, task)
+	s.Nil(err)
+}
+
+func (s *replicationDLQHandlerSuite) TestPutTask_NoSourceTaskID() {
+	task := newReplicationHistoryTask(cluster.TestCurrentClusterName)
+	task.SourceTaskId = nil
+
+	err := s.dlqHandler.putTask(cluster.TestAlternativeClusterName, task)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *replicationDLQHandlerSuite) TestEmitDLQSize() {
+	s.mockExecutionMgr.On("GetReplicationDLQSize", &persistence.GetReplicationDLQSizeRequest{
+		SourceClusterName: cluster.TestAlternativeClusterName,
+	}).Return(&persistence.GetReplicationDLQSizeResponse{Size: 1}, nil).Once()
+
+	s.dlqHandler.emitDLQSize(cluster.TestAlternativeClusterName)
+}
+
+func (s *replicationDLQHandlerSuite) newDLQTaskInfos(tasks ...*replicator.ReplicationTask) []*persistence.ReplicationDLQTaskInfo {
+	var taskInfos []*persistence.ReplicationDLQTaskInfo
+	for i, task := range tasks {
+		taskInfo, err := persistence.NewReplicationDLQTaskInfo(int64(i+1), task)
+		s.Nil(err)
+		taskInfos = append(taskInfos, taskInfo)
+	}
+	return taskInfos
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber-common/bark"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/xdc"
+)
+
+type (
+	// replicationTaskExecutor applies the replication tasks received from a source cluster to a shard, it is used
+	// both for the pulled replication tasks and for the tasks merged back from the replication dead letter queue
+	replicationTaskExecutor struct {
+		currentCluster      string
+		sourceCluster       string
+		historyEngine       Engine
+		historyRereplicator xdc.HistoryRereplicator
+		metricsClient       metrics.Client
+		metricsScope        int
+		logger              bark.Logger
+	}
+)
+
+func newReplicationTaskExecutor(shard ShardContext, historyEngine Engine, sourceCluster string,
+	adminClient admin.Client, metricsScope int, logger bark.Logger) *replicationTaskExecutor {
+
+	historyRereplicator := xdc.NewHistoryRereplicator(
+		shard.GetDomainCache(),
+		adminClient,
+		func(ctx context.Context, request *h.ReplicateRawEventsRequest) error {
+			return historyEngine.ReplicateRawEvents(ctx, request)
+		},
+		persistence.NewHistorySerializer(),
+		historyRereplicationTimeout,
+		logger,
+	)
+
+	return &replicationTaskExecutor{
+		currentCluster:      shard.GetService().GetClusterMetadata().GetCurrentClusterName(),
+		sourceCluster:       sourceCluster,
+		historyEngine:       historyEngine,
+		historyRereplicator: historyRereplicator,
+		metricsClient:       shard.GetMetricsClient(),
+		metricsScope:        metricsScope,
+		logger:              logger,
+	}
+}
+
+// execute applies a replication task, inRetry forces the replicated events to be buffered when they arrive out of
+// order after a previous attempt failed with RetryTaskError
+func (e *replicationTaskExecutor) execute(task *replicator.ReplicationTask, inRetry bool) error {
+	e.metricsClient.IncCounter(e.metricsScope, metrics.TaskRequests)
+	sw := e.metricsClient.StartTimer(e.metricsScope, metrics.TaskLatency)
+	defer sw.Stop()
+
+	switch task.GetTaskType() {
+	case replicator.ReplicationTaskTypeSyncShardStatus:
+		return e.handleSyncShardTask(task)
+	case replicator.ReplicationTaskTypeSyncActivity:
+		return e.handleActivityTask(task)
+	case replicator.ReplicationTaskTypeHistory:
+		return e.handleHistoryReplicationTask(task, inRetry)
+	default:
+		return &shared.BadRequestError{Message: "unknown replication task"}
+	}
+}
+
+func (e *replicationTaskExecutor) handleSyncShardTask(task *replicator.ReplicationTask) error {
+	attr := task.SyncShardStatusTaskAttributes
+	if time.Now().Sub(time.Unix(0, attr.GetTimestamp())) > dropSyncShardTaskThreshold {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), replicationTaskApplyTimeout)
+	defer cancel()
+	return e.historyEngine.SyncShardStatus(ctx, &h.SyncShardStatusRequest{
+		SourceCluster: attr.SourceCluster,
+		ShardId:       attr.ShardId,
+		Timestamp:     attr.Timestamp,
+	})
+}
+
+func (e *replicationTaskExecutor) handleActivityTask(task *replicator.ReplicationTask) error {
+	attr := task.SyncActicvityTaskAttributes
+	ctx, cancel := context.WithTimeout(context.Background(), replicationTaskApplyTimeout)
+	err := e.historyEngine.SyncActivity(ctx, &h.SyncActivityRequest{
+		DomainId:          attr.DomainId,
+		WorkflowId:        attr.WorkflowId,
+		RunId:             attr.RunId,
+		Version:           attr.Version,
+		ScheduledId:       attr.ScheduledId,
+		ScheduledTime:     attr.ScheduledTime,
+		StartedId:         attr.StartedId,
+		StartedTime:       attr.StartedTime,
+		LastHeartbeatTime: attr.LastHeartbeatTime,
+		Details:           attr.Details,
+		Attempt:           attr.Attempt,
+	})
+	cancel()
+
+	retryErr, ok := err.(*shared.RetryTaskError)
+	if !ok {
+		return err
+	}
+
+	// the next event ID should be at least schedule ID + 1
+	e.resendHistory(attr.GetDomainId(), attr.GetWorkflowId(), retryErr, attr.GetRunId(), attr.GetScheduledId()+1)
+	// should return the replication error, not the resending error
+	return err
+}
+
+func (e *replicationTaskExecutor) handleHistoryReplicationTask(task *replicator.ReplicationTask, inRetry bool) error {
+	attr := task.HistoryTaskAttributes
+	processTask := false
+	for _, cluster := range attr.TargetClusters {
+		if e.currentCluster == cluster {
+			processTask = true
+			break
+		}
+	}
+	if !processTask {
+		return nil
+	}
+
+	request := &h.ReplicateEventsRequest{
+		SourceCluster: common.StringPtr(e.sourceCluster),
+		DomainUUID:    attr.DomainId,
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: attr.WorkflowId,
+			RunId:      attr.RunId,
+		},
+		FirstEventId:            attr.FirstEventId,
+		NextEventId:             attr.NextEventId,
+		Version:                 attr.Version,
+		ReplicationInfo:         attr.ReplicationInfo,
+		History:                 attr.History,
+		NewRunHistory:           attr.NewRunHistory,
+		ForceBufferEvents:       common.BoolPtr(inRetry),
+		EventStoreVersion:       attr.EventStoreVersion,
+		NewRunEventStoreVersion: attr.NewRunEventStoreVersion,
+	}
+
+	var err error
+	for i := 0; i < replicationBufferRetryCount; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), replicationTaskApplyTimeout)
+		err = e.historyEngine.ReplicateEvents(ctx, request)
+		cancel()
+
+		// Replication tasks could be slightly out of order for a particular workflow execution, retry with a small
+		// delay before returning RetryTaskError, so the task is retried with buffering enabled
+		if _, ok := err.(*shared.RetryTaskError); !ok {
+			return err
+		}
+		time.Sleep(replicationRetryTaskWait)
+	}
+
+	retryErr, ok := err.(*shared.RetryTaskError)
+	if !ok {
+		return err
+	}
+
+	e.resendHistory(attr.GetDomainId(), attr.GetWorkflowId(), retryErr, attr.GetRunId(), attr.GetFirstEventId())
+	// should return the replication error, not the resending error
+	return err
+}
+
+// resendHistory asks the source cluster for the events missing before a replication task
+func (e *replicationTaskExecutor) resendHistory(domainID, workflowID string, retryErr *shared.RetryTaskError,
+	endRunID string, endEventID int64) {
+	if err := e.historyRereplicator.SendMultiWorkflowHistory(
+		domainID, workflowID,
+		retryErr.GetRunId(), retryErr.GetNextEventId(), endRunID, endEventID,
+	); err != nil {
+		e.logger.WithFields(bark.Fields{
+			logging.TagDomainID:            domainID,
+			logging.TagWorkflowExecutionID: workflowID,
+			logging.TagErr:                 err,
+		}).Error("error resend history")
+	}
+}
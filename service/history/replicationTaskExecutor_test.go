@@ -0,0 +1,173 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/xdc"
+)
+
+type (
+	replicationTaskExecutorSuite struct {
+		suite.Suite
+		logger                  bark.Logger
+		mockHistoryEngine       *MockHistoryEngine
+		mockHistoryRereplicator *xdc.MockHistoryRereplicator
+
+		executor *replicationTaskExecutor
+	}
+)
+
+func TestReplicationTaskExecutorSuite(t *testing.T) {
+	s := new(replicationTaskExecutorSuite)
+	suite.Run(t, s)
+}
+
+func (s *replicationTaskExecutorSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+}
+
+func (s *replicationTaskExecutorSuite) SetupTest() {
+	log2 := log.New()
+	log2.Level = log.DebugLevel
+	s.logger = bark.NewLoggerFromLogrus(log2)
+	s.mockHistoryEngine = &MockHistoryEngine{}
+	s.mockHistoryRereplicator = &xdc.MockHistoryRereplicator{}
+
+	s.executor = &replicationTaskExecutor{
+		currentCluster:      cluster.TestCurrentClusterName,
+		sourceCluster:       cluster.TestAlternativeClusterName,
+		historyEngine:       s.mockHistoryEngine,
+		historyRereplicator: s.mockHistoryRereplicator,
+		metricsClient:       metrics.NewClient(tally.NoopScope, metrics.History),
+		metricsScope:        metrics.ReplicationTaskPullerScope,
+		logger:              s.logger,
+	}
+}
+
+func (s *replicationTaskExecutorSuite) TearDownTest() {
+	s.mockHistoryEngine.AssertExpectations(s.T())
+	s.mockHistoryRereplicator.AssertExpectations(s.T())
+}
+
+func (s *replicationTaskExecutorSuite) TestExecute_UnknownTask() {
+	err := s.executor.execute(&replicator.ReplicationTask{}, false)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *replicationTaskExecutorSuite) TestHandleHistoryReplicationTask_NotTargeted() {
+	err := s.executor.handleHistoryReplicationTask(newReplicationHistoryTask(cluster.TestAlternativeClusterName), false)
+	s.Nil(err)
+}
+
+func (s *replicationTaskExecutorSuite) TestHandleHistoryReplicationTask_ResendHistory() {
+	task := newReplicationHistoryTask(cluster.TestCurrentClusterName)
+	attr := task.HistoryTaskAttributes
+	retryErr := &shared.RetryTaskError{
+		DomainId:    attr.DomainId,
+		WorkflowId:  attr.WorkflowId,
+		RunId:       common.StringPtr(uuid.New()),
+		NextEventId: common.Int64Ptr(12),
+	}
+	s.mockHistoryEngine.On("ReplicateEvents", mock.Anything).Return(retryErr).Times(replicationBufferRetryCount)
+	s.mockHistoryRereplicator.On("SendMultiWorkflowHistory",
+		attr.GetDomainId(), attr.GetWorkflowId(),
+		retryErr.GetRunId(), retryErr.GetNextEventId(), attr.GetRunId(), attr.GetFirstEventId(),
+	).Return(nil).Once()
+
+	err := s.executor.handleHistoryReplicationTask(task, false)
+	s.Equal(retryErr, err)
+}
+
+func (s *replicationTaskExecutorSuite) TestHandleActivityTask_ResendHistory() {
+	domainID := validDomainID
+	workflowID := "some random workflow ID"
+	runID := uuid.New()
+	scheduleID := int64(144)
+	task := &replicator.ReplicationTask{
+		TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncActivity),
+		SyncActicvityTaskAttributes: &replicator.SyncActicvityTaskAttributes{
+			DomainId:    common.StringPtr(domainID),
+			WorkflowId:  common.StringPtr(workflowID),
+			RunId:       common.StringPtr(runID),
+			ScheduledId: common.Int64Ptr(scheduleID),
+		},
+	}
+	retryErr := &shared.RetryTaskError{
+		DomainId:    common.StringPtr(domainID),
+		WorkflowId:  common.StringPtr(workflowID),
+		RunId:       common.StringPtr(runID),
+		NextEventId: common.Int64Ptr(100),
+	}
+	s.mockHistoryEngine.On("SyncActivity", mock.Anything).Return(retryErr).Once()
+	s.mockHistoryRereplicator.On("SendMultiWorkflowHistory",
+		domainID, workflowID, runID, int64(100), runID, scheduleID+1,
+	).Return(nil).Once()
+
+	err := s.executor.handleActivityTask(task)
+	s.Equal(retryErr, err)
+}
+
+func (s *replicationTaskExecutorSuite) TestHandleSyncShardTask_Expired() {
+	err := s.executor.handleSyncShardTask(&replicator.ReplicationTask{
+		TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncShardStatus),
+		SyncShardStatusTaskAttributes: &replicator.SyncShardStatusTaskAttributes{
+			SourceCluster: common.StringPtr(cluster.TestAlternativeClusterName),
+			ShardId:       common.Int64Ptr(5),
+			Timestamp:     common.Int64Ptr(time.Now().Add(-2 * dropSyncShardTaskThreshold).UnixNano()),
+		},
+	})
+	s.Nil(err)
+}
+
+func newReplicationHistoryTask(targetCluster string) *replicator.ReplicationTask {
+	return &replicator.ReplicationTask{
+		TaskType:     replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeHistory),
+		SourceTaskId: common.Int64Ptr(rand.Int63()),
+		HistoryTaskAttributes: &replicator.HistoryTaskAttributes{
+			TargetClusters: []string{targetCluster},
+			DomainId:       common.StringPtr(validDomainID),
+			WorkflowId:     common.StringPtr("some random workflow ID"),
+			RunId:          common.StringPtr(uuid.New()),
+			FirstEventId:   common.Int64Ptr(5),
+			NextEventId:    common.Int64Ptr(8),
+			Version:        common.Int64Ptr(100),
+			History:        &shared.History{},
+		},
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// replicationTaskPuller pulls the replication tasks of a shard from a remote cluster and applies them to the
+	// shard, it is used instead of the kafka replicator when the clusters are in pull replication mode
+	replicationTaskPuller struct {
+		currentCluster string
+		sourceCluster  string
+		shard          ShardContext
+		adminClient    admin.Client
+		executor       *replicationTaskExecutor
+		dlqHandler     *replicationDLQHandler
+		config         *Config
+		metricsClient  metrics.Client
+		logger         bark.Logger
+
+		status     int32
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+
+		// lastRetrievedMessageID is the ID of the last replication task applied, the source cluster starts from the
+		// replication ack level of the shard when it is -1
+		lastRetrievedMessageID int64
+	}
+)
+
+const (
+	replicationPullTimeout       = 10 * time.Second
+	replicationPullErrorWait     = time.Second
+	replicationTaskApplyTimeout  = 30 * time.Second
+	replicationBufferRetryCount  = 8
+	replicationRetryTaskWait     = 100 * time.Millisecond
+	dropSyncShardTaskThreshold   = 10 * time.Minute
+	replicationPullRetryInterval = 100 * time.Millisecond
+	replicationPullMaxInterval   = 2 * time.Second
+	replicationPullExpiration    = 10 * time.Second
+)
+
+var (
+	replicationTaskPullerRetryPolicy = createReplicationTaskPullerRetryPolicy()
+)
+
+func newReplicationTaskPuller(shard ShardContext, historyEngine Engine, dlqHandler *replicationDLQHandler,
+	sourceCluster string, logger bark.Logger) *replicationTaskPuller {
+
+	adminClient := admin.NewRetryableClient(
+		shard.GetService().GetClientBean().GetRemoteAdminClient(sourceCluster),
+		common.CreateAdminServiceRetryPolicy(),
+		common.IsWhitelistServiceTransientError,
+	)
+	logger = logger.WithFields(bark.Fields{
+		logging.TagWorkflowComponent: logging.TagValueReplicationTaskPullerComponent,
+		logging.TagSourceCluster:     sourceCluster,
+	})
+
+	return &replicationTaskPuller{
+		currentCluster: shard.GetService().GetClusterMetadata().GetCurrentClusterName(),
+		sourceCluster:  sourceCluster,
+		shard:          shard,
+		adminClient:    adminClient,
+		executor: newReplicationTaskExecutor(shard, historyEngine, sourceCluster, adminClient,
+			metrics.ReplicationTaskPullerScope, logger),
+		dlqHandler:             dlqHandler,
+		config:                 shard.GetConfig(),
+		metricsClient:          shard.GetMetricsClient(),
+		logger:                 logger,
+		status:                 common.DaemonStatusInitialized,
+		shutdownCh:             make(chan struct{}),
+		lastRetrievedMessageID: -1,
+	}
+}
+
+func (p *replicationTaskPuller) Start() {
+	if !atomic.CompareAndSwapInt32(&p.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	p.shutdownWG.Add(1)
+	go p.pullLoop()
+
+	p.logger.Info("Replication task puller started.")
+}
+
+func (p *replicationTaskPuller) Stop() {
+	if !atomic.CompareAndSwapInt32(&p.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(p.shutdownCh)
+	if success := common.AwaitWaitGroup(&p.shutdownWG, time.Minute); !success {
+		p.logger.Warn("Replication task puller timed out on shutdown.")
+	}
+
+	p.logger.Info("Replication task puller stopped.")
+}
+
+func (p *replicationTaskPuller) pullLoop() {
+	defer p.shutdownWG.Done()
+
+	for {
+		select {
+		case <-p.shutdownCh:
+			return
+		default:
+		}
+
+		if err := p.pullAndApply(); err != nil {
+			p.logger.WithField(logging.TagErr, err).Warn("Unable to pull replication tasks.")
+			select {
+			case <-p.shutdownCh:
+				return
+			case <-time.After(replicationPullErrorWait):
+			}
+		}
+	}
+}
+
+// pullAndApply pulls a batch of replication tasks from the source cluster and applies them one by one, the tasks
+// of a shard are applied in order so the pulled position can only move forward once the whole batch is applied
+func (p *replicationTaskPuller) pullAndApply() error {
+	ctx, cancel := context.WithTimeout(context.Background(), replicationPullTimeout)
+	response, err := p.adminClient.GetReplicationMessages(ctx, &replicator.GetReplicationMessagesRequest{
+		ShardId:                common.Int32Ptr(int32(p.shard.GetShardID())),
+		LastRetrievedMessageId: common.Int64Ptr(p.lastRetrievedMessageID),
+		ClusterName:            common.StringPtr(p.currentCluster),
+	})
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	for _, task := range response.ReplicationTasks {
+		select {
+		case <-p.shutdownCh:
+			return nil
+		default:
+		}
+		p.processWithRetry(task)
+	}
+
+	p.lastRetrievedMessageID = response.GetLastRetrievedMessageId()
+	return nil
+}
+
+func (p *replicationTaskPuller) processWithRetry(task *replicator.ReplicationTask) {
+	var err error
+	logger := p.logger.WithFields(bark.Fields{
+		logging.TagTaskType:     task.GetTaskType(),
+		logging.TagAttemptStart: time.Now(),
+	})
+
+	forceBuffer := false
+	remainingRetryCount := p.config.ReplicationTaskPullerMaxRetryCount()
+
+	attempt := 0
+	op := func() error {
+		attempt++
+		err = p.executor.execute(task, forceBuffer)
+		if _, ok := err.(*shared.RetryTaskError); ok {
+			// Enable buffering of replication tasks for next attempt
+			forceBuffer = true
+		}
+		return err
+	}
+
+ProcessRetryLoop:
+	for {
+		select {
+		case <-p.shutdownCh:
+			return
+		default:
+			err = backoff.Retry(op, replicationTaskPullerRetryPolicy, isTransientReplicationError)
+			if err != nil && isTransientReplicationError(err) {
+				// Any whitelisted transient errors should be retried indefinitely
+				if common.IsWhitelistServiceTransientError(err) {
+					if attempt%100 == 0 {
+						logger.WithFields(bark.Fields{
+							logging.TagErr:          err,
+							logging.TagAttemptCount: attempt,
+							logging.TagAttemptEnd:   time.Now(),
+						}).Warn("Error (transient) processing replication task.")
+					}
+					continue ProcessRetryLoop
+				}
+
+				// Otherwise retry a limited number of times, so RetryTaskError can be retried with buffering enabled
+				remainingRetryCount--
+				if remainingRetryCount > 0 {
+					continue ProcessRetryLoop
+				}
+			}
+		}
+
+		break ProcessRetryLoop
+	}
+
+	if err != nil {
+		// Task still failed after all retries, move it to the dead letter queue of the shard to not block the
+		// replication of the other workflow executions, it can be merged back once the problem is fixed
+		p.metricsClient.IncCounter(metrics.ReplicationTaskPullerScope, metrics.TaskFailures)
+		logger = logger.WithFields(bark.Fields{
+			logging.TagErr:          err,
+			logging.TagAttemptCount: attempt,
+			logging.TagAttemptEnd:   time.Now(),
+		})
+		if task.GetTaskType() == replicator.ReplicationTaskTypeSyncShardStatus {
+			logger.Error("Error processing replication task, skipping the task.")
+			return
+		}
+		if dlqErr := p.dlqHandler.putTask(p.sourceCluster, task); dlqErr != nil {
+			logger.WithField(logging.TagErr, dlqErr).Error("Unable to put replication task to DLQ, skipping the task.")
+			return
+		}
+		logger.Error("Error processing replication task, moved the task to DLQ.")
+	}
+}
+
+func isTransientReplicationError(err error) bool {
+	switch err.(type) {
+	case *shared.BadRequestError:
+		return false
+	default:
+		return true
+	}
+}
+
+func createReplicationTaskPullerRetryPolicy() backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(replicationPullRetryInterval)
+	policy.SetMaximumInterval(replicationPullMaxInterval)
+	policy.SetExpirationInterval(replicationPullExpiration)
+
+	return policy
+}
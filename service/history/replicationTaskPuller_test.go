@@ -0,0 +1,194 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/xdc"
+)
+
+type (
+	replicationTaskPullerSuite struct {
+		suite.Suite
+		logger                  bark.Logger
+		mockAdminClient         *mocks.AdminClient
+		mockHistoryEngine       *MockHistoryEngine
+		mockHistoryRereplicator *xdc.MockHistoryRereplicator
+		mockExecutionMgr        *mocks.ExecutionManager
+
+		puller *replicationTaskPuller
+	}
+)
+
+func TestReplicationTaskPullerSuite(t *testing.T) {
+	s := new(replicationTaskPullerSuite)
+	suite.Run(t, s)
+}
+
+func (s *replicationTaskPullerSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+}
+
+func (s *replicationTaskPullerSuite) SetupTest() {
+	log2 := log.New()
+	log2.Level = log.DebugLevel
+	s.logger = bark.NewLoggerFromLogrus(log2)
+	s.mockAdminClient = &mocks.AdminClient{}
+	s.mockHistoryEngine = &MockHistoryEngine{}
+	s.mockHistoryRereplicator = &xdc.MockHistoryRereplicator{}
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
+
+	shard := &shardContextImpl{
+		shardID:   5,
+		shardInfo: &persistence.ShardInfo{ShardID: 5, RangeID: 1},
+		config:    NewDynamicConfigForTest(),
+		logger:    s.logger,
+	}
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.History)
+	s.puller = &replicationTaskPuller{
+		currentCluster: cluster.TestCurrentClusterName,
+		sourceCluster:  cluster.TestAlternativeClusterName,
+		shard:          shard,
+		adminClient:    s.mockAdminClient,
+		executor: &replicationTaskExecutor{
+			currentCluster:      cluster.TestCurrentClusterName,
+			sourceCluster:       cluster.TestAlternativeClusterName,
+			historyEngine:       s.mockHistoryEngine,
+			historyRereplicator: s.mockHistoryRereplicator,
+			metricsClient:       metricsClient,
+			metricsScope:        metrics.ReplicationTaskPullerScope,
+			logger:              s.logger,
+		},
+		dlqHandler: &replicationDLQHandler{
+			currentCluster:   cluster.TestCurrentClusterName,
+			shard:            shard,
+			historyEngine:    s.mockHistoryEngine,
+			executionManager: s.mockExecutionMgr,
+			logger:           s.logger,
+			metricsClients:   map[string]metrics.Client{cluster.TestAlternativeClusterName: metricsClient},
+			executors:        make(map[string]*replicationTaskExecutor),
+		},
+		config:                 shard.config,
+		metricsClient:          metricsClient,
+		logger:                 s.logger,
+		status:                 common.DaemonStatusInitialized,
+		shutdownCh:             make(chan struct{}),
+		lastRetrievedMessageID: -1,
+	}
+}
+
+func (s *replicationTaskPullerSuite) TearDownTest() {
+	s.mockAdminClient.AssertExpectations(s.T())
+	s.mockHistoryEngine.AssertExpectations(s.T())
+	s.mockHistoryRereplicator.AssertExpectations(s.T())
+	s.mockExecutionMgr.AssertExpectations(s.T())
+}
+
+func (s *replicationTaskPullerSuite) TestPullAndApply() {
+	timestamp := time.Now().UnixNano()
+	historyTask := newReplicationHistoryTask(cluster.TestCurrentClusterName)
+	s.mockAdminClient.On("GetReplicationMessages", mock.Anything, &replicator.GetReplicationMessagesRequest{
+		ShardId:                common.Int32Ptr(5),
+		LastRetrievedMessageId: common.Int64Ptr(-1),
+		ClusterName:            common.StringPtr(cluster.TestCurrentClusterName),
+	}).Return(&replicator.ReplicationMessages{
+		ReplicationTasks: []*replicator.ReplicationTask{
+			historyTask,
+			{
+				TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncShardStatus),
+				SyncShardStatusTaskAttributes: &replicator.SyncShardStatusTaskAttributes{
+					SourceCluster: common.StringPtr(cluster.TestAlternativeClusterName),
+					ShardId:       common.Int64Ptr(5),
+					Timestamp:     common.Int64Ptr(timestamp),
+				},
+			},
+		},
+		LastRetrievedMessageId: common.Int64Ptr(123),
+		HasMore:                common.BoolPtr(false),
+	}, nil).Once()
+	s.mockHistoryEngine.On("ReplicateEvents", mock.MatchedBy(func(request *h.ReplicateEventsRequest) bool {
+		return request.GetSourceCluster() == cluster.TestAlternativeClusterName &&
+			request.WorkflowExecution.GetRunId() == historyTask.HistoryTaskAttributes.GetRunId() &&
+			!request.GetForceBufferEvents()
+	})).Return(nil).Once()
+	s.mockHistoryEngine.On("SyncShardStatus", &h.SyncShardStatusRequest{
+		SourceCluster: common.StringPtr(cluster.TestAlternativeClusterName),
+		ShardId:       common.Int64Ptr(5),
+		Timestamp:     common.Int64Ptr(timestamp),
+	}).Return(nil).Once()
+
+	err := s.puller.pullAndApply()
+	s.Nil(err)
+	s.Equal(int64(123), s.puller.lastRetrievedMessageID)
+}
+
+func (s *replicationTaskPullerSuite) TestPullAndApply_PullFailed() {
+	s.mockAdminClient.On("GetReplicationMessages", mock.Anything, mock.Anything).
+		Return(nil, &shared.InternalServiceError{}).Once()
+
+	err := s.puller.pullAndApply()
+	s.NotNil(err)
+	s.Equal(int64(-1), s.puller.lastRetrievedMessageID)
+}
+
+func (s *replicationTaskPullerSuite) TestProcessWithRetry_MoveToDLQ() {
+	task := newReplicationHistoryTask(cluster.TestCurrentClusterName)
+	s.mockHistoryEngine.On("ReplicateEvents", mock.Anything).Return(&shared.BadRequestError{}).Once()
+	s.mockExecutionMgr.On("PutReplicationTaskToDLQ", mock.MatchedBy(func(request *persistence.PutReplicationTaskToDLQRequest) bool {
+		return request.SourceClusterName == cluster.TestAlternativeClusterName &&
+			request.TaskInfo.DomainID == validDomainID &&
+			request.TaskInfo.RunID == task.HistoryTaskAttributes.GetRunId() &&
+			request.TaskInfo.TaskID == task.GetSourceTaskId()
+	})).Return(nil).Once()
+
+	s.puller.processWithRetry(task)
+}
+
+func (s *replicationTaskPullerSuite) TestProcessWithRetry_SkipSyncShardTask() {
+	s.mockHistoryEngine.On("SyncShardStatus", mock.Anything).Return(&shared.BadRequestError{}).Once()
+
+	s.puller.processWithRetry(&replicator.ReplicationTask{
+		TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncShardStatus),
+		SyncShardStatusTaskAttributes: &replicator.SyncShardStatusTaskAttributes{
+			SourceCluster: common.StringPtr(cluster.TestAlternativeClusterName),
+			ShardId:       common.Int64Ptr(5),
+			Timestamp:     common.Int64Ptr(time.Now().UnixNano()),
+		},
+	})
+}
@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"time"
@@ -52,6 +53,12 @@ type (
 
 		sync.Mutex
 		lastShardSyncTimestamp time.Time
+		// newTaskCh is closed and replaced when new replication tasks are written, to wake up the pulls waiting for them
+		newTaskCh chan struct{}
+
+		ackLevelLock sync.Mutex
+		// clusterAckLevels are the replication task IDs applied by each cluster pulling the tasks
+		clusterAckLevels map[string]int64
 	}
 )
 
@@ -61,8 +68,15 @@ var (
 	defaultHistoryPageSize    = 1000
 )
 
+const (
+	// replicationLongPollBuffer is the time left to return the replication tasks before the pull request times out
+	replicationLongPollBuffer = time.Second
+)
+
+// newReplicatorQueueProcessor creates the processor of the replication task queue of a shard, the tasks are published
+// with the replicator producer, or pulled by the other clusters with getTasks if the producer is nil
 func newReplicatorQueueProcessor(shard ShardContext, historyCache *historyCache, replicator messaging.Producer,
-	executionMgr persistence.ExecutionManager, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, logger bark.Logger) queueProcessor {
+	executionMgr persistence.ExecutionManager, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, logger bark.Logger) replicatorQueueProcessor {
 
 	currentClusterNamer := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 
@@ -95,6 +109,8 @@ func newReplicatorQueueProcessor(shard ShardContext, historyCache *historyCache,
 		metricsClient:       shard.GetMetricsClient(),
 		options:             options,
 		logger:              logger,
+		newTaskCh:           make(chan struct{}),
+		clusterAckLevels:    shard.GetReplicatorClusterAckLevels(),
 	}
 
 	queueAckMgr := newQueueAckMgr(shard, options, processor, shard.GetReplicatorAckLevel(), logger)
@@ -137,7 +153,27 @@ func (p *replicatorQueueProcessorImpl) queueShutdown() error {
 	return nil
 }
 
-func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence.ReplicationTaskInfo) (retError error) {
+func (p *replicatorQueueProcessorImpl) notifyNewTask() {
+	p.queueProcessorBase.notifyNewTask()
+
+	p.Lock()
+	close(p.newTaskCh)
+	p.newTaskCh = make(chan struct{})
+	p.Unlock()
+}
+
+func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence.ReplicationTaskInfo) error {
+	replicationTask, err := p.generateSyncActivityTask(task)
+	if err != nil || replicationTask == nil {
+		return err
+	}
+	return p.replicator.Publish(replicationTask)
+}
+
+// generateSyncActivityTask returns the sync activity replication task of the activity, or nil if the activity
+// does not need to be synced anymore
+func (p *replicatorQueueProcessorImpl) generateSyncActivityTask(
+	task *persistence.ReplicationTaskInfo) (replicationTask *replicator.ReplicationTask, retError error) {
 	domainID := task.DomainID
 	execution := shared.WorkflowExecution{
 		WorkflowId: common.StringPtr(task.WorkflowID),
@@ -145,25 +181,25 @@ func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence
 	}
 	context, release, err := p.historyCache.getOrCreateWorkflowExecution(domainID, execution)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer func() { release(retError) }()
 
 	msBuilder, err := context.loadWorkflowExecution()
 	if err != nil {
 		if _, ok := err.(*shared.EntityNotExistsError); ok {
-			return nil
+			return nil, nil
 		}
-		return err
+		return nil, err
 	}
 	if !msBuilder.IsWorkflowExecutionRunning() {
 		// workflow already finished, no need to process the timer
-		return nil
+		return nil, nil
 	}
 
 	activityInfo, ok := msBuilder.GetActivityInfo(task.ScheduledID)
 	if !ok {
-		return nil
+		return nil, nil
 	}
 
 	// int64 can only represent several hundred years of time
@@ -176,8 +212,9 @@ func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence
 		heartbeatTime = activityInfo.StartedTime.UnixNano()
 	}
 
-	replicationTask := &replicator.ReplicationTask{
-		TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncActivity),
+	return &replicator.ReplicationTask{
+		TaskType:     replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncActivity),
+		SourceTaskId: common.Int64Ptr(task.TaskID),
 		SyncActicvityTaskAttributes: &replicator.SyncActicvityTaskAttributes{
 			DomainId:          common.StringPtr(task.DomainID),
 			WorkflowId:        common.StringPtr(task.WorkflowID),
@@ -191,23 +228,11 @@ func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence
 			Details:           activityInfo.Details,
 			Attempt:           common.Int32Ptr(activityInfo.Attempt),
 		},
-	}
-
-	return p.replicator.Publish(replicationTask)
+	}, nil
 }
 
 func (p *replicatorQueueProcessorImpl) processHistoryReplicationTask(task *persistence.ReplicationTaskInfo) error {
-
-	domainEntry, err := p.shard.GetDomainCache().GetDomainByID(task.DomainID)
-	if err != nil {
-		return err
-	}
-	targetClusters := []string{}
-	for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
-		targetClusters = append(targetClusters, cluster.ClusterName)
-	}
-
-	replicationTask, err := GenerateReplicationTask(targetClusters, task, p.historyMgr, p.historyV2Mgr, p.metricsClient, p.logger, nil)
+	replicationTask, err := p.generateHistoryReplicationTask(task)
 	if err != nil {
 		return err
 	}
@@ -221,6 +246,21 @@ func (p *replicatorQueueProcessorImpl) processHistoryReplicationTask(task *persi
 	return err
 }
 
+func (p *replicatorQueueProcessorImpl) generateHistoryReplicationTask(
+	task *persistence.ReplicationTaskInfo) (*replicator.ReplicationTask, error) {
+
+	domainEntry, err := p.shard.GetDomainCache().GetDomainByID(task.DomainID)
+	if err != nil {
+		return nil, err
+	}
+	targetClusters := []string{}
+	for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
+		targetClusters = append(targetClusters, cluster.ClusterName)
+	}
+
+	return GenerateReplicationTask(targetClusters, task, p.historyMgr, p.historyV2Mgr, p.metricsClient, p.logger, nil)
+}
+
 // GenerateReplicationTask generate replication task
 func GenerateReplicationTask(targetClusters []string, task *persistence.ReplicationTaskInfo,
 	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
@@ -251,7 +291,8 @@ func GenerateReplicationTask(targetClusters []string, task *persistence.Replicat
 	}
 
 	ret := &replicator.ReplicationTask{
-		TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeHistory),
+		TaskType:     replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeHistory),
+		SourceTaskId: common.Int64Ptr(task.TaskID),
 		HistoryTaskAttributes: &replicator.HistoryTaskAttributes{
 			TargetClusters:          targetClusters,
 			DomainId:                common.StringPtr(task.DomainID),
@@ -318,6 +359,194 @@ func (p *replicatorQueueProcessorImpl) updateAckLevel(ackLevel int64) error {
 	return err
 }
 
+// getTasks returns the replication tasks after lastReadTaskID to a cluster pulling them, and waits for new tasks until
+// the long poll timeout if there are none. The polling cluster has applied all the tasks up to lastReadTaskID, which
+// is -1 if the polling cluster does not know where it stopped, the tasks after the replication ack level are returned
+func (p *replicatorQueueProcessorImpl) getTasks(
+	ctx context.Context,
+	pollingCluster string,
+	lastReadTaskID int64,
+) (*replicator.ReplicationMessages, error) {
+
+	if lastReadTaskID >= 0 {
+		p.updateClusterAckLevel(pollingCluster, lastReadTaskID)
+	}
+	readLevel := lastReadTaskID
+	if ackLevel := p.shard.GetReplicatorAckLevel(); readLevel < ackLevel {
+		readLevel = ackLevel
+	}
+
+	expiration := time.Now().Add(p.shard.GetConfig().ReplicationTaskLongPollTimeout())
+	if deadline, ok := ctx.Deadline(); ok && deadline.Add(-replicationLongPollBuffer).Before(expiration) {
+		expiration = deadline.Add(-replicationLongPollBuffer)
+	}
+
+	for {
+		// the channel has to be taken before reading, so a task written right after the read wakes up the poll
+		p.Lock()
+		newTaskCh := p.newTaskCh
+		p.Unlock()
+
+		response, err := p.executionMgr.GetReplicationTasks(&persistence.GetReplicationTasksRequest{
+			ReadLevel:    readLevel,
+			MaxReadLevel: p.shard.GetTransferMaxReadLevel(),
+			BatchSize:    p.options.BatchSize(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(response.Tasks) > 0 {
+			return p.toReplicationMessages(readLevel, response.Tasks, len(response.NextPageToken) != 0)
+		}
+
+		wait := expiration.Sub(time.Now())
+		if wait <= 0 {
+			return p.toReplicationMessages(readLevel, nil, false)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-newTaskCh:
+			timer.Stop()
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (p *replicatorQueueProcessorImpl) toReplicationMessages(
+	readLevel int64,
+	tasks []*persistence.ReplicationTaskInfo,
+	hasMore bool,
+) (*replicator.ReplicationMessages, error) {
+
+	replicationTasks := []*replicator.ReplicationTask{}
+	lastReadTaskID := readLevel
+TaskLoop:
+	for _, task := range tasks {
+		var replicationTask *replicator.ReplicationTask
+		var err error
+		switch task.TaskType {
+		case persistence.ReplicationTaskTypeSyncActivity:
+			replicationTask, err = p.generateSyncActivityTask(task)
+		case persistence.ReplicationTaskTypeHistory:
+			replicationTask, err = p.generateHistoryReplicationTask(task)
+			if _, ok := err.(*shared.EntityNotExistsError); ok {
+				p.logger.WithFields(bark.Fields{
+					logging.TagTaskID:              task.TaskID,
+					logging.TagDomainID:            task.DomainID,
+					logging.TagWorkflowExecutionID: task.WorkflowID,
+					logging.TagWorkflowRunID:       task.RunID,
+				}).Warn("History of replication task not found, skipping the task.")
+				replicationTask, err = nil, nil
+			}
+		default:
+			p.logger.WithFields(bark.Fields{
+				logging.TagTaskID:   task.TaskID,
+				logging.TagTaskType: task.TaskType,
+			}).Error("Unknown replication task type, skipping the task.")
+		}
+
+		if err != nil {
+			if lastReadTaskID == readLevel {
+				return nil, err
+			}
+			// return the tasks generated so far, the failed one is retried by the next pull
+			hasMore = true
+			break TaskLoop
+		}
+		if replicationTask != nil {
+			replicationTasks = append(replicationTasks, replicationTask)
+		}
+		lastReadTaskID = task.TaskID
+	}
+
+	if !hasMore {
+		// all the tasks written so far are returned, let the polling cluster know the current time of the shard
+		replicationTasks = append(replicationTasks, &replicator.ReplicationTask{
+			TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncShardStatus),
+			SyncShardStatusTaskAttributes: &replicator.SyncShardStatusTaskAttributes{
+				SourceCluster: common.StringPtr(p.currentClusterNamer),
+				ShardId:       common.Int64Ptr(int64(p.shard.GetShardID())),
+				Timestamp:     common.Int64Ptr(common.NewRealTimeSource().Now().UnixNano()),
+			},
+		})
+	}
+
+	return &replicator.ReplicationMessages{
+		ReplicationTasks:       replicationTasks,
+		LastRetrievedMessageId: common.Int64Ptr(lastReadTaskID),
+		HasMore:                common.BoolPtr(hasMore),
+	}, nil
+}
+
+// updateClusterAckLevel records the replication tasks applied by a cluster pulling them in the shard info, the tasks
+// applied by all the other clusters are completed and the replication ack level of the shard is moved forward.
+func (p *replicatorQueueProcessorImpl) updateClusterAckLevel(cluster string, ackLevel int64) {
+	p.ackLevelLock.Lock()
+	defer p.ackLevelLock.Unlock()
+
+	if currentAckLevel, ok := p.clusterAckLevels[cluster]; !ok || ackLevel > currentAckLevel {
+		p.clusterAckLevels[cluster] = ackLevel
+		if err := p.shard.UpdateReplicatorClusterAckLevel(cluster, ackLevel); err != nil {
+			p.logger.WithFields(bark.Fields{
+				logging.TagSourceCluster: cluster,
+				logging.TagErr:           err,
+			}).Warn("Unable to update replication cluster ack level.")
+		}
+	}
+
+	minAckLevel := int64(-1)
+	for clusterName := range p.shard.GetService().GetClusterMetadata().GetAllClusterFailoverVersions() {
+		if clusterName == p.currentClusterNamer {
+			continue
+		}
+		clusterAckLevel, ok := p.clusterAckLevels[clusterName]
+		if !ok {
+			return
+		}
+		if minAckLevel == -1 || clusterAckLevel < minAckLevel {
+			minAckLevel = clusterAckLevel
+		}
+	}
+
+	readLevel := p.shard.GetReplicatorAckLevel()
+	if minAckLevel <= readLevel {
+		return
+	}
+	for {
+		response, err := p.executionMgr.GetReplicationTasks(&persistence.GetReplicationTasksRequest{
+			ReadLevel:    readLevel,
+			MaxReadLevel: minAckLevel,
+			BatchSize:    p.options.BatchSize(),
+		})
+		if err != nil {
+			p.logger.WithField(logging.TagErr, err).Warn("Unable to read the replication tasks to complete.")
+			return
+		}
+		if len(response.Tasks) == 0 {
+			break
+		}
+		for _, task := range response.Tasks {
+			if err := p.executionMgr.CompleteReplicationTask(&persistence.CompleteReplicationTaskRequest{
+				TaskID: task.TaskID,
+			}); err != nil {
+				p.logger.WithFields(bark.Fields{
+					logging.TagTaskID: task.TaskID,
+					logging.TagErr:    err,
+				}).Warn("Unable to complete replication task.")
+				return
+			}
+			readLevel = task.TaskID
+		}
+	}
+
+	if err := p.shard.UpdateReplicatorAckLevel(minAckLevel); err != nil {
+		p.logger.WithField(logging.TagErr, err).Warn("Unable to update replication ack level.")
+	}
+}
+
 // GetAllHistory return history
 func GetAllHistory(historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
 	metricsClient metrics.Client, logger bark.Logger, byBatch bool,
@@ -21,12 +21,14 @@
 package history
 
 import (
+	"context"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/pborman/uuid"
 	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
@@ -41,6 +43,7 @@ import (
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
@@ -96,7 +99,7 @@ func (s *replicatorQueueProcessorSuite) SetupTest() {
 	s.mockService = service.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, metricsClient, s.mockClientBean, s.logger)
 	s.mockShard = &shardContextImpl{
 		service:                   s.mockService,
-		shardInfo:                 &persistence.ShardInfo{ShardID: 0, RangeID: 1, TransferAckLevel: 0},
+		shardInfo:                 &persistence.ShardInfo{ShardID: 0, RangeID: 1, TransferAckLevel: 0, ClusterReplicationLevel: map[string]int64{}},
 		transferSequenceNumber:    1,
 		maxTransferSequenceNumber: 100000,
 		closeCh:                   make(chan int, 100),
@@ -325,7 +328,8 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_ActivityRunning() {
 		}, nil,
 	).Once()
 	s.mockProducer.On("Publish", &replicator.ReplicationTask{
-		TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncActivity),
+		TaskType:     replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncActivity),
+		SourceTaskId: common.Int64Ptr(taskID),
 		SyncActicvityTaskAttributes: &replicator.SyncActicvityTaskAttributes{
 			DomainId:          common.StringPtr(domainID),
 			WorkflowId:        common.StringPtr(workflowID),
@@ -344,3 +348,92 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_ActivityRunning() {
 	_, err := s.replicatorQueueProcessor.process(task)
 	s.Nil(err)
 }
+
+func (s *replicatorQueueProcessorSuite) TestGetTasks_NoTasks() {
+	s.mockShard.GetConfig().ReplicationTaskLongPollTimeout = dynamicconfig.GetDurationPropertyFn(10 * time.Millisecond)
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    0,
+		MaxReadLevel: s.mockShard.GetTransferMaxReadLevel(),
+		BatchSize:    s.replicatorQueueProcessor.options.BatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{}, nil)
+
+	response, err := s.replicatorQueueProcessor.getTasks(context.Background(), cluster.TestAlternativeClusterName, -1)
+	s.Nil(err)
+	s.Equal(int64(0), response.GetLastRetrievedMessageId())
+	s.False(response.GetHasMore())
+	s.Equal(1, len(response.ReplicationTasks))
+	s.Equal(replicator.ReplicationTaskTypeSyncShardStatus, response.ReplicationTasks[0].GetTaskType())
+	s.Equal(s.currentClusterNamer, response.ReplicationTasks[0].SyncShardStatusTaskAttributes.GetSourceCluster())
+}
+
+func (s *replicatorQueueProcessorSuite) TestGetTasks_WaitForNewTask() {
+	taskID := int64(12)
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    0,
+		MaxReadLevel: s.mockShard.GetTransferMaxReadLevel(),
+		BatchSize:    s.replicatorQueueProcessor.options.BatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{}, nil).Run(func(args mock.Arguments) {
+		s.replicatorQueueProcessor.notifyNewTask()
+	}).Once()
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    0,
+		MaxReadLevel: s.mockShard.GetTransferMaxReadLevel(),
+		BatchSize:    s.replicatorQueueProcessor.options.BatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{{TaskID: taskID, TaskType: -1}},
+	}, nil).Once()
+
+	response, err := s.replicatorQueueProcessor.getTasks(context.Background(), cluster.TestAlternativeClusterName, -1)
+	s.Nil(err)
+	s.Equal(taskID, response.GetLastRetrievedMessageId())
+	s.False(response.GetHasMore())
+	s.Equal(1, len(response.ReplicationTasks))
+	s.Equal(replicator.ReplicationTaskTypeSyncShardStatus, response.ReplicationTasks[0].GetTaskType())
+}
+
+func (s *replicatorQueueProcessorSuite) TestGetTasks_CompleteAppliedTasks() {
+	s.mockShard.GetConfig().ReplicationTaskLongPollTimeout = dynamicconfig.GetDurationPropertyFn(10 * time.Millisecond)
+	s.mockShard.(*shardContextImpl).lastUpdated = time.Now()
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(cluster.TestAllClusterFailoverVersions)
+	lastReadTaskID := int64(5)
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    0,
+		MaxReadLevel: lastReadTaskID,
+		BatchSize:    s.replicatorQueueProcessor.options.BatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{{TaskID: 3}},
+	}, nil).Once()
+	s.mockExecutionMgr.On("CompleteReplicationTask", &persistence.CompleteReplicationTaskRequest{TaskID: 3}).Return(nil).Once()
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    3,
+		MaxReadLevel: lastReadTaskID,
+		BatchSize:    s.replicatorQueueProcessor.options.BatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{}, nil).Once()
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    lastReadTaskID,
+		MaxReadLevel: s.mockShard.GetTransferMaxReadLevel(),
+		BatchSize:    s.replicatorQueueProcessor.options.BatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{}, nil)
+
+	response, err := s.replicatorQueueProcessor.getTasks(context.Background(), cluster.TestAlternativeClusterName, lastReadTaskID)
+	s.Nil(err)
+	s.Equal(lastReadTaskID, response.GetLastRetrievedMessageId())
+	s.Equal(lastReadTaskID, s.mockShard.GetReplicatorAckLevel())
+	s.Equal(map[string]int64{cluster.TestAlternativeClusterName: lastReadTaskID}, s.mockShard.GetReplicatorClusterAckLevels())
+}
+
+func (s *replicatorQueueProcessorSuite) TestUpdateClusterAckLevel_LoadedFromShard() {
+	s.mockShard.(*shardContextImpl).lastUpdated = time.Now()
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(cluster.TestAllClusterFailoverVersions)
+	ackLevel := int64(10)
+	s.Nil(s.mockShard.UpdateReplicatorAckLevel(ackLevel))
+	s.Nil(s.mockShard.UpdateReplicatorClusterAckLevel(cluster.TestAlternativeClusterName, ackLevel))
+
+	processor := newReplicatorQueueProcessor(
+		s.mockShard, newHistoryCache(s.mockShard), s.mockProducer, s.mockExecutionMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr, s.logger,
+	).(*replicatorQueueProcessorImpl)
+	processor.updateClusterAckLevel(cluster.TestAlternativeClusterName, ackLevel-5)
+	s.Equal(map[string]int64{cluster.TestAlternativeClusterName: ackLevel}, processor.clusterAckLevels)
+	s.Equal(map[string]int64{cluster.TestAlternativeClusterName: ackLevel}, s.mockShard.GetReplicatorClusterAckLevels())
+	s.Equal(ackLevel, s.mockShard.GetReplicatorAckLevel())
+}
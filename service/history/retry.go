@@ -25,7 +25,6 @@ import (
 	"math"
 	"time"
 
-	"github.com/robfig/cron"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence"
@@ -54,6 +53,7 @@ func prepareActivityNextRetryWithNowTime(version int64, a *persistence.ActivityI
 	a.RequestID = ""
 	a.StartedTime = time.Time{}
 	a.TimerTaskStatus = TimerTaskStatusNone
+	a.LastFailureReason = errReason
 
 	return &persistence.ActivityRetryTimerTask{
 		Version:             a.Version,
@@ -68,12 +68,12 @@ func getBackoffForNextCronSchedule(cronSchedule string, nowTime time.Time) time.
 		return common.NoRetryBackoff
 	}
 
-	schedule, err := cron.ParseStandard(cronSchedule)
-	if err != nil {
+	nextScheduleTime := common.GetNextCronScheduleTime(cronSchedule, nowTime)
+	if nextScheduleTime.IsZero() {
 		return common.NoRetryBackoff
 	}
 
-	backoffInterval := schedule.Next(nowTime).Sub(nowTime)
+	backoffInterval := nextScheduleTime.Sub(nowTime)
 	roundedInterval := time.Second * time.Duration(math.Ceil(backoffInterval.Seconds()))
 	return roundedInterval
 }
@@ -67,6 +67,7 @@ func Test_NextRetry(t *testing.T) {
 	a.NotNil(retryTask)
 	a.Equal(version, retryTask.GetVersion())
 	a.Equal(now.Add(time.Second), retryTask.(*persistence.ActivityRetryTimerTask).VisibilityTimestamp)
+	a.Equal(reason, ai.LastFailureReason)
 
 	retryTask = prepareActivityNextRetryWithNowTime(version, ai, reason, now)
 	a.NotNil(retryTask)
@@ -146,8 +147,22 @@ func Test_NextCronSchedule(t *testing.T) {
 	backoff = getBackoffForNextCronSchedule(cronSpec, now.Add(backoff))
 	a.Equal(time.Minute, backoff)
 
+	// every day cron in a timezone
+	now, _ = time.Parse(time.RFC3339, "2018-12-17T08:08:00+00:00")
+	cronSpec = "CRON_TZ=America/New_York 0 10 * * *"
+	backoff = getBackoffForNextCronSchedule(cronSpec, now)
+	a.Equal(time.Hour*6+time.Minute*52, backoff)
+	cronSpec = "TZ=Asia/Tokyo 0 10 * * *"
+	backoff = getBackoffForNextCronSchedule(cronSpec, now)
+	a.Equal(time.Hour*16+time.Minute*52, backoff)
+
 	// invalid cron spec
 	cronSpec = "invalid-cron-spec"
 	backoff = getBackoffForNextCronSchedule(cronSpec, now)
 	a.Equal(common.NoRetryBackoff, backoff)
+
+	// invalid timezone
+	cronSpec = "CRON_TZ=Invalid/Location 0 10 * * *"
+	backoff = getBackoffForNextCronSchedule(cronSpec, now)
+	a.Equal(common.NoRetryBackoff, backoff)
 }
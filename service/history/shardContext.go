@@ -54,6 +54,8 @@ type (
 		UpdateTransferClusterAckLevel(cluster string, ackLevel int64) error
 		GetReplicatorAckLevel() int64
 		UpdateReplicatorAckLevel(ackLevel int64) error
+		GetReplicatorClusterAckLevels() map[string]int64
+		UpdateReplicatorClusterAckLevel(cluster string, ackLevel int64) error
 		GetTimerAckLevel() time.Time
 		UpdateTimerAckLevel(ackLevel time.Time) error
 		GetTimerClusterAckLevel(cluster string) time.Time
@@ -70,6 +72,7 @@ type (
 			*persistence.CreateWorkflowExecutionResponse, error)
 		UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error)
 		ResetMutableState(request *persistence.ResetMutableStateRequest) error
+		ResetWorkflowExecution(request *persistence.ResetWorkflowExecutionRequest) error
 		AppendHistoryEvents(request *persistence.AppendHistoryEventsRequest) (int, error)
 		AppendHistoryV2Events(request *persistence.AppendHistoryNodesRequest, domainID string) (int, error)
 		NotifyNewHistoryEvent(event *historyEventNotification) error
@@ -210,6 +213,26 @@ func (s *shardContextImpl) UpdateReplicatorAckLevel(ackLevel int64) error {
 	return s.updateShardInfoLocked()
 }
 
+func (s *shardContextImpl) GetReplicatorClusterAckLevels() map[string]int64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	ackLevels := make(map[string]int64, len(s.shardInfo.ClusterReplicationLevel))
+	for k, v := range s.shardInfo.ClusterReplicationLevel {
+		ackLevels[k] = v
+	}
+	return ackLevels
+}
+
+func (s *shardContextImpl) UpdateReplicatorClusterAckLevel(cluster string, ackLevel int64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.shardInfo.ClusterReplicationLevel[cluster] = ackLevel
+	s.shardInfo.StolenSinceRenew = 0
+	return s.updateShardInfoLocked()
+}
+
 func (s *shardContextImpl) GetTimerAckLevel() time.Time {
 	s.RLock()
 	defer s.RUnlock()
@@ -601,6 +624,91 @@ Reset_Loop:
 	return ErrMaxAttemptsExceeded
 }
 
+func (s *shardContextImpl) ResetWorkflowExecution(request *persistence.ResetWorkflowExecutionRequest) error {
+	encoding, err := s.getDefaultEncoding(request.ExecutionInfo.DomainID)
+	if err != nil {
+		return err
+	}
+	request.Encoding = encoding
+
+	s.Lock()
+	defer s.Unlock()
+
+	transferMaxReadLevel := int64(0)
+	// assign IDs for the transfer tasks
+	// Must be done under the shard lock to ensure transfer tasks are written to persistence in increasing
+	// ID order
+	var transferTasks []persistence.Task
+	transferTasks = append(transferTasks, request.CurrTransferTasks...)
+	transferTasks = append(transferTasks, request.TransferTasks...)
+	for _, task := range transferTasks {
+		id, err := s.getNextTransferTaskIDLocked()
+		if err != nil {
+			return err
+		}
+		s.logger.Debugf("Assigning transfer task ID: %v", id)
+		task.SetTaskID(id)
+		transferMaxReadLevel = id
+	}
+	defer s.updateMaxReadLevelLocked(transferMaxReadLevel)
+
+	if request.CurrExecutionInfo != nil {
+		if err := s.allocateTimerIDsLocked(request.CurrTimerTasks, request.CurrExecutionInfo.DomainID,
+			request.CurrExecutionInfo.WorkflowID); err != nil {
+			return err
+		}
+	}
+	if err := s.allocateTimerIDsLocked(request.TimerTasks, request.ExecutionInfo.DomainID,
+		request.ExecutionInfo.WorkflowID); err != nil {
+		return err
+	}
+
+Reset_Loop:
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		currentRangeID := s.getRangeID()
+		request.RangeID = currentRangeID
+		err := s.executionManager.ResetWorkflowExecution(request)
+		if err != nil {
+			switch err.(type) {
+			case *persistence.ConditionFailedError,
+				*persistence.CurrentWorkflowConditionFailedError,
+				*shared.ServiceBusyError,
+				*shared.LimitExceededError:
+				// No special handling required for these errors
+			case *persistence.ShardOwnershipLostError:
+				{
+					// RangeID might have been renewed by the same host while this update was in flight
+					// Retry the operation if we still have the shard ownership
+					if currentRangeID != s.getRangeID() {
+						continue Reset_Loop
+					} else {
+						// Shard is stolen, trigger shutdown of history engine
+						s.closeShard()
+					}
+				}
+			default:
+				{
+					// We have no idea if the write failed or will eventually make it to
+					// persistence. Increment RangeID to guarantee that subsequent reads
+					// will either see that write, or know for certain that it failed.
+					// This allows the callers to reliably check the outcome by performing
+					// a read.
+					err1 := s.renewRangeLocked(false)
+					if err1 != nil {
+						// At this point we have no choice but to unload the shard, so that it
+						// gets a new RangeID when it's reloaded.
+						s.closeShard()
+					}
+				}
+			}
+		}
+
+		return err
+	}
+
+	return ErrMaxAttemptsExceeded
+}
+
 func (s *shardContextImpl) AppendHistoryV2Events(request *persistence.AppendHistoryNodesRequest, domainID string) (int, error) {
 	encoding, err := s.getDefaultEncoding(domainID)
 	if err != nil {
@@ -1017,6 +1125,10 @@ func copyShardInfo(shardInfo *persistence.ShardInfo) *persistence.ShardInfo {
 	for k, v := range shardInfo.ClusterTimerAckLevel {
 		clusterTimerAckLevel[k] = v
 	}
+	clusterReplicationLevel := make(map[string]int64)
+	for k, v := range shardInfo.ClusterReplicationLevel {
+		clusterReplicationLevel[k] = v
+	}
 	shardInfoCopy := &persistence.ShardInfo{
 		ShardID:                   shardInfo.ShardID,
 		Owner:                     shardInfo.Owner,
@@ -1029,6 +1141,7 @@ func copyShardInfo(shardInfo *persistence.ShardInfo) *persistence.ShardInfo {
 		TimerFailoverLevels:       timerFailoverLevels,
 		ClusterTransferAckLevel:   clusterTransferAckLevel,
 		ClusterTimerAckLevel:      clusterTimerAckLevel,
+		ClusterReplicationLevel:   clusterReplicationLevel,
 		DomainNotificationVersion: shardInfo.DomainNotificationVersion,
 	}
 
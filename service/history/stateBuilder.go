@@ -327,6 +327,10 @@ func (b *stateBuilderImpl) applyEvents(domainID, requestID string, execution sha
 		case shared.EventTypeMarkerRecorded:
 			// No mutable state action is needed
 
+		case shared.EventTypeUpsertWorkflowSearchAttributes:
+			b.msBuilder.ReplicateUpsertWorkflowSearchAttributesEvent(event)
+			b.transferTasks = append(b.transferTasks, b.scheduleUpsertWorkflowSearchAttributesTransferTask())
+
 		case shared.EventTypeWorkflowExecutionSignaled:
 			b.msBuilder.ReplicateWorkflowExecutionSignaled(event)
 
@@ -511,6 +515,10 @@ func (b *stateBuilderImpl) scheduleDeleteHistoryTransferTask() persistence.Task
 	return &persistence.CloseExecutionTask{}
 }
 
+func (b *stateBuilderImpl) scheduleUpsertWorkflowSearchAttributesTransferTask() persistence.Task {
+	return &persistence.UpsertWorkflowSearchAttributesTask{}
+}
+
 func (b *stateBuilderImpl) scheduleDecisionTimerTask(event *shared.HistoryEvent, scheduleID int64, attempt int64,
 	timeoutSecond int32) persistence.Task {
 	return b.getTimerBuilder(event).AddStartToCloseDecisionTimoutTask(scheduleID, attempt, timeoutSecond)
@@ -1335,6 +1335,39 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeMarkerRecorded() {
 	s.Empty(s.stateBuilder.newRunTransferTasks)
 }
 
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeUpsertWorkflowSearchAttributes() {
+	version := int64(1)
+	requestID := uuid.New()
+	domainID := validDomainID
+	execution := shared.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	now := time.Now()
+	evenType := shared.EventTypeUpsertWorkflowSearchAttributes
+	event := &shared.HistoryEvent{
+		Version:   common.Int64Ptr(version),
+		EventId:   common.Int64Ptr(130),
+		Timestamp: common.Int64Ptr(now.UnixNano()),
+		EventType: &evenType,
+		UpsertWorkflowSearchAttributesEventAttributes: &shared.UpsertWorkflowSearchAttributesEventAttributes{
+			SearchAttributes: &shared.SearchAttributes{
+				IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"some random keyword"`)},
+			},
+		},
+	}
+	s.mockMutableState.On("ReplicateUpsertWorkflowSearchAttributesEvent", event).Once()
+	s.mockUpdateVersion(event)
+
+	s.stateBuilder.applyEvents(domainID, requestID, execution, s.toHistory(event), nil, 0, 0)
+
+	s.Empty(s.stateBuilder.timerTasks)
+	s.Equal([]persistence.Task{&persistence.UpsertWorkflowSearchAttributesTask{}}, s.stateBuilder.transferTasks)
+	s.Empty(s.stateBuilder.newRunTimerTasks)
+	s.Empty(s.stateBuilder.newRunTransferTasks)
+}
+
 func (s *stateBuilderSuite) TestApplyEvents_EventTypeExternalWorkflowExecutionSignaled() {
 	version := int64(1)
 	requestID := uuid.New()
@@ -560,8 +560,8 @@ Update_History_Loop:
 			return nil
 		}
 
-		if msBuilder.HasPendingDecisionTask() {
-			// already has decision task
+		if msBuilder.HasProcessedOrPendingDecisionTask() {
+			// already has decision task, e.g. the run was triggered before the backoff timer fired
 			return nil
 		}
 
@@ -220,6 +220,12 @@ func (t *transferQueueActiveProcessorImpl) process(qTask queueTaskInfo) (int, er
 	case persistence.TransferTaskTypeStartChildExecution:
 		return metrics.TransferActiveTaskStartChildExecutionScope, t.processStartChildExecution(task)
 
+	case persistence.TransferTaskTypeUpsertWorkflowSearchAttributes:
+		return metrics.TransferActiveTaskUpsertWorkflowSearchAttributesScope, t.processUpsertWorkflowSearchAttributes(task)
+
+	case persistence.TransferTaskTypeRecordWorkflowStarted:
+		return metrics.TransferActiveTaskRecordWorkflowStartedScope, t.processRecordWorkflowStarted(task)
+
 	default:
 		return metrics.TransferActiveQueueProcessorScope, errUnknownTransferTask
 	}
@@ -309,6 +315,8 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence
 	decisionTimeout := common.MinInt32(workflowTimeout, common.MaxTaskTimeout)
 	wfTypeName := executionInfo.WorkflowTypeName
 	startTimestamp := executionInfo.StartTimestamp
+	memo := executionInfo.Memo // the memo is never changed after the start of the execution
+	searchAttributes := copySearchAttributes(executionInfo.SearchAttributes)
 	if msBuilder.IsStickyTaskListEnabled() {
 		tasklist.Name = common.StringPtr(executionInfo.StickyTaskList)
 		tasklist.Kind = common.TaskListKindPtr(workflow.TaskListKindSticky)
@@ -318,7 +326,7 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
 	if task.ScheduleID <= common.FirstEventID+2 {
-		err = t.recordWorkflowStarted(task.DomainID, execution, wfTypeName, startTimestamp.UnixNano(), workflowTimeout)
+		err = t.recordWorkflowStarted(task.DomainID, execution, wfTypeName, startTimestamp.UnixNano(), workflowTimeout, memo, searchAttributes)
 		if err != nil {
 			return err
 		}
@@ -378,12 +386,15 @@ func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persisten
 	workflowCloseTimestamp := msBuilder.GetLastUpdatedTimestamp()
 	workflowCloseStatus := getWorkflowExecutionCloseStatus(executionInfo.CloseStatus)
 	workflowHistoryLength := msBuilder.GetNextEventID() - 1
+	memo := executionInfo.Memo
+	searchAttributes := copySearchAttributes(executionInfo.SearchAttributes)
 
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
 	err = t.recordWorkflowClosed(
 		domainID, execution, workflowTypeName, workflowStartTimestamp, workflowCloseTimestamp, workflowCloseStatus, workflowHistoryLength,
+		memo, searchAttributes,
 	)
 	if err != nil {
 		return err
@@ -745,6 +756,8 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(task *pers
 				WorkflowIdReusePolicy: attributes.WorkflowIdReusePolicy,
 				ChildPolicy:           attributes.ChildPolicy,
 				RetryPolicy:           attributes.RetryPolicy,
+				Memo:                  attributes.Memo,
+				SearchAttributes:      attributes.SearchAttributes,
 			},
 			ParentExecutionInfo: &h.ParentExecutionInfo{
 				DomainUUID: common.StringPtr(domainID),
@@ -795,6 +808,82 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(task *pers
 	return err
 }
 
+func (t *transferQueueActiveProcessorImpl) processUpsertWorkflowSearchAttributes(task *persistence.TransferTaskInfo) (retError error) {
+
+	var err error
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(task.WorkflowID),
+		RunId:      common.StringPtr(task.RunID),
+	}
+
+	context, release, err := t.cache.getOrCreateWorkflowExecution(task.DomainID, execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	var msBuilder mutableState
+	msBuilder, err = loadMutableStateForTransferTask(context, task, t.metricsClient, t.logger)
+	if err != nil {
+		return err
+	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
+		// the close execution task records the latest search attributes
+		return nil
+	}
+
+	// there is no need to verify the task version, the task always records
+	// the latest search attributes of the mutable state
+	executionInfo := msBuilder.GetExecutionInfo()
+	workflowTimeout := executionInfo.WorkflowTimeout
+	wfTypeName := executionInfo.WorkflowTypeName
+	startTimestamp := executionInfo.StartTimestamp
+	memo := executionInfo.Memo
+	searchAttributes := copySearchAttributes(executionInfo.SearchAttributes)
+
+	// release the context lock since we no longer need mutable state builder and
+	// the rest of logic is making RPC call, which takes time.
+	release(nil)
+	return t.upsertWorkflowExecution(task.DomainID, execution, wfTypeName, startTimestamp.UnixNano(),
+		task.VisibilityTimestamp.UnixNano(), workflowTimeout, memo, searchAttributes)
+}
+
+func (t *transferQueueActiveProcessorImpl) processRecordWorkflowStarted(task *persistence.TransferTaskInfo) (retError error) {
+
+	var err error
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(task.WorkflowID),
+		RunId:      common.StringPtr(task.RunID),
+	}
+
+	context, release, err := t.cache.getOrCreateWorkflowExecution(task.DomainID, execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	var msBuilder mutableState
+	msBuilder, err = loadMutableStateForTransferTask(context, task, t.metricsClient, t.logger)
+	if err != nil {
+		return err
+	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
+		// the close execution task records the closed execution
+		return nil
+	}
+
+	executionInfo := msBuilder.GetExecutionInfo()
+	workflowTimeout := executionInfo.WorkflowTimeout
+	wfTypeName := executionInfo.WorkflowTypeName
+	startTimestamp := executionInfo.StartTimestamp
+	memo := executionInfo.Memo
+	searchAttributes := copySearchAttributes(executionInfo.SearchAttributes)
+
+	// release the context lock since we no longer need mutable state builder and
+	// the rest of logic is making RPC call, which takes time.
+	release(nil)
+	return t.recordWorkflowStarted(task.DomainID, execution, wfTypeName, startTimestamp.UnixNano(), workflowTimeout,
+		memo, searchAttributes)
+}
+
 func (t *transferQueueActiveProcessorImpl) recordChildExecutionStarted(task *persistence.TransferTaskInfo,
 	context workflowExecutionContext, initiatedAttributes *workflow.StartChildWorkflowExecutionInitiatedEventAttributes,
 	runID string) error {
@@ -139,7 +139,7 @@ func (t *transferQueueProcessorBase) pushDecision(task *persistence.TransferTask
 
 func (t *transferQueueProcessorBase) recordWorkflowStarted(
 	domainID string, execution workflow.WorkflowExecution, workflowTypeName string,
-	startTimeUnixNano int64, workflowTimeout int32) error {
+	startTimeUnixNano int64, workflowTimeout int32, memo map[string][]byte, searchAttributes map[string][]byte) error {
 	domain := defaultDomainName
 	isSampledEnabled := false
 	wid := execution.GetWorkflowId()
@@ -161,33 +161,90 @@ func (t *transferQueueProcessorBase) recordWorkflowStarted(
 	}
 
 	// publish to kafka
-	if t.visibilityProducer != nil {
-		msg := &messaging.OpenWorkflowMsg{
-			Domain:     domain,
-			WorkflowID: wid,
-			RunID:      rid,
-			StartTime:  startTimeUnixNano,
-		}
-		err := t.visibilityProducer.Publish(msg)
-		if err != nil {
+	err = t.publishVisibilityMsg(&messaging.VisibilityMsg{
+		MsgType:          messaging.VisibilityMsgTypeOpen,
+		DomainID:         domainID,
+		Domain:           domain,
+		WorkflowID:       wid,
+		RunID:            rid,
+		WorkflowTypeName: workflowTypeName,
+		StartTime:        startTimeUnixNano,
+		Memo:             memo,
+		SearchAttributes: searchAttributes,
+		Version:          startTimeUnixNano,
+	})
+	if err != nil {
+		return err
+	}
+
+	return t.visibilityMgr.RecordWorkflowExecutionStarted(&persistence.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       domainID,
+		Domain:           domain,
+		Execution:        execution,
+		WorkflowTypeName: workflowTypeName,
+		StartTimestamp:   startTimeUnixNano,
+		WorkflowTimeout:  int64(workflowTimeout),
+		Memo:             memo,
+		SearchAttributes: searchAttributes,
+	})
+}
+
+func (t *transferQueueProcessorBase) upsertWorkflowExecution(
+	domainID string, execution workflow.WorkflowExecution, workflowTypeName string,
+	startTimeUnixNano int64, upsertTimeUnixNano int64, workflowTimeout int32,
+	memo map[string][]byte, searchAttributes map[string][]byte) error {
+	domain := defaultDomainName
+	isSampledEnabled := false
+	wid := execution.GetWorkflowId()
+
+	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); !ok {
 			return err
 		}
+	} else {
+		domain = domainEntry.GetInfo().Name
+		isSampledEnabled = domainEntry.IsSampledForLongerRetentionEnabled(wid)
 	}
 
-	return t.visibilityMgr.RecordWorkflowExecutionStarted(&persistence.RecordWorkflowExecutionStartedRequest{
+	// if sampled for longer retention is enabled, only record those sampled events
+	if isSampledEnabled && !domainEntry.IsSampledForLongerRetention(wid) {
+		return nil
+	}
+
+	err = t.publishVisibilityMsg(&messaging.VisibilityMsg{
+		MsgType:          messaging.VisibilityMsgTypeUpsert,
+		DomainID:         domainID,
+		Domain:           domain,
+		WorkflowID:       wid,
+		RunID:            execution.GetRunId(),
+		WorkflowTypeName: workflowTypeName,
+		StartTime:        startTimeUnixNano,
+		Memo:             memo,
+		SearchAttributes: searchAttributes,
+		Version:          upsertTimeUnixNano,
+	})
+	if err != nil {
+		return err
+	}
+
+	return t.visibilityMgr.UpsertWorkflowExecution(&persistence.UpsertWorkflowExecutionRequest{
 		DomainUUID:       domainID,
 		Domain:           domain,
 		Execution:        execution,
 		WorkflowTypeName: workflowTypeName,
 		StartTimestamp:   startTimeUnixNano,
+		UpsertTimestamp:  upsertTimeUnixNano,
 		WorkflowTimeout:  int64(workflowTimeout),
+		Memo:             memo,
+		SearchAttributes: searchAttributes,
 	})
 }
 
 func (t *transferQueueProcessorBase) recordWorkflowClosed(
 	domainID string, execution workflow.WorkflowExecution, workflowTypeName string,
 	startTimeUnixNano int64, endTimeUnixNano int64, closeStatus workflow.WorkflowExecutionCloseStatus,
-	historyLength int64) error {
+	historyLength int64, memo map[string][]byte, searchAttributes map[string][]byte) error {
 	// Record closing in visibility store
 	retentionSeconds := int64(0)
 	domain := defaultDomainName
@@ -212,6 +269,25 @@ func (t *transferQueueProcessorBase) recordWorkflowClosed(
 		return nil
 	}
 
+	err = t.publishVisibilityMsg(&messaging.VisibilityMsg{
+		MsgType:          messaging.VisibilityMsgTypeClosed,
+		DomainID:         domainID,
+		Domain:           domain,
+		WorkflowID:       wid,
+		RunID:            execution.GetRunId(),
+		WorkflowTypeName: workflowTypeName,
+		StartTime:        startTimeUnixNano,
+		CloseTime:        endTimeUnixNano,
+		CloseStatus:      int32(closeStatus),
+		HistoryLength:    historyLength,
+		Memo:             memo,
+		SearchAttributes: searchAttributes,
+		Version:          endTimeUnixNano,
+	})
+	if err != nil {
+		return err
+	}
+
 	return t.visibilityMgr.RecordWorkflowExecutionClosed(&persistence.RecordWorkflowExecutionClosedRequest{
 		DomainUUID:       domainID,
 		Domain:           domain,
@@ -222,5 +298,29 @@ func (t *transferQueueProcessorBase) recordWorkflowClosed(
 		Status:           closeStatus,
 		HistoryLength:    historyLength,
 		RetentionSeconds: retentionSeconds,
+		Memo:             memo,
+		SearchAttributes: searchAttributes,
 	})
 }
+
+// publishVisibilityMsg publishes the visibility record to kafka if visibility to kafka is enabled
+func (t *transferQueueProcessorBase) publishVisibilityMsg(msg *messaging.VisibilityMsg) error {
+	if t.visibilityProducer == nil {
+		return nil
+	}
+	return t.visibilityProducer.Publish(msg)
+}
+
+// copySearchAttributes copies the search attributes of the mutable state, so that
+// they can be used after the lock of the workflow execution is released
+func copySearchAttributes(input map[string][]byte) map[string][]byte {
+	if input == nil {
+		return nil
+	}
+
+	result := make(map[string][]byte, len(input))
+	for key, value := range input {
+		result[key] = value
+	}
+	return result
+}
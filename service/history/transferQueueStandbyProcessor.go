@@ -145,6 +145,12 @@ func (t *transferQueueStandbyProcessorImpl) process(qTask queueTaskInfo) (int, e
 	case persistence.TransferTaskTypeStartChildExecution:
 		return metrics.TransferStandbyTaskStartChildExecutionScope, t.processStartChildExecution(task, lastAttempt)
 
+	case persistence.TransferTaskTypeUpsertWorkflowSearchAttributes:
+		return metrics.TransferStandbyTaskUpsertWorkflowSearchAttributesScope, t.processUpsertWorkflowSearchAttributes(task)
+
+	case persistence.TransferTaskTypeRecordWorkflowStarted:
+		return metrics.TransferStandbyTaskRecordWorkflowStartedScope, t.processRecordWorkflowStarted(task)
+
 	default:
 		return metrics.TransferStandbyQueueProcessorScope, errUnknownTransferTask
 	}
@@ -214,7 +220,7 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(transferTask *pe
 
 		if !isPending {
 			if markWorkflowAsOpen {
-				err := t.recordWorkflowStarted(transferTask.DomainID, execution, wfTypeName, startTimestamp.UnixNano(), workflowTimeout)
+				err := t.recordWorkflowStarted(transferTask.DomainID, execution, wfTypeName, startTimestamp.UnixNano(), workflowTimeout, executionInfo.Memo, executionInfo.SearchAttributes)
 				if err != nil {
 					return err
 				}
@@ -230,7 +236,7 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(transferTask *pe
 		}
 
 		if markWorkflowAsOpen {
-			err = t.recordWorkflowStarted(transferTask.DomainID, execution, wfTypeName, startTimestamp.UnixNano(), workflowTimeout)
+			err = t.recordWorkflowStarted(transferTask.DomainID, execution, wfTypeName, startTimestamp.UnixNano(), workflowTimeout, executionInfo.Memo, executionInfo.SearchAttributes)
 		}
 
 		now := t.shard.GetCurrentTime(t.clusterName)
@@ -291,6 +297,7 @@ func (t *transferQueueStandbyProcessorImpl) processCloseExecution(transferTask *
 
 		return t.recordWorkflowClosed(
 			transferTask.DomainID, execution, workflowTypeName, workflowStartTimestamp, workflowCloseTimestamp, workflowCloseStatus, workflowHistoryLength,
+			executionInfo.Memo, executionInfo.SearchAttributes,
 		)
 	}, standbyTaskPostActionNoOp) // no op post action, since the entire workflow is finished
 }
@@ -407,6 +414,42 @@ func (t *transferQueueStandbyProcessorImpl) processStartChildExecution(transferT
 	}, postProcessingFn)
 }
 
+func (t *transferQueueStandbyProcessorImpl) processUpsertWorkflowSearchAttributes(transferTask *persistence.TransferTaskInfo) error {
+
+	processTaskIfClosed := false
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(transferTask.WorkflowID),
+		RunId:      common.StringPtr(transferTask.RunID),
+	}
+
+	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
+
+		executionInfo := msBuilder.GetExecutionInfo()
+		return t.upsertWorkflowExecution(
+			transferTask.DomainID, execution, executionInfo.WorkflowTypeName, executionInfo.StartTimestamp.UnixNano(),
+			transferTask.VisibilityTimestamp.UnixNano(), executionInfo.WorkflowTimeout, executionInfo.Memo, executionInfo.SearchAttributes,
+		)
+	}, standbyTaskPostActionNoOp)
+}
+
+func (t *transferQueueStandbyProcessorImpl) processRecordWorkflowStarted(transferTask *persistence.TransferTaskInfo) error {
+
+	processTaskIfClosed := false
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(transferTask.WorkflowID),
+		RunId:      common.StringPtr(transferTask.RunID),
+	}
+
+	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
+
+		executionInfo := msBuilder.GetExecutionInfo()
+		return t.recordWorkflowStarted(
+			transferTask.DomainID, execution, executionInfo.WorkflowTypeName, executionInfo.StartTimestamp.UnixNano(),
+			executionInfo.WorkflowTimeout, executionInfo.Memo, executionInfo.SearchAttributes,
+		)
+	}, standbyTaskPostActionNoOp)
+}
+
 func (t *transferQueueStandbyProcessorImpl) processTransfer(processTaskIfClosed bool, transferTask *persistence.TransferTaskInfo,
 	action func(mutableState) error, postAction func() error) (retError error) {
 	context, release, err := t.cache.getOrCreateWorkflowExecution(t.getDomainIDAndWorkflowExecution(transferTask))
@@ -629,6 +629,7 @@ func (c *workflowExecutionContextImpl) appendFirstBatchHistoryForContinueAsNew(c
 			BranchToken:   newStateBuilder.GetCurrentBranch(),
 			Events:        history.Events,
 			TransactionID: transactionID,
+			Info:          persistence.BuildHistoryGarbageCleanupInfo(domainID, executionInfo.WorkflowID, executionInfo.RunID),
 		}, newStateBuilder.GetExecutionInfo().DomainID)
 	} else {
 		historySize, err = c.shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
@@ -0,0 +1,440 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const workflowResetReason = "reset workflow"
+
+type (
+	workflowResetor interface {
+		ResetWorkflowExecution(ctx context.Context, resetRequest *workflow.ResetWorkflowExecutionRequest,
+			baseContext workflowExecutionContext, baseMutableState mutableState,
+			currContext workflowExecutionContext, currMutableState mutableState) (*workflow.ResetWorkflowExecutionResponse, error)
+	}
+
+	workflowResetorImpl struct {
+		eng *historyEngineImpl
+	}
+)
+
+var _ workflowResetor = (*workflowResetorImpl)(nil)
+
+func newWorkflowResetor(historyEngine *historyEngineImpl) *workflowResetorImpl {
+	return &workflowResetorImpl{
+		eng: historyEngine,
+	}
+}
+
+// ResetWorkflowExecution creates a new run of the workflow from the decision finish event of the base run. The new run
+// keeps the history of the base run up to that event, fails the decision that was started at that point, re-applies the
+// signals received after that point and schedules a new decision. The current run, if still running, is terminated.
+func (w *workflowResetorImpl) ResetWorkflowExecution(ctx context.Context, request *workflow.ResetWorkflowExecutionRequest,
+	baseContext workflowExecutionContext, baseMutableState mutableState,
+	currContext workflowExecutionContext, currMutableState mutableState) (*workflow.ResetWorkflowExecutionResponse, error) {
+
+	if err := w.validateResetWorkflowBeforeReplay(baseMutableState, request); err != nil {
+		return nil, err
+	}
+
+	domainID := baseContext.getDomainID()
+	baseRunID := baseContext.getExecution().GetRunId()
+	newRunID := uuid.New()
+	newMutableState, signals, err := w.replayBaseRun(domainID, baseMutableState, request, newRunID)
+	if err != nil {
+		return nil, err
+	}
+
+	resetEventID := request.GetDecisionFinishEventId()
+	forkResp, err := w.eng.historyV2Mgr.ForkHistoryBranch(&persistence.ForkHistoryBranchRequest{
+		ForkBranchToken: baseMutableState.GetCurrentBranch(),
+		ForkNodeID:      resetEventID,
+		Info:            persistence.BuildHistoryGarbageCleanupInfo(domainID, baseContext.getExecution().GetWorkflowId(), newRunID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	newMutableState.executionInfo.BranchToken = forkResp.NewBranchToken
+
+	// the forked branch would be leaked if the new run is not persisted
+	needDeleteBranch := true
+	defer func() {
+		if needDeleteBranch {
+			w.eng.historyV2Mgr.DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{
+				BranchToken: forkResp.NewBranchToken,
+			})
+		}
+	}()
+
+	transferTasks, timerTasks, err := w.addResetEvents(domainID, newMutableState, request, baseRunID, newRunID, signals)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionID, err := w.eng.shard.GetNextTransferTaskID()
+	if err != nil {
+		return nil, err
+	}
+	historySize, err := w.eng.shard.AppendHistoryV2Events(&persistence.AppendHistoryNodesRequest{
+		IsNewBranch:   true,
+		BranchToken:   newMutableState.GetCurrentBranch(),
+		Events:        newMutableState.GetHistoryBuilder().GetHistory().Events,
+		TransactionID: transactionID,
+		Info:          persistence.BuildHistoryGarbageCleanupInfo(domainID, baseContext.getExecution().GetWorkflowId(), newRunID),
+	}, domainID)
+	if err != nil {
+		return nil, err
+	}
+	newMutableState.IncrementHistorySize(historySize)
+	newMutableState.executionInfo.SetLastFirstEventID(resetEventID)
+
+	var activityInfos []*persistence.ActivityInfo
+	for _, ai := range newMutableState.GetPendingActivityInfos() {
+		activityInfos = append(activityInfos, ai)
+	}
+	var timerInfos []*persistence.TimerInfo
+	for _, ti := range newMutableState.GetPendingTimerInfos() {
+		timerInfos = append(timerInfos, ti)
+	}
+	var signalRequestedIDs []string
+	for id := range newMutableState.pendingSignalRequestedIDs {
+		signalRequestedIDs = append(signalRequestedIDs, id)
+	}
+
+	resetRequest := &persistence.ResetWorkflowExecutionRequest{
+		PrevRunID:                currContext.getExecution().GetRunId(),
+		ExecutionInfo:            newMutableState.GetExecutionInfo(),
+		ReplicationState:         newMutableState.GetReplicationState(),
+		TransferTasks:            transferTasks,
+		TimerTasks:               timerTasks,
+		InsertActivityInfos:      activityInfos,
+		InsertTimerInfos:         timerInfos,
+		InsertSignalRequestedIDs: signalRequestedIDs,
+	}
+	// the mutable state of the current run is modified by the termination, it is reloaded by the next user of the
+	// context whether the reset succeeds or not
+	defer currContext.clear()
+	if currMutableState.IsWorkflowExecutionRunning() {
+		if err := w.terminateCurrentRun(domainID, currContext, currMutableState, request, newRunID, resetRequest); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.eng.shard.ResetWorkflowExecution(resetRequest); err != nil {
+		w.logError(domainID, baseContext.getExecution(), "Failed to persist the new run of a workflow reset.", err)
+		return nil, err
+	}
+	needDeleteBranch = false
+
+	if resetRequest.CurrExecutionInfo != nil {
+		w.eng.shard.NotifyNewHistoryEvent(newHistoryEventNotification(
+			domainID,
+			currContext.getExecution(),
+			currMutableState.GetLastFirstEventID(),
+			currMutableState.GetNextEventID(),
+			currMutableState.GetPreviousStartedEventID(),
+			currMutableState.IsWorkflowExecutionRunning(),
+		))
+		w.eng.timerProcessor.NotifyNewTimers(w.eng.currentClusterName, w.eng.shard.GetCurrentTime(w.eng.currentClusterName),
+			resetRequest.CurrTimerTasks)
+	}
+	w.eng.timerProcessor.NotifyNewTimers(w.eng.currentClusterName, w.eng.shard.GetCurrentTime(w.eng.currentClusterName), timerTasks)
+	return &workflow.ResetWorkflowExecutionResponse{
+		RunId: common.StringPtr(newRunID),
+	}, nil
+}
+
+func (w *workflowResetorImpl) validateResetWorkflowBeforeReplay(baseMutableState mutableState,
+	request *workflow.ResetWorkflowExecutionRequest) error {
+
+	if baseMutableState.GetEventStoreVersion() != persistence.EventStoreVersionV2 {
+		return &workflow.BadRequestError{Message: "Reset is only supported for workflows using events V2."}
+	}
+	if baseMutableState.GetReplicationState() != nil {
+		return &workflow.BadRequestError{Message: "Reset is not supported for global domains."}
+	}
+	resetEventID := request.GetDecisionFinishEventId()
+	if resetEventID <= common.FirstEventID || resetEventID >= baseMutableState.GetNextEventID() {
+		return &workflow.BadRequestError{
+			Message: fmt.Sprintf("DecisionFinishEventId %v is out of range, the base run has %v events.",
+				resetEventID, baseMutableState.GetNextEventID()-1),
+		}
+	}
+	return nil
+}
+
+// replayBaseRun rebuilds the mutable state of the base run as it was right before the reset event, and collects the
+// signals received from that event on
+func (w *workflowResetorImpl) replayBaseRun(domainID string, baseMutableState mutableState,
+	request *workflow.ResetWorkflowExecutionRequest, newRunID string) (*mutableStateBuilder, []*workflow.HistoryEvent, error) {
+
+	baseInfo := baseMutableState.GetExecutionInfo()
+	resetEventID := request.GetDecisionFinishEventId()
+	newExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(baseInfo.WorkflowID),
+		RunId:      common.StringPtr(newRunID),
+	}
+
+	newMutableState := newMutableStateBuilderWithReplicationState(
+		w.eng.currentClusterName,
+		w.eng.shard.GetConfig(),
+		w.eng.logger,
+		common.EmptyVersion,
+	)
+	newMutableState.executionInfo.EventStoreVersion = persistence.EventStoreVersionV2
+	sBuilder := newStateBuilder(w.eng.shard, newMutableState, w.eng.logger)
+
+	var signals []*workflow.HistoryEvent
+	foundResetEvent := false
+	var nextPageToken []byte
+	for hasMore := true; hasMore; hasMore = len(nextPageToken) > 0 {
+		response, err := w.eng.historyV2Mgr.ReadHistoryBranchByBatch(&persistence.ReadHistoryBranchRequest{
+			BranchToken:   baseMutableState.GetCurrentBranch(),
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    baseMutableState.GetNextEventID(),
+			PageSize:      defaultHistoryPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		nextPageToken = response.NextPageToken
+
+		for _, batch := range response.History {
+			events := batch.Events
+			if len(events) == 0 {
+				continue
+			}
+			firstEvent := events[0]
+			lastEvent := events[len(events)-1]
+
+			if firstEvent.GetEventId() < resetEventID {
+				if lastEvent.GetEventId() >= resetEventID {
+					return nil, nil, &workflow.BadRequestError{
+						Message: fmt.Sprintf("Event %v is not a decision finish event.", resetEventID),
+					}
+				}
+				// NOTE: passing 0 as newRunEventStoreVersion is safe here, since the base run is not continued as new
+				// before the reset event
+				if _, _, _, err := sBuilder.applyEvents(domainID, request.GetRequestId(), newExecution, events, nil,
+					persistence.EventStoreVersionV2, 0); err != nil {
+					return nil, nil, err
+				}
+				newMutableState.executionInfo.SetLastFirstEventID(firstEvent.GetEventId())
+				continue
+			}
+
+			if firstEvent.GetEventId() == resetEventID {
+				switch firstEvent.GetEventType() {
+				case workflow.EventTypeDecisionTaskCompleted,
+					workflow.EventTypeDecisionTaskFailed,
+					workflow.EventTypeDecisionTaskTimedOut:
+					foundResetEvent = true
+				}
+			}
+			for _, event := range events {
+				if event.GetEventType() == workflow.EventTypeWorkflowExecutionSignaled {
+					signals = append(signals, event)
+				}
+			}
+		}
+	}
+
+	if !foundResetEvent {
+		return nil, nil, &workflow.BadRequestError{
+			Message: fmt.Sprintf("Event %v is not a decision finish event.", resetEventID),
+		}
+	}
+	if err := w.validateResetWorkflowAfterReplay(newMutableState); err != nil {
+		return nil, nil, err
+	}
+
+	// this is a local domain, so the new run does not carry any replication state
+	newMutableState.replicationState = nil
+	// Applying events to mutableState does not move the nextEventID.  Explicitly set nextEventID to new value
+	newMutableState.executionInfo.SetNextEventID(resetEventID)
+	newMutableState.executionInfo.StartTimestamp = baseInfo.StartTimestamp
+	newMutableState.executionInfo.LastUpdatedTimestamp = w.eng.shard.GetTimeSource().Now()
+	return newMutableState, signals, nil
+}
+
+func (w *workflowResetorImpl) validateResetWorkflowAfterReplay(newMutableState *mutableStateBuilder) error {
+	if !newMutableState.HasInFlightDecisionTask() {
+		return &workflow.BadRequestError{Message: "There is no started decision at the reset point."}
+	}
+	if len(newMutableState.GetPendingChildExecutionInfos()) > 0 {
+		return &workflow.BadRequestError{Message: "Reset is not supported when there are pending child workflows at the reset point."}
+	}
+	if len(newMutableState.pendingRequestCancelInfoIDs) > 0 {
+		return &workflow.BadRequestError{Message: "Reset is not supported when there are pending cancel requests at the reset point."}
+	}
+	if len(newMutableState.pendingSignalInfoIDs) > 0 {
+		return &workflow.BadRequestError{Message: "Reset is not supported when there are pending signals to other workflows at the reset point."}
+	}
+	return nil
+}
+
+// addResetEvents fails the started decision of the new run, re-applies the signals and schedules a new decision. It
+// returns the tasks which need to be created for the new run.
+func (w *workflowResetorImpl) addResetEvents(domainID string, newMutableState *mutableStateBuilder,
+	request *workflow.ResetWorkflowExecutionRequest, baseRunID, newRunID string,
+	signals []*workflow.HistoryEvent) ([]persistence.Task, []persistence.Task, error) {
+
+	di, _ := newMutableState.GetInFlightDecisionTask()
+	if newMutableState.AddDecisionTaskResetFailedEvent(di.ScheduleID, di.StartedID, request.GetReason(),
+		request.GetIdentity(), baseRunID, newRunID) == nil {
+		return nil, nil, &workflow.InternalServiceError{Message: "Failed to add decision failed event."}
+	}
+
+	for _, event := range signals {
+		attributes := event.WorkflowExecutionSignaledEventAttributes
+		if newMutableState.AddWorkflowExecutionSignaled(attributes.GetSignalName(), attributes.Input,
+			attributes.GetIdentity()) == nil {
+			return nil, nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution signaled event."}
+		}
+	}
+
+	di = newMutableState.AddDecisionTaskScheduledEvent()
+	if di == nil {
+		return nil, nil, &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
+	}
+	// the new run is not started by a workflow started event, so its visibility record is created by a dedicated task
+	transferTasks := []persistence.Task{
+		&persistence.DecisionTask{
+			DomainID:   domainID,
+			TaskList:   di.TaskList,
+			ScheduleID: di.ScheduleID,
+		},
+		&persistence.RecordWorkflowStartedTask{},
+	}
+
+	// activities which were not started at the reset point are dispatched again, the timers of all activities and user
+	// timers are created from scratch for the new run
+	for _, ai := range newMutableState.GetPendingActivityInfos() {
+		if ai.StartedID == common.EmptyEventID {
+			transferTasks = append(transferTasks, &persistence.ActivityTask{
+				DomainID:   ai.DomainID,
+				TaskList:   ai.TaskList,
+				ScheduleID: ai.ScheduleID,
+			})
+		}
+		ai.TimerTaskStatus = TimerTaskStatusNone
+		if err := newMutableState.UpdateActivity(ai); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, ti := range newMutableState.GetPendingTimerInfos() {
+		ti.TaskID = TimerTaskStatusNone
+		newMutableState.UpdateUserTimer(ti.TimerID, ti)
+	}
+
+	var timerTasks []persistence.Task
+	tBuilder := w.eng.getTimerBuilder(&workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(newMutableState.executionInfo.WorkflowID),
+		RunId:      common.StringPtr(newRunID),
+	})
+	if timerTask := tBuilder.GetActivityTimerTaskIfNeeded(newMutableState); timerTask != nil {
+		timerTasks = append(timerTasks, timerTask)
+	}
+	tBuilder.GetUserTimers(newMutableState)
+	if timerTask := tBuilder.GetUserTimerTaskIfNeeded(newMutableState); timerTask != nil {
+		timerTasks = append(timerTasks, timerTask)
+	}
+
+	// the new run gets the full workflow timeout, counting from the reset
+	now := w.eng.shard.GetTimeSource().Now()
+	duration := time.Duration(newMutableState.executionInfo.WorkflowTimeout) * time.Second
+	timerTasks = append(timerTasks, &persistence.WorkflowTimeoutTask{
+		VisibilityTimestamp: now.Add(duration),
+	})
+	setTaskInfo(newMutableState.GetCurrentVersion(), now, transferTasks, timerTasks)
+	return transferTasks, timerTasks, nil
+}
+
+// terminateCurrentRun terminates the current run as part of the reset request, so that the termination is persisted
+// only together with the creation of the new run. The terminated event is appended to the history of the current run
+// beforehand, like any other update of a run.
+func (w *workflowResetorImpl) terminateCurrentRun(domainID string, currContext workflowExecutionContext,
+	currMutableState mutableState, request *workflow.ResetWorkflowExecutionRequest, newRunID string,
+	resetRequest *persistence.ResetWorkflowExecutionRequest) error {
+
+	condition := currMutableState.GetNextEventID()
+	if currMutableState.AddWorkflowExecutionTerminatedEvent(&workflow.TerminateWorkflowExecutionRequest{
+		Reason:   common.StringPtr(workflowResetReason),
+		Details:  []byte(fmt.Sprintf("new run: %v, reason: %v", newRunID, request.GetReason())),
+		Identity: request.Identity,
+	}) == nil {
+		return &workflow.InternalServiceError{Message: "Unable to terminate workflow execution."}
+	}
+	currMutableState.ClearStickyness()
+
+	updates, err := currMutableState.CloseUpdateSession()
+	if err != nil {
+		return err
+	}
+	transactionID, err := w.eng.shard.GetNextTransferTaskID()
+	if err != nil {
+		return err
+	}
+	historyBuilder := updates.newEventsBuilder
+	historySize, err := currContext.appendHistoryEvents(historyBuilder, historyBuilder.history, transactionID)
+	if err != nil {
+		return err
+	}
+	currMutableState.IncrementHistorySize(historySize)
+	currMutableState.GetExecutionInfo().SetLastFirstEventID(historyBuilder.GetFirstEvent().GetEventId())
+
+	tBuilder := w.eng.getTimerBuilder(currContext.getExecution())
+	closeTask, cleanupTask, err := w.eng.getDeleteWorkflowTasks(domainID, currContext.getExecution().GetWorkflowId(), tBuilder)
+	if err != nil {
+		return err
+	}
+	transferTasks := []persistence.Task{closeTask}
+	timerTasks := []persistence.Task{cleanupTask}
+	setTaskInfo(currMutableState.GetCurrentVersion(), w.eng.shard.GetTimeSource().Now(), transferTasks, timerTasks)
+
+	resetRequest.CurrExecutionInfo = currMutableState.GetExecutionInfo()
+	resetRequest.CurrReplicationState = currMutableState.GetReplicationState()
+	resetRequest.CurrCondition = condition
+	resetRequest.CurrTransferTasks = transferTasks
+	resetRequest.CurrTimerTasks = timerTasks
+	return nil
+}
+
+func (w *workflowResetorImpl) logError(domainID string, execution *workflow.WorkflowExecution, msg string, err error) {
+	w.eng.logger.WithFields(bark.Fields{
+		logging.TagDomainID:            domainID,
+		logging.TagWorkflowExecutionID: execution.GetWorkflowId(),
+		logging.TagWorkflowRunID:       execution.GetRunId(),
+		logging.TagErr:                 err,
+	}).Error(msg)
+}
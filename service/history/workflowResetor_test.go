@@ -0,0 +1,371 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/pborman/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	h "github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+type (
+	resetorSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		historyEngine       *historyEngineImpl
+		mockMatchingClient  *mocks.MatchingClient
+		mockHistoryClient   *mocks.HistoryClient
+		mockVisibilityMgr   *mocks.VisibilityManager
+		mockExecutionMgr    *mocks.ExecutionManager
+		mockHistoryMgr      *mocks.HistoryManager
+		mockHistoryV2Mgr    *mocks.HistoryV2Manager
+		mockShardManager    *mocks.ShardManager
+		mockClusterMetadata *mocks.ClusterMetadata
+		mockProducer        *mocks.KafkaProducer
+		mockMessagingClient messaging.Client
+		mockService         service.Service
+		mockDomainCache     *cache.DomainCacheMock
+		mockClientBean      *client.MockClientBean
+		mockArchivalClient  *mocks.ArchivalClient
+
+		shardClosedCh chan int
+		config        *Config
+		logger        bark.Logger
+	}
+)
+
+func TestResetorSuite(t *testing.T) {
+	s := new(resetorSuite)
+	suite.Run(t, s)
+}
+
+func (s *resetorSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+
+	l := log.New()
+	l.Level = log.DebugLevel
+	s.logger = bark.NewLoggerFromLogrus(l)
+	s.config = NewDynamicConfigForEventsV2Test()
+}
+
+func (s *resetorSuite) TearDownSuite() {
+}
+
+func (s *resetorSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+
+	shardID := 0
+	s.mockMatchingClient = &mocks.MatchingClient{}
+	s.mockHistoryClient = &mocks.HistoryClient{}
+	s.mockVisibilityMgr = &mocks.VisibilityManager{}
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
+	s.mockHistoryMgr = &mocks.HistoryManager{}
+	s.mockHistoryV2Mgr = &mocks.HistoryV2Manager{}
+	s.mockShardManager = &mocks.ShardManager{}
+	s.mockClusterMetadata = &mocks.ClusterMetadata{}
+	s.mockProducer = &mocks.KafkaProducer{}
+	s.shardClosedCh = make(chan int, 100)
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.History)
+	s.mockMessagingClient = mocks.NewMockMessagingClient(s.mockProducer, nil)
+	s.mockClientBean = &client.MockClientBean{}
+	s.mockService = service.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, metricsClient, s.mockClientBean, s.logger)
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(cluster.TestSingleDCAllClusterFailoverVersions)
+	s.mockClusterMetadata.On("IsGlobalDomainEnabled").Return(false)
+	s.mockDomainCache = &cache.DomainCacheMock{}
+	s.mockDomainCache.On("GetDomainByID", mock.Anything).Return(
+		cache.NewDomainCacheEntryWithConfig(&p.DomainInfo{ID: validDomainID}, &p.DomainConfig{Retention: 1}), nil,
+	)
+	s.mockArchivalClient = &mocks.ArchivalClient{}
+
+	mockShard := &shardContextImpl{
+		service:                   s.mockService,
+		shardInfo:                 &p.ShardInfo{ShardID: shardID, RangeID: 1, TransferAckLevel: 0},
+		transferSequenceNumber:    1,
+		executionManager:          s.mockExecutionMgr,
+		historyMgr:                s.mockHistoryMgr,
+		historyV2Mgr:              s.mockHistoryV2Mgr,
+		domainCache:               s.mockDomainCache,
+		shardManager:              s.mockShardManager,
+		maxTransferSequenceNumber: 100000,
+		closeCh:                   s.shardClosedCh,
+		config:                    s.config,
+		logger:                    s.logger,
+		metricsClient:             metrics.NewClient(tally.NoopScope, metrics.History),
+	}
+
+	historyCache := newHistoryCache(mockShard)
+	h := &historyEngineImpl{
+		currentClusterName: mockShard.GetService().GetClusterMetadata().GetCurrentClusterName(),
+		shard:              mockShard,
+		executionManager:   s.mockExecutionMgr,
+		historyMgr:         s.mockHistoryMgr,
+		historyV2Mgr:       s.mockHistoryV2Mgr,
+		historyCache:       historyCache,
+		logger:             s.logger,
+		metricsClient:      metrics.NewClient(tally.NoopScope, metrics.History),
+		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
+		config:             s.config,
+		archivalClient:     s.mockArchivalClient,
+	}
+	h.txProcessor = newTransferQueueProcessor(mockShard, h, s.mockVisibilityMgr, s.mockProducer, s.mockMatchingClient, s.mockHistoryClient, s.logger)
+	h.timerProcessor = newTimerQueueProcessor(mockShard, h, s.mockMatchingClient, s.logger)
+	h.resetor = newWorkflowResetor(h)
+	s.historyEngine = h
+}
+
+func (s *resetorSuite) TearDownTest() {
+	s.mockMatchingClient.AssertExpectations(s.T())
+	s.mockExecutionMgr.AssertExpectations(s.T())
+	s.mockHistoryMgr.AssertExpectations(s.T())
+	s.mockHistoryV2Mgr.AssertExpectations(s.T())
+	s.mockShardManager.AssertExpectations(s.T())
+	s.mockVisibilityMgr.AssertExpectations(s.T())
+	s.mockProducer.AssertExpectations(s.T())
+	s.mockClientBean.AssertExpectations(s.T())
+	s.mockArchivalClient.AssertExpectations(s.T())
+}
+
+func (s *resetorSuite) TestResetWorkflowExecution_DecisionTaskCompleted() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	msBuilder := s.createRunningBaseRun(we)
+	s.mockLoadBaseRun(we, msBuilder)
+
+	forkedBranch := []byte("forked branch")
+	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything).Return(
+		&p.ForkHistoryBranchResponse{NewBranchToken: forkedBranch}, nil,
+	).Once()
+	var appendRequests []*p.AppendHistoryNodesRequest
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 100}, nil).Run(
+		func(args mock.Arguments) {
+			appendRequests = append(appendRequests, args.Get(0).(*p.AppendHistoryNodesRequest))
+		},
+	).Twice()
+	var resetRequest *p.ResetWorkflowExecutionRequest
+	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resetRequest = args.Get(0).(*p.ResetWorkflowExecutionRequest)
+	}).Once()
+
+	response, err := s.historyEngine.ResetWorkflowExecution(context.Background(), s.newResetRequest(we, 4))
+	s.NoError(err)
+	s.NotEqual(we.GetRunId(), response.GetRunId())
+
+	// the new run keeps the events before the decision completed event, fails the decision which was started at that
+	// point, re-applies the signal received afterwards and schedules a new decision
+	s.Equal(2, len(appendRequests))
+	s.True(appendRequests[0].IsNewBranch)
+	s.Equal(forkedBranch, appendRequests[0].BranchToken)
+	s.Equal(3, len(appendRequests[0].Events))
+	s.Equal(workflow.EventTypeDecisionTaskFailed, appendRequests[0].Events[0].GetEventType())
+	s.Equal(int64(4), appendRequests[0].Events[0].GetEventId())
+	s.Equal(workflow.EventTypeWorkflowExecutionSignaled, appendRequests[0].Events[1].GetEventType())
+	s.Equal(workflow.EventTypeDecisionTaskScheduled, appendRequests[0].Events[2].GetEventType())
+
+	s.NotNil(resetRequest)
+	s.Equal(we.GetRunId(), resetRequest.PrevRunID)
+	s.Equal(response.GetRunId(), resetRequest.ExecutionInfo.RunID)
+	s.Equal(forkedBranch, resetRequest.ExecutionInfo.BranchToken)
+	s.Equal(int64(7), resetRequest.ExecutionInfo.NextEventID)
+	s.Equal(int64(6), resetRequest.ExecutionInfo.DecisionScheduleID)
+	s.Equal(0, len(resetRequest.InsertActivityInfos))
+	var transferTaskTypes []int
+	for _, task := range resetRequest.TransferTasks {
+		transferTaskTypes = append(transferTaskTypes, task.GetType())
+	}
+	s.Equal([]int{p.TransferTaskTypeDecisionTask, p.TransferTaskTypeRecordWorkflowStarted}, transferTaskTypes)
+
+	// the termination of the current run is part of the same request, it is not persisted on its own
+	s.False(appendRequests[1].IsNewBranch)
+	s.Equal(1, len(appendRequests[1].Events))
+	s.Equal(workflow.EventTypeWorkflowExecutionTerminated, appendRequests[1].Events[0].GetEventType())
+	s.Equal(int64(9), appendRequests[1].Events[0].GetEventId())
+	s.NotNil(resetRequest.CurrExecutionInfo)
+	s.Equal(we.GetRunId(), resetRequest.CurrExecutionInfo.RunID)
+	s.Equal(p.WorkflowStateCompleted, resetRequest.CurrExecutionInfo.State)
+	s.Equal(p.WorkflowCloseStatusTerminated, resetRequest.CurrExecutionInfo.CloseStatus)
+	s.Equal(int64(9), resetRequest.CurrCondition)
+	s.Equal(1, len(resetRequest.CurrTransferTasks))
+	s.Equal(p.TransferTaskTypeCloseExecution, resetRequest.CurrTransferTasks[0].GetType())
+	s.Equal(1, len(resetRequest.CurrTimerTasks))
+	s.Equal(p.TaskTypeDeleteHistoryEvent, resetRequest.CurrTimerTasks[0].GetType())
+}
+
+func (s *resetorSuite) TestResetWorkflowExecution_InvalidResetEventID() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	msBuilder := s.createRunningBaseRun(we)
+	s.mockLoadBaseRun(we, msBuilder)
+
+	// out of the range of the events of the base run
+	_, err := s.historyEngine.ResetWorkflowExecution(context.Background(), s.newResetRequest(we, 100))
+	s.IsType(&workflow.BadRequestError{}, err)
+
+	// the decision started event is not a decision finish event
+	_, err = s.historyEngine.ResetWorkflowExecution(context.Background(), s.newResetRequest(we, 3))
+	s.IsType(&workflow.BadRequestError{}, err)
+
+	s.mockHistoryV2Mgr.AssertNotCalled(s.T(), "ForkHistoryBranch", mock.Anything)
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "ResetWorkflowExecution", mock.Anything)
+}
+
+func (s *resetorSuite) TestResetWorkflowExecution_CurrentRunClosed() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	msBuilder := newMutableStateBuilder("test", s.config, bark.NewLoggerFromLogrus(log.New()))
+	msBuilder.SetHistoryTree(we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	completedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.GetEventId(), nil, identity)
+	msBuilder.AddCompletedWorkflowEvent(completedEvent.GetEventId(), &workflow.CompleteWorkflowExecutionDecisionAttributes{
+		Result: []byte("result"),
+	})
+	s.mockLoadBaseRun(we, msBuilder)
+
+	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything).Return(
+		&p.ForkHistoryBranchResponse{NewBranchToken: []byte("forked branch")}, nil,
+	).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.MatchedBy(func(request *p.AppendHistoryNodesRequest) bool {
+		return request.IsNewBranch
+	})).Return(&p.AppendHistoryNodesResponse{Size: 100}, nil).Once()
+	var resetRequest *p.ResetWorkflowExecutionRequest
+	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resetRequest = args.Get(0).(*p.ResetWorkflowExecutionRequest)
+	}).Once()
+
+	response, err := s.historyEngine.ResetWorkflowExecution(context.Background(),
+		s.newResetRequest(we, completedEvent.GetEventId()))
+	s.NoError(err)
+
+	// there is nothing to terminate, the reset only creates the new run
+	s.NotNil(resetRequest)
+	s.Equal(we.GetRunId(), resetRequest.PrevRunID)
+	s.Equal(response.GetRunId(), resetRequest.ExecutionInfo.RunID)
+	s.Nil(resetRequest.CurrExecutionInfo)
+	s.Equal(0, len(resetRequest.CurrTransferTasks))
+	s.Equal(0, len(resetRequest.CurrTimerTasks))
+}
+
+func (s *resetorSuite) TestResetWorkflowExecution_PersistenceFailure() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	msBuilder := s.createRunningBaseRun(we)
+	s.mockLoadBaseRun(we, msBuilder)
+
+	forkedBranch := []byte("forked branch")
+	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything).Return(
+		&p.ForkHistoryBranchResponse{NewBranchToken: forkedBranch}, nil,
+	).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 100}, nil).Twice()
+	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything).Return(&p.ConditionFailedError{}).Once()
+	s.mockHistoryV2Mgr.On("DeleteHistoryBranch", &p.DeleteHistoryBranchRequest{BranchToken: forkedBranch}).Return(nil).Once()
+
+	_, err := s.historyEngine.ResetWorkflowExecution(context.Background(), s.newResetRequest(we, 4))
+	s.IsType(&p.ConditionFailedError{}, err)
+
+	// the current run is not terminated without the new run
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "UpdateWorkflowExecution", mock.Anything)
+}
+
+// createRunningBaseRun creates a run with a completed decision, which scheduled an activity, followed by a signal and
+// a started decision
+func (s *resetorSuite) createRunningBaseRun(we workflow.WorkflowExecution) *mutableStateBuilder {
+	tl := "testTaskList"
+	identity := "testIdentity"
+	msBuilder := newMutableStateBuilder("test", s.config, bark.NewLoggerFromLogrus(log.New()))
+	msBuilder.SetHistoryTree(we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	completedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.GetEventId(), nil, identity)
+	addActivityTaskScheduledEvent(msBuilder, completedEvent.GetEventId(), "activity1", "activity_type1", tl,
+		[]byte("input1"), 100, 10, 5)
+	msBuilder.AddWorkflowExecutionSignaled("signal1", []byte("signal input"), identity)
+	di = addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	return msBuilder
+}
+
+// mockLoadBaseRun mocks the base run as the current run of the workflow, each event of its history being a batch
+func (s *resetorSuite) mockLoadBaseRun(we workflow.WorkflowExecution, msBuilder *mutableStateBuilder) {
+	var batches []*workflow.History
+	for _, event := range msBuilder.GetHistoryBuilder().GetHistory().Events {
+		batches = append(batches, &workflow.History{Events: []*workflow.HistoryEvent{event}})
+	}
+
+	ms := createMutableState(msBuilder)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&p.GetWorkflowExecutionResponse{State: ms}, nil)
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&p.GetCurrentExecutionResponse{
+		RunID: we.GetRunId(),
+	}, nil)
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything).Return(&p.ReadHistoryBranchByBatchResponse{
+		History: batches,
+	}, nil)
+}
+
+func (s *resetorSuite) newResetRequest(we workflow.WorkflowExecution, resetEventID int64) *h.ResetWorkflowExecutionRequest {
+	return &h.ResetWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(validDomainID),
+		ResetRequest: &workflow.ResetWorkflowExecutionRequest{
+			Domain:                common.StringPtr("domain"),
+			WorkflowExecution:     &we,
+			Reason:                common.StringPtr("reset reason"),
+			DecisionFinishEventId: common.Int64Ptr(resetEventID),
+			RequestId:             common.StringPtr(uuid.New()),
+			Identity:              common.StringPtr("testIdentity"),
+		},
+	}
+}
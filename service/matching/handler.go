@@ -79,7 +79,13 @@ func (h *Handler) Start() error {
 	h.domainCache.Start()
 	h.metricsClient = h.Service.GetMetricsClient()
 	h.engine = NewEngine(
-		h.taskPersistence, h.Service.GetClientBean().GetHistoryClient(), h.config, h.Service.GetLogger(), h.Service.GetMetricsClient(), h.domainCache,
+		h.taskPersistence,
+		h.Service.GetClientBean().GetHistoryClient(),
+		h.Service.GetClientBean().GetMatchingClient(),
+		h.config,
+		h.Service.GetLogger(),
+		h.Service.GetMetricsClient(),
+		h.domainCache,
 	)
 	h.startWG.Done()
 	return nil
@@ -271,6 +277,9 @@ func (h *Handler) handleErr(err error, scope int) error {
 	case *gen.DomainNotActiveError:
 		h.metricsClient.IncCounter(scope, metrics.CadenceErrDomainNotActiveCounter)
 		return err
+	case *m.RemoteSyncMatchFailedError:
+		// not a failure, the partition which forwarded the task persists it instead
+		return err
 	default:
 		h.metricsClient.IncCounter(scope, metrics.CadenceFailures)
 		return &gen.InternalServiceError{Message: err.Error()}
@@ -30,6 +30,7 @@ import (
 	m "github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
+	mc "github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/logging"
@@ -47,6 +48,7 @@ import (
 type matchingEngineImpl struct {
 	taskManager     persistence.TaskManager
 	historyService  history.Client
+	matchingClient  mc.Client
 	tokenSerializer common.TaskTokenSerializer
 	logger          bark.Logger
 	metricsClient   metrics.Client
@@ -82,6 +84,8 @@ var (
 	ErrNoTasks    = errors.New("No tasks")
 	errPumpClosed = errors.New("Task list pump closed its channel")
 
+	errRemoteSyncMatchFailed = &m.RemoteSyncMatchFailedError{Message: "no poller is waiting for the forwarded task"}
+
 	pollerIDKey pollerIDCtxKey = "pollerID"
 	identityKey identityCtxKey = "identity"
 )
@@ -99,11 +103,24 @@ func (t *taskListID) String() string {
 	return r
 }
 
+// isRoot returns true if the task list is not a partition of another task list
+func (t *taskListID) isRoot() bool {
+	_, partition := mc.ParseTaskListPartition(t.taskListName)
+	return partition == 0
+}
+
+// rootName returns the name of the task list this partition belongs to
+func (t *taskListID) rootName() string {
+	name, _ := mc.ParseTaskListPartition(t.taskListName)
+	return name
+}
+
 var _ Engine = (*matchingEngineImpl)(nil) // Asserts that interface is indeed implemented
 
 // NewEngine creates an instance of matching engine
 func NewEngine(taskManager persistence.TaskManager,
 	historyService history.Client,
+	matchingClient mc.Client,
 	config *Config,
 	logger bark.Logger,
 	metricsClient metrics.Client,
@@ -113,6 +130,7 @@ func NewEngine(taskManager persistence.TaskManager,
 	return &matchingEngineImpl{
 		taskManager:     taskManager,
 		historyService:  historyService,
+		matchingClient:  matchingClient,
 		tokenSerializer: common.NewJSONTaskTokenSerializer(),
 		taskLists:       make(map[taskListID]taskListManager),
 		logger: logger.WithFields(bark.Fields{
@@ -230,6 +248,9 @@ func (e *matchingEngineImpl) AddDecisionTask(addRequest *m.AddDecisionTaskReques
 		ScheduleID:             addRequest.GetScheduleId(),
 		ScheduleToStartTimeout: addRequest.GetScheduleToStartTimeoutSeconds(),
 	}
+	if addRequest.ForwardedFrom != nil {
+		return tlMgr.DispatchForwardedTask(taskInfo, addRequest.GetForwardedFrom())
+	}
 	return tlMgr.AddTask(addRequest.Execution, taskInfo)
 }
 
@@ -253,6 +274,9 @@ func (e *matchingEngineImpl) AddActivityTask(addRequest *m.AddActivityTaskReques
 		ScheduleID:             addRequest.GetScheduleId(),
 		ScheduleToStartTimeout: addRequest.GetScheduleToStartTimeoutSeconds(),
 	}
+	if addRequest.ForwardedFrom != nil {
+		return tlMgr.DispatchForwardedTask(taskInfo, addRequest.GetForwardedFrom())
+	}
 	return tlMgr.AddTask(addRequest.Execution, taskInfo)
 }
 
@@ -266,6 +290,11 @@ func (e *matchingEngineImpl) PollForDecisionTask(ctx context.Context, req *m.Pol
 	request := req.PollRequest
 	taskListName := request.TaskList.GetName()
 	e.logger.Debugf("Received PollForDecisionTask for taskList=%v", taskListName)
+	taskList := newTaskListID(domainID, taskListName, persistence.TaskListTypeDecision)
+	if !taskList.isRoot() {
+		// history only knows the task list by its own name
+		request = copyPollForDecisionTaskRequest(request, taskList.rootName())
+	}
 pollLoop:
 	for {
 		err := common.IsValidContext(ctx)
@@ -276,9 +305,49 @@ pollLoop:
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(ctx, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
-		taskList := newTaskListID(domainID, taskListName, persistence.TaskListTypeDecision)
 		taskListKind := common.TaskListKindPtr(request.TaskList.GetKind())
-		tCtx, err := e.getTask(pollerCtx, taskList, nil, taskListKind)
+		var tCtx *taskContext
+		switch {
+		case e.canForwardPoll(taskList, req.ForwardedFrom):
+			tCtx, err = e.tryGetTask(pollerCtx, taskList, nil, taskListKind)
+			if err == ErrNoTasks {
+				// nothing to hand out here, wait for a task on the root partition instead
+				e.metricsClient.IncCounter(metrics.MatchingPollForDecisionTaskScope, metrics.ForwardedPollCounter)
+				return e.matchingClient.PollForDecisionTask(ctx, &m.PollForDecisionTaskRequest{
+					DomainUUID:    req.DomainUUID,
+					PollerID:      req.PollerID,
+					PollRequest:   request,
+					ForwardedFrom: common.StringPtr(taskListName),
+				})
+			}
+		case isPollFromRoot(taskList, req.ForwardedFrom):
+			tCtx, err = e.tryGetTask(pollerCtx, taskList, nil, taskListKind)
+		case e.canPollBacklogPartitions(taskList):
+			tCtx, err = e.tryGetTask(pollerCtx, taskList, nil, taskListKind)
+			if err == ErrNoTasks {
+				var resp *m.PollForDecisionTaskResponse
+				e.pollBacklogPartitions(taskList, taskListKind, func(partition string) (bool, error) {
+					e.metricsClient.IncCounter(metrics.MatchingPollForDecisionTaskScope, metrics.ForwardedPollCounter)
+					partitionResp, err := e.matchingClient.PollForDecisionTask(ctx, &m.PollForDecisionTaskRequest{
+						DomainUUID:    req.DomainUUID,
+						PollerID:      req.PollerID,
+						PollRequest:   copyPollForDecisionTaskRequest(request, partition),
+						ForwardedFrom: common.StringPtr(taskListName),
+					})
+					if err != nil || len(partitionResp.TaskToken) == 0 {
+						return false, err
+					}
+					resp = partitionResp
+					return true, nil
+				})
+				if resp != nil {
+					return resp, nil
+				}
+				tCtx, err = e.getTask(pollerCtx, taskList, nil, taskListKind)
+			}
+		default:
+			tCtx, err = e.getTask(pollerCtx, taskList, nil, taskListKind)
+		}
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
 			if err == ErrNoTasks || err == errPumpClosed {
@@ -368,6 +437,11 @@ func (e *matchingEngineImpl) PollForActivityTask(ctx context.Context, req *m.Pol
 	request := req.PollRequest
 	taskListName := request.TaskList.GetName()
 	e.logger.Debugf("Received PollForActivityTask for taskList=%v", taskListName)
+	taskList := newTaskListID(domainID, taskListName, persistence.TaskListTypeActivity)
+	if !taskList.isRoot() {
+		// history only knows the task list by its own name
+		request = copyPollForActivityTaskRequest(request, taskList.rootName())
+	}
 pollLoop:
 	for {
 		err := common.IsValidContext(ctx)
@@ -375,7 +449,6 @@ pollLoop:
 			return nil, err
 		}
 
-		taskList := newTaskListID(domainID, taskListName, persistence.TaskListTypeActivity)
 		var maxDispatch *float64
 		if request.TaskListMetadata != nil {
 			maxDispatch = request.TaskListMetadata.MaxTasksPerSecond
@@ -385,7 +458,48 @@ pollLoop:
 		pollerCtx := context.WithValue(ctx, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
 		taskListKind := common.TaskListKindPtr(request.TaskList.GetKind())
-		tCtx, err := e.getTask(pollerCtx, taskList, maxDispatch, taskListKind)
+		var tCtx *taskContext
+		switch {
+		case e.canForwardPoll(taskList, req.ForwardedFrom):
+			tCtx, err = e.tryGetTask(pollerCtx, taskList, maxDispatch, taskListKind)
+			if err == ErrNoTasks {
+				// nothing to hand out here, wait for a task on the root partition instead
+				e.metricsClient.IncCounter(metrics.MatchingPollForActivityTaskScope, metrics.ForwardedPollCounter)
+				return e.matchingClient.PollForActivityTask(ctx, &m.PollForActivityTaskRequest{
+					DomainUUID:    req.DomainUUID,
+					PollerID:      req.PollerID,
+					PollRequest:   request,
+					ForwardedFrom: common.StringPtr(taskListName),
+				})
+			}
+		case isPollFromRoot(taskList, req.ForwardedFrom):
+			tCtx, err = e.tryGetTask(pollerCtx, taskList, maxDispatch, taskListKind)
+		case e.canPollBacklogPartitions(taskList):
+			tCtx, err = e.tryGetTask(pollerCtx, taskList, maxDispatch, taskListKind)
+			if err == ErrNoTasks {
+				var resp *workflow.PollForActivityTaskResponse
+				e.pollBacklogPartitions(taskList, taskListKind, func(partition string) (bool, error) {
+					e.metricsClient.IncCounter(metrics.MatchingPollForActivityTaskScope, metrics.ForwardedPollCounter)
+					partitionResp, err := e.matchingClient.PollForActivityTask(ctx, &m.PollForActivityTaskRequest{
+						DomainUUID:    req.DomainUUID,
+						PollerID:      req.PollerID,
+						PollRequest:   copyPollForActivityTaskRequest(request, partition),
+						ForwardedFrom: common.StringPtr(taskListName),
+					})
+					if err != nil || len(partitionResp.TaskToken) == 0 {
+						return false, err
+					}
+					resp = partitionResp
+					return true, nil
+				})
+				if resp != nil {
+					return resp, nil
+				}
+				tCtx, err = e.getTask(pollerCtx, taskList, maxDispatch, taskListKind)
+			}
+		default:
+			tCtx, err = e.getTask(pollerCtx, taskList, maxDispatch, taskListKind)
+		}
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
 			if err == ErrNoTasks || err == errPumpClosed {
@@ -572,6 +686,62 @@ func (e *matchingEngineImpl) getTask(
 	return tlMgr.GetTaskContext(ctx, maxDispatchPerSecond)
 }
 
+// Returns a task which is ready to be handed out without waiting for one, ErrNoTasks is returned otherwise
+func (e *matchingEngineImpl) tryGetTask(
+	ctx context.Context, taskList *taskListID, maxDispatchPerSecond *float64, taskListKind *workflow.TaskListKind,
+) (*taskContext, error) {
+	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
+	if err != nil {
+		return nil, err
+	}
+	return tlMgr.TryGetTaskContext(ctx, maxDispatchPerSecond)
+}
+
+// Polls on partitions other than the root one which find no task are forwarded to the root partition,
+// which the tasks that find no poller on the other partitions are forwarded to as well
+func (e *matchingEngineImpl) canForwardPoll(taskList *taskListID, forwardedFrom *string) bool {
+	return forwardedFrom == nil && e.matchingClient != nil && !taskList.isRoot()
+}
+
+// Polls on the root partition which find no task are forwarded to the partitions which forwarded it a task
+// no poller was waiting for
+func (e *matchingEngineImpl) canPollBacklogPartitions(taskList *taskListID) bool {
+	return e.matchingClient != nil && taskList.isRoot()
+}
+
+// pollBacklogPartitions forwards a poll which found no task on the root partition to the partitions with a
+// backlog one at a time, until one of them hands out a task. The partitions only hand out a task which is
+// ready, the ones which have none are forgotten until they forward a task no poller is waiting for again.
+func (e *matchingEngineImpl) pollBacklogPartitions(
+	taskList *taskListID, taskListKind *workflow.TaskListKind, poll func(partition string) (bool, error),
+) {
+	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
+	if err != nil {
+		return
+	}
+	for {
+		partition, ok := tlMgr.GetBacklogPartition()
+		if !ok {
+			return
+		}
+		found, err := poll(partition)
+		if err != nil {
+			e.logger.Debugf("Failed to forward poll to partition=%v of taskList=%v, error=%v",
+				partition, taskList.taskListName, err)
+		}
+		if found {
+			return
+		}
+		tlMgr.RemoveBacklogPartition(partition)
+	}
+}
+
+// Polls forwarded by the root partition only take a task which is ready, the poller waits on the root
+// partition otherwise
+func isPollFromRoot(taskList *taskListID, forwardedFrom *string) bool {
+	return forwardedFrom != nil && !taskList.isRoot()
+}
+
 func (e *matchingEngineImpl) unloadTaskList(id *taskListID) {
 	e.taskListsLock.Lock()
 	tlMgr, ok := e.taskLists[*id]
@@ -665,6 +835,22 @@ func newTaskListID(domainID, taskListName string, taskType int) *taskListID {
 	return &taskListID{domainID: domainID, taskListName: taskListName, taskType: taskType}
 }
 
+func copyPollForDecisionTaskRequest(
+	request *workflow.PollForDecisionTaskRequest, taskListName string,
+) *workflow.PollForDecisionTaskRequest {
+	requestCopy := *request
+	requestCopy.TaskList = &workflow.TaskList{Name: common.StringPtr(taskListName), Kind: request.TaskList.Kind}
+	return &requestCopy
+}
+
+func copyPollForActivityTaskRequest(
+	request *workflow.PollForActivityTaskRequest, taskListName string,
+) *workflow.PollForActivityTaskRequest {
+	requestCopy := *request
+	requestCopy.TaskList = &workflow.TaskList{Name: common.StringPtr(taskListName), Kind: request.TaskList.Kind}
+	return &requestCopy
+}
+
 func workflowExecutionPtr(execution workflow.WorkflowExecution) *workflow.WorkflowExecution {
 	return &execution
 }
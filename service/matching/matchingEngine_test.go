@@ -35,6 +35,7 @@ import (
 	"github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
+	mc "github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
@@ -55,6 +56,7 @@ type (
 	matchingEngineSuite struct {
 		suite.Suite
 		historyClient        *mocks.HistoryClient
+		matchingClient       *mocks.MatchingClient
 		matchingEngine       *matchingEngineImpl
 		taskManager          *testTaskManager
 		mockExecutionManager *mocks.ExecutionManager
@@ -106,6 +108,7 @@ func (s *matchingEngineSuite) SetupTest() {
 	defer s.Unlock()
 	s.mockExecutionManager = &mocks.ExecutionManager{}
 	s.historyClient = &mocks.HistoryClient{}
+	s.matchingClient = &mocks.MatchingClient{}
 	s.taskManager = newTestTaskManager(s.logger)
 	s.domainCache = &cache.DomainCacheMock{}
 	s.domainCache.On("GetDomainByID", mock.Anything).Return(cache.CreateDomainCacheEntry("domainName"), nil)
@@ -117,7 +120,9 @@ func (s *matchingEngineSuite) SetupTest() {
 func (s *matchingEngineSuite) newMatchingEngine(
 	config *Config, taskMgr persistence.TaskManager,
 ) *matchingEngineImpl {
-	return newMatchingEngine(config, taskMgr, s.historyClient, s.logger, s.domainCache)
+	e := newMatchingEngine(config, taskMgr, s.historyClient, s.logger, s.domainCache)
+	e.matchingClient = s.matchingClient
+	return e
 }
 
 func newMatchingEngine(
@@ -138,6 +143,7 @@ func newMatchingEngine(
 
 func (s *matchingEngineSuite) TearDownTest() {
 	s.mockExecutionManager.AssertExpectations(s.T())
+	s.matchingClient.AssertExpectations(s.T())
 	s.matchingEngine.Stop()
 }
 
@@ -1343,6 +1349,182 @@ func (s *matchingEngineSuite) TestAddTaskAfterStartFailure() {
 	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
 }
 
+func (s *matchingEngineSuite) TestAddTaskToPartition_ForwardedToRoot() {
+	domainID := "domainId"
+	tl := "makeToast"
+	partition := mc.TaskListPartitionName(tl, 1)
+	tlID := &taskListID{domainID: domainID, taskListName: partition, taskType: persistence.TaskListTypeDecision}
+
+	s.matchingClient.On("AddDecisionTask", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, request *matching.AddDecisionTaskRequest) error {
+			s.Equal(tl, request.TaskList.GetName())
+			s.Equal(partition, request.GetForwardedFrom())
+			return nil
+		}).Once()
+
+	syncMatch, err := s.matchingEngine.AddDecisionTask(&matching.AddDecisionTaskRequest{
+		DomainUUID:                    common.StringPtr(domainID),
+		Execution:                     &workflow.WorkflowExecution{RunId: common.StringPtr("run1"), WorkflowId: common.StringPtr("workflow1")},
+		ScheduleId:                    common.Int64Ptr(0),
+		TaskList:                      &workflow.TaskList{Name: common.StringPtr(partition)},
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
+	})
+	s.NoError(err)
+	s.True(syncMatch)
+	s.EqualValues(0, s.taskManager.getCreateTaskCount(tlID))
+}
+
+func (s *matchingEngineSuite) TestAddTaskToPartition_PersistedWhenRootHasNoPoller() {
+	domainID := "domainId"
+	tl := "makeToast"
+	partition := mc.TaskListPartitionName(tl, 1)
+	tlID := &taskListID{domainID: domainID, taskListName: partition, taskType: persistence.TaskListTypeActivity}
+
+	s.matchingClient.On("AddActivityTask", mock.Anything, mock.Anything).Return(errRemoteSyncMatchFailed)
+
+	syncMatch, err := s.matchingEngine.AddActivityTask(&matching.AddActivityTaskRequest{
+		SourceDomainUUID:              common.StringPtr(domainID),
+		DomainUUID:                    common.StringPtr(domainID),
+		Execution:                     &workflow.WorkflowExecution{RunId: common.StringPtr("run1"), WorkflowId: common.StringPtr("workflow1")},
+		ScheduleId:                    common.Int64Ptr(0),
+		TaskList:                      &workflow.TaskList{Name: common.StringPtr(partition)},
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
+	})
+	s.NoError(err)
+	s.False(syncMatch)
+	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
+}
+
+func (s *matchingEngineSuite) TestAddForwardedTask_NoPoller() {
+	domainID := "domainId"
+	tl := "makeToast"
+	tlID := &taskListID{domainID: domainID, taskListName: tl, taskType: persistence.TaskListTypeDecision}
+
+	syncMatch, err := s.matchingEngine.AddDecisionTask(&matching.AddDecisionTaskRequest{
+		DomainUUID:                    common.StringPtr(domainID),
+		Execution:                     &workflow.WorkflowExecution{RunId: common.StringPtr("run1"), WorkflowId: common.StringPtr("workflow1")},
+		ScheduleId:                    common.Int64Ptr(0),
+		TaskList:                      &workflow.TaskList{Name: common.StringPtr(tl)},
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
+		ForwardedFrom:                 common.StringPtr(mc.TaskListPartitionName(tl, 1)),
+	})
+	s.Equal(errRemoteSyncMatchFailed, err)
+	s.False(syncMatch)
+	s.EqualValues(0, s.taskManager.getCreateTaskCount(tlID))
+
+	tlMgr, err := s.matchingEngine.getTaskListManager(tlID, nil)
+	s.NoError(err)
+	partition, ok := tlMgr.GetBacklogPartition()
+	s.True(ok)
+	s.Equal(mc.TaskListPartitionName(tl, 1), partition)
+}
+
+func (s *matchingEngineSuite) TestPollRoot_ForwardedToBacklogPartition() {
+	domainID := "domainId"
+	tl := "makeToast"
+	partition := mc.TaskListPartitionName(tl, 1)
+	tlID := &taskListID{domainID: domainID, taskListName: tl, taskType: persistence.TaskListTypeActivity}
+	response := &workflow.PollForActivityTaskResponse{TaskToken: []byte("token")}
+
+	tlMgr, err := s.matchingEngine.getTaskListManager(tlID, nil)
+	s.NoError(err)
+	_, err = tlMgr.DispatchForwardedTask(&persistence.TaskInfo{DomainID: domainID}, partition)
+	s.Equal(errRemoteSyncMatchFailed, err)
+
+	s.matchingClient.On("PollForActivityTask", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, request *matching.PollForActivityTaskRequest) *workflow.PollForActivityTaskResponse {
+			s.Equal(partition, request.PollRequest.TaskList.GetName())
+			s.Equal(tl, request.GetForwardedFrom())
+			s.Equal("pollerID", request.GetPollerID())
+			return response
+		}, nil).Once()
+
+	resp, err := s.matchingEngine.PollForActivityTask(s.callContext, &matching.PollForActivityTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PollerID:   common.StringPtr("pollerID"),
+		PollRequest: &workflow.PollForActivityTaskRequest{
+			TaskList: &workflow.TaskList{Name: common.StringPtr(tl)},
+			Identity: common.StringPtr("nobody"),
+		},
+	})
+	s.NoError(err)
+	s.Equal(response, resp)
+	_, ok := tlMgr.GetBacklogPartition()
+	s.True(ok)
+}
+
+func (s *matchingEngineSuite) TestPollRoot_BacklogPartitionWithoutTasks() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	domainID := "domainId"
+	tl := "makeToast"
+	partition := mc.TaskListPartitionName(tl, 1)
+	tlID := &taskListID{domainID: domainID, taskListName: tl, taskType: persistence.TaskListTypeDecision}
+
+	tlMgr, err := s.matchingEngine.getTaskListManager(tlID, nil)
+	s.NoError(err)
+	_, err = tlMgr.DispatchForwardedTask(&persistence.TaskInfo{DomainID: domainID}, partition)
+	s.Equal(errRemoteSyncMatchFailed, err)
+
+	s.matchingClient.On("PollForDecisionTask", mock.Anything, mock.Anything).
+		Return(&matching.PollForDecisionTaskResponse{}, nil).Once()
+
+	resp, err := s.matchingEngine.PollForDecisionTask(s.callContext, &matching.PollForDecisionTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PollerID:   common.StringPtr("pollerID"),
+		PollRequest: &workflow.PollForDecisionTaskRequest{
+			TaskList: &workflow.TaskList{Name: common.StringPtr(tl)},
+			Identity: common.StringPtr("nobody"),
+		},
+	})
+	s.NoError(err)
+	s.Equal(emptyPollForDecisionTaskResponse, resp)
+	_, ok := tlMgr.GetBacklogPartition()
+	s.False(ok)
+}
+
+func (s *matchingEngineSuite) TestPollFromRoot_PartitionWithoutTasks() {
+	domainID := "domainId"
+	tl := "makeToast"
+
+	resp, err := s.matchingEngine.PollForDecisionTask(s.callContext, &matching.PollForDecisionTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PollerID:   common.StringPtr("pollerID"),
+		PollRequest: &workflow.PollForDecisionTaskRequest{
+			TaskList: &workflow.TaskList{Name: common.StringPtr(mc.TaskListPartitionName(tl, 1))},
+			Identity: common.StringPtr("nobody"),
+		},
+		ForwardedFrom: common.StringPtr(tl),
+	})
+	s.NoError(err)
+	s.Equal(emptyPollForDecisionTaskResponse, resp)
+}
+
+func (s *matchingEngineSuite) TestPollPartitionWithoutTasks_ForwardedToRoot() {
+	domainID := "domainId"
+	tl := "makeToast"
+	partition := mc.TaskListPartitionName(tl, 2)
+	response := &matching.PollForDecisionTaskResponse{TaskToken: []byte("token")}
+
+	s.matchingClient.On("PollForDecisionTask", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, request *matching.PollForDecisionTaskRequest) *matching.PollForDecisionTaskResponse {
+			s.Equal(tl, request.PollRequest.TaskList.GetName())
+			s.Equal(partition, request.GetForwardedFrom())
+			s.Equal("pollerID", request.GetPollerID())
+			return response
+		}, nil).Once()
+
+	resp, err := s.matchingEngine.PollForDecisionTask(s.callContext, &matching.PollForDecisionTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PollerID:   common.StringPtr("pollerID"),
+		PollRequest: &workflow.PollForDecisionTaskRequest{
+			TaskList: &workflow.TaskList{Name: common.StringPtr(partition)},
+			Identity: common.StringPtr("nobody"),
+		},
+	})
+	s.NoError(err)
+	s.Equal(response, resp)
+}
+
 func (s *matchingEngineSuite) TestTaskListManagerGetTaskBatch() {
 	runID := "run1"
 	workflowID := "workflow1"
@@ -1619,6 +1801,48 @@ func (m *testTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequ
 	return &persistence.UpdateTaskListResponse{}, nil
 }
 
+// ListTaskList provides a mock function with given fields: request
+func (m *testTaskManager) ListTaskList(request *persistence.ListTaskListRequest) (*persistence.ListTaskListResponse, error) {
+	m.Lock()
+	defer m.Unlock()
+	response := &persistence.ListTaskListResponse{}
+	for id, tlm := range m.taskLists {
+		if id.domainID != request.DomainID {
+			continue
+		}
+		tlm.Lock()
+		response.Items = append(response.Items, &persistence.TaskListInfo{
+			DomainID: id.domainID,
+			Name:     id.taskListName,
+			TaskType: id.taskType,
+			RangeID:  tlm.rangeID,
+			AckLevel: tlm.ackLevel,
+		})
+		tlm.Unlock()
+	}
+	return response, nil
+}
+
+// DeleteTaskList provides a mock function with given fields: request
+func (m *testTaskManager) DeleteTaskList(request *persistence.DeleteTaskListRequest) error {
+	m.Lock()
+	defer m.Unlock()
+	id := newTaskListID(request.DomainID, request.TaskListName, request.TaskListType)
+	tlm, ok := m.taskLists[*id]
+	if !ok {
+		return nil
+	}
+	tlm.Lock()
+	defer tlm.Unlock()
+	if tlm.rangeID != request.RangeID {
+		return &persistence.ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to delete task list: name=%v, type=%v", request.TaskListName, request.TaskListType),
+		}
+	}
+	delete(m.taskLists, *id)
+	return nil
+}
+
 // CompleteTask provides a mock function with given fields: request
 func (m *testTaskManager) CompleteTask(request *persistence.CompleteTaskRequest) error {
 	m.logger.Debugf("CompleteTask taskID=%v, ackLevel=%v", request.TaskID, request.TaskList.AckLevel)
@@ -1636,6 +1860,24 @@ func (m *testTaskManager) CompleteTask(request *persistence.CompleteTaskRequest)
 	return nil
 }
 
+// CompleteTasksLessThan provides a mock function with given fields: request
+func (m *testTaskManager) CompleteTasksLessThan(request *persistence.CompleteTasksLessThanRequest) (int, error) {
+	tlm := m.getTaskListManager(newTaskListID(request.DomainID, request.TaskListName, request.TaskType))
+	tlm.Lock()
+	defer tlm.Unlock()
+
+	completed := 0
+	for _, key := range tlm.tasks.Keys() {
+		taskID := key.(int64)
+		if taskID >= request.TaskID || (request.Limit > 0 && completed == request.Limit) {
+			break
+		}
+		tlm.tasks.Remove(taskID)
+		completed++
+	}
+	return completed, nil
+}
+
 // CreateTask provides a mock function with given fields: request
 func (m *testTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
 	domainID := request.TaskListInfo.DomainID
@@ -59,7 +59,11 @@ type taskListManager interface {
 	Start() error
 	Stop()
 	AddTask(execution *s.WorkflowExecution, taskInfo *persistence.TaskInfo) (syncMatch bool, err error)
+	DispatchForwardedTask(taskInfo *persistence.TaskInfo, forwardedFrom string) (syncMatch bool, err error)
+	GetBacklogPartition() (partition string, ok bool)
+	RemoveBacklogPartition(partition string)
 	GetTaskContext(ctx context.Context, maxDispatchPerSecond *float64) (*taskContext, error)
+	TryGetTaskContext(ctx context.Context, maxDispatchPerSecond *float64) (*taskContext, error)
 	SyncMatchQueryTask(ctx context.Context, queryTask *queryTaskInfo) error
 	CancelPoller(pollerID string)
 	GetAllPollerInfo() []*pollerInfo
@@ -88,7 +92,8 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 	}
 
 	domain := domainEntry.GetInfo().Name
-	taskListName := id.taskListName
+	// partitions share the configuration of the task list they belong to
+	taskListName := id.rootName()
 	taskType := id.taskType
 	return &taskListConfig{
 		RangeSize: config.RangeSize,
@@ -234,6 +239,7 @@ func newTaskListManagerWithRateLimiter(
 		config:              config,
 		pollerHistory:       newPollerHistory(),
 		outstandingPollsMap: make(map[string]context.CancelFunc),
+		backlogPartitions:   make(map[string]struct{}),
 		rateLimiter:         rl,
 		taskListKind:        taskListKind,
 	}
@@ -308,6 +314,10 @@ type taskListManagerImpl struct {
 	// prevent tasks being dispatched to zombie pollers.
 	outstandingPollsLock sync.Mutex
 	outstandingPollsMap  map[string]context.CancelFunc
+	// backlogPartitions are the partitions of the task list which forwarded a task to this root partition
+	// while no poller was waiting for it. The polls which find no task here are forwarded to them.
+	backlogPartitionsLock sync.Mutex
+	backlogPartitions     map[string]struct{}
 	// Rate limiter for task dispatch
 	rateLimiter *rateLimiter
 
@@ -381,6 +391,10 @@ func (c *taskListManagerImpl) AddTask(execution *s.WorkflowExecution, taskInfo *
 			syncMatch = true
 			return r, err
 		}
+		if err == nil && c.forwardTask(execution, taskInfo) == nil {
+			syncMatch = true
+			return &persistence.CreateTasksResponse{}, nil
+		}
 		r, err = c.taskWriter.appendTask(execution, taskInfo, rangeID)
 		syncMatch = false
 		return r, err
@@ -391,6 +405,43 @@ func (c *taskListManagerImpl) AddTask(execution *s.WorkflowExecution, taskInfo *
 	return syncMatch, err
 }
 
+// DispatchForwardedTask hands a task forwarded by another partition of the task list to a waiting poller.
+// Forwarded tasks are never persisted here, errRemoteSyncMatchFailed tells the partition which forwarded
+// the task to keep it instead. The partition is then remembered as having a backlog, so that the next polls
+// which find no task here are forwarded to it.
+func (c *taskListManagerImpl) DispatchForwardedTask(
+	taskInfo *persistence.TaskInfo,
+	forwardedFrom string,
+) (syncMatch bool, err error) {
+	c.startWG.Wait()
+	r, err := c.trySyncMatch(taskInfo)
+	if err != nil || r == nil {
+		c.backlogPartitionsLock.Lock()
+		c.backlogPartitions[forwardedFrom] = struct{}{}
+		c.backlogPartitionsLock.Unlock()
+		return false, errRemoteSyncMatchFailed
+	}
+	return true, nil
+}
+
+// GetBacklogPartition returns one of the partitions which forwarded a task no poller was waiting for
+func (c *taskListManagerImpl) GetBacklogPartition() (partition string, ok bool) {
+	c.backlogPartitionsLock.Lock()
+	defer c.backlogPartitionsLock.Unlock()
+	for partition := range c.backlogPartitions {
+		return partition, true
+	}
+	return "", false
+}
+
+// RemoveBacklogPartition forgets a partition which had no task ready for a forwarded poll, until it
+// forwards a task no poller is waiting for again
+func (c *taskListManagerImpl) RemoveBacklogPartition(partition string) {
+	c.backlogPartitionsLock.Lock()
+	delete(c.backlogPartitions, partition)
+	c.backlogPartitionsLock.Unlock()
+}
+
 func (c *taskListManagerImpl) SyncMatchQueryTask(ctx context.Context, queryTask *queryTaskInfo) error {
 	c.startWG.Wait()
 
@@ -416,9 +467,25 @@ func (c *taskListManagerImpl) SyncMatchQueryTask(ctx context.Context, queryTask
 func (c *taskListManagerImpl) GetTaskContext(
 	ctx context.Context,
 	maxDispatchPerSecond *float64,
+) (*taskContext, error) {
+	return c.getTaskContext(ctx, maxDispatchPerSecond, true)
+}
+
+// TryGetTaskContext is like GetTaskContext but returns ErrNoTasks rather than waiting when no task is ready
+func (c *taskListManagerImpl) TryGetTaskContext(
+	ctx context.Context,
+	maxDispatchPerSecond *float64,
+) (*taskContext, error) {
+	return c.getTaskContext(ctx, maxDispatchPerSecond, false)
+}
+
+func (c *taskListManagerImpl) getTaskContext(
+	ctx context.Context,
+	maxDispatchPerSecond *float64,
+	wait bool,
 ) (*taskContext, error) {
 	c.rateLimiter.UpdateMaxDispatch(maxDispatchPerSecond)
-	result, err := c.getTask(ctx)
+	result, err := c.getTask(ctx, wait)
 	if err != nil {
 		return nil, err
 	}
@@ -516,7 +583,7 @@ func (c *taskListManagerImpl) completeTaskPoll(taskID int64) (ackLevel int64) {
 }
 
 // Loads task from taskBuffer (which is populated from persistence) or from sync match to add task call
-func (c *taskListManagerImpl) getTask(ctx context.Context) (*getTaskResult, error) {
+func (c *taskListManagerImpl) getTask(ctx context.Context, wait bool) (*getTaskResult, error) {
 	scope := metrics.MatchingTaskListMgrScope
 	timer := time.NewTimer(c.config.LongPollExpirationInterval())
 	defer timer.Stop()
@@ -556,6 +623,16 @@ func (c *taskListManagerImpl) getTask(ctx context.Context) (*getTaskResult, erro
 		tasksForPoll = c.tasksForPoll
 	}
 
+	if !wait {
+		select {
+		case result := <-tasksForPoll:
+			c.metricsClient.IncCounter(scope, metrics.PollSuccessCounter)
+			return result, nil
+		default:
+			return nil, ErrNoTasks
+		}
+	}
+
 	select {
 	case result := <-tasksForPoll:
 		if result.syncMatch {
@@ -758,9 +835,7 @@ deliverBufferTasksLoop:
 			if !ok { // Task list getTasks pump is shutdown
 				break deliverBufferTasksLoop
 			}
-			select {
-			case c.tasksForPoll <- &getTaskResult{task: task}:
-			case <-c.deliverBufferShutdownCh:
+			if !c.dispatchBufferedTask(task) {
 				break deliverBufferTasksLoop
 			}
 		case <-c.deliverBufferShutdownCh:
@@ -769,6 +844,79 @@ deliverBufferTasksLoop:
 	}
 }
 
+// dispatchBufferedTask blocks until the task is handed out, false is returned on shutdown. Partitions other
+// than the root offer the task to the root partition when no poller is waiting for it here, as that is where
+// the pollers which find no task on a partition wait. The backlog is drained back to back for as long as the
+// root partition has pollers waiting. Once it has none, the root partition remembers this partition and
+// forwards the next polls which find no task there to it.
+func (c *taskListManagerImpl) dispatchBufferedTask(task *persistence.TaskInfo) bool {
+	if c.canForward() {
+		select {
+		case c.tasksForPoll <- &getTaskResult{task: task}:
+			return true
+		default:
+		}
+		execution := s.WorkflowExecution{
+			WorkflowId: common.StringPtr(task.WorkflowID),
+			RunId:      common.StringPtr(task.RunID),
+		}
+		if c.forwardTask(&execution, task) == nil {
+			tCtx := &taskContext{tlMgr: c, info: task, workflowExecution: execution}
+			tCtx.completeTask(nil)
+			return true
+		}
+	}
+	select {
+	case c.tasksForPoll <- &getTaskResult{task: task}:
+		return true
+	case <-c.deliverBufferShutdownCh:
+		return false
+	}
+}
+
+// canForward returns true if tasks and pollers can be forwarded to the root partition of the task list
+func (c *taskListManagerImpl) canForward() bool {
+	return c.engine.matchingClient != nil && !c.taskListID.isRoot()
+}
+
+// forwardTask offers the task to the pollers waiting on the root partition of the task list, a nil error
+// means that the task was handed to one of them
+func (c *taskListManagerImpl) forwardTask(execution *s.WorkflowExecution, taskInfo *persistence.TaskInfo) error {
+	if !c.canForward() {
+		return errRemoteSyncMatchFailed
+	}
+	taskList := &s.TaskList{
+		Name: common.StringPtr(c.taskListID.rootName()),
+		Kind: c.taskListKind,
+	}
+	forwardedFrom := common.StringPtr(c.taskListID.taskListName)
+	var err error
+	if c.taskListID.taskType == persistence.TaskListTypeActivity {
+		err = c.engine.matchingClient.AddActivityTask(context.Background(), &m.AddActivityTaskRequest{
+			DomainUUID:                    common.StringPtr(c.taskListID.domainID),
+			SourceDomainUUID:              common.StringPtr(taskInfo.DomainID),
+			Execution:                     execution,
+			TaskList:                      taskList,
+			ScheduleId:                    common.Int64Ptr(taskInfo.ScheduleID),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(taskInfo.ScheduleToStartTimeout),
+			ForwardedFrom:                 forwardedFrom,
+		})
+	} else {
+		err = c.engine.matchingClient.AddDecisionTask(context.Background(), &m.AddDecisionTaskRequest{
+			DomainUUID:                    common.StringPtr(taskInfo.DomainID),
+			Execution:                     execution,
+			TaskList:                      taskList,
+			ScheduleId:                    common.Int64Ptr(taskInfo.ScheduleID),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(taskInfo.ScheduleToStartTimeout),
+			ForwardedFrom:                 forwardedFrom,
+		})
+	}
+	if err == nil {
+		c.metricsClient.IncCounter(metrics.MatchingTaskListMgrScope, metrics.ForwardedTaskCounter)
+	}
+	return err
+}
+
 func (c *taskListManagerImpl) getTasksPump() {
 	defer close(c.taskBuffer)
 	c.startWG.Wait()
@@ -0,0 +1,72 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/client/frontend"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+type (
+	// Batcher is the cadence client worker responsible for running batch operations
+	Batcher struct {
+		worker worker.Worker
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(BatchWorkflow, workflow.RegisterOptions{Name: BatchWorkflowFnName})
+	activity.RegisterWithOptions(BatchActivity, activity.RegisterOptions{Name: batchActivityFnName})
+}
+
+// New returns a new Batcher
+func New(frontendClient frontend.Client, scope tally.Scope) *Batcher {
+	logger, _ := zap.NewProduction()
+	actCtx := context.WithValue(context.Background(), frontendClientKey, frontendClient)
+	wo := worker.Options{
+		Logger:                    logger,
+		MetricsScope:              scope.SubScope(batcherScope),
+		BackgroundActivityContext: actCtx,
+	}
+	return &Batcher{
+		worker: worker.New(frontendClient, Domain, TaskList, wo),
+	}
+}
+
+// Start the Batcher
+func (b *Batcher) Start() error {
+	if err := b.worker.Start(); err != nil {
+		b.worker.Stop()
+		return err
+	}
+	return nil
+}
+
+// Stop the Batcher
+func (b *Batcher) Stop() {
+	b.worker.Stop()
+}
@@ -0,0 +1,389 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/service/worker/sysworkflow"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	// Domain is the domain the batch workflows run in, the workflows they operate on can be in any domain
+	Domain = sysworkflow.Domain
+	// TaskList is the task list of the batch workflows
+	TaskList = "cadsys-batcher-tl"
+	// WorkflowIDPrefix is the prefix of the workflow ids of batch workflows
+	WorkflowIDPrefix = "cadsys-batcher"
+	// BatchWorkflowFnName is the name of the batch workflow function
+	BatchWorkflowFnName = "BatchWorkflow"
+	// WorkflowStartToCloseTimeout is the time for a batch operation to finish
+	WorkflowStartToCloseTimeout = sysworkflow.WorkflowStartToCloseTimeout
+	// DecisionTaskStartToCloseTimeout is the time for decision to finish
+	DecisionTaskStartToCloseTimeout = sysworkflow.DecisionTaskStartToCloseTimeout
+
+	// BatchTypeTerminate terminates the workflows
+	BatchTypeTerminate = "terminate"
+	// BatchTypeCancel requests cancellation of the workflows
+	BatchTypeCancel = "cancel"
+	// BatchTypeSignal signals the workflows
+	BatchTypeSignal = "signal"
+
+	// DefaultRPS is the default rate of operations of a batch
+	DefaultRPS = 50
+	// DefaultConcurrency is the default number of operations of a batch running at the same time
+	DefaultConcurrency = 5
+
+	batchActivityFnName       = "BatchActivity"
+	batchActivityHeartbeat    = time.Minute
+	batchIdentity             = "cadence-batcher"
+	batcherScope              = "batcher"
+	listPageSize              = 1000
+	errReasonInvalidParams    = "cadenceInternal:InvalidBatchParams"
+	logTagBatchType           = "batch-type"
+	logTagBatchDomain         = "batch-domain"
+	logTagBatchPage           = "batch-page"
+	logTagBatchWorkflowID     = "batch-workflow-id"
+	logTagBatchSuccessCount   = "batch-success-count"
+	logTagBatchErrorCount     = "batch-error-count"
+	operationSuccessCounter   = "operation-success"
+	operationFailureCounter   = "operation-failure"
+	workflowsProcessedCounter = "workflows-processed"
+)
+
+type (
+	// BatchParams are the parameters of a batch operation. The operation applies to the open workflows of
+	// the domain which match all the filters that are set.
+	BatchParams struct {
+		DomainName string
+		// WorkflowType, WorkflowIDPrefix and StartTimeEarliest/StartTimeLatest (unix nanos) filter the workflows
+		WorkflowType      string
+		WorkflowIDPrefix  string
+		StartTimeEarliest int64
+		StartTimeLatest   int64
+		// BatchType is one of terminate, cancel or signal
+		BatchType string
+		Reason    string
+		// SignalName and SignalInput are only used by signal operations
+		SignalName  string
+		SignalInput string
+		// RPS is the max rate of operations, Concurrency the max number of operations in flight
+		RPS         int
+		Concurrency int
+	}
+
+	// HeartBeatDetails is the progress of a batch operation. It is recorded with the heartbeats of the batch
+	// activity so that a retried activity continues from the last processed page, and skips the workflows of
+	// that page it processed already.
+	HeartBeatDetails struct {
+		PageToken    []byte
+		CurrentPage  int
+		SuccessCount int
+		ErrorCount   int
+		// ProcessedInPage are the keys of the workflows of the current page which are processed
+		ProcessedInPage []string `json:",omitempty"`
+	}
+
+	// taskResult is the outcome of the operation on a workflow
+	taskResult struct {
+		execution *shared.WorkflowExecution
+		err       error
+	}
+
+	contextKey int
+)
+
+const (
+	frontendClientKey contextKey = iota
+)
+
+// BatchWorkflow runs a batch operation, it returns the progress of the operation when it completes
+func BatchWorkflow(ctx workflow.Context, params BatchParams) (HeartBeatDetails, error) {
+	var result HeartBeatDetails
+	params, err := ValidateParams(params)
+	if err != nil {
+		workflow.GetLogger(ctx).Error("invalid batch params", zap.Error(err))
+		return result, cadence.NewCustomError(errReasonInvalidParams, err.Error())
+	}
+
+	ao := workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    WorkflowStartToCloseTimeout,
+		HeartbeatTimeout:       batchActivityHeartbeat,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          10 * time.Second,
+			BackoffCoefficient:       2.0,
+			MaximumInterval:          5 * time.Minute,
+			ExpirationInterval:       WorkflowStartToCloseTimeout,
+			NonRetriableErrorReasons: []string{errReasonInvalidParams},
+		},
+	}
+	actCtx := workflow.WithActivityOptions(ctx, ao)
+	err = workflow.ExecuteActivity(actCtx, batchActivityFnName, params).Get(ctx, &result)
+	return result, err
+}
+
+// BatchActivity lists the workflows matching the filters of the batch page by page and applies the operation to them
+func BatchActivity(ctx context.Context, params BatchParams) (HeartBeatDetails, error) {
+	logger := activity.GetLogger(ctx).With(
+		zap.String(logTagBatchType, params.BatchType),
+		zap.String(logTagBatchDomain, params.DomainName))
+	scope := activity.GetMetricsScope(ctx).Tagged(map[string]string{logTagBatchType: params.BatchType})
+	client := ctx.Value(frontendClientKey).(frontend.Client)
+
+	hbd := HeartBeatDetails{}
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &hbd); err != nil {
+			logger.Error("failed to recover the progress of the batch, starting over", zap.Error(err))
+			hbd = HeartBeatDetails{}
+		}
+	}
+
+	tokenBucket := common.NewTokenBucket(params.RPS, common.NewRealTimeSource())
+	for {
+		request := &shared.ListOpenWorkflowExecutionsRequest{
+			Domain:          common.StringPtr(params.DomainName),
+			MaximumPageSize: common.Int32Ptr(listPageSize),
+			NextPageToken:   hbd.PageToken,
+			StartTimeFilter: getStartTimeFilter(params),
+		}
+		if params.WorkflowType != "" {
+			request.TypeFilter = &shared.WorkflowTypeFilter{Name: common.StringPtr(params.WorkflowType)}
+		}
+		resp, err := client.ListOpenWorkflowExecutions(ctx, request)
+		if err != nil {
+			logger.Error("failed to list workflows", zap.Int(logTagBatchPage, hbd.CurrentPage), zap.Error(err))
+			return hbd, err
+		}
+
+		// the page token of the current page is kept until the whole page is processed, so a retried
+		// activity lists the page again and skips the workflows it recorded as processed
+		processed := make(map[string]struct{}, len(hbd.ProcessedInPage))
+		for _, key := range hbd.ProcessedInPage {
+			processed[key] = struct{}{}
+		}
+		var executions []*shared.WorkflowExecution
+		for _, info := range resp.Executions {
+			if _, ok := processed[getWorkflowKey(info.Execution)]; ok {
+				continue
+			}
+			if strings.HasPrefix(info.Execution.GetWorkflowId(), params.WorkflowIDPrefix) {
+				executions = append(executions, info.Execution)
+			}
+		}
+
+		results := processPage(ctx, client, tokenBucket, params, executions)
+		for range executions {
+			result := <-results
+			switch {
+			case result.err == nil:
+				hbd.SuccessCount++
+				scope.Counter(operationSuccessCounter).Inc(1)
+			case ctx.Err() != nil:
+				// the activity is canceled or timed out, the workflow is processed again if it is retried
+				continue
+			default:
+				hbd.ErrorCount++
+				scope.Counter(operationFailureCounter).Inc(1)
+			}
+			scope.Counter(workflowsProcessedCounter).Inc(1)
+			hbd.ProcessedInPage = append(hbd.ProcessedInPage, getWorkflowKey(result.execution))
+			activity.RecordHeartbeat(ctx, hbd)
+		}
+		if ctx.Err() != nil {
+			return hbd, ctx.Err()
+		}
+
+		hbd.CurrentPage++
+		hbd.PageToken = resp.NextPageToken
+		hbd.ProcessedInPage = nil
+		activity.RecordHeartbeat(ctx, hbd)
+		if len(hbd.PageToken) == 0 {
+			break
+		}
+	}
+
+	logger.Info("batch operation completed",
+		zap.Int(logTagBatchSuccessCount, hbd.SuccessCount), zap.Int(logTagBatchErrorCount, hbd.ErrorCount))
+	return hbd, nil
+}
+
+// processPage applies the operation to the executions with params.Concurrency goroutines, the result of
+// every operation is sent to the returned channel
+func processPage(
+	ctx context.Context,
+	client frontend.Client,
+	tokenBucket common.TokenBucket,
+	params BatchParams,
+	executions []*shared.WorkflowExecution,
+) <-chan taskResult {
+
+	logger := activity.GetLogger(ctx)
+	batchID := activity.GetInfo(ctx).WorkflowExecution.ID
+	taskCh := make(chan *shared.WorkflowExecution, len(executions))
+	resultCh := make(chan taskResult, len(executions))
+	for _, execution := range executions {
+		taskCh <- execution
+	}
+	close(taskCh)
+
+	for i := 0; i < params.Concurrency; i++ {
+		go func() {
+			for execution := range taskCh {
+				err := processWorkflow(ctx, client, tokenBucket, batchID, params, execution)
+				if err != nil {
+					logger.Error("failed to process workflow",
+						zap.String(logTagBatchWorkflowID, execution.GetWorkflowId()), zap.Error(err))
+				}
+				resultCh <- taskResult{execution: execution, err: err}
+			}
+		}()
+	}
+	return resultCh
+}
+
+func processWorkflow(
+	ctx context.Context,
+	client frontend.Client,
+	tokenBucket common.TokenBucket,
+	batchID string,
+	params BatchParams,
+	execution *shared.WorkflowExecution,
+) error {
+
+	if err := waitForToken(ctx, tokenBucket); err != nil {
+		return err
+	}
+
+	// the request id is the same every time the batch processes the workflow, so the signal or
+	// cancellation request of a retried operation is deduplicated by the history service
+	requestID := getRequestID(batchID, execution)
+	var err error
+	switch params.BatchType {
+	case BatchTypeTerminate:
+		err = client.TerminateWorkflowExecution(ctx, &shared.TerminateWorkflowExecutionRequest{
+			Domain:            common.StringPtr(params.DomainName),
+			WorkflowExecution: execution,
+			Reason:            common.StringPtr(params.Reason),
+			Identity:          common.StringPtr(batchIdentity),
+		})
+	case BatchTypeCancel:
+		err = client.RequestCancelWorkflowExecution(ctx, &shared.RequestCancelWorkflowExecutionRequest{
+			Domain:            common.StringPtr(params.DomainName),
+			WorkflowExecution: execution,
+			Identity:          common.StringPtr(batchIdentity),
+			RequestId:         common.StringPtr(requestID),
+		})
+	case BatchTypeSignal:
+		err = client.SignalWorkflowExecution(ctx, &shared.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(params.DomainName),
+			WorkflowExecution: execution,
+			SignalName:        common.StringPtr(params.SignalName),
+			Input:             []byte(params.SignalInput),
+			Identity:          common.StringPtr(batchIdentity),
+			RequestId:         common.StringPtr(requestID),
+		})
+	default:
+		err = fmt.Errorf("unknown batch type: %v", params.BatchType)
+	}
+
+	if _, ok := err.(*shared.EntityNotExistsError); ok {
+		// the workflow was closed after it was listed
+		return nil
+	}
+	return err
+}
+
+// getWorkflowKey returns the key of the workflow the progress of the batch is recorded with
+func getWorkflowKey(execution *shared.WorkflowExecution) string {
+	return execution.GetWorkflowId() + "/" + execution.GetRunId()
+}
+
+// getRequestID returns the request id of the operation of the batch on the workflow
+func getRequestID(batchID string, execution *shared.WorkflowExecution) string {
+	return uuid.NewSHA1(uuid.NameSpace_OID, []byte(batchID+"/"+getWorkflowKey(execution))).String()
+}
+
+func waitForToken(ctx context.Context, tokenBucket common.TokenBucket) error {
+	for {
+		ok, waitTime := tokenBucket.TryConsume(1)
+		if ok {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(waitTime):
+		}
+	}
+}
+
+func getStartTimeFilter(params BatchParams) *shared.StartTimeFilter {
+	latest := params.StartTimeLatest
+	if latest == 0 {
+		latest = time.Now().UnixNano()
+	}
+	return &shared.StartTimeFilter{
+		EarliestTime: common.Int64Ptr(params.StartTimeEarliest),
+		LatestTime:   common.Int64Ptr(latest),
+	}
+}
+
+// ValidateParams checks the params of a batch and fills in the defaults
+func ValidateParams(params BatchParams) (BatchParams, error) {
+	if params.DomainName == "" {
+		return params, errors.New("domain is not set")
+	}
+	if params.Reason == "" {
+		return params, errors.New("reason is not set")
+	}
+	switch params.BatchType {
+	case BatchTypeTerminate, BatchTypeCancel:
+	case BatchTypeSignal:
+		if params.SignalName == "" {
+			return params, errors.New("signal name is not set")
+		}
+	default:
+		return params, fmt.Errorf("unknown batch type: %v", params.BatchType)
+	}
+	if params.StartTimeLatest != 0 && params.StartTimeLatest < params.StartTimeEarliest {
+		return params, errors.New("latest start time is before earliest start time")
+	}
+	if params.RPS <= 0 {
+		params.RPS = DefaultRPS
+	}
+	if params.Concurrency <= 0 {
+		params.Concurrency = DefaultConcurrency
+	}
+	return params, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common"
+	"go.uber.org/cadence/.gen/go/shared"
+)
+
+type workflowSuite struct {
+	suite.Suite
+}
+
+func TestWorkflowSuite(t *testing.T) {
+	s := new(workflowSuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowSuite) TestValidateParams() {
+	params, err := ValidateParams(BatchParams{
+		DomainName: "test-domain",
+		BatchType:  BatchTypeTerminate,
+		Reason:     "test",
+	})
+	s.NoError(err)
+	s.Equal(DefaultRPS, params.RPS)
+	s.Equal(DefaultConcurrency, params.Concurrency)
+
+	params, err = ValidateParams(BatchParams{
+		DomainName:  "test-domain",
+		BatchType:   BatchTypeSignal,
+		Reason:      "test",
+		SignalName:  "test-signal",
+		RPS:         10,
+		Concurrency: 2,
+	})
+	s.NoError(err)
+	s.Equal(10, params.RPS)
+	s.Equal(2, params.Concurrency)
+}
+
+func (s *workflowSuite) TestValidateParams_Invalid() {
+	valid := BatchParams{
+		DomainName: "test-domain",
+		BatchType:  BatchTypeCancel,
+		Reason:     "test",
+	}
+
+	params := valid
+	params.DomainName = ""
+	_, err := ValidateParams(params)
+	s.Error(err)
+
+	params = valid
+	params.Reason = ""
+	_, err = ValidateParams(params)
+	s.Error(err)
+
+	params = valid
+	params.BatchType = "reset"
+	_, err = ValidateParams(params)
+	s.Error(err)
+
+	params = valid
+	params.BatchType = BatchTypeSignal
+	_, err = ValidateParams(params)
+	s.Error(err)
+
+	params = valid
+	params.StartTimeEarliest = 2
+	params.StartTimeLatest = 1
+	_, err = ValidateParams(params)
+	s.Error(err)
+}
+
+func (s *workflowSuite) TestGetRequestID() {
+	execution := &shared.WorkflowExecution{WorkflowId: common.StringPtr("test-workflow-id"), RunId: common.StringPtr("test-run-id")}
+	otherRun := &shared.WorkflowExecution{WorkflowId: common.StringPtr("test-workflow-id"), RunId: common.StringPtr("other-run-id")}
+
+	requestID := getRequestID("test-batch-id", execution)
+	s.Equal(requestID, getRequestID("test-batch-id", execution))
+	s.NotEqual(requestID, getRequestID("other-batch-id", execution))
+	s.NotEqual(requestID, getRequestID("test-batch-id", otherRun))
+}
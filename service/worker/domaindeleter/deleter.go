@@ -0,0 +1,88 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domaindeleter
+
+import (
+	"context"
+
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/persistence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+type (
+	// BootstrapParams are the clients and persistence managers the domain deleter operates on
+	BootstrapParams struct {
+		FrontendClient        frontend.Client
+		NumberOfHistoryShards int
+		MetadataMgr           persistence.MetadataManager
+		VisibilityMgr         persistence.VisibilityManager
+		TaskMgr               persistence.TaskManager
+		HistoryMgr            persistence.HistoryManager
+		HistoryV2Mgr          persistence.HistoryV2Manager
+		ExecutionMgrFactory   persistence.ExecutionManagerFactory
+	}
+
+	// DomainDeleter is the cadence client worker responsible for running domain deletions
+	DomainDeleter struct {
+		worker worker.Worker
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(DeleteDomainWorkflow, workflow.RegisterOptions{Name: DeleteDomainWorkflowFnName})
+	activity.RegisterWithOptions(terminateExecutionsActivity, activity.RegisterOptions{Name: terminateExecutionsActivityFnName})
+	activity.RegisterWithOptions(deleteExecutionsActivity, activity.RegisterOptions{Name: deleteExecutionsActivityFnName})
+	activity.RegisterWithOptions(deleteTaskListsActivity, activity.RegisterOptions{Name: deleteTaskListsActivityFnName})
+	activity.RegisterWithOptions(deleteDomainActivity, activity.RegisterOptions{Name: deleteDomainActivityFnName})
+}
+
+// New returns a new DomainDeleter
+func New(params *BootstrapParams, scope tally.Scope) *DomainDeleter {
+	logger, _ := zap.NewProduction()
+	actCtx := context.WithValue(context.Background(), bootstrapParamsKey, params)
+	wo := worker.Options{
+		Logger:                    logger,
+		MetricsScope:              scope.SubScope(domainDeleterScope),
+		BackgroundActivityContext: actCtx,
+	}
+	return &DomainDeleter{
+		worker: worker.New(params.FrontendClient, Domain, TaskList, wo),
+	}
+}
+
+// Start the DomainDeleter
+func (d *DomainDeleter) Start() error {
+	if err := d.worker.Start(); err != nil {
+		d.worker.Stop()
+		return err
+	}
+	return nil
+}
+
+// Stop the DomainDeleter
+func (d *DomainDeleter) Stop() {
+	d.worker.Stop()
+}
@@ -0,0 +1,505 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domaindeleter
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/worker/sysworkflow"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	// Domain is the domain the domain deletion workflows run in
+	Domain = sysworkflow.Domain
+	// TaskList is the task list of the domain deletion workflows
+	TaskList = "cadsys-domain-deleter-tl"
+	// WorkflowIDPrefix is the prefix of the workflow ids of domain deletion workflows
+	WorkflowIDPrefix = "cadsys-domain-deleter"
+	// DeleteDomainWorkflowFnName is the name of the domain deletion workflow function
+	DeleteDomainWorkflowFnName = "DeleteDomainWorkflow"
+	// WorkflowStartToCloseTimeout is the time for a domain deletion to finish
+	WorkflowStartToCloseTimeout = sysworkflow.WorkflowStartToCloseTimeout
+	// DecisionTaskStartToCloseTimeout is the time for decision to finish
+	DecisionTaskStartToCloseTimeout = sysworkflow.DecisionTaskStartToCloseTimeout
+	// QueryTypeProgress is the query type which returns the progress of a domain deletion
+	QueryTypeProgress = "progress"
+
+	// StageTerminateExecutions is the stage in which the open executions of the domain are terminated
+	StageTerminateExecutions = "terminate-executions"
+	// StageDeleteExecutions is the stage in which the closed executions of the domain are deleted
+	StageDeleteExecutions = "delete-executions"
+	// StageDeleteTaskLists is the stage in which the task lists of the domain are deleted
+	StageDeleteTaskLists = "delete-task-lists"
+	// StageDeleteDomain is the stage in which the domain record is deleted
+	StageDeleteDomain = "delete-domain"
+	// StageCompleted is the stage of a completed domain deletion
+	StageCompleted = "completed"
+
+	terminateExecutionsActivityFnName = "TerminateExecutionsActivity"
+	deleteExecutionsActivityFnName    = "DeleteExecutionsActivity"
+	deleteTaskListsActivityFnName     = "DeleteTaskListsActivity"
+	deleteDomainActivityFnName        = "DeleteDomainActivity"
+
+	activityHeartbeat           = time.Minute
+	visibilityPollInterval      = 10 * time.Second
+	listPageSize                = 100
+	deleterIdentity             = "cadence-domain-deleter"
+	terminateReason             = "domain is deleted"
+	domainDeleterScope          = "domain-deleter"
+	errReasonInvalidParams      = "cadenceInternal:InvalidDomainDeletionParams"
+	errReasonOpenExecutions     = "cadenceInternal:DomainHasOpenExecutions"
+	logTagDomainID              = "domain-id"
+	logTagDomainName            = "domain-name"
+	logTagWorkflowID            = "workflow-id"
+	logTagRunID                 = "run-id"
+	logTagTaskList              = "task-list"
+	logTagCount                 = "count"
+	executionsTerminatedCounter = "executions-terminated"
+	executionsDeletedCounter    = "executions-deleted"
+	taskListsDeletedCounter     = "task-lists-deleted"
+	domainsDeletedCounter       = "domains-deleted"
+)
+
+type (
+	// Params are the parameters of a domain deletion
+	Params struct {
+		DomainID   string
+		DomainName string
+	}
+
+	// Progress is the progress of a domain deletion. It is returned by the progress query while the deletion
+	// runs, and by the workflow once it completes.
+	Progress struct {
+		Stage                string
+		ExecutionsTerminated int
+		ExecutionsDeleted    int
+		TaskListsDeleted     int
+	}
+
+	contextKey int
+)
+
+const (
+	bootstrapParamsKey contextKey = iota
+)
+
+// WorkflowID returns the id of the workflow which deletes the domain with the given id, there is at most one
+// deletion of a domain running at a time
+func WorkflowID(domainID string) string {
+	return WorkflowIDPrefix + "-" + domainID
+}
+
+// DeleteDomainWorkflow terminates the open executions of a deprecated domain, deletes its executions together
+// with their history and visibility records, deletes its task lists, and finally deletes the domain itself.
+// Every stage is an activity which can be retried, the activities only look at what is left of the domain,
+// so a retried activity continues where the previous attempt stopped. If an execution was opened while the
+// domain was being deleted, the deletion starts over from terminating the open executions.
+func DeleteDomainWorkflow(ctx workflow.Context, params Params) (Progress, error) {
+	logger := workflow.GetLogger(ctx).With(
+		zap.String(logTagDomainID, params.DomainID),
+		zap.String(logTagDomainName, params.DomainName))
+	progress := Progress{Stage: StageTerminateExecutions}
+	if err := workflow.SetQueryHandler(ctx, QueryTypeProgress, func() (Progress, error) {
+		return progress, nil
+	}); err != nil {
+		return progress, err
+	}
+	if err := validateParams(params); err != nil {
+		logger.Error("invalid domain deletion params", zap.Error(err))
+		return progress, cadence.NewCustomError(errReasonInvalidParams, err.Error())
+	}
+
+	ao := workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    WorkflowStartToCloseTimeout,
+		HeartbeatTimeout:       activityHeartbeat,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          10 * time.Second,
+			BackoffCoefficient:       2.0,
+			MaximumInterval:          5 * time.Minute,
+			ExpirationInterval:       WorkflowStartToCloseTimeout,
+			NonRetriableErrorReasons: []string{errReasonOpenExecutions},
+		},
+	}
+	actCtx := workflow.WithActivityOptions(ctx, ao)
+
+	for {
+		var count int
+		progress.Stage = StageTerminateExecutions
+		if err := workflow.ExecuteActivity(actCtx, terminateExecutionsActivityFnName, params).Get(ctx, &count); err != nil {
+			return progress, err
+		}
+		progress.ExecutionsTerminated += count
+		progress.Stage = StageDeleteExecutions
+		if err := workflow.ExecuteActivity(actCtx, deleteExecutionsActivityFnName, params).Get(ctx, &count); err != nil {
+			return progress, err
+		}
+		progress.ExecutionsDeleted += count
+		progress.Stage = StageDeleteTaskLists
+		if err := workflow.ExecuteActivity(actCtx, deleteTaskListsActivityFnName, params).Get(ctx, &count); err != nil {
+			return progress, err
+		}
+		progress.TaskListsDeleted += count
+		progress.Stage = StageDeleteDomain
+		err := workflow.ExecuteActivity(actCtx, deleteDomainActivityFnName, params).Get(ctx, nil)
+		if customErr, ok := err.(*cadence.CustomError); ok && customErr.Reason() == errReasonOpenExecutions {
+			logger.Warn("executions were opened during the domain deletion, deleting them again")
+			continue
+		}
+		if err != nil {
+			return progress, err
+		}
+		break
+	}
+	progress.Stage = StageCompleted
+
+	logger.Info("domain deleted",
+		zap.Int(executionsTerminatedCounter, progress.ExecutionsTerminated),
+		zap.Int(executionsDeletedCounter, progress.ExecutionsDeleted),
+		zap.Int(taskListsDeletedCounter, progress.TaskListsDeleted))
+	return progress, nil
+}
+
+// terminateExecutionsActivity terminates the open executions of the domain, it returns once visibility has
+// recorded all of them as closed
+func terminateExecutionsActivity(ctx context.Context, params Params) (int, error) {
+	bootstrap := ctx.Value(bootstrapParamsKey).(*BootstrapParams)
+	logger := activity.GetLogger(ctx).With(zap.String(logTagDomainID, params.DomainID))
+	scope := activity.GetMetricsScope(ctx)
+	terminated := getHeartbeatCount(ctx, logger)
+
+	var previousPage map[string]struct{}
+	for {
+		// the first page is listed every time, the terminated executions leave it once their close is recorded
+		resp, err := bootstrap.VisibilityMgr.ListOpenWorkflowExecutions(newListRequest(params))
+		if err != nil {
+			logger.Error("failed to list open executions", zap.Error(err))
+			return terminated, err
+		}
+		if len(resp.Executions) == 0 {
+			logger.Info("open executions terminated", zap.Int(logTagCount, terminated))
+			return terminated, nil
+		}
+
+		currentPage := make(map[string]struct{}, len(resp.Executions))
+		for _, info := range resp.Executions {
+			runID := info.Execution.GetRunId()
+			currentPage[runID] = struct{}{}
+			if _, ok := previousPage[runID]; ok {
+				// terminated already, its close is not recorded in visibility yet
+				continue
+			}
+			if err := terminateExecution(ctx, bootstrap, params, info); err != nil {
+				logger.Error("failed to terminate execution",
+					zap.String(logTagWorkflowID, info.Execution.GetWorkflowId()), zap.String(logTagRunID, runID), zap.Error(err))
+				return terminated, err
+			}
+			terminated++
+			scope.Counter(executionsTerminatedCounter).Inc(1)
+			activity.RecordHeartbeat(ctx, terminated)
+		}
+		previousPage = currentPage
+
+		select {
+		case <-ctx.Done():
+			return terminated, ctx.Err()
+		case <-time.After(visibilityPollInterval):
+		}
+		activity.RecordHeartbeat(ctx, terminated)
+	}
+}
+
+// deleteExecutionsActivity deletes the closed executions of the domain together with their history and
+// visibility records
+func deleteExecutionsActivity(ctx context.Context, params Params) (int, error) {
+	bootstrap := ctx.Value(bootstrapParamsKey).(*BootstrapParams)
+	logger := activity.GetLogger(ctx).With(zap.String(logTagDomainID, params.DomainID))
+	scope := activity.GetMetricsScope(ctx)
+	deleted := getHeartbeatCount(ctx, logger)
+
+	var previousPage map[string]struct{}
+	for {
+		// the deleted executions leave visibility, so the first page is listed until there is nothing left
+		resp, err := bootstrap.VisibilityMgr.ListClosedWorkflowExecutions(newListRequest(params))
+		if err != nil {
+			logger.Error("failed to list closed executions", zap.Error(err))
+			return deleted, err
+		}
+		if len(resp.Executions) == 0 {
+			logger.Info("executions deleted", zap.Int(logTagCount, deleted))
+			return deleted, nil
+		}
+
+		currentPage := make(map[string]struct{}, len(resp.Executions))
+		progressed := false
+		for _, info := range resp.Executions {
+			runID := info.Execution.GetRunId()
+			currentPage[runID] = struct{}{}
+			if _, ok := previousPage[runID]; ok {
+				// deleted already, but still returned by a replica which has not seen the delete yet
+				continue
+			}
+			if err := deleteExecution(bootstrap, params, info); err != nil {
+				logger.Error("failed to delete execution",
+					zap.String(logTagWorkflowID, info.Execution.GetWorkflowId()), zap.String(logTagRunID, runID), zap.Error(err))
+				return deleted, err
+			}
+			progressed = true
+			deleted++
+			scope.Counter(executionsDeletedCounter).Inc(1)
+			activity.RecordHeartbeat(ctx, deleted)
+		}
+		previousPage = currentPage
+
+		if ctx.Err() != nil {
+			return deleted, ctx.Err()
+		}
+		if !progressed {
+			select {
+			case <-ctx.Done():
+				return deleted, ctx.Err()
+			case <-time.After(visibilityPollInterval):
+			}
+			activity.RecordHeartbeat(ctx, deleted)
+		}
+	}
+}
+
+// deleteTaskListsActivity deletes the task lists of the domain together with their tasks
+func deleteTaskListsActivity(ctx context.Context, params Params) (int, error) {
+	bootstrap := ctx.Value(bootstrapParamsKey).(*BootstrapParams)
+	logger := activity.GetLogger(ctx).With(zap.String(logTagDomainID, params.DomainID))
+	scope := activity.GetMetricsScope(ctx)
+	deleted := getHeartbeatCount(ctx, logger)
+
+	var pageToken []byte
+	for {
+		resp, err := bootstrap.TaskMgr.ListTaskList(&persistence.ListTaskListRequest{
+			DomainID:  params.DomainID,
+			PageSize:  listPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			logger.Error("failed to list task lists", zap.Error(err))
+			return deleted, err
+		}
+
+		for _, taskList := range resp.Items {
+			// a task list which is leased by matching again after it was listed is not deleted, the
+			// activity fails and the retry lists it again
+			if err := bootstrap.TaskMgr.DeleteTaskList(&persistence.DeleteTaskListRequest{
+				DomainID:     params.DomainID,
+				TaskListName: taskList.Name,
+				TaskListType: taskList.TaskType,
+				RangeID:      taskList.RangeID,
+			}); err != nil {
+				logger.Error("failed to delete task list", zap.String(logTagTaskList, taskList.Name), zap.Error(err))
+				return deleted, err
+			}
+			deleted++
+			scope.Counter(taskListsDeletedCounter).Inc(1)
+			activity.RecordHeartbeat(ctx, deleted)
+		}
+
+		if len(resp.NextPageToken) == 0 {
+			logger.Info("task lists deleted", zap.Int(logTagCount, deleted))
+			return deleted, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// deleteDomainActivity deletes the domain record, after which the domain no longer exists. The domain is only
+// deleted if it has no open executions, an execution started before the deprecation of the domain was seen by
+// all the history hosts could have been opened after the open executions were terminated.
+func deleteDomainActivity(ctx context.Context, params Params) error {
+	bootstrap := ctx.Value(bootstrapParamsKey).(*BootstrapParams)
+	logger := activity.GetLogger(ctx).With(zap.String(logTagDomainID, params.DomainID))
+	open, err := hasOpenExecutions(bootstrap, params)
+	if err != nil {
+		logger.Error("failed to list open executions", zap.Error(err))
+		return err
+	}
+	if open {
+		return cadence.NewCustomError(errReasonOpenExecutions)
+	}
+	if err := bootstrap.MetadataMgr.DeleteDomain(&persistence.DeleteDomainRequest{ID: params.DomainID}); err != nil {
+		logger.Error("failed to delete domain", zap.Error(err))
+		return err
+	}
+	activity.GetMetricsScope(ctx).Counter(domainsDeletedCounter).Inc(1)
+	return nil
+}
+
+func terminateExecution(ctx context.Context, bootstrap *BootstrapParams, params Params, info *gen.WorkflowExecutionInfo) error {
+	err := bootstrap.FrontendClient.TerminateWorkflowExecution(ctx, &shared.TerminateWorkflowExecutionRequest{
+		Domain: common.StringPtr(params.DomainName),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: info.Execution.WorkflowId,
+			RunId:      info.Execution.RunId,
+		},
+		Reason:   common.StringPtr(terminateReason),
+		Identity: common.StringPtr(deleterIdentity),
+	})
+	if _, ok := err.(*shared.EntityNotExistsError); ok {
+		// the execution was closed after it was listed, its close is recorded in visibility soon. If the
+		// mutable state is gone as well, the visibility record is stale and would never be closed.
+		executionMgr, err := newExecutionManager(bootstrap, info.Execution.GetWorkflowId())
+		if err != nil {
+			return err
+		}
+		_, err = executionMgr.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+			DomainID:  params.DomainID,
+			Execution: *info.Execution,
+		})
+		if _, ok := err.(*gen.EntityNotExistsError); ok {
+			return deleteExecution(bootstrap, params, info)
+		}
+		return err
+	}
+	return err
+}
+
+// deleteExecution deletes the history, the mutable state and the visibility record of an execution. Every
+// step can be done again, so an execution which was deleted partially is deleted again from the start.
+func deleteExecution(bootstrap *BootstrapParams, params Params, info *gen.WorkflowExecutionInfo) error {
+	execution := *info.Execution
+	executionMgr, err := newExecutionManager(bootstrap, execution.GetWorkflowId())
+	if err != nil {
+		return err
+	}
+
+	// the history branch is only known from the mutable state, so the history is deleted first
+	resp, err := executionMgr.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		DomainID:  params.DomainID,
+		Execution: execution,
+	})
+	switch err.(type) {
+	case nil:
+		executionInfo := resp.State.ExecutionInfo
+		if executionInfo.EventStoreVersion == persistence.EventStoreVersionV2 {
+			if err := bootstrap.HistoryV2Mgr.DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{
+				BranchToken: executionInfo.BranchToken,
+			}); err != nil {
+				return err
+			}
+		} else if err := deleteHistoryV1(bootstrap, params, execution); err != nil {
+			return err
+		}
+		if err := executionMgr.DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+			DomainID:   params.DomainID,
+			WorkflowID: execution.GetWorkflowId(),
+			RunID:      execution.GetRunId(),
+		}); err != nil {
+			return err
+		}
+		if err := executionMgr.DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
+			DomainID:   params.DomainID,
+			WorkflowID: execution.GetWorkflowId(),
+			RunID:      execution.GetRunId(),
+		}); err != nil {
+			return err
+		}
+	case *gen.EntityNotExistsError:
+		// the mutable state is gone already, deleted by a previous attempt or after the retention period,
+		// the history of the v1 store can still be deleted as it is found by the execution alone
+		if err := deleteHistoryV1(bootstrap, params, execution); err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+
+	return bootstrap.VisibilityMgr.DeleteWorkflowExecution(&persistence.VisibilityDeleteWorkflowExecutionRequest{
+		DomainUUID:     params.DomainID,
+		Domain:         params.DomainName,
+		Execution:      execution,
+		StartTimestamp: info.GetStartTime(),
+		CloseTimestamp: info.GetCloseTime(),
+	})
+}
+
+func deleteHistoryV1(bootstrap *BootstrapParams, params Params, execution gen.WorkflowExecution) error {
+	return bootstrap.HistoryMgr.DeleteWorkflowExecutionHistory(&persistence.DeleteWorkflowExecutionHistoryRequest{
+		DomainID:  params.DomainID,
+		Execution: execution,
+	})
+}
+
+// newExecutionManager returns the execution manager of the shard of the workflow, the execution manager shares
+// the store session of the factory, so it is not closed by the caller
+func newExecutionManager(bootstrap *BootstrapParams, workflowID string) (persistence.ExecutionManager, error) {
+	shardID := common.WorkflowIDToHistoryShard(workflowID, bootstrap.NumberOfHistoryShards)
+	return bootstrap.ExecutionMgrFactory.NewExecutionManager(shardID)
+}
+
+// getHeartbeatCount returns the count recorded with the last heartbeat of a previous attempt of the activity
+func getHeartbeatCount(ctx context.Context, logger *zap.Logger) int {
+	count := 0
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &count); err != nil {
+			logger.Error("failed to recover the progress of the activity, counting from zero", zap.Error(err))
+			return 0
+		}
+	}
+	return count
+}
+
+// hasOpenExecutions returns whether visibility has an open execution of the domain
+func hasOpenExecutions(bootstrap *BootstrapParams, params Params) (bool, error) {
+	request := newListRequest(params)
+	request.PageSize = 1
+	resp, err := bootstrap.VisibilityMgr.ListOpenWorkflowExecutions(request)
+	if err != nil {
+		return false, err
+	}
+	return len(resp.Executions) > 0, nil
+}
+
+func newListRequest(params Params) *persistence.ListWorkflowExecutionsRequest {
+	return &persistence.ListWorkflowExecutionsRequest{
+		DomainUUID:        params.DomainID,
+		Domain:            params.DomainName,
+		EarliestStartTime: 0,
+		LatestStartTime:   time.Now().UnixNano(),
+		PageSize:          listPageSize,
+	}
+}
+
+func validateParams(params Params) error {
+	if params.DomainID == "" {
+		return errors.New("domain id is not set")
+	}
+	if params.DomainName == "" {
+		return errors.New("domain name is not set")
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domaindeleter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type workflowSuite struct {
+	suite.Suite
+
+	mockExecutionMgr *mocks.ExecutionManager
+	mockHistoryMgr   *mocks.HistoryManager
+	mockHistoryV2Mgr *mocks.HistoryV2Manager
+	mockVisibility   *mocks.VisibilityManager
+	bootstrap        *BootstrapParams
+}
+
+func TestWorkflowSuite(t *testing.T) {
+	s := new(workflowSuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowSuite) SetupTest() {
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
+	s.mockHistoryMgr = &mocks.HistoryManager{}
+	s.mockHistoryV2Mgr = &mocks.HistoryV2Manager{}
+	s.mockVisibility = &mocks.VisibilityManager{}
+	mockFactory := &mocks.ExecutionManagerFactory{}
+	mockFactory.On("NewExecutionManager", mock.Anything).Return(s.mockExecutionMgr, nil)
+	s.bootstrap = &BootstrapParams{
+		NumberOfHistoryShards: 4,
+		VisibilityMgr:         s.mockVisibility,
+		HistoryMgr:            s.mockHistoryMgr,
+		HistoryV2Mgr:          s.mockHistoryV2Mgr,
+		ExecutionMgrFactory:   mockFactory,
+	}
+}
+
+func (s *workflowSuite) TearDownTest() {
+	s.mockExecutionMgr.AssertExpectations(s.T())
+	s.mockHistoryMgr.AssertExpectations(s.T())
+	s.mockHistoryV2Mgr.AssertExpectations(s.T())
+	s.mockVisibility.AssertExpectations(s.T())
+}
+
+func (s *workflowSuite) TestValidateParams() {
+	s.NoError(validateParams(Params{DomainID: "test-domain-id", DomainName: "test-domain"}))
+	s.Error(validateParams(Params{DomainName: "test-domain"}))
+	s.Error(validateParams(Params{DomainID: "test-domain-id"}))
+}
+
+func (s *workflowSuite) TestWorkflowID() {
+	s.Equal("cadsys-domain-deleter-test-domain-id", WorkflowID("test-domain-id"))
+}
+
+func (s *workflowSuite) TestDeleteExecution_HistoryV2() {
+	params := Params{DomainID: "test-domain-id", DomainName: "test-domain"}
+	info := s.newExecutionInfo()
+	branchToken := []byte("branch-token")
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				EventStoreVersion: persistence.EventStoreVersionV2,
+				BranchToken:       branchToken,
+			},
+		},
+	}, nil).Once()
+	s.mockHistoryV2Mgr.On("DeleteHistoryBranch", &persistence.DeleteHistoryBranchRequest{
+		BranchToken: branchToken,
+	}).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteCurrentWorkflowExecution", &persistence.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   params.DomainID,
+		WorkflowID: info.Execution.GetWorkflowId(),
+		RunID:      info.Execution.GetRunId(),
+	}).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteWorkflowExecution", &persistence.DeleteWorkflowExecutionRequest{
+		DomainID:   params.DomainID,
+		WorkflowID: info.Execution.GetWorkflowId(),
+		RunID:      info.Execution.GetRunId(),
+	}).Return(nil).Once()
+	s.mockVisibility.On("DeleteWorkflowExecution", &persistence.VisibilityDeleteWorkflowExecutionRequest{
+		DomainUUID:     params.DomainID,
+		Domain:         params.DomainName,
+		Execution:      *info.Execution,
+		StartTimestamp: info.GetStartTime(),
+		CloseTimestamp: info.GetCloseTime(),
+	}).Return(nil).Once()
+
+	s.NoError(deleteExecution(s.bootstrap, params, info))
+}
+
+func (s *workflowSuite) TestDeleteExecution_MutableStateMissing() {
+	params := Params{DomainID: "test-domain-id", DomainName: "test-domain"}
+	info := s.newExecutionInfo()
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, &gen.EntityNotExistsError{}).Once()
+	s.mockHistoryMgr.On("DeleteWorkflowExecutionHistory", &persistence.DeleteWorkflowExecutionHistoryRequest{
+		DomainID:  params.DomainID,
+		Execution: *info.Execution,
+	}).Return(nil).Once()
+	s.mockVisibility.On("DeleteWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	s.NoError(deleteExecution(s.bootstrap, params, info))
+}
+
+func (s *workflowSuite) TestDeleteExecution_ReadFailure() {
+	params := Params{DomainID: "test-domain-id", DomainName: "test-domain"}
+	info := s.newExecutionInfo()
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, &gen.InternalServiceError{}).Once()
+
+	s.Error(deleteExecution(s.bootstrap, params, info))
+}
+
+func (s *workflowSuite) TestHasOpenExecutions() {
+	params := Params{DomainID: "test-domain-id", DomainName: "test-domain"}
+
+	s.mockVisibility.On("ListOpenWorkflowExecutions", mock.MatchedBy(func(request *persistence.ListWorkflowExecutionsRequest) bool {
+		return request.DomainUUID == params.DomainID && request.PageSize == 1
+	})).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*gen.WorkflowExecutionInfo{s.newExecutionInfo()},
+	}, nil).Once()
+	open, err := hasOpenExecutions(s.bootstrap, params)
+	s.NoError(err)
+	s.True(open)
+
+	s.mockVisibility.On("ListOpenWorkflowExecutions", mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+	open, err = hasOpenExecutions(s.bootstrap, params)
+	s.NoError(err)
+	s.False(open)
+}
+
+func (s *workflowSuite) newExecutionInfo() *gen.WorkflowExecutionInfo {
+	return &gen.WorkflowExecutionInfo{
+		Execution: &gen.WorkflowExecution{
+			WorkflowId: common.StringPtr("test-workflow-id"),
+			RunId:      common.StringPtr("test-run-id"),
+		},
+		StartTime: common.Int64Ptr(100),
+		CloseTime: common.Int64Ptr(200),
+	}
+}
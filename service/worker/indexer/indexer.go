@@ -0,0 +1,277 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package indexer
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/codec/gob"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/search"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// Indexer consumes the visibility messages published by the history service and writes them
+	// in batches to the search backend
+	Indexer struct {
+		client        messaging.Client
+		searchClient  search.Client
+		consumer      messaging.Consumer
+		config        *Config
+		logger        bark.Logger
+		metricsClient metrics.Client
+		msgEncoder    *gob.Encoder
+		isStarted     int32
+		isStopped     int32
+		shutdownWG    sync.WaitGroup
+		shutdownCh    chan struct{}
+	}
+
+	// Config contains all the indexer config for worker
+	Config struct {
+		IndexerConcurrency   dynamicconfig.IntPropertyFn
+		IndexerBatchSize     dynamicconfig.IntPropertyFn
+		IndexerFlushInterval dynamicconfig.DurationPropertyFn
+	}
+
+	// batch is the visibility messages a worker has not written to the search backend yet
+	batch struct {
+		messages  []messaging.Message
+		documents []*search.Document
+	}
+)
+
+const (
+	consumerName = "cadence-visibility-indexer"
+
+	bulkInitialRetryInterval = 100 * time.Millisecond
+	bulkMaxRetryInterval     = 5 * time.Second
+	bulkExpirationInterval   = time.Minute
+)
+
+var bulkRetryPolicy = createBulkRetryPolicy()
+
+// NewIndexer creates a new indexer for visibility messages
+func NewIndexer(client messaging.Client, searchClient search.Client, config *Config, logger bark.Logger,
+	metricsClient metrics.Client) *Indexer {
+	return &Indexer{
+		client:       client,
+		searchClient: searchClient,
+		config:       config,
+		logger: logger.WithFields(bark.Fields{
+			logging.TagWorkflowComponent: logging.TagValueIndexerComponent,
+			logging.TagConsumerName:      consumerName,
+		}),
+		metricsClient: metricsClient,
+		msgEncoder:    gob.NewGobEncoder(),
+		shutdownCh:    make(chan struct{}),
+	}
+}
+
+// Start is called to start the indexer
+func (i *Indexer) Start() error {
+	if !atomic.CompareAndSwapInt32(&i.isStarted, 0, 1) {
+		return nil
+	}
+
+	consumer, err := i.client.NewConsumer(messaging.VisibilityTopicsKey, messaging.VisibilityTopicsKey, consumerName,
+		i.config.IndexerConcurrency())
+	if err != nil {
+		return err
+	}
+	if err := consumer.Start(); err != nil {
+		return err
+	}
+
+	i.consumer = consumer
+	i.shutdownWG.Add(1)
+	go i.processorPump()
+
+	i.logger.Info("Indexer started.")
+	return nil
+}
+
+// Stop is called to stop the indexer, the messages which were not indexed yet are consumed again
+// after the restart as they are not acked
+func (i *Indexer) Stop() {
+	if !atomic.CompareAndSwapInt32(&i.isStopped, 0, 1) {
+		return
+	}
+
+	if atomic.LoadInt32(&i.isStarted) == 1 {
+		close(i.shutdownCh)
+	}
+
+	if success := common.AwaitWaitGroup(&i.shutdownWG, time.Minute); !success {
+		i.logger.Warn("Indexer timed out on shutdown.")
+	}
+	i.logger.Info("Indexer stopped.")
+}
+
+func (i *Indexer) processorPump() {
+	defer i.shutdownWG.Done()
+
+	var workerWG sync.WaitGroup
+	for workerID := 0; workerID < i.config.IndexerConcurrency(); workerID++ {
+		workerWG.Add(1)
+		go i.messageProcessLoop(&workerWG)
+	}
+
+	<-i.shutdownCh
+	// Indexer is shutting down, close the underlying consumer
+	i.consumer.Stop()
+
+	if success := common.AwaitWaitGroup(&workerWG, 10*time.Second); !success {
+		i.logger.Warn("Indexer timed out on worker shutdown.")
+	}
+}
+
+func (i *Indexer) messageProcessLoop(workerWG *sync.WaitGroup) {
+	defer workerWG.Done()
+
+	pending := &batch{}
+	flushTimer := time.NewTimer(i.config.IndexerFlushInterval())
+	defer flushTimer.Stop()
+
+	for {
+		select {
+		case msg, ok := <-i.consumer.Messages():
+			if !ok {
+				return // channel closed
+			}
+			i.add(pending, msg)
+			if len(pending.messages) >= i.config.IndexerBatchSize() {
+				i.flush(pending)
+			}
+		case <-flushTimer.C:
+			i.flush(pending)
+			flushTimer.Reset(i.config.IndexerFlushInterval())
+		case <-i.shutdownCh:
+			return
+		}
+	}
+}
+
+// add decodes the message and adds it to the batch, messages which can not be decoded are nacked
+// right away so that they are moved to the DLQ
+func (i *Indexer) add(pending *batch, msg messaging.Message) {
+	i.metricsClient.IncCounter(metrics.IndexerScope, metrics.IndexerMessages)
+
+	visibilityMsg := &messaging.VisibilityMsg{}
+	if err := i.msgEncoder.Decode(msg.Value(), visibilityMsg); err != nil {
+		i.metricsClient.IncCounter(metrics.IndexerScope, metrics.IndexerFailures)
+		i.logger.WithFields(bark.Fields{
+			logging.TagPartitionKey: msg.Partition(),
+			logging.TagOffset:       msg.Offset(),
+			logging.TagErr:          err,
+		}).Error("Failed to decode visibility message.")
+		msg.Nack()
+		return
+	}
+
+	pending.messages = append(pending.messages, msg)
+	pending.documents = append(pending.documents, toDocument(visibilityMsg))
+}
+
+// flush writes the batch to the search backend and acks the messages which were indexed. A message which
+// is stale, because a newer version of the execution is already indexed, is acked as well.
+func (i *Indexer) flush(pending *batch) {
+	if len(pending.messages) == 0 {
+		return
+	}
+	defer func() {
+		pending.messages = nil
+		pending.documents = nil
+	}()
+
+	var errs []error
+	op := func() error {
+		var err error
+		sw := i.metricsClient.StartTimer(metrics.IndexerScope, metrics.IndexerBulkLatency)
+		errs, err = i.searchClient.Bulk(context.Background(), pending.documents)
+		sw.Stop()
+		return err
+	}
+	if err := backoff.Retry(op, bulkRetryPolicy, nil); err != nil {
+		i.metricsClient.AddCounter(metrics.IndexerScope, metrics.IndexerFailures, int64(len(pending.messages)))
+		i.logger.WithFields(bark.Fields{
+			logging.TagErr: err,
+		}).Error("Failed to write visibility messages to the search backend.")
+		for _, msg := range pending.messages {
+			msg.Nack()
+		}
+		return
+	}
+
+	for index, msg := range pending.messages {
+		err := errs[index]
+		if err == nil || err == search.ErrVersionConflict {
+			msg.Ack()
+			continue
+		}
+
+		document := pending.documents[index]
+		i.metricsClient.IncCounter(metrics.IndexerScope, metrics.IndexerFailures)
+		i.logger.WithFields(bark.Fields{
+			logging.TagDomainID:            document.DomainID,
+			logging.TagWorkflowExecutionID: document.WorkflowID,
+			logging.TagWorkflowRunID:       document.RunID,
+			logging.TagErr:                 err,
+		}).Error("Failed to index visibility message.")
+		msg.Nack()
+	}
+}
+
+func toDocument(msg *messaging.VisibilityMsg) *search.Document {
+	document := &search.Document{
+		DomainID:         msg.DomainID,
+		WorkflowID:       msg.WorkflowID,
+		RunID:            msg.RunID,
+		WorkflowType:     msg.WorkflowTypeName,
+		StartTime:        msg.StartTime,
+		Memo:             msg.Memo,
+		SearchAttributes: msg.SearchAttributes,
+		Version:          msg.Version,
+	}
+	if msg.MsgType == messaging.VisibilityMsgTypeClosed {
+		document.CloseTime = common.Int64Ptr(msg.CloseTime)
+		document.CloseStatus = common.Int32Ptr(msg.CloseStatus)
+		document.HistoryLength = common.Int64Ptr(msg.HistoryLength)
+	}
+	return document
+}
+
+func createBulkRetryPolicy() backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(bulkInitialRetryInterval)
+	policy.SetMaximumInterval(bulkMaxRetryInterval)
+	policy.SetExpirationInterval(bulkExpirationInterval)
+
+	return policy
+}
@@ -0,0 +1,256 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package indexer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/codec/gob"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/search"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	indexerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		consumer     *testConsumer
+		searchClient *testSearchClient
+		indexer      *Indexer
+		acks         chan ackResult
+	}
+
+	testConsumer struct {
+		msgC chan messaging.Message
+	}
+
+	testMessage struct {
+		value  []byte
+		offset int64
+		acks   chan ackResult
+	}
+
+	ackResult struct {
+		offset int64
+		acked  bool
+	}
+
+	// testSearchClient fails the documents of the workflow ids in errs with the given error
+	testSearchClient struct {
+		sync.Mutex
+		bulkErr error
+		errs    map[string]error
+		bulks   [][]*search.Document
+	}
+)
+
+func TestIndexerSuite(t *testing.T) {
+	s := new(indexerSuite)
+	suite.Run(t, s)
+}
+
+func (s *indexerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.consumer = &testConsumer{msgC: make(chan messaging.Message, 10)}
+	s.searchClient = &testSearchClient{errs: make(map[string]error)}
+	s.acks = make(chan ackResult, 10)
+	config := &Config{
+		IndexerConcurrency:   dynamicconfig.GetIntPropertyFn(1),
+		IndexerBatchSize:     dynamicconfig.GetIntPropertyFn(2),
+		IndexerFlushInterval: dynamicconfig.GetDurationPropertyFn(50 * time.Millisecond),
+	}
+	s.indexer = NewIndexer(mocks.NewMockMessagingClient(nil, s.consumer), s.searchClient, config,
+		bark.NewNopLogger(), metrics.NewClient(tally.NoopScope, metrics.Worker))
+	s.NoError(s.indexer.Start())
+}
+
+func (s *indexerSuite) TearDownTest() {
+	s.indexer.Stop()
+}
+
+func (s *indexerSuite) TestIndexBatch() {
+	s.send(1, &messaging.VisibilityMsg{
+		MsgType:    messaging.VisibilityMsgTypeOpen,
+		DomainID:   "domain-id",
+		WorkflowID: "wid-1",
+		RunID:      "rid-1",
+		StartTime:  100,
+		Version:    100,
+	})
+	s.send(2, &messaging.VisibilityMsg{
+		MsgType:       messaging.VisibilityMsgTypeClosed,
+		DomainID:      "domain-id",
+		WorkflowID:    "wid-2",
+		RunID:         "rid-2",
+		StartTime:     100,
+		CloseTime:     200,
+		CloseStatus:   1,
+		HistoryLength: 10,
+		Version:       200,
+	})
+
+	s.Equal(ackResult{offset: 1, acked: true}, s.receiveAck())
+	s.Equal(ackResult{offset: 2, acked: true}, s.receiveAck())
+
+	bulks := s.searchClient.getBulks()
+	s.Len(bulks, 1)
+	s.Len(bulks[0], 2)
+	open, closed := bulks[0][0], bulks[0][1]
+	s.Equal("wid-1", open.WorkflowID)
+	s.Nil(open.CloseTime)
+	s.Equal("wid-2", closed.WorkflowID)
+	s.Equal(int64(200), *closed.CloseTime)
+	s.Equal(int32(1), *closed.CloseStatus)
+	s.Equal(int64(10), *closed.HistoryLength)
+	s.Equal(int64(200), closed.Version)
+}
+
+func (s *indexerSuite) TestFlushOnInterval() {
+	s.send(1, &messaging.VisibilityMsg{WorkflowID: "wid", Version: 100})
+
+	s.Equal(ackResult{offset: 1, acked: true}, s.receiveAck())
+	s.Len(s.searchClient.getBulks(), 1)
+}
+
+func (s *indexerSuite) TestStaleAckedAndFailedNacked() {
+	s.searchClient.setError("wid-stale", search.ErrVersionConflict)
+	s.searchClient.setError("wid-failed", errors.New("mapping conflict"))
+	s.send(1, &messaging.VisibilityMsg{WorkflowID: "wid-stale", Version: 100})
+	s.send(2, &messaging.VisibilityMsg{WorkflowID: "wid-failed", Version: 100})
+
+	s.Equal(ackResult{offset: 1, acked: true}, s.receiveAck())
+	s.Equal(ackResult{offset: 2, acked: false}, s.receiveAck())
+}
+
+func (s *indexerSuite) TestUndecodableMessageNacked() {
+	s.consumer.msgC <- &testMessage{value: []byte("not gob"), offset: 1, acks: s.acks}
+
+	s.Equal(ackResult{offset: 1, acked: false}, s.receiveAck())
+	s.Empty(s.searchClient.getBulks())
+}
+
+func (s *indexerSuite) TestBulkFailureNacksBatch() {
+	policy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	policy.SetMaximumAttempts(2)
+	bulkRetryPolicy, policy = policy, bulkRetryPolicy.(*backoff.ExponentialRetryPolicy)
+	defer func() { bulkRetryPolicy = policy }()
+
+	s.searchClient.bulkErr = errors.New("backend unavailable")
+	s.send(1, &messaging.VisibilityMsg{WorkflowID: "wid-1", Version: 100})
+	s.send(2, &messaging.VisibilityMsg{WorkflowID: "wid-2", Version: 100})
+
+	s.Equal(ackResult{offset: 1, acked: false}, s.receiveAck())
+	s.Equal(ackResult{offset: 2, acked: false}, s.receiveAck())
+}
+
+func (s *indexerSuite) send(offset int64, msg *messaging.VisibilityMsg) {
+	value, err := gob.NewGobEncoder().Encode(msg)
+	s.NoError(err)
+	s.consumer.msgC <- &testMessage{value: value, offset: offset, acks: s.acks}
+}
+
+func (s *indexerSuite) receiveAck() ackResult {
+	select {
+	case result := <-s.acks:
+		return result
+	case <-time.After(5 * time.Second):
+		s.FailNow("timed out waiting for the message to be acked")
+	}
+	return ackResult{}
+}
+
+func (c *testConsumer) Start() error {
+	return nil
+}
+
+func (c *testConsumer) Stop() {
+	close(c.msgC)
+}
+
+func (c *testConsumer) Messages() <-chan messaging.Message {
+	return c.msgC
+}
+
+func (m *testMessage) Value() []byte {
+	return m.value
+}
+
+func (m *testMessage) Partition() int32 {
+	return 0
+}
+
+func (m *testMessage) Offset() int64 {
+	return m.offset
+}
+
+func (m *testMessage) Ack() error {
+	m.acks <- ackResult{offset: m.offset, acked: true}
+	return nil
+}
+
+func (m *testMessage) Nack() error {
+	m.acks <- ackResult{offset: m.offset, acked: false}
+	return nil
+}
+
+func (c *testSearchClient) Bulk(ctx context.Context, documents []*search.Document) ([]error, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.bulkErr != nil {
+		return nil, c.bulkErr
+	}
+	c.bulks = append(c.bulks, documents)
+	errs := make([]error, len(documents))
+	for i, document := range documents {
+		errs[i] = c.errs[document.WorkflowID]
+	}
+	return errs, nil
+}
+
+func (c *testSearchClient) Query(ctx context.Context, request *search.QueryRequest) (*search.QueryResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *testSearchClient) setError(workflowID string, err error) {
+	c.Lock()
+	defer c.Unlock()
+	c.errs[workflowID] = err
+}
+
+func (c *testSearchClient) getBulks() [][]*search.Document {
+	c.Lock()
+	defer c.Unlock()
+	return c.bulks
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicator
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// domainReplicationPuller pulls the changes of the global domains from a remote cluster and applies them, it is
+	// used instead of the kafka consumer when the clusters are in pull replication mode
+	domainReplicationPuller struct {
+		currentCluster   string
+		sourceCluster    string
+		adminClient      admin.Client
+		domainReplicator DomainReplicator
+		metricsClient    metrics.Client
+		logger           bark.Logger
+
+		status     int32
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+
+		// lastRetrievedMessageID is the notification version of the last domain change applied in the source
+		// cluster, all the domains are pulled again when it is -1 which is harmless as applying them is idempotent
+		lastRetrievedMessageID int64
+	}
+)
+
+const (
+	domainReplicationPullTimeout  = 10 * time.Second
+	domainReplicationPullInterval = 10 * time.Second
+)
+
+func newDomainReplicationPuller(currentCluster, sourceCluster string, adminClient admin.Client,
+	domainReplicator DomainReplicator, metricsClient metrics.Client, logger bark.Logger) *domainReplicationPuller {
+
+	return &domainReplicationPuller{
+		currentCluster:   currentCluster,
+		sourceCluster:    sourceCluster,
+		adminClient:      adminClient,
+		domainReplicator: domainReplicator,
+		metricsClient:    metricsClient,
+		logger: logger.WithFields(bark.Fields{
+			logging.TagWorkflowComponent: logging.TagValueDomainReplicationPullerComponent,
+			logging.TagSourceCluster:     sourceCluster,
+		}),
+		status:                 common.DaemonStatusInitialized,
+		shutdownCh:             make(chan struct{}),
+		lastRetrievedMessageID: -1,
+	}
+}
+
+func (p *domainReplicationPuller) Start() {
+	if !atomic.CompareAndSwapInt32(&p.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	p.shutdownWG.Add(1)
+	go p.pullLoop()
+
+	p.logger.Info("Domain replication puller started.")
+}
+
+func (p *domainReplicationPuller) Stop() {
+	if !atomic.CompareAndSwapInt32(&p.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(p.shutdownCh)
+	if success := common.AwaitWaitGroup(&p.shutdownWG, time.Minute); !success {
+		p.logger.Warn("Domain replication puller timed out on shutdown.")
+	}
+
+	p.logger.Info("Domain replication puller stopped.")
+}
+
+func (p *domainReplicationPuller) pullLoop() {
+	defer p.shutdownWG.Done()
+
+	for {
+		select {
+		case <-p.shutdownCh:
+			return
+		default:
+		}
+
+		hasMore, err := p.pullAndApply()
+		if err != nil {
+			p.logger.WithField(logging.TagErr, err).Warn("Unable to pull domain replication tasks.")
+		} else if hasMore {
+			continue
+		}
+
+		select {
+		case <-p.shutdownCh:
+			return
+		case <-time.After(domainReplicationPullInterval):
+		}
+	}
+}
+
+// pullAndApply pulls a batch of domain changes from the source cluster and applies them in order, the pulled
+// position only moves forward once the whole batch is applied so a failed batch is pulled again
+func (p *domainReplicationPuller) pullAndApply() (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), domainReplicationPullTimeout)
+	response, err := p.adminClient.GetDomainReplicationMessages(ctx, &replicator.GetDomainReplicationMessagesRequest{
+		LastRetrievedMessageId: common.Int64Ptr(p.lastRetrievedMessageID),
+		ClusterName:            common.StringPtr(p.currentCluster),
+	})
+	cancel()
+	if err != nil {
+		return false, err
+	}
+
+	for _, task := range response.ReplicationTasks {
+		if err := p.apply(task); err != nil {
+			return false, err
+		}
+	}
+
+	p.lastRetrievedMessageID = response.GetLastRetrievedMessageId()
+	return response.GetHasMore(), nil
+}
+
+func (p *domainReplicationPuller) apply(task *replicator.ReplicationTask) error {
+	p.metricsClient.IncCounter(metrics.DomainReplicationTaskScope, metrics.ReplicatorMessages)
+	sw := p.metricsClient.StartTimer(metrics.DomainReplicationTaskScope, metrics.ReplicatorLatency)
+	defer sw.Stop()
+
+	if err := p.domainReplicator.HandleReceivingTask(task.DomainTaskAttributes); err != nil {
+		p.metricsClient.IncCounter(metrics.DomainReplicationTaskScope, metrics.ReplicatorFailures)
+		return err
+	}
+	return nil
+}
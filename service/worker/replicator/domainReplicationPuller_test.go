@@ -0,0 +1,121 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+)
+
+type (
+	domainReplicationPullerSuite struct {
+		suite.Suite
+		mockAdminClient      *mocks.AdminClient
+		mockDomainReplicator *MockDomainReplicator
+		puller               *domainReplicationPuller
+	}
+)
+
+func TestDomainReplicationPullerSuite(t *testing.T) {
+	s := new(domainReplicationPullerSuite)
+	suite.Run(t, s)
+}
+
+func (s *domainReplicationPullerSuite) SetupTest() {
+	s.mockAdminClient = &mocks.AdminClient{}
+	s.mockDomainReplicator = &MockDomainReplicator{}
+	s.puller = newDomainReplicationPuller(cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName,
+		s.mockAdminClient, s.mockDomainReplicator, metrics.NewClient(tally.NoopScope, metrics.Worker),
+		bark.NewLoggerFromLogrus(logrus.New()))
+}
+
+func (s *domainReplicationPullerSuite) TearDownTest() {
+	s.mockAdminClient.AssertExpectations(s.T())
+	s.mockDomainReplicator.AssertExpectations(s.T())
+}
+
+func (s *domainReplicationPullerSuite) TestPullAndApply() {
+	tasks := []*replicator.ReplicationTask{newDomainReplicationTask(), newDomainReplicationTask()}
+	s.mockAdminClient.On("GetDomainReplicationMessages", mock.Anything, &replicator.GetDomainReplicationMessagesRequest{
+		LastRetrievedMessageId: common.Int64Ptr(-1),
+		ClusterName:            common.StringPtr(cluster.TestCurrentClusterName),
+	}).Return(&replicator.ReplicationMessages{
+		ReplicationTasks:       tasks,
+		LastRetrievedMessageId: common.Int64Ptr(12),
+		HasMore:                common.BoolPtr(true),
+	}, nil).Once()
+	s.mockDomainReplicator.On("HandleReceivingTask", tasks[0].DomainTaskAttributes).Return(nil).Once()
+	s.mockDomainReplicator.On("HandleReceivingTask", tasks[1].DomainTaskAttributes).Return(nil).Once()
+
+	hasMore, err := s.puller.pullAndApply()
+	s.Nil(err)
+	s.True(hasMore)
+	s.Equal(int64(12), s.puller.lastRetrievedMessageID)
+}
+
+func (s *domainReplicationPullerSuite) TestPullAndApply_ApplyFailed() {
+	s.puller.lastRetrievedMessageID = 5
+	tasks := []*replicator.ReplicationTask{newDomainReplicationTask(), newDomainReplicationTask()}
+	s.mockAdminClient.On("GetDomainReplicationMessages", mock.Anything, &replicator.GetDomainReplicationMessagesRequest{
+		LastRetrievedMessageId: common.Int64Ptr(5),
+		ClusterName:            common.StringPtr(cluster.TestCurrentClusterName),
+	}).Return(&replicator.ReplicationMessages{
+		ReplicationTasks:       tasks,
+		LastRetrievedMessageId: common.Int64Ptr(12),
+		HasMore:                common.BoolPtr(false),
+	}, nil).Once()
+	s.mockDomainReplicator.On("HandleReceivingTask", tasks[0].DomainTaskAttributes).Return(errors.New("some random error")).Once()
+
+	_, err := s.puller.pullAndApply()
+	s.NotNil(err)
+	s.Equal(int64(5), s.puller.lastRetrievedMessageID)
+}
+
+func (s *domainReplicationPullerSuite) TestPullAndApply_PullFailed() {
+	s.mockAdminClient.On("GetDomainReplicationMessages", mock.Anything, mock.Anything).
+		Return(nil, errors.New("some random error")).Once()
+
+	_, err := s.puller.pullAndApply()
+	s.NotNil(err)
+	s.Equal(int64(-1), s.puller.lastRetrievedMessageID)
+}
+
+func newDomainReplicationTask() *replicator.ReplicationTask {
+	return &replicator.ReplicationTask{
+		TaskType: replicator.ReplicationTaskTypeDomain.Ptr(),
+		DomainTaskAttributes: &replicator.DomainTaskAttributes{
+			DomainOperation: replicator.DomainOperationUpdate.Ptr(),
+			ID:              common.StringPtr(uuid.New()),
+		},
+	}
+}
@@ -22,6 +22,7 @@ package replicator
 
 import (
 	"context"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -42,6 +43,7 @@ import (
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/xdc"
 	"go.uber.org/yarpc/yarpcerrors"
 )
@@ -70,8 +72,15 @@ type (
 		historyRereplicator xdc.HistoryRereplicator
 		historyClient       history.Client
 		msgEncoder          codec.BinaryEncoder
+		executionMgrFactory persistence.ExecutionManagerFactory
+		numberOfShards      int
 
 		rereplicationLock locks.IDMutex
+
+		sync.Mutex
+		// dlqMetricsClients holds the metrics client tagged with the shard and the source cluster of each shard which
+		// had a task moved to its DLQ
+		dlqMetricsClients map[int]metrics.Client
 	}
 )
 
@@ -94,13 +103,16 @@ var (
 	ErrUnknownReplicationTask = &shared.BadRequestError{Message: "unknown replication task"}
 	// ErrDeserializeReplicationTask is the error to indicate failure to deserialize replication task
 	ErrDeserializeReplicationTask = &shared.BadRequestError{Message: "Failed to deserialize replication task"}
+	// ErrNoSourceTaskID is the error to indicate the replication task has no source task ID to be put to DLQ
+	ErrNoSourceTaskID = &shared.BadRequestError{Message: "replication task has no source task ID"}
 
 	replicationTaskRetryPolicy = createReplicatorRetryPolicy()
 )
 
 func newReplicationTaskProcessor(currentCluster, sourceCluster, consumer string, client messaging.Client, config *Config,
 	logger bark.Logger, metricsClient metrics.Client, domainReplicator DomainReplicator,
-	historyRereplicator xdc.HistoryRereplicator, historyClient history.Client) *replicationTaskProcessor {
+	historyRereplicator xdc.HistoryRereplicator, historyClient history.Client,
+	executionMgrFactory persistence.ExecutionManagerFactory, numberOfShards int) *replicationTaskProcessor {
 
 	retryableHistoryClient := history.NewRetryableClient(historyClient, common.CreateHistoryServiceRetryPolicy(),
 		common.IsWhitelistServiceTransientError)
@@ -118,6 +130,9 @@ func newReplicationTaskProcessor(currentCluster, sourceCluster, consumer string,
 		historyRereplicator: historyRereplicator,
 		historyClient:       retryableHistoryClient,
 		msgEncoder:          codec.NewThriftRWEncoder(),
+		executionMgrFactory: executionMgrFactory,
+		numberOfShards:      numberOfShards,
+		dlqMetricsClients:   make(map[int]metrics.Client),
 		rereplicationLock: locks.NewIDMutex(rereplicationLockShards, func(key interface{}) uint32 {
 			id, ok := key.(definition.WorkflowIdentifier)
 			if !ok {
@@ -273,14 +288,80 @@ ProcessRetryLoop:
 		msg.Ack()
 	} else {
 		// Task still failed after all retries.  This is most probably due to a bug in replication code.
-		// Nack the task to move it to DLQ to not block replication for other workflow executions.
-		logger.WithFields(bark.Fields{
+		// Move the task to the replication DLQ of its shard to not block replication for other workflow executions,
+		// tasks which do not belong to a shard or cannot be put to the DLQ are nack'ed to the kafka DLQ instead.
+		logger = logger.WithFields(bark.Fields{
 			logging.TagErr:          err,
 			logging.TagAttemptCount: attempt,
 			logging.TagAttemptEnd:   time.Now(),
-		}).Error("Error processing replication task.")
-		msg.Nack()
+		})
+		if dlqErr := p.putToDLQ(msg); dlqErr != nil {
+			logger.WithField(logging.TagErr, dlqErr).Error("Error processing replication task, nack'ed the task.")
+			msg.Nack()
+			return
+		}
+		logger.Error("Error processing replication task, moved the task to DLQ.")
+		msg.Ack()
+	}
+}
+
+// putToDLQ adds a history or sync activity replication task to the replication DLQ of the shard of its workflow
+func (p *replicationTaskProcessor) putToDLQ(msg messaging.Message) error {
+	task, err := p.deserialize(msg.Value())
+	if err != nil {
+		return err
+	}
+
+	var workflowID string
+	switch task.GetTaskType() {
+	case replicator.ReplicationTaskTypeHistory:
+		workflowID = task.HistoryTaskAttributes.GetWorkflowId()
+	case replicator.ReplicationTaskTypeSyncActivity:
+		workflowID = task.SyncActicvityTaskAttributes.GetWorkflowId()
+	default:
+		return ErrUnknownReplicationTask
+	}
+
+	if task.SourceTaskId == nil {
+		return ErrNoSourceTaskID
+	}
+	// the task is keyed by its ID in the replication queue of the source shard, so a redelivered task overwrites the
+	// one put before instead of being added twice
+	taskInfo, err := persistence.NewReplicationDLQTaskInfo(task.GetSourceTaskId(), task)
+	if err != nil {
+		return err
+	}
+	shardID := common.WorkflowIDToHistoryShard(workflowID, p.numberOfShards)
+	// the execution manager shares the store session of the factory, so it is not closed here
+	executionMgr, err := p.executionMgrFactory.NewExecutionManager(shardID)
+	if err != nil {
+		return err
 	}
+	if err := executionMgr.PutReplicationTaskToDLQ(&persistence.PutReplicationTaskToDLQRequest{
+		SourceClusterName: p.sourceCluster,
+		TaskInfo:          taskInfo,
+	}); err != nil {
+		return err
+	}
+
+	// the size of the DLQ is reported periodically by the history shard which owns it
+	p.getDLQMetricsClient(shardID).IncCounter(metrics.ReplicatorScope, metrics.ReplicatorDLQEnqueued)
+	return nil
+}
+
+func (p *replicationTaskProcessor) getDLQMetricsClient(shardID int) metrics.Client {
+	p.Lock()
+	defer p.Unlock()
+
+	if metricsClient, ok := p.dlqMetricsClients[shardID]; ok {
+		return metricsClient
+	}
+	metricsClient := p.metricsClient.Tagged(map[string]string{
+		metrics.ShardTagName:         strconv.Itoa(shardID),
+		metrics.SourceClusterTagName: p.sourceCluster,
+	})
+	p.dlqMetricsClients[shardID] = metricsClient
+	return metricsClient
 }
 
 func (p *replicationTaskProcessor) process(msg messaging.Message, logger bark.Logger, inRetry bool) (bark.Logger, error) {
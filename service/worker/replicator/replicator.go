@@ -54,9 +54,13 @@ type (
 		config            *Config
 		client            messaging.Client
 		processors        []*replicationTaskProcessor
+		domainPullers     []*domainReplicationPuller
 		logger            bark.Logger
 		metricsClient     metrics.Client
 		historySerializer persistence.HistorySerializer
+
+		executionMgrFactory   persistence.ExecutionManagerFactory
+		numberOfHistoryShards int
 	}
 
 	// Config contains all the replication config for worker
@@ -75,7 +79,8 @@ const (
 // NewReplicator creates a new replicator for processing replication tasks
 func NewReplicator(clusterMetadata cluster.Metadata, metadataManagerV2 persistence.MetadataManager,
 	domainCache cache.DomainCache, clientBean client.Bean, config *Config,
-	client messaging.Client, logger bark.Logger, metricsClient metrics.Client) *Replicator {
+	client messaging.Client, logger bark.Logger, metricsClient metrics.Client,
+	executionMgrFactory persistence.ExecutionManagerFactory, numberOfHistoryShards int) *Replicator {
 
 	logger = logger.WithFields(bark.Fields{
 		logging.TagWorkflowComponent: logging.TagValueReplicatorComponent,
@@ -92,12 +97,34 @@ func NewReplicator(clusterMetadata cluster.Metadata, metadataManagerV2 persisten
 		logger:            logger,
 		metricsClient:     metricsClient,
 		historySerializer: persistence.NewHistorySerializer(),
+
+		executionMgrFactory:   executionMgrFactory,
+		numberOfHistoryShards: numberOfHistoryShards,
 	}
 }
 
 // Start is called to start replicator
 func (r *Replicator) Start() error {
 	currentClusterName := r.clusterMetadata.GetCurrentClusterName()
+	if r.clusterMetadata.IsPullReplicationEnabled() {
+		// the history replication tasks are pulled by the history shards, only the domains are pulled here
+		for cluster := range r.clusterMetadata.GetAllClusterFailoverVersions() {
+			if cluster != currentClusterName {
+				adminClient := admin.NewRetryableClient(
+					r.clientBean.GetRemoteAdminClient(cluster),
+					common.CreateAdminServiceRetryPolicy(),
+					common.IsWhitelistServiceTransientError,
+				)
+				r.domainPullers = append(r.domainPullers, newDomainReplicationPuller(currentClusterName, cluster,
+					adminClient, r.domainReplicator, r.metricsClient, r.logger))
+			}
+		}
+		for _, puller := range r.domainPullers {
+			puller.Start()
+		}
+		return nil
+	}
+
 	for cluster := range r.clusterMetadata.GetAllClusterFailoverVersions() {
 		if cluster != currentClusterName {
 			consumerName := getConsumerName(currentClusterName, cluster)
@@ -127,7 +154,8 @@ func (r *Replicator) Start() error {
 				logger,
 			)
 			r.processors = append(r.processors, newReplicationTaskProcessor(currentClusterName, cluster, consumerName, r.client,
-				r.config, logger, r.metricsClient, r.domainReplicator, historyRereplicator, r.historyClient))
+				r.config, logger, r.metricsClient, r.domainReplicator, historyRereplicator, r.historyClient,
+				r.executionMgrFactory, r.numberOfHistoryShards))
 		}
 	}
 
@@ -145,6 +173,9 @@ func (r *Replicator) Stop() {
 	for _, processor := range r.processors {
 		processor.Stop()
 	}
+	for _, puller := range r.domainPullers {
+		puller.Stop()
+	}
 	r.domainCache.Stop()
 }
 
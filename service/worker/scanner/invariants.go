@@ -0,0 +1,409 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scanner
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	// EntityTypeExecution is the type of the concrete executions of a shard
+	EntityTypeExecution EntityType = "execution"
+	// EntityTypeCurrentExecution is the type of the current executions of a shard
+	EntityTypeCurrentExecution EntityType = "current-execution"
+	// EntityTypeTransferTask is the type of the transfer tasks of a shard
+	EntityTypeTransferTask EntityType = "transfer-task"
+	// EntityTypeTimerTask is the type of the timer tasks of a shard
+	EntityTypeTimerTask EntityType = "timer-task"
+	// EntityTypeHistoryBranch is the type of the branches of the history trees
+	EntityTypeHistoryBranch EntityType = "history-branch"
+
+	// InvariantHistoryExists is the name of the invariant checking that the history branch of an execution exists
+	InvariantHistoryExists = "history-exists"
+	// InvariantCurrentRunExists is the name of the invariant checking that a current execution points at an existing run
+	InvariantCurrentRunExists = "current-run-exists"
+	// InvariantTransferTaskRunExists is the name of the invariant checking that a transfer task belongs to an existing run
+	InvariantTransferTaskRunExists = "transfer-task-run-exists"
+	// InvariantTimerTaskRunExists is the name of the invariant checking that a timer task belongs to an existing run
+	InvariantTimerTaskRunExists = "timer-task-run-exists"
+	// InvariantHistoryBranchOwned is the name of the invariant checking that a history branch belongs to an existing run
+	InvariantHistoryBranchOwned = "history-branch-owned"
+)
+
+type (
+	// EntityType is the type of the persisted records an invariant is checked on
+	EntityType string
+
+	// Entity is a persisted record checked by the scanner, only the fields which identify a record of its type are set
+	Entity struct {
+		Type                EntityType
+		ShardID             int       `json:",omitempty"`
+		DomainID            string    `json:",omitempty"`
+		WorkflowID          string    `json:",omitempty"`
+		RunID               string    `json:",omitempty"`
+		TaskID              int64     `json:",omitempty"`
+		VisibilityTimestamp time.Time `json:",omitempty"`
+		TreeID              string    `json:",omitempty"`
+		BranchID            string    `json:",omitempty"`
+		ForkTime            time.Time `json:",omitempty"`
+		Info                string    `json:",omitempty"`
+	}
+
+	// Finding is a violation of an invariant by an entity
+	Finding struct {
+		Invariant string
+		Entity    Entity
+		Reason    string
+	}
+
+	// Invariant is a property the persisted workflow data must have. The scanner checks every invariant on the
+	// entities of its type and the fixer applies the fix of the invariant to the findings of a scan.
+	Invariant interface {
+		// Name identifies the invariant in the reports
+		Name() string
+		// EntityType is the type of the entities the invariant is checked on
+		EntityType() EntityType
+		// Check returns a finding when the entity violates the invariant, nil when it does not
+		Check(r *Resources, entity *Entity) (*Finding, error)
+		// Fix remediates a finding of the invariant, the fixer only fixes the findings which still hold
+		// when the entity is checked again
+		Fix(r *Resources, finding *Finding) error
+	}
+
+	// Resources are the persistence managers the invariants check and fix entities with
+	Resources struct {
+		NumberOfHistoryShards int
+		HistoryV2Mgr          persistence.HistoryV2Manager
+		executionMgrFactory   persistence.ExecutionManagerFactory
+		executionMgrs         map[int]persistence.ExecutionManager
+	}
+
+	historyExistsInvariant struct {
+		deleteExecutions dynamicconfig.BoolPropertyFn
+	}
+	currentRunExistsInvariant   struct{}
+	transferTaskRunInvariant    struct{}
+	timerTaskRunInvariant       struct{}
+	historyBranchOwnedInvariant struct {
+		minAge dynamicconfig.DurationPropertyFn
+	}
+)
+
+var (
+	thriftEncoder = codec.NewThriftRWEncoder()
+
+	// errFixDisabled is returned by the fix of an invariant whose findings are only reported
+	errFixDisabled = errors.New("fix of the invariant is turned off, the finding is only reported")
+)
+
+// DefaultInvariants returns all the invariants of the scanner, the history branches created less than
+// HistoryBranchMinAge ago are not checked as their execution can still be in the process of being created
+func DefaultInvariants(config *Config) []Invariant {
+	return []Invariant{
+		&historyExistsInvariant{deleteExecutions: config.DeleteExecutionsWithoutHistory},
+		&currentRunExistsInvariant{},
+		&transferTaskRunInvariant{},
+		&timerTaskRunInvariant{},
+		&historyBranchOwnedInvariant{minAge: config.HistoryBranchMinAge},
+	}
+}
+
+// NewResources returns the resources of the invariants, the execution managers are created once per shard and
+// share the store session of the factory
+func NewResources(bootstrap *BootstrapParams) *Resources {
+	return &Resources{
+		NumberOfHistoryShards: bootstrap.NumberOfHistoryShards,
+		HistoryV2Mgr:          bootstrap.HistoryV2Mgr,
+		executionMgrFactory:   bootstrap.ExecutionMgrFactory,
+		executionMgrs:         make(map[int]persistence.ExecutionManager),
+	}
+}
+
+// ExecutionManager returns the execution manager of the shard
+func (r *Resources) ExecutionManager(shardID int) (persistence.ExecutionManager, error) {
+	if executionMgr, ok := r.executionMgrs[shardID]; ok {
+		return executionMgr, nil
+	}
+	executionMgr, err := r.executionMgrFactory.NewExecutionManager(shardID)
+	if err != nil {
+		return nil, err
+	}
+	r.executionMgrs[shardID] = executionMgr
+	return executionMgr, nil
+}
+
+func (i *historyExistsInvariant) Name() string {
+	return InvariantHistoryExists
+}
+
+func (i *historyExistsInvariant) EntityType() EntityType {
+	return EntityTypeExecution
+}
+
+// Check reads the mutable state of the execution and looks its history branch up in its history tree. The
+// executions of the deprecated history store are not checked, nor are the closed executions whose history
+// may have been archived and is deleted along with them by their retention timer.
+func (i *historyExistsInvariant) Check(r *Resources, entity *Entity) (*Finding, error) {
+	executionInfo, err := getExecutionInfo(r, entity)
+	if err != nil || executionInfo == nil || executionInfo.EventStoreVersion != persistence.EventStoreVersionV2 {
+		return nil, err
+	}
+	if executionInfo.State == persistence.WorkflowStateCompleted {
+		return nil, nil
+	}
+
+	var branch gen.HistoryBranch
+	if err := thriftEncoder.Decode(executionInfo.BranchToken, &branch); err != nil {
+		return newFinding(i, entity, fmt.Sprintf("invalid history branch token: %v", err)), nil
+	}
+	resp, err := r.HistoryV2Mgr.GetHistoryTree(&persistence.GetHistoryTreeRequest{TreeID: branch.GetTreeID()})
+	if err != nil {
+		if _, ok := err.(*persistence.ConditionFailedError); ok {
+			// a branch of the tree is being forked, the tree is checked again by the next scan
+			return nil, nil
+		}
+		return nil, err
+	}
+	for _, br := range resp.Branches {
+		if br.GetBranchID() == branch.GetBranchID() {
+			return nil, nil
+		}
+	}
+	return newFinding(i, entity, fmt.Sprintf("history branch %v of tree %v does not exist", branch.GetBranchID(), branch.GetTreeID())), nil
+}
+
+// Fix deletes the execution whose history is lost when DeleteExecutionsWithoutHistory is on, the current
+// execution is only deleted when it points at the run. The finding is only reported otherwise.
+func (i *historyExistsInvariant) Fix(r *Resources, finding *Finding) error {
+	if i.deleteExecutions == nil || !i.deleteExecutions() {
+		return errFixDisabled
+	}
+	return deleteExecution(r, &finding.Entity)
+}
+
+func (i *currentRunExistsInvariant) Name() string {
+	return InvariantCurrentRunExists
+}
+
+func (i *currentRunExistsInvariant) EntityType() EntityType {
+	return EntityTypeCurrentExecution
+}
+
+// Check verifies the current run of the workflow has a mutable state
+func (i *currentRunExistsInvariant) Check(r *Resources, entity *Entity) (*Finding, error) {
+	executionMgr, err := r.ExecutionManager(entity.ShardID)
+	if err != nil {
+		return nil, err
+	}
+	current, err := executionMgr.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		DomainID:   entity.DomainID,
+		WorkflowID: entity.WorkflowID,
+	})
+	if err != nil {
+		if _, ok := err.(*gen.EntityNotExistsError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if current.RunID != entity.RunID {
+		// the workflow was started again after the current execution was listed
+		return nil, nil
+	}
+	return checkRunExists(i, r, entity)
+}
+
+// Fix deletes the current execution, a new run of the workflow can be started afterwards
+func (i *currentRunExistsInvariant) Fix(r *Resources, finding *Finding) error {
+	executionMgr, err := r.ExecutionManager(finding.Entity.ShardID)
+	if err != nil {
+		return err
+	}
+	return executionMgr.DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   finding.Entity.DomainID,
+		WorkflowID: finding.Entity.WorkflowID,
+		RunID:      finding.Entity.RunID,
+	})
+}
+
+func (i *transferTaskRunInvariant) Name() string {
+	return InvariantTransferTaskRunExists
+}
+
+func (i *transferTaskRunInvariant) EntityType() EntityType {
+	return EntityTypeTransferTask
+}
+
+// Check verifies the run the transfer task belongs to has a mutable state
+func (i *transferTaskRunInvariant) Check(r *Resources, entity *Entity) (*Finding, error) {
+	return checkRunExists(i, r, entity)
+}
+
+// Fix completes the transfer task, the task could not be processed without the run anyway
+func (i *transferTaskRunInvariant) Fix(r *Resources, finding *Finding) error {
+	executionMgr, err := r.ExecutionManager(finding.Entity.ShardID)
+	if err != nil {
+		return err
+	}
+	return executionMgr.CompleteTransferTask(&persistence.CompleteTransferTaskRequest{TaskID: finding.Entity.TaskID})
+}
+
+func (i *timerTaskRunInvariant) Name() string {
+	return InvariantTimerTaskRunExists
+}
+
+func (i *timerTaskRunInvariant) EntityType() EntityType {
+	return EntityTypeTimerTask
+}
+
+// Check verifies the run the timer task belongs to has a mutable state
+func (i *timerTaskRunInvariant) Check(r *Resources, entity *Entity) (*Finding, error) {
+	return checkRunExists(i, r, entity)
+}
+
+// Fix completes the timer task, the task could not be processed without the run anyway
+func (i *timerTaskRunInvariant) Fix(r *Resources, finding *Finding) error {
+	executionMgr, err := r.ExecutionManager(finding.Entity.ShardID)
+	if err != nil {
+		return err
+	}
+	return executionMgr.CompleteTimerTask(&persistence.CompleteTimerTaskRequest{
+		VisibilityTimestamp: finding.Entity.VisibilityTimestamp,
+		TaskID:              finding.Entity.TaskID,
+	})
+}
+
+func (i *historyBranchOwnedInvariant) Name() string {
+	return InvariantHistoryBranchOwned
+}
+
+func (i *historyBranchOwnedInvariant) EntityType() EntityType {
+	return EntityTypeHistoryBranch
+}
+
+// Check verifies the run the history branch was created for has a mutable state. The branches written without
+// the info of their owner are not checked.
+func (i *historyBranchOwnedInvariant) Check(r *Resources, entity *Entity) (*Finding, error) {
+	if entity.Info == "" || time.Now().Sub(entity.ForkTime) < i.minAge() {
+		return nil, nil
+	}
+	domainID, workflowID, runID, err := persistence.SplitHistoryGarbageCleanupInfo(entity.Info)
+	if err != nil {
+		return nil, nil
+	}
+	owner := &Entity{
+		Type:       EntityTypeExecution,
+		ShardID:    common.WorkflowIDToHistoryShard(workflowID, r.NumberOfHistoryShards),
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      runID,
+	}
+	executionInfo, err := getExecutionInfo(r, owner)
+	if err != nil || executionInfo != nil {
+		return nil, err
+	}
+	return newFinding(i, entity, fmt.Sprintf("run %v of workflow %v does not exist", runID, workflowID)), nil
+}
+
+// Fix deletes the orphaned history branch, the nodes shared with the other branches of the tree are kept
+func (i *historyBranchOwnedInvariant) Fix(r *Resources, finding *Finding) error {
+	resp, err := r.HistoryV2Mgr.GetHistoryTree(&persistence.GetHistoryTreeRequest{TreeID: finding.Entity.TreeID})
+	if err != nil {
+		return err
+	}
+	for _, br := range resp.Branches {
+		if br.GetBranchID() != finding.Entity.BranchID {
+			continue
+		}
+		branchToken, err := thriftEncoder.Encode(br)
+		if err != nil {
+			return err
+		}
+		return r.HistoryV2Mgr.DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{BranchToken: branchToken})
+	}
+	// deleted already
+	return nil
+}
+
+// checkRunExists returns a finding of the invariant when the run of the entity has no mutable state
+func checkRunExists(i Invariant, r *Resources, entity *Entity) (*Finding, error) {
+	executionInfo, err := getExecutionInfo(r, entity)
+	if err != nil || executionInfo != nil {
+		return nil, err
+	}
+	return newFinding(i, entity, fmt.Sprintf("run %v of workflow %v does not exist", entity.RunID, entity.WorkflowID)), nil
+}
+
+// getExecutionInfo returns the execution info of the run of the entity, nil when the run does not exist
+func getExecutionInfo(r *Resources, entity *Entity) (*persistence.WorkflowExecutionInfo, error) {
+	executionMgr, err := r.ExecutionManager(entity.ShardID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := executionMgr.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		DomainID: entity.DomainID,
+		Execution: gen.WorkflowExecution{
+			WorkflowId: common.StringPtr(entity.WorkflowID),
+			RunId:      common.StringPtr(entity.RunID),
+		},
+	})
+	if err != nil {
+		if _, ok := err.(*gen.EntityNotExistsError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return resp.State.ExecutionInfo, nil
+}
+
+func deleteExecution(r *Resources, entity *Entity) error {
+	executionMgr, err := r.ExecutionManager(entity.ShardID)
+	if err != nil {
+		return err
+	}
+	if err := executionMgr.DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   entity.DomainID,
+		WorkflowID: entity.WorkflowID,
+		RunID:      entity.RunID,
+	}); err != nil {
+		return err
+	}
+	return executionMgr.DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
+		DomainID:   entity.DomainID,
+		WorkflowID: entity.WorkflowID,
+		RunID:      entity.RunID,
+	})
+}
+
+func newFinding(i Invariant, entity *Entity, reason string) *Finding {
+	return &Finding{
+		Invariant: i.Name(),
+		Entity:    *entity,
+		Reason:    reason,
+	}
+}
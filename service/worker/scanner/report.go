@@ -0,0 +1,119 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scanner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/uber/cadence/common/blobstore"
+)
+
+const (
+	// ReportKeyPrefix is the prefix of the names of the report blobs of the execution scanner
+	ReportKeyPrefix = "execution-scanner"
+	// ScanIDTag is the tag of the report blobs holding the id of their scan
+	ScanIDTag = "scan_id"
+
+	reportKeyExtension = ".json"
+	fixReportKeySuffix = "_fix"
+)
+
+// Fix outcomes
+const (
+	// FixOutcomeFixed is the outcome of a finding the invariant fixed
+	FixOutcomeFixed = "fixed"
+	// FixOutcomeSkipped is the outcome of a finding which no longer holds, or whose invariant is unknown
+	FixOutcomeSkipped = "skipped"
+	// FixOutcomeReported is the outcome of a finding which still holds but whose fix is turned off
+	FixOutcomeReported = "reported"
+	// FixOutcomeFailed is the outcome of a finding which could not be checked again or fixed
+	FixOutcomeFailed = "failed"
+)
+
+type (
+	// Report is the content of a report blob, the findings of a scan for a part of the persisted data
+	Report struct {
+		ScanID   string
+		Findings []Finding
+	}
+
+	// FixReport is the content of the report blob written by the fix pass for a report of a scan
+	FixReport struct {
+		ScanID  string
+		Results []FixResult
+	}
+
+	// FixResult is the outcome of the fix of a finding
+	FixResult struct {
+		Finding Finding
+		Outcome string
+		Error   string `json:",omitempty"`
+	}
+)
+
+// ReportKey returns the name of the report blob of a part of a scan, the blob names have no directories as
+// not all the blobstores support them
+func ReportKey(scanID string, part string) string {
+	return fmt.Sprintf("%v_%v_%v%v", ReportKeyPrefix, scanID, part, reportKeyExtension)
+}
+
+// FixReportKey returns the name of the blob the fix pass writes its outcomes for a report to
+func FixReportKey(reportKey string) string {
+	return strings.TrimSuffix(reportKey, reportKeyExtension) + fixReportKeySuffix + reportKeyExtension
+}
+
+func uploadReport(ctx context.Context, client blobstore.Client, bucket string, key string, scanID string,
+	report interface{}) error {
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	return client.UploadBlob(ctx, bucket, key, &blobstore.Blob{
+		Body:            bytes.NewReader(data),
+		CompressionType: blobstore.NoCompression,
+		Tags: map[string]string{
+			ScanIDTag: scanID,
+		},
+	})
+}
+
+// DownloadReport reads a report blob of a scan from the bucket
+func DownloadReport(ctx context.Context, client blobstore.Client, bucket string, key string) (*Report, error) {
+	blob, err := client.DownloadBlob(ctx, bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(blob.Body)
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{}
+	if err := json.Unmarshal(data, report); err != nil {
+		return nil, fmt.Errorf("failed to decode report %v: %v", key, err)
+	}
+	return report, nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scanner
+
+import (
+	"context"
+
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/client"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+type (
+	// BootstrapParams are the clients and persistence managers the execution scanner operates on
+	BootstrapParams struct {
+		FrontendClient        frontend.Client
+		NumberOfHistoryShards int
+		ExecutionMgrFactory   persistence.ExecutionManagerFactory
+		HistoryV2Mgr          persistence.HistoryV2Manager
+		BlobstoreClient       blobstore.Client
+		// Bucket is the blobstore bucket the scan and fix reports are written to
+		Bucket string
+		Config *Config
+		// Invariants are checked on the entities of their type, DefaultInvariants is used when empty
+		Invariants []Invariant
+	}
+
+	// Config contains all the execution scanner config for worker
+	Config struct {
+		// ScanInterval is the time between the end of a scan and the start of the next one
+		ScanInterval dynamicconfig.DurationPropertyFn
+		// ScanConcurrency is the number of activities the shards are scanned by
+		ScanConcurrency dynamicconfig.IntPropertyFn
+		// FixEnabled is whether the findings of a scan are fixed once the scan completes
+		FixEnabled dynamicconfig.BoolPropertyFn
+		// HistoryBranchMinAge is the age under which the history branches are not checked
+		HistoryBranchMinAge dynamicconfig.DurationPropertyFn
+		// DeleteExecutionsWithoutHistory is whether the fix deletes the executions whose history branch is
+		// missing, their findings are only reported when it is off
+		DeleteExecutionsWithoutHistory dynamicconfig.BoolPropertyFn
+	}
+
+	// Scanner is the cadence client worker responsible for running the execution scans
+	Scanner struct {
+		params *BootstrapParams
+		worker worker.Worker
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(ScanWorkflow, workflow.RegisterOptions{Name: ScanWorkflowFnName})
+	workflow.RegisterWithOptions(FixWorkflow, workflow.RegisterOptions{Name: FixWorkflowFnName})
+	activity.RegisterWithOptions(scanConfigActivity, activity.RegisterOptions{Name: scanConfigActivityFnName})
+	activity.RegisterWithOptions(scanShardsActivity, activity.RegisterOptions{Name: scanShardsActivityFnName})
+	activity.RegisterWithOptions(scanHistoryBranchesActivity, activity.RegisterOptions{Name: scanHistoryBranchesActivityFnName})
+	activity.RegisterWithOptions(fixReportActivity, activity.RegisterOptions{Name: fixReportActivityFnName})
+}
+
+// New creates a new execution scanner
+func New(params *BootstrapParams, scope tally.Scope) *Scanner {
+	if len(params.Invariants) == 0 {
+		params.Invariants = DefaultInvariants(params.Config)
+	}
+	logger, _ := zap.NewProduction()
+	actCtx := context.WithValue(context.Background(), bootstrapParamsKey, params)
+	wo := worker.Options{
+		Logger:                    logger,
+		MetricsScope:              scope.SubScope(executionScannerScope),
+		BackgroundActivityContext: actCtx,
+	}
+	return &Scanner{
+		params: params,
+		worker: worker.New(params.FrontendClient, Domain, TaskList, wo),
+	}
+}
+
+// Start starts the worker and the scan workflow, the scan workflow keeps running across restarts of the worker
+func (s *Scanner) Start() error {
+	if err := s.worker.Start(); err != nil {
+		s.worker.Stop()
+		return err
+	}
+
+	cadenceClient := client.NewClient(s.params.FrontendClient, Domain, &client.Options{})
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                              WorkflowID,
+		TaskList:                        TaskList,
+		ExecutionStartToCloseTimeout:    WorkflowStartToCloseTimeout,
+		DecisionTaskStartToCloseTimeout: DecisionTaskStartToCloseTimeout,
+		WorkflowIDReusePolicy:           client.WorkflowIDReusePolicyAllowDuplicate,
+	}
+	_, err := cadenceClient.StartWorkflow(context.Background(), workflowOptions, ScanWorkflowFnName)
+	if err != nil && !isWorkflowAlreadyStarted(err) {
+		s.worker.Stop()
+		return err
+	}
+	return nil
+}
+
+// Stop stops the worker, the scan in progress is resumed by the next worker
+func (s *Scanner) Stop() {
+	s.worker.Stop()
+}
+
+func isWorkflowAlreadyStarted(err error) bool {
+	_, ok := err.(*shared.WorkflowExecutionAlreadyStartedError)
+	return ok
+}
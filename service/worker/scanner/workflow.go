@@ -0,0 +1,657 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scanner
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/worker/sysworkflow"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	// Domain is the domain the execution scanner workflows run in
+	Domain = sysworkflow.Domain
+	// TaskList is the task list of the execution scanner workflows
+	TaskList = "cadsys-execution-scanner-tl"
+	// WorkflowID is the id of the scan workflow, there is a single scan workflow per cluster
+	WorkflowID = "cadsys-execution-scanner"
+	// FixWorkflowIDPrefix is the prefix of the workflow ids of the fix workflows
+	FixWorkflowIDPrefix = "cadsys-execution-scanner-fix"
+	// ScanWorkflowFnName is the name of the scan workflow function
+	ScanWorkflowFnName = "ExecutionScanWorkflow"
+	// FixWorkflowFnName is the name of the fix workflow function
+	FixWorkflowFnName = "ExecutionFixWorkflow"
+	// WorkflowStartToCloseTimeout is the time for a scan, or the fix of its findings, to finish
+	WorkflowStartToCloseTimeout = sysworkflow.WorkflowStartToCloseTimeout
+	// DecisionTaskStartToCloseTimeout is the time for decision to finish
+	DecisionTaskStartToCloseTimeout = sysworkflow.DecisionTaskStartToCloseTimeout
+
+	scanConfigActivityFnName          = "ScanConfigActivity"
+	scanShardsActivityFnName          = "ScanShardsActivity"
+	scanHistoryBranchesActivityFnName = "ScanHistoryBranchesActivity"
+	fixReportActivityFnName           = "FixReportActivity"
+
+	activityHeartbeat       = time.Minute
+	scanPageSize            = 1000
+	executionScannerScope   = "execution-scanner"
+	invariantTagName        = "invariant"
+	logTagScanID            = "scan-id"
+	logTagShardID           = "shard-id"
+	logTagReportKey         = "report-key"
+	logTagInvariant         = "invariant"
+	logTagScanned           = "scanned"
+	logTagFindings          = "findings"
+	logTagFixed             = "fixed"
+	logTagSkipped           = "skipped"
+	logTagReported          = "reported"
+	logTagFailed            = "failed"
+	entitiesScannedCounter  = "entities-scanned"
+	findingsCounter         = "findings"
+	checkFailuresCounter    = "check-failures"
+	findingsFixedCounter    = "findings-fixed"
+	findingsSkippedCounter  = "findings-skipped"
+	findingsReportedCounter = "findings-reported"
+	fixFailuresCounter      = "fix-failures"
+	reportFailuresCounter   = "report-failures"
+)
+
+type (
+	// ScanConfig is the config of a scan, it is read once at the start of every scan
+	ScanConfig struct {
+		Concurrency           int
+		Interval              time.Duration
+		FixEnabled            bool
+		NumberOfHistoryShards int
+	}
+
+	// ScanShardsParams are the parameters of an activity scanning a set of shards
+	ScanShardsParams struct {
+		ScanID string
+		Shards []int
+	}
+
+	// ScanSummary is the outcome of a scan, the findings are in the report blobs
+	ScanSummary struct {
+		Scanned    int
+		Findings   int
+		ReportKeys []string
+	}
+
+	// FixParams are the parameters of a fix workflow
+	FixParams struct {
+		ScanID     string
+		ReportKeys []string
+	}
+
+	// FixReportParams are the parameters of an activity fixing the findings of a report
+	FixReportParams struct {
+		ScanID    string
+		ReportKey string
+	}
+
+	// FixSummary is the outcome of the fix of the findings of a scan, the outcomes of every finding are
+	// in the fix report blobs
+	FixSummary struct {
+		Fixed      int
+		Skipped    int
+		Reported   int
+		Failed     int
+		ReportKeys []string
+	}
+
+	// scanShardsProgress is recorded with the heartbeats of the shard scans, a retried activity resumes
+	// from the first shard it did not complete
+	scanShardsProgress struct {
+		NextShard int
+		Summary   ScanSummary
+	}
+
+	// scanHistoryBranchesProgress is recorded with the heartbeats of the history branch scans, a retried
+	// activity resumes from the first page it did not complete
+	scanHistoryBranchesProgress struct {
+		PageToken []byte
+		Page      int
+		Summary   ScanSummary
+	}
+
+	// entityScanner checks the invariants on the entities listed page by page
+	entityScanner struct {
+		ctx        context.Context
+		resources  *Resources
+		invariants map[EntityType][]Invariant
+		logger     *zap.Logger
+		scope      tally.Scope
+		heartbeat  func()
+		scanned    int
+		findings   []Finding
+	}
+
+	contextKey int
+)
+
+const (
+	bootstrapParamsKey contextKey = iota
+)
+
+var activityOptions = workflow.ActivityOptions{
+	ScheduleToStartTimeout: 5 * time.Minute,
+	StartToCloseTimeout:    WorkflowStartToCloseTimeout,
+	HeartbeatTimeout:       activityHeartbeat,
+	RetryPolicy: &cadence.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    5 * time.Minute,
+		ExpirationInterval: WorkflowStartToCloseTimeout,
+	},
+}
+
+// ScanWorkflow scans all the shards and history trees, then runs the fix workflow on the findings when the fixes
+// are enabled. It sleeps for the scan interval once done and continues as new, each run is a scan identified by
+// its run id.
+func ScanWorkflow(ctx workflow.Context) error {
+	scanID := workflow.GetInfo(ctx).WorkflowExecution.RunID
+	logger := workflow.GetLogger(ctx).With(zap.String(logTagScanID, scanID))
+	actCtx := workflow.WithActivityOptions(ctx, activityOptions)
+
+	var config ScanConfig
+	if err := workflow.ExecuteActivity(actCtx, scanConfigActivityFnName).Get(ctx, &config); err != nil {
+		return err
+	}
+
+	futures := []workflow.Future{
+		workflow.ExecuteActivity(actCtx, scanHistoryBranchesActivityFnName, scanID),
+	}
+	for _, shards := range partitionShards(config.NumberOfHistoryShards, config.Concurrency) {
+		futures = append(futures, workflow.ExecuteActivity(actCtx, scanShardsActivityFnName, ScanShardsParams{
+			ScanID: scanID,
+			Shards: shards,
+		}))
+	}
+	summary := ScanSummary{}
+	for _, future := range futures {
+		var partial ScanSummary
+		if err := future.Get(ctx, &partial); err != nil {
+			// the findings of the other activities are still reported and fixed, the next scan covers the rest
+			logger.Error("failed to scan", zap.Error(err))
+			continue
+		}
+		summary.Scanned += partial.Scanned
+		summary.Findings += partial.Findings
+		summary.ReportKeys = append(summary.ReportKeys, partial.ReportKeys...)
+	}
+	logger.Info("scan completed", zap.Int(logTagScanned, summary.Scanned), zap.Int(logTagFindings, summary.Findings))
+
+	if config.FixEnabled && len(summary.ReportKeys) > 0 {
+		childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+			WorkflowID:                   FixWorkflowID(scanID),
+			TaskList:                     TaskList,
+			ExecutionStartToCloseTimeout: WorkflowStartToCloseTimeout,
+			TaskStartToCloseTimeout:      DecisionTaskStartToCloseTimeout,
+		})
+		fixParams := FixParams{ScanID: scanID, ReportKeys: summary.ReportKeys}
+		if err := workflow.ExecuteChildWorkflow(childCtx, FixWorkflowFnName, fixParams).Get(ctx, nil); err != nil {
+			logger.Error("failed to fix findings", zap.Error(err))
+		}
+	}
+
+	if err := workflow.Sleep(ctx, config.Interval); err != nil {
+		return err
+	}
+	return workflow.NewContinueAsNewError(ctx, ScanWorkflowFnName)
+}
+
+// FixWorkflow applies the fixes of the invariants to the findings of the reports of a scan
+func FixWorkflow(ctx workflow.Context, params FixParams) (FixSummary, error) {
+	logger := workflow.GetLogger(ctx).With(zap.String(logTagScanID, params.ScanID))
+	actCtx := workflow.WithActivityOptions(ctx, activityOptions)
+
+	summary := FixSummary{}
+	for _, key := range params.ReportKeys {
+		var partial FixSummary
+		reportParams := FixReportParams{ScanID: params.ScanID, ReportKey: key}
+		if err := workflow.ExecuteActivity(actCtx, fixReportActivityFnName, reportParams).Get(ctx, &partial); err != nil {
+			return summary, err
+		}
+		summary.Fixed += partial.Fixed
+		summary.Skipped += partial.Skipped
+		summary.Reported += partial.Reported
+		summary.Failed += partial.Failed
+		summary.ReportKeys = append(summary.ReportKeys, partial.ReportKeys...)
+	}
+	logger.Info("fix completed",
+		zap.Int(logTagFixed, summary.Fixed), zap.Int(logTagSkipped, summary.Skipped),
+		zap.Int(logTagReported, summary.Reported), zap.Int(logTagFailed, summary.Failed))
+	return summary, nil
+}
+
+// FixWorkflowID returns the id of the fix workflow of a scan
+func FixWorkflowID(scanID string) string {
+	return FixWorkflowIDPrefix + "-" + scanID
+}
+
+func scanConfigActivity(ctx context.Context) (ScanConfig, error) {
+	bootstrap := ctx.Value(bootstrapParamsKey).(*BootstrapParams)
+	return ScanConfig{
+		Concurrency:           bootstrap.Config.ScanConcurrency(),
+		Interval:              bootstrap.Config.ScanInterval(),
+		FixEnabled:            bootstrap.Config.FixEnabled(),
+		NumberOfHistoryShards: bootstrap.NumberOfHistoryShards,
+	}, nil
+}
+
+func scanShardsActivity(ctx context.Context, params ScanShardsParams) (ScanSummary, error) {
+	bootstrap := ctx.Value(bootstrapParamsKey).(*BootstrapParams)
+	logger := activity.GetLogger(ctx).With(zap.String(logTagScanID, params.ScanID))
+
+	progress := scanShardsProgress{}
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			logger.Error("failed to recover the progress of the scan, starting over", zap.Error(err))
+			progress = scanShardsProgress{}
+		}
+	}
+
+	scanner := newEntityScanner(ctx, bootstrap, logger, func() {
+		activity.RecordHeartbeat(ctx, progress)
+	})
+	for ; progress.NextShard < len(params.Shards); progress.NextShard++ {
+		shardID := params.Shards[progress.NextShard]
+		shardLogger := logger.With(zap.Int(logTagShardID, shardID))
+		findings, err := scanner.scanShard(shardID)
+		if err != nil {
+			shardLogger.Error("failed to scan shard", zap.Error(err))
+			return progress.Summary, err
+		}
+		progress.Summary.Scanned += scanner.scanned
+		if len(findings) > 0 {
+			key := ReportKey(params.ScanID, fmt.Sprintf("shard-%v", shardID))
+			report := &Report{ScanID: params.ScanID, Findings: findings}
+			if err := uploadReport(ctx, bootstrap.BlobstoreClient, bootstrap.Bucket, key, params.ScanID, report); err != nil {
+				activity.GetMetricsScope(ctx).Counter(reportFailuresCounter).Inc(1)
+				shardLogger.Error("failed to upload report", zap.String(logTagReportKey, key), zap.Error(err))
+				return progress.Summary, err
+			}
+			progress.Summary.Findings += len(findings)
+			progress.Summary.ReportKeys = append(progress.Summary.ReportKeys, key)
+		}
+		shardLogger.Info("shard scanned", zap.Int(logTagScanned, scanner.scanned), zap.Int(logTagFindings, len(findings)))
+		scanner.reset()
+		activity.RecordHeartbeat(ctx, scanShardsProgress{NextShard: progress.NextShard + 1, Summary: progress.Summary})
+	}
+	return progress.Summary, nil
+}
+
+func scanHistoryBranchesActivity(ctx context.Context, scanID string) (ScanSummary, error) {
+	bootstrap := ctx.Value(bootstrapParamsKey).(*BootstrapParams)
+	logger := activity.GetLogger(ctx).With(zap.String(logTagScanID, scanID))
+
+	progress := scanHistoryBranchesProgress{}
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			logger.Error("failed to recover the progress of the scan, starting over", zap.Error(err))
+			progress = scanHistoryBranchesProgress{}
+		}
+	}
+
+	scanner := newEntityScanner(ctx, bootstrap, logger, func() {})
+	for {
+		resp, err := bootstrap.HistoryV2Mgr.GetAllHistoryTreeBranches(&persistence.GetAllHistoryTreeBranchesRequest{
+			NextPageToken: progress.PageToken,
+			PageSize:      scanPageSize,
+		})
+		if err != nil {
+			logger.Error("failed to list history branches", zap.Error(err))
+			return progress.Summary, err
+		}
+		entities := make([]*Entity, 0, len(resp.Branches))
+		for _, branch := range resp.Branches {
+			entities = append(entities, &Entity{
+				Type:     EntityTypeHistoryBranch,
+				TreeID:   branch.TreeID,
+				BranchID: branch.BranchID,
+				ForkTime: branch.ForkTime,
+				Info:     branch.Info,
+			})
+		}
+		if err := scanner.check(EntityTypeHistoryBranch, entities); err != nil {
+			return progress.Summary, err
+		}
+
+		progress.Summary.Scanned += scanner.scanned
+		if len(scanner.findings) > 0 {
+			key := ReportKey(scanID, fmt.Sprintf("history-branches-%v", progress.Page))
+			report := &Report{ScanID: scanID, Findings: scanner.findings}
+			if err := uploadReport(ctx, bootstrap.BlobstoreClient, bootstrap.Bucket, key, scanID, report); err != nil {
+				activity.GetMetricsScope(ctx).Counter(reportFailuresCounter).Inc(1)
+				logger.Error("failed to upload report", zap.String(logTagReportKey, key), zap.Error(err))
+				return progress.Summary, err
+			}
+			progress.Summary.Findings += len(scanner.findings)
+			progress.Summary.ReportKeys = append(progress.Summary.ReportKeys, key)
+		}
+		scanner.reset()
+		progress.Page++
+		progress.PageToken = resp.NextPageToken
+		activity.RecordHeartbeat(ctx, progress)
+
+		if len(resp.NextPageToken) == 0 {
+			logger.Info("history branches scanned",
+				zap.Int(logTagScanned, progress.Summary.Scanned), zap.Int(logTagFindings, progress.Summary.Findings))
+			return progress.Summary, nil
+		}
+	}
+}
+
+// fixReportActivity fixes the findings of a report which still hold. A retried activity goes through the report
+// again, the findings it fixed already no longer hold and are reported as skipped.
+func fixReportActivity(ctx context.Context, params FixReportParams) (FixSummary, error) {
+	bootstrap := ctx.Value(bootstrapParamsKey).(*BootstrapParams)
+	logger := activity.GetLogger(ctx).With(zap.String(logTagScanID, params.ScanID), zap.String(logTagReportKey, params.ReportKey))
+	scope := activity.GetMetricsScope(ctx)
+	summary := FixSummary{}
+
+	report, err := DownloadReport(ctx, bootstrap.BlobstoreClient, bootstrap.Bucket, params.ReportKey)
+	if err != nil {
+		if err == blobstore.ErrBlobNotExists {
+			logger.Warn("report does not exist, nothing to fix")
+			return summary, nil
+		}
+		logger.Error("failed to download report", zap.Error(err))
+		return summary, err
+	}
+
+	resources := NewResources(bootstrap)
+	fixReport := &FixReport{ScanID: params.ScanID}
+	for i := range report.Findings {
+		result := fixFinding(resources, bootstrap.Invariants, &report.Findings[i])
+		invariantScope := scope.Tagged(map[string]string{invariantTagName: result.Finding.Invariant})
+		switch result.Outcome {
+		case FixOutcomeFixed:
+			summary.Fixed++
+			invariantScope.Counter(findingsFixedCounter).Inc(1)
+		case FixOutcomeSkipped:
+			summary.Skipped++
+			invariantScope.Counter(findingsSkippedCounter).Inc(1)
+		case FixOutcomeReported:
+			summary.Reported++
+			invariantScope.Counter(findingsReportedCounter).Inc(1)
+		default:
+			summary.Failed++
+			invariantScope.Counter(fixFailuresCounter).Inc(1)
+			logger.Warn("failed to fix finding", zap.String(logTagInvariant, result.Finding.Invariant), zap.String("error", result.Error))
+		}
+		fixReport.Results = append(fixReport.Results, result)
+		activity.RecordHeartbeat(ctx, i)
+	}
+
+	key := FixReportKey(params.ReportKey)
+	if err := uploadReport(ctx, bootstrap.BlobstoreClient, bootstrap.Bucket, key, params.ScanID, fixReport); err != nil {
+		scope.Counter(reportFailuresCounter).Inc(1)
+		logger.Error("failed to upload fix report", zap.Error(err))
+		return summary, err
+	}
+	summary.ReportKeys = append(summary.ReportKeys, key)
+	return summary, nil
+}
+
+// fixFinding checks the entity of the finding again and applies the fix of the invariant if it is still violated
+func fixFinding(r *Resources, invariants []Invariant, finding *Finding) FixResult {
+	result := FixResult{Finding: *finding}
+	var invariant Invariant
+	for _, i := range invariants {
+		if i.Name() == finding.Invariant {
+			invariant = i
+			break
+		}
+	}
+	if invariant == nil {
+		result.Outcome = FixOutcomeSkipped
+		result.Error = fmt.Sprintf("unknown invariant %v", finding.Invariant)
+		return result
+	}
+
+	current, err := invariant.Check(r, &finding.Entity)
+	if err != nil {
+		result.Outcome = FixOutcomeFailed
+		result.Error = err.Error()
+		return result
+	}
+	if current == nil {
+		result.Outcome = FixOutcomeSkipped
+		return result
+	}
+	if err := invariant.Fix(r, current); err != nil {
+		if err == errFixDisabled {
+			result.Outcome = FixOutcomeReported
+			return result
+		}
+		result.Outcome = FixOutcomeFailed
+		result.Error = err.Error()
+		return result
+	}
+	result.Outcome = FixOutcomeFixed
+	return result
+}
+
+// partitionShards spreads the shards over the given number of activities
+func partitionShards(numberOfShards int, concurrency int) [][]int {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	if concurrency > numberOfShards {
+		concurrency = numberOfShards
+	}
+	partitions := make([][]int, concurrency)
+	for shardID := 0; shardID < numberOfShards; shardID++ {
+		partitions[shardID%concurrency] = append(partitions[shardID%concurrency], shardID)
+	}
+	return partitions
+}
+
+func newEntityScanner(ctx context.Context, bootstrap *BootstrapParams, logger *zap.Logger, heartbeat func()) *entityScanner {
+	invariants := make(map[EntityType][]Invariant)
+	for _, invariant := range bootstrap.Invariants {
+		invariants[invariant.EntityType()] = append(invariants[invariant.EntityType()], invariant)
+	}
+	return &entityScanner{
+		ctx:        ctx,
+		resources:  NewResources(bootstrap),
+		invariants: invariants,
+		logger:     logger,
+		scope:      activity.GetMetricsScope(ctx),
+		heartbeat:  heartbeat,
+	}
+}
+
+// scanShard checks the invariants on all the entities of a shard, the entity types without invariants are not listed
+func (s *entityScanner) scanShard(shardID int) ([]Finding, error) {
+	executionMgr, err := s.resources.ExecutionManager(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.scan(EntityTypeExecution, func(pageToken []byte) ([]*Entity, []byte, error) {
+		resp, err := executionMgr.ListConcreteExecutions(&persistence.ListConcreteExecutionsRequest{
+			PageSize:  scanPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		entities := make([]*Entity, 0, len(resp.Executions))
+		for _, execution := range resp.Executions {
+			entities = append(entities, &Entity{
+				Type:       EntityTypeExecution,
+				ShardID:    shardID,
+				DomainID:   execution.DomainID,
+				WorkflowID: execution.WorkflowID,
+				RunID:      execution.RunID,
+			})
+		}
+		return entities, resp.PageToken, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.scan(EntityTypeCurrentExecution, func(pageToken []byte) ([]*Entity, []byte, error) {
+		resp, err := executionMgr.ListCurrentExecutions(&persistence.ListCurrentExecutionsRequest{
+			PageSize:  scanPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		entities := make([]*Entity, 0, len(resp.Executions))
+		for _, execution := range resp.Executions {
+			entities = append(entities, &Entity{
+				Type:       EntityTypeCurrentExecution,
+				ShardID:    shardID,
+				DomainID:   execution.DomainID,
+				WorkflowID: execution.WorkflowID,
+				RunID:      execution.RunID,
+			})
+		}
+		return entities, resp.PageToken, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.scan(EntityTypeTransferTask, func(pageToken []byte) ([]*Entity, []byte, error) {
+		resp, err := executionMgr.GetTransferTasks(&persistence.GetTransferTasksRequest{
+			ReadLevel:     0,
+			MaxReadLevel:  math.MaxInt64,
+			BatchSize:     scanPageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		entities := make([]*Entity, 0, len(resp.Tasks))
+		for _, task := range resp.Tasks {
+			entities = append(entities, &Entity{
+				Type:       EntityTypeTransferTask,
+				ShardID:    shardID,
+				DomainID:   task.DomainID,
+				WorkflowID: task.WorkflowID,
+				RunID:      task.RunID,
+				TaskID:     task.TaskID,
+			})
+		}
+		return entities, resp.NextPageToken, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.scan(EntityTypeTimerTask, func(pageToken []byte) ([]*Entity, []byte, error) {
+		resp, err := executionMgr.GetTimerIndexTasks(&persistence.GetTimerIndexTasksRequest{
+			// the zero time is out of the range of the unix nanos the timers are stored with
+			MinTimestamp:  time.Unix(0, 0),
+			MaxTimestamp:  time.Unix(0, math.MaxInt64),
+			BatchSize:     scanPageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		entities := make([]*Entity, 0, len(resp.Timers))
+		for _, timer := range resp.Timers {
+			entities = append(entities, &Entity{
+				Type:                EntityTypeTimerTask,
+				ShardID:             shardID,
+				DomainID:            timer.DomainID,
+				WorkflowID:          timer.WorkflowID,
+				RunID:               timer.RunID,
+				TaskID:              timer.TaskID,
+				VisibilityTimestamp: timer.VisibilityTimestamp,
+			})
+		}
+		return entities, resp.NextPageToken, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.findings, nil
+}
+
+// scan lists the entities of a type page by page and checks their invariants
+func (s *entityScanner) scan(entityType EntityType, list func(pageToken []byte) ([]*Entity, []byte, error)) error {
+	if len(s.invariants[entityType]) == 0 {
+		return nil
+	}
+	var pageToken []byte
+	for {
+		if err := s.ctx.Err(); err != nil {
+			return err
+		}
+		entities, nextPageToken, err := list(pageToken)
+		if err != nil {
+			return err
+		}
+		if err := s.check(entityType, entities); err != nil {
+			return err
+		}
+		s.heartbeat()
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+// check checks the invariants of the type on the entities. The entities which cannot be checked are skipped, they
+// are checked again by the next scan.
+func (s *entityScanner) check(entityType EntityType, entities []*Entity) error {
+	for _, entity := range entities {
+		if err := s.ctx.Err(); err != nil {
+			return err
+		}
+		s.scanned++
+		s.scope.Counter(entitiesScannedCounter).Inc(1)
+		for _, invariant := range s.invariants[entityType] {
+			finding, err := invariant.Check(s.resources, entity)
+			if err != nil {
+				s.scope.Tagged(map[string]string{invariantTagName: invariant.Name()}).Counter(checkFailuresCounter).Inc(1)
+				s.logger.Warn("failed to check invariant", zap.String(logTagInvariant, invariant.Name()), zap.Error(err))
+				continue
+			}
+			if finding != nil {
+				s.scope.Tagged(map[string]string{invariantTagName: invariant.Name()}).Counter(findingsCounter).Inc(1)
+				s.findings = append(s.findings, *finding)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *entityScanner) reset() {
+	s.scanned = 0
+	s.findings = nil
+}
@@ -0,0 +1,366 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scanner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type workflowSuite struct {
+	suite.Suite
+	*require.Assertions
+
+	mockExecutionMgr *mocks.ExecutionManager
+	mockHistoryV2Mgr *mocks.HistoryV2Manager
+	mockBlobstore    *mocks.Client
+	bootstrap        *BootstrapParams
+	resources        *Resources
+	deleteExecutions bool
+}
+
+const (
+	testDomainID   = "test-domain-id"
+	testWorkflowID = "test-workflow-id"
+	testRunID      = "test-run-id"
+	testTreeID     = "test-tree-id"
+	testBranchID   = "test-branch-id"
+	testBucket     = "test-bucket"
+)
+
+func TestWorkflowSuite(t *testing.T) {
+	s := new(workflowSuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
+	s.mockHistoryV2Mgr = &mocks.HistoryV2Manager{}
+	s.mockBlobstore = &mocks.Client{}
+	s.deleteExecutions = false
+	mockFactory := &mocks.ExecutionManagerFactory{}
+	mockFactory.On("NewExecutionManager", mock.Anything).Return(s.mockExecutionMgr, nil)
+	s.bootstrap = &BootstrapParams{
+		NumberOfHistoryShards: 4,
+		ExecutionMgrFactory:   mockFactory,
+		HistoryV2Mgr:          s.mockHistoryV2Mgr,
+		BlobstoreClient:       s.mockBlobstore,
+		Bucket:                testBucket,
+		Invariants: DefaultInvariants(&Config{
+			HistoryBranchMinAge:            dynamicconfig.GetDurationPropertyFn(time.Hour),
+			DeleteExecutionsWithoutHistory: func(opts ...dynamicconfig.FilterOption) bool { return s.deleteExecutions },
+		}),
+	}
+	s.resources = NewResources(s.bootstrap)
+}
+
+func (s *workflowSuite) TearDownTest() {
+	s.mockExecutionMgr.AssertExpectations(s.T())
+	s.mockHistoryV2Mgr.AssertExpectations(s.T())
+	s.mockBlobstore.AssertExpectations(s.T())
+}
+
+func (s *workflowSuite) TestPartitionShards() {
+	s.Equal([][]int{{0, 3}, {1, 4}, {2}}, partitionShards(5, 3))
+	s.Equal([][]int{{0}, {1}}, partitionShards(2, 4))
+	s.Equal([][]int{{0, 1, 2}}, partitionShards(3, 0))
+}
+
+func (s *workflowSuite) TestReportKeys() {
+	key := ReportKey("test-scan-id", "shard-3")
+	s.Equal("execution-scanner_test-scan-id_shard-3.json", key)
+	s.Equal("execution-scanner_test-scan-id_shard-3_fix.json", FixReportKey(key))
+}
+
+func (s *workflowSuite) TestHistoryExists_BranchMissing() {
+	invariant := s.getInvariant(InvariantHistoryExists)
+	entity := s.newEntity(EntityTypeExecution)
+	s.mockGetWorkflowExecution(s.newBranchToken(testBranchID))
+	s.mockHistoryV2Mgr.On("GetHistoryTree", &persistence.GetHistoryTreeRequest{TreeID: testTreeID}).
+		Return(&persistence.GetHistoryTreeResponse{
+			Branches: []*gen.HistoryBranch{{TreeID: common.StringPtr(testTreeID), BranchID: common.StringPtr("other-branch-id")}},
+		}, nil).Once()
+
+	finding, err := invariant.Check(s.resources, entity)
+	s.NoError(err)
+	s.NotNil(finding)
+	s.Equal(InvariantHistoryExists, finding.Invariant)
+	s.Equal(*entity, finding.Entity)
+}
+
+func (s *workflowSuite) TestHistoryExists_BranchPresent() {
+	invariant := s.getInvariant(InvariantHistoryExists)
+	s.mockGetWorkflowExecution(s.newBranchToken(testBranchID))
+	s.mockHistoryV2Mgr.On("GetHistoryTree", &persistence.GetHistoryTreeRequest{TreeID: testTreeID}).
+		Return(&persistence.GetHistoryTreeResponse{
+			Branches: []*gen.HistoryBranch{{TreeID: common.StringPtr(testTreeID), BranchID: common.StringPtr(testBranchID)}},
+		}, nil).Once()
+
+	finding, err := invariant.Check(s.resources, s.newEntity(EntityTypeExecution))
+	s.NoError(err)
+	s.Nil(finding)
+}
+
+func (s *workflowSuite) TestHistoryExists_Closed() {
+	invariant := s.getInvariant(InvariantHistoryExists)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				EventStoreVersion: persistence.EventStoreVersionV2,
+				BranchToken:       s.newBranchToken(testBranchID),
+				State:             persistence.WorkflowStateCompleted,
+			},
+		},
+	}, nil).Once()
+
+	finding, err := invariant.Check(s.resources, s.newEntity(EntityTypeExecution))
+	s.NoError(err)
+	s.Nil(finding)
+}
+
+func (s *workflowSuite) TestHistoryExists_FixDisabled() {
+	invariant := s.getInvariant(InvariantHistoryExists)
+	finding := &Finding{Invariant: InvariantHistoryExists, Entity: *s.newEntity(EntityTypeExecution)}
+	s.Equal(errFixDisabled, invariant.Fix(s.resources, finding))
+
+	s.mockGetWorkflowExecution(s.newBranchToken(testBranchID))
+	s.mockHistoryV2Mgr.On("GetHistoryTree", &persistence.GetHistoryTreeRequest{TreeID: testTreeID}).
+		Return(&persistence.GetHistoryTreeResponse{}, nil).Once()
+	result := fixFinding(s.resources, s.bootstrap.Invariants, finding)
+	s.Equal(FixOutcomeReported, result.Outcome)
+}
+
+func (s *workflowSuite) TestHistoryExists_Fix() {
+	s.deleteExecutions = true
+	invariant := s.getInvariant(InvariantHistoryExists)
+	s.mockExecutionMgr.On("DeleteCurrentWorkflowExecution", &persistence.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteWorkflowExecution", &persistence.DeleteWorkflowExecutionRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}).Return(nil).Once()
+
+	s.NoError(invariant.Fix(s.resources, &Finding{Invariant: InvariantHistoryExists, Entity: *s.newEntity(EntityTypeExecution)}))
+}
+
+func (s *workflowSuite) TestCurrentRunExists_RunMissing() {
+	invariant := s.getInvariant(InvariantCurrentRunExists)
+	s.mockExecutionMgr.On("GetCurrentExecution", &persistence.GetCurrentExecutionRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+	}).Return(&persistence.GetCurrentExecutionResponse{RunID: testRunID}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, &gen.EntityNotExistsError{}).Once()
+
+	finding, err := invariant.Check(s.resources, s.newEntity(EntityTypeCurrentExecution))
+	s.NoError(err)
+	s.NotNil(finding)
+}
+
+func (s *workflowSuite) TestCurrentRunExists_RunReplaced() {
+	invariant := s.getInvariant(InvariantCurrentRunExists)
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).
+		Return(&persistence.GetCurrentExecutionResponse{RunID: "new-run-id"}, nil).Once()
+
+	finding, err := invariant.Check(s.resources, s.newEntity(EntityTypeCurrentExecution))
+	s.NoError(err)
+	s.Nil(finding)
+}
+
+func (s *workflowSuite) TestTimerTaskRunExists_Fix() {
+	invariant := s.getInvariant(InvariantTimerTaskRunExists)
+	entity := s.newEntity(EntityTypeTimerTask)
+	entity.TaskID = 12
+	entity.VisibilityTimestamp = time.Unix(0, 1000)
+	s.mockExecutionMgr.On("CompleteTimerTask", &persistence.CompleteTimerTaskRequest{
+		VisibilityTimestamp: entity.VisibilityTimestamp,
+		TaskID:              entity.TaskID,
+	}).Return(nil).Once()
+
+	s.NoError(invariant.Fix(s.resources, &Finding{Invariant: InvariantTimerTaskRunExists, Entity: *entity}))
+}
+
+func (s *workflowSuite) TestHistoryBranchOwned() {
+	invariant := s.getInvariant(InvariantHistoryBranchOwned)
+	entity := &Entity{
+		Type:     EntityTypeHistoryBranch,
+		TreeID:   testTreeID,
+		BranchID: testBranchID,
+		ForkTime: time.Now().Add(-2 * time.Hour),
+		Info:     persistence.BuildHistoryGarbageCleanupInfo(testDomainID, testWorkflowID, testRunID),
+	}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, &gen.EntityNotExistsError{}).Once()
+
+	finding, err := invariant.Check(s.resources, entity)
+	s.NoError(err)
+	s.NotNil(finding)
+
+	// too recent, its execution can still be being created
+	entity.ForkTime = time.Now()
+	finding, err = invariant.Check(s.resources, entity)
+	s.NoError(err)
+	s.Nil(finding)
+
+	// written before the owners were recorded
+	entity.ForkTime = time.Now().Add(-2 * time.Hour)
+	entity.Info = ""
+	finding, err = invariant.Check(s.resources, entity)
+	s.NoError(err)
+	s.Nil(finding)
+}
+
+func (s *workflowSuite) TestHistoryBranchOwned_Fix() {
+	invariant := s.getInvariant(InvariantHistoryBranchOwned)
+	branch := &gen.HistoryBranch{TreeID: common.StringPtr(testTreeID), BranchID: common.StringPtr(testBranchID)}
+	s.mockHistoryV2Mgr.On("GetHistoryTree", &persistence.GetHistoryTreeRequest{TreeID: testTreeID}).
+		Return(&persistence.GetHistoryTreeResponse{Branches: []*gen.HistoryBranch{branch}}, nil).Once()
+	s.mockHistoryV2Mgr.On("DeleteHistoryBranch", &persistence.DeleteHistoryBranchRequest{
+		BranchToken: s.newBranchToken(testBranchID),
+	}).Return(nil).Once()
+
+	s.NoError(invariant.Fix(s.resources, &Finding{
+		Invariant: InvariantHistoryBranchOwned,
+		Entity:    Entity{Type: EntityTypeHistoryBranch, TreeID: testTreeID, BranchID: testBranchID},
+	}))
+}
+
+func (s *workflowSuite) TestFixFinding() {
+	entity := s.newEntity(EntityTypeTransferTask)
+	entity.TaskID = 34
+
+	// no longer holds
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{ExecutionInfo: &persistence.WorkflowExecutionInfo{}},
+	}, nil).Once()
+	result := fixFinding(s.resources, s.bootstrap.Invariants, &Finding{Invariant: InvariantTransferTaskRunExists, Entity: *entity})
+	s.Equal(FixOutcomeSkipped, result.Outcome)
+
+	// still holds
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, &gen.EntityNotExistsError{}).Once()
+	s.mockExecutionMgr.On("CompleteTransferTask", &persistence.CompleteTransferTaskRequest{TaskID: 34}).Return(nil).Once()
+	result = fixFinding(s.resources, s.bootstrap.Invariants, &Finding{Invariant: InvariantTransferTaskRunExists, Entity: *entity})
+	s.Equal(FixOutcomeFixed, result.Outcome)
+
+	// cannot be checked again
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, errors.New("some random error")).Once()
+	result = fixFinding(s.resources, s.bootstrap.Invariants, &Finding{Invariant: InvariantTransferTaskRunExists, Entity: *entity})
+	s.Equal(FixOutcomeFailed, result.Outcome)
+	s.Equal("some random error", result.Error)
+
+	result = fixFinding(s.resources, s.bootstrap.Invariants, &Finding{Invariant: "unknown-invariant", Entity: *entity})
+	s.Equal(FixOutcomeSkipped, result.Outcome)
+}
+
+func (s *workflowSuite) TestReportRoundTrip() {
+	report := &Report{
+		ScanID:   "test-scan-id",
+		Findings: []Finding{{Invariant: InvariantCurrentRunExists, Entity: *s.newEntity(EntityTypeCurrentExecution), Reason: "test reason"}},
+	}
+	key := ReportKey(report.ScanID, "shard-1")
+	var uploaded []byte
+	s.mockBlobstore.On("UploadBlob", mock.Anything, testBucket, key, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		blob := args.Get(3).(*blobstore.Blob)
+		s.Equal(report.ScanID, blob.Tags[ScanIDTag])
+		data, err := ioutil.ReadAll(blob.Body)
+		s.NoError(err)
+		uploaded = data
+	}).Once()
+	s.NoError(uploadReport(context.Background(), s.mockBlobstore, testBucket, key, report.ScanID, report))
+
+	s.mockBlobstore.On("DownloadBlob", mock.Anything, testBucket, key).
+		Return(&blobstore.Blob{Body: bytes.NewReader(uploaded)}, nil).Once()
+	downloaded, err := DownloadReport(context.Background(), s.mockBlobstore, testBucket, key)
+	s.NoError(err)
+	s.Equal(report, downloaded)
+}
+
+func (s *workflowSuite) TestEntityJSON() {
+	data, err := json.Marshal(s.newEntity(EntityTypeExecution))
+	s.NoError(err)
+	entity := &Entity{}
+	s.NoError(json.Unmarshal(data, entity))
+	s.Equal(s.newEntity(EntityTypeExecution), entity)
+}
+
+func (s *workflowSuite) getInvariant(name string) Invariant {
+	for _, invariant := range s.bootstrap.Invariants {
+		if invariant.Name() == name {
+			return invariant
+		}
+	}
+	s.FailNow("invariant not found", name)
+	return nil
+}
+
+func (s *workflowSuite) newEntity(entityType EntityType) *Entity {
+	return &Entity{
+		Type:       entityType,
+		ShardID:    common.WorkflowIDToHistoryShard(testWorkflowID, s.bootstrap.NumberOfHistoryShards),
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+}
+
+func (s *workflowSuite) newBranchToken(branchID string) []byte {
+	token, err := thriftEncoder.Encode(&gen.HistoryBranch{
+		TreeID:   common.StringPtr(testTreeID),
+		BranchID: common.StringPtr(branchID),
+	})
+	s.NoError(err)
+	return token
+}
+
+func (s *workflowSuite) mockGetWorkflowExecution(branchToken []byte) {
+	s.mockExecutionMgr.On("GetWorkflowExecution", &persistence.GetWorkflowExecutionRequest{
+		DomainID: testDomainID,
+		Execution: gen.WorkflowExecution{
+			WorkflowId: common.StringPtr(testWorkflowID),
+			RunId:      common.StringPtr(testRunID),
+		},
+	}).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				EventStoreVersion: persistence.EventStoreVersionV2,
+				BranchToken:       branchToken,
+			},
+		},
+	}, nil).Once()
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scavenger
+
+import (
+	"context"
+
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/client"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+type (
+	// BootstrapParams are the clients and persistence managers the task list scavenger operates on
+	BootstrapParams struct {
+		FrontendClient        frontend.Client
+		TaskMgr               persistence.TaskManager
+		NumberOfHistoryShards int
+		// ExecutionMgrFactory creates the execution managers the workflows of the old tasks are looked up with
+		ExecutionMgrFactory persistence.ExecutionManagerFactory
+		Config              *Config
+	}
+
+	// Config contains all the task list scavenger config for worker
+	Config struct {
+		// ScavengeInterval is the time between the end of a scavenge and the start of the next one
+		ScavengeInterval dynamicconfig.DurationPropertyFn
+		// TaskMaxAge is the age over which the tasks which have not been dispatched are deleted when their
+		// workflow or activity no longer exists
+		TaskMaxAge dynamicconfig.DurationPropertyFn
+		// TaskListIdleTime is the time a task list without backlog is not updated for before it is deleted
+		TaskListIdleTime dynamicconfig.DurationPropertyFn
+		// PersistenceMaxRPS is the max rate of the persistence requests of the scavenger
+		PersistenceMaxRPS dynamicconfig.IntPropertyFn
+	}
+
+	// Scavenger is the cadence client worker responsible for running the task list scavenges
+	Scavenger struct {
+		params *BootstrapParams
+		worker worker.Worker
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(ScavengerWorkflow, workflow.RegisterOptions{Name: ScavengerWorkflowFnName})
+	activity.RegisterWithOptions(scavengeConfigActivity, activity.RegisterOptions{Name: scavengeConfigActivityFnName})
+	activity.RegisterWithOptions(scavengeActivity, activity.RegisterOptions{Name: scavengeActivityFnName})
+}
+
+// New creates a new task list scavenger
+func New(params *BootstrapParams, scope tally.Scope) *Scavenger {
+	logger, _ := zap.NewProduction()
+	actCtx := context.WithValue(context.Background(), bootstrapParamsKey, params)
+	wo := worker.Options{
+		Logger:                    logger,
+		MetricsScope:              scope.SubScope(taskListScavengerScope),
+		BackgroundActivityContext: actCtx,
+	}
+	return &Scavenger{
+		params: params,
+		worker: worker.New(params.FrontendClient, Domain, TaskList, wo),
+	}
+}
+
+// Start starts the worker and the scavenger workflow, the scavenger workflow keeps running across restarts of
+// the worker
+func (s *Scavenger) Start() error {
+	if err := s.worker.Start(); err != nil {
+		s.worker.Stop()
+		return err
+	}
+
+	cadenceClient := client.NewClient(s.params.FrontendClient, Domain, &client.Options{})
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                              WorkflowID,
+		TaskList:                        TaskList,
+		ExecutionStartToCloseTimeout:    WorkflowStartToCloseTimeout,
+		DecisionTaskStartToCloseTimeout: DecisionTaskStartToCloseTimeout,
+		WorkflowIDReusePolicy:           client.WorkflowIDReusePolicyAllowDuplicate,
+	}
+	_, err := cadenceClient.StartWorkflow(context.Background(), workflowOptions, ScavengerWorkflowFnName)
+	if err != nil && !isWorkflowAlreadyStarted(err) {
+		s.worker.Stop()
+		return err
+	}
+	return nil
+}
+
+// Stop stops the worker, the scavenge in progress is resumed by the next worker
+func (s *Scavenger) Stop() {
+	s.worker.Stop()
+}
+
+func isWorkflowAlreadyStarted(err error) bool {
+	_, ok := err.(*shared.WorkflowExecutionAlreadyStartedError)
+	return ok
+}
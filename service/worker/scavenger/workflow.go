@@ -0,0 +1,439 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scavenger
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/uber-go/tally"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/worker/sysworkflow"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	// Domain is the domain the task list scavenger workflow runs in
+	Domain = sysworkflow.Domain
+	// TaskList is the task list of the task list scavenger workflow
+	TaskList = "cadsys-tasklist-scavenger-tl"
+	// WorkflowID is the id of the scavenger workflow, there is a single scavenger workflow per cluster
+	WorkflowID = "cadsys-tasklist-scavenger"
+	// ScavengerWorkflowFnName is the name of the scavenger workflow function
+	ScavengerWorkflowFnName = "TaskListScavengerWorkflow"
+	// WorkflowStartToCloseTimeout is the time for a scavenge to finish
+	WorkflowStartToCloseTimeout = sysworkflow.WorkflowStartToCloseTimeout
+	// DecisionTaskStartToCloseTimeout is the time for decision to finish
+	DecisionTaskStartToCloseTimeout = sysworkflow.DecisionTaskStartToCloseTimeout
+
+	scavengeConfigActivityFnName = "TaskListScavengeConfigActivity"
+	scavengeActivityFnName       = "TaskListScavengeActivity"
+
+	activityHeartbeat         = time.Minute
+	taskListPageSize          = 100
+	taskBatchSize             = 1000
+	taskListScavengerScope    = "tasklist-scavenger"
+	logTagScavengeID          = "scavenge-id"
+	logTagDomainID            = "domain-id"
+	logTagTaskList            = "task-list"
+	logTagTaskType            = "task-type"
+	logTagScanned             = "task-lists-scanned"
+	logTagTasksDeleted        = "tasks-deleted"
+	logTagTaskListsDeleted    = "task-lists-deleted"
+	logTagFailed              = "failed"
+	taskListsScannedCounter   = "task-lists-scanned"
+	tasksDeletedCounter       = "tasks-deleted"
+	taskListsDeletedCounter   = "task-lists-deleted"
+	taskListFailuresCounter   = "task-list-failures"
+	taskListDeleteSkipCounter = "task-list-delete-skipped"
+)
+
+type (
+	// ScavengeConfig is the config of a scavenge, it is read once at the start of every scavenge
+	ScavengeConfig struct {
+		Interval          time.Duration
+		TaskMaxAge        time.Duration
+		TaskListIdleTime  time.Duration
+		PersistenceMaxRPS int
+	}
+
+	// ScavengeSummary is the outcome of a scavenge
+	ScavengeSummary struct {
+		TaskListsScanned int
+		TasksDeleted     int
+		TaskListsDeleted int
+		Failed           int
+	}
+
+	// scavengeProgress is recorded with the heartbeats of the scavenge, a retried activity resumes from the
+	// page of task lists it did not complete
+	scavengeProgress struct {
+		PageToken []byte
+		Summary   ScavengeSummary
+	}
+
+	// taskListOutcome is the outcome of the scavenge of a single task list
+	taskListOutcome struct {
+		tasksDeleted int
+		deleted      bool
+	}
+
+	// taskListScavenger deletes the completed and expired tasks of task lists, and the idle task lists
+	// without backlog
+	taskListScavenger struct {
+		taskMgr               persistence.TaskManager
+		numberOfHistoryShards int
+		executionMgrFactory   persistence.ExecutionManagerFactory
+		executionMgrs         map[int]persistence.ExecutionManager
+		config                ScavengeConfig
+		limiter               common.TokenBucket
+		logger                *zap.Logger
+		scope                 tally.Scope
+		heartbeat             func()
+		now                   func() time.Time
+	}
+
+	contextKey int
+)
+
+const (
+	bootstrapParamsKey contextKey = iota
+)
+
+var activityOptions = workflow.ActivityOptions{
+	ScheduleToStartTimeout: 5 * time.Minute,
+	StartToCloseTimeout:    WorkflowStartToCloseTimeout,
+	HeartbeatTimeout:       activityHeartbeat,
+	RetryPolicy: &cadence.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    5 * time.Minute,
+		ExpirationInterval: WorkflowStartToCloseTimeout,
+	},
+}
+
+// ScavengerWorkflow walks all the task lists of all the domains, deletes their completed and expired tasks and
+// deletes the idle task lists without backlog. It sleeps for the scavenge interval once done and continues as
+// new, each run is a scavenge identified by its run id.
+func ScavengerWorkflow(ctx workflow.Context) error {
+	scavengeID := workflow.GetInfo(ctx).WorkflowExecution.RunID
+	logger := workflow.GetLogger(ctx).With(zap.String(logTagScavengeID, scavengeID))
+	actCtx := workflow.WithActivityOptions(ctx, activityOptions)
+
+	var config ScavengeConfig
+	if err := workflow.ExecuteActivity(actCtx, scavengeConfigActivityFnName).Get(ctx, &config); err != nil {
+		return err
+	}
+
+	var summary ScavengeSummary
+	if err := workflow.ExecuteActivity(actCtx, scavengeActivityFnName, config).Get(ctx, &summary); err != nil {
+		// the task lists which were not scavenged are covered by the next scavenge
+		logger.Error("failed to scavenge task lists", zap.Error(err))
+	} else {
+		logger.Info("scavenge completed",
+			zap.Int(logTagScanned, summary.TaskListsScanned),
+			zap.Int(logTagTasksDeleted, summary.TasksDeleted),
+			zap.Int(logTagTaskListsDeleted, summary.TaskListsDeleted),
+			zap.Int(logTagFailed, summary.Failed))
+	}
+
+	if err := workflow.Sleep(ctx, config.Interval); err != nil {
+		return err
+	}
+	return workflow.NewContinueAsNewError(ctx, ScavengerWorkflowFnName)
+}
+
+func scavengeConfigActivity(ctx context.Context) (ScavengeConfig, error) {
+	bootstrap := ctx.Value(bootstrapParamsKey).(*BootstrapParams)
+	return ScavengeConfig{
+		Interval:          bootstrap.Config.ScavengeInterval(),
+		TaskMaxAge:        bootstrap.Config.TaskMaxAge(),
+		TaskListIdleTime:  bootstrap.Config.TaskListIdleTime(),
+		PersistenceMaxRPS: bootstrap.Config.PersistenceMaxRPS(),
+	}, nil
+}
+
+func scavengeActivity(ctx context.Context, config ScavengeConfig) (ScavengeSummary, error) {
+	bootstrap := ctx.Value(bootstrapParamsKey).(*BootstrapParams)
+	logger := activity.GetLogger(ctx)
+
+	progress := scavengeProgress{}
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			logger.Error("failed to recover the progress of the scavenge, starting over", zap.Error(err))
+			progress = scavengeProgress{}
+		}
+	}
+
+	scavenger := newTaskListScavenger(bootstrap, config, logger, activity.GetMetricsScope(ctx), func() {
+		activity.RecordHeartbeat(ctx, progress)
+	})
+	err := scavenger.scavenge(&progress)
+	return progress.Summary, err
+}
+
+func newTaskListScavenger(bootstrap *BootstrapParams, config ScavengeConfig, logger *zap.Logger,
+	scope tally.Scope, heartbeat func()) *taskListScavenger {
+	return &taskListScavenger{
+		taskMgr:               bootstrap.TaskMgr,
+		numberOfHistoryShards: bootstrap.NumberOfHistoryShards,
+		executionMgrFactory:   bootstrap.ExecutionMgrFactory,
+		executionMgrs:         make(map[int]persistence.ExecutionManager),
+		config:                config,
+		limiter:               common.NewTokenBucket(config.PersistenceMaxRPS, common.NewRealTimeSource()),
+		logger:                logger,
+		scope:                 scope,
+		heartbeat:             heartbeat,
+		now:                   time.Now,
+	}
+}
+
+// scavenge walks the task lists of all the domains page by page, starting from the page of the progress
+func (s *taskListScavenger) scavenge(progress *scavengeProgress) error {
+	for {
+		s.throttle()
+		resp, err := s.taskMgr.ListTaskList(&persistence.ListTaskListRequest{
+			PageSize:  taskListPageSize,
+			PageToken: progress.PageToken,
+		})
+		if err != nil {
+			s.logger.Error("failed to list task lists", zap.Error(err))
+			return err
+		}
+		for _, info := range resp.Items {
+			outcome, err := s.scavengeTaskList(info)
+			progress.Summary.TaskListsScanned++
+			s.scope.Counter(taskListsScannedCounter).Inc(1)
+			if err != nil {
+				// a single task list does not fail the scavenge, it is retried by the next one
+				progress.Summary.Failed++
+				s.scope.Counter(taskListFailuresCounter).Inc(1)
+				s.taskListLogger(info).Error("failed to scavenge task list", zap.Error(err))
+			}
+			progress.Summary.TasksDeleted += outcome.tasksDeleted
+			s.scope.Counter(tasksDeletedCounter).Inc(int64(outcome.tasksDeleted))
+			if outcome.deleted {
+				progress.Summary.TaskListsDeleted++
+			}
+			s.heartbeat()
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		progress.PageToken = resp.NextPageToken
+		s.heartbeat()
+	}
+}
+
+// scavengeTaskList deletes the tasks of the task list below its ack level, then the expired tasks at the head
+// of its backlog. The task list is deleted when it has no backlog left and was not updated for the idle time,
+// the delete is conditioned on its range id so that a task list leased again in the meantime is kept.
+func (s *taskListScavenger) scavengeTaskList(info *persistence.TaskListInfo) (taskListOutcome, error) {
+	outcome := taskListOutcome{}
+
+	deleted, err := s.completeTasksLessThan(info, info.AckLevel+1, math.MaxInt32)
+	outcome.tasksDeleted += deleted
+	if err != nil {
+		return outcome, err
+	}
+
+	hasBacklog := false
+	readLevel := info.AckLevel
+	for {
+		s.throttle()
+		resp, err := s.taskMgr.GetTasks(&persistence.GetTasksRequest{
+			DomainID:     info.DomainID,
+			TaskList:     info.Name,
+			TaskType:     info.TaskType,
+			ReadLevel:    readLevel,
+			MaxReadLevel: math.MaxInt64,
+			BatchSize:    taskBatchSize,
+		})
+		if err != nil {
+			return outcome, err
+		}
+		expired := 0
+		for expired < len(resp.Tasks) {
+			ok, err := s.isExpired(info.TaskType, resp.Tasks[expired])
+			if err != nil {
+				return outcome, err
+			}
+			if !ok {
+				break
+			}
+			expired++
+		}
+		if expired > 0 {
+			// only the leading run of expired tasks is deleted, a range delete would remove the live tasks
+			// interleaved with the expired ones otherwise
+			deleted, err := s.completeTasksLessThan(info, resp.Tasks[expired-1].TaskID+1, expired)
+			outcome.tasksDeleted += deleted
+			if err != nil {
+				return outcome, err
+			}
+		}
+		if expired < len(resp.Tasks) {
+			hasBacklog = true
+			break
+		}
+		if len(resp.Tasks) < taskBatchSize {
+			break
+		}
+		readLevel = resp.Tasks[len(resp.Tasks)-1].TaskID
+	}
+
+	if hasBacklog || !s.isIdle(info) {
+		return outcome, nil
+	}
+	s.throttle()
+	err = s.taskMgr.DeleteTaskList(&persistence.DeleteTaskListRequest{
+		DomainID:     info.DomainID,
+		TaskListName: info.Name,
+		TaskListType: info.TaskType,
+		RangeID:      info.RangeID,
+	})
+	if err != nil {
+		if _, ok := err.(*persistence.ConditionFailedError); ok {
+			s.scope.Counter(taskListDeleteSkipCounter).Inc(1)
+			return outcome, nil
+		}
+		return outcome, err
+	}
+	s.scope.Counter(taskListsDeletedCounter).Inc(1)
+	s.taskListLogger(info).Info("idle task list deleted")
+	outcome.deleted = true
+	return outcome, nil
+}
+
+// completeTasksLessThan deletes the tasks of the task list with an id less than taskID in batches, up to limit
+// tasks, and returns the number of tasks deleted
+func (s *taskListScavenger) completeTasksLessThan(info *persistence.TaskListInfo, taskID int64, limit int) (int, error) {
+	total := 0
+	for total < limit {
+		batch := taskBatchSize
+		if limit-total < batch {
+			batch = limit - total
+		}
+		s.throttle()
+		deleted, err := s.taskMgr.CompleteTasksLessThan(&persistence.CompleteTasksLessThanRequest{
+			DomainID:     info.DomainID,
+			TaskListName: info.Name,
+			TaskType:     info.TaskType,
+			TaskID:       taskID,
+			Limit:        batch,
+		})
+		if err != nil {
+			return total, err
+		}
+		total += deleted
+		if deleted < batch {
+			break
+		}
+	}
+	return total, nil
+}
+
+// isExpired is whether the schedule to start timeout of the task expired, or the task is older than the max age
+// and the workflow or the activity it was scheduled for no longer exists
+func (s *taskListScavenger) isExpired(taskType int, task *persistence.TaskInfo) (bool, error) {
+	now := s.now()
+	if !task.Expiry.IsZero() && task.Expiry.Before(now) {
+		return true, nil
+	}
+	if task.CreatedTime.IsZero() || now.Sub(task.CreatedTime) <= s.config.TaskMaxAge {
+		return false, nil
+	}
+	exists, err := s.isScheduled(taskType, task)
+	return !exists, err
+}
+
+// isScheduled is whether the workflow of the task is running and still waits for the decision or the activity
+// the task was scheduled for to be started
+func (s *taskListScavenger) isScheduled(taskType int, task *persistence.TaskInfo) (bool, error) {
+	shardID := common.WorkflowIDToHistoryShard(task.WorkflowID, s.numberOfHistoryShards)
+	executionMgr, err := s.executionManager(shardID)
+	if err != nil {
+		return false, err
+	}
+	s.throttle()
+	resp, err := executionMgr.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		DomainID: task.DomainID,
+		Execution: gen.WorkflowExecution{
+			WorkflowId: common.StringPtr(task.WorkflowID),
+			RunId:      common.StringPtr(task.RunID),
+		},
+	})
+	if err != nil {
+		if _, ok := err.(*gen.EntityNotExistsError); ok {
+			return false, nil
+		}
+		return false, err
+	}
+	executionInfo := resp.State.ExecutionInfo
+	if executionInfo.State == persistence.WorkflowStateCompleted {
+		return false, nil
+	}
+	if taskType == persistence.TaskListTypeDecision {
+		return executionInfo.DecisionScheduleID == task.ScheduleID &&
+			executionInfo.DecisionStartedID == common.EmptyEventID, nil
+	}
+	activityInfo, ok := resp.State.ActivityInfos[task.ScheduleID]
+	return ok && activityInfo.StartedID == common.EmptyEventID, nil
+}
+
+// executionManager returns the execution manager of the shard, the execution managers are created once per shard
+// and share the store session of the factory
+func (s *taskListScavenger) executionManager(shardID int) (persistence.ExecutionManager, error) {
+	if executionMgr, ok := s.executionMgrs[shardID]; ok {
+		return executionMgr, nil
+	}
+	executionMgr, err := s.executionMgrFactory.NewExecutionManager(shardID)
+	if err != nil {
+		return nil, err
+	}
+	s.executionMgrs[shardID] = executionMgr
+	return executionMgr, nil
+}
+
+// isIdle is whether the task list was not updated for the idle time, a task list the store does not report the
+// last update time of is never idle
+func (s *taskListScavenger) isIdle(info *persistence.TaskListInfo) bool {
+	return !info.LastUpdated.IsZero() && s.now().Sub(info.LastUpdated) > s.config.TaskListIdleTime
+}
+
+func (s *taskListScavenger) throttle() {
+	for !s.limiter.Consume(1, activityHeartbeat/2) {
+		s.heartbeat()
+	}
+}
+
+func (s *taskListScavenger) taskListLogger(info *persistence.TaskListInfo) *zap.Logger {
+	return s.logger.With(
+		zap.String(logTagDomainID, info.DomainID),
+		zap.String(logTagTaskList, info.Name),
+		zap.Int(logTagTaskType, info.TaskType))
+}
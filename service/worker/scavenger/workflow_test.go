@@ -0,0 +1,339 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scavenger
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"go.uber.org/zap"
+)
+
+type workflowSuite struct {
+	suite.Suite
+	*require.Assertions
+
+	mockTaskMgr      *mocks.TaskManager
+	mockExecutionMgr *mocks.ExecutionManager
+	scavenger        *taskListScavenger
+	now              time.Time
+}
+
+const (
+	testDomainID   = "test-domain-id"
+	testTaskList   = "test-task-list"
+	testWorkflowID = "test-workflow-id"
+	testRunID      = "test-run-id"
+)
+
+func TestWorkflowSuite(t *testing.T) {
+	s := new(workflowSuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.mockTaskMgr = &mocks.TaskManager{}
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
+	mockFactory := &mocks.ExecutionManagerFactory{}
+	mockFactory.On("NewExecutionManager", mock.Anything).Return(s.mockExecutionMgr, nil)
+	s.now = time.Now()
+	config := ScavengeConfig{
+		Interval:          time.Hour,
+		TaskMaxAge:        24 * time.Hour,
+		TaskListIdleTime:  time.Hour,
+		PersistenceMaxRPS: 1000,
+	}
+	bootstrap := &BootstrapParams{
+		TaskMgr:               s.mockTaskMgr,
+		NumberOfHistoryShards: 4,
+		ExecutionMgrFactory:   mockFactory,
+	}
+	s.scavenger = newTaskListScavenger(bootstrap, config, zap.NewNop(), tally.NoopScope, func() {})
+	s.scavenger.now = func() time.Time { return s.now }
+}
+
+func (s *workflowSuite) TearDownTest() {
+	s.mockTaskMgr.AssertExpectations(s.T())
+	s.mockExecutionMgr.AssertExpectations(s.T())
+}
+
+func (s *workflowSuite) TestScavengeTaskList_IdleWithoutBacklog() {
+	info := s.newTaskListInfo(10, s.now.Add(-2*time.Hour))
+	s.mockCompleteTasksLessThan(11, math.MaxInt32, 3)
+	s.mockGetTasks(10)
+	s.mockTaskMgr.On("DeleteTaskList", &persistence.DeleteTaskListRequest{
+		DomainID:     testDomainID,
+		TaskListName: testTaskList,
+		TaskListType: persistence.TaskListTypeActivity,
+		RangeID:      5,
+	}).Return(nil).Once()
+
+	outcome, err := s.scavenger.scavengeTaskList(info)
+	s.NoError(err)
+	s.True(outcome.deleted)
+	s.Equal(3, outcome.tasksDeleted)
+}
+
+func (s *workflowSuite) TestScavengeTaskList_NotIdle() {
+	info := s.newTaskListInfo(10, s.now.Add(-time.Minute))
+	s.mockCompleteTasksLessThan(11, math.MaxInt32, 0)
+	s.mockGetTasks(10)
+
+	outcome, err := s.scavenger.scavengeTaskList(info)
+	s.NoError(err)
+	s.False(outcome.deleted)
+	s.Equal(0, outcome.tasksDeleted)
+}
+
+func (s *workflowSuite) TestScavengeTaskList_UnknownLastUpdated() {
+	info := s.newTaskListInfo(10, time.Time{})
+	s.mockCompleteTasksLessThan(11, math.MaxInt32, 0)
+	s.mockGetTasks(10)
+
+	outcome, err := s.scavenger.scavengeTaskList(info)
+	s.NoError(err)
+	s.False(outcome.deleted)
+	s.Equal(0, outcome.tasksDeleted)
+}
+
+func (s *workflowSuite) TestScavengeTaskList_ExpiredTasks() {
+	info := s.newTaskListInfo(10, s.now.Add(-2*time.Hour))
+	s.mockCompleteTasksLessThan(11, math.MaxInt32, 0)
+	expiredTask := s.newTask(12, s.now.Add(-time.Hour), 5)
+	expiredTask.Expiry = s.now.Add(-time.Minute)
+	s.mockGetTasks(10,
+		s.newTask(11, s.now.Add(-48*time.Hour), 5),
+		expiredTask,
+		s.newTask(13, s.now.Add(-48*time.Hour), 7),
+		s.newTask(14, s.now.Add(-48*time.Hour), 9),
+	)
+	s.mockExecutionMgr.On("GetWorkflowExecution", s.newGetWorkflowExecutionRequest()).
+		Return(s.newGetWorkflowExecutionResponse(persistence.WorkflowStateRunning, 7), nil).Twice()
+	s.mockCompleteTasksLessThan(13, 2, 2)
+
+	outcome, err := s.scavenger.scavengeTaskList(info)
+	s.NoError(err)
+	s.False(outcome.deleted)
+	s.Equal(2, outcome.tasksDeleted)
+}
+
+func (s *workflowSuite) TestScavengeTaskList_DeleteSkipped() {
+	info := s.newTaskListInfo(10, s.now.Add(-2*time.Hour))
+	s.mockCompleteTasksLessThan(11, math.MaxInt32, 0)
+	s.mockGetTasks(10, s.newTask(11, s.now.Add(-48*time.Hour), 5))
+	s.mockExecutionMgr.On("GetWorkflowExecution", s.newGetWorkflowExecutionRequest()).
+		Return(nil, &gen.EntityNotExistsError{}).Once()
+	s.mockCompleteTasksLessThan(12, 1, 1)
+	s.mockTaskMgr.On("DeleteTaskList", &persistence.DeleteTaskListRequest{
+		DomainID:     testDomainID,
+		TaskListName: testTaskList,
+		TaskListType: persistence.TaskListTypeActivity,
+		RangeID:      5,
+	}).Return(&persistence.ConditionFailedError{Msg: "range id mismatch"}).Once()
+
+	outcome, err := s.scavenger.scavengeTaskList(info)
+	s.NoError(err)
+	s.False(outcome.deleted)
+	s.Equal(1, outcome.tasksDeleted)
+}
+
+func (s *workflowSuite) TestScavengeTaskList_LookupFailure() {
+	info := s.newTaskListInfo(10, s.now.Add(-2*time.Hour))
+	s.mockCompleteTasksLessThan(11, math.MaxInt32, 0)
+	s.mockGetTasks(10, s.newTask(11, s.now.Add(-48*time.Hour), 5))
+	s.mockExecutionMgr.On("GetWorkflowExecution", s.newGetWorkflowExecutionRequest()).
+		Return(nil, errors.New("persistence failure")).Once()
+
+	outcome, err := s.scavenger.scavengeTaskList(info)
+	s.Error(err)
+	s.False(outcome.deleted)
+	s.Equal(0, outcome.tasksDeleted)
+}
+
+func (s *workflowSuite) TestIsExpired_YoungTask() {
+	task := s.newTask(11, s.now.Add(-time.Hour), 5)
+	expired, err := s.scavenger.isExpired(persistence.TaskListTypeActivity, task)
+	s.NoError(err)
+	s.False(expired)
+}
+
+func (s *workflowSuite) TestIsExpired_WorkflowCompleted() {
+	s.mockExecutionMgr.On("GetWorkflowExecution", s.newGetWorkflowExecutionRequest()).
+		Return(s.newGetWorkflowExecutionResponse(persistence.WorkflowStateCompleted, 5), nil).Once()
+
+	task := s.newTask(11, s.now.Add(-48*time.Hour), 5)
+	expired, err := s.scavenger.isExpired(persistence.TaskListTypeActivity, task)
+	s.NoError(err)
+	s.True(expired)
+}
+
+func (s *workflowSuite) TestIsExpired_Activity() {
+	resp := s.newGetWorkflowExecutionResponse(persistence.WorkflowStateRunning, 5)
+	resp.State.ActivityInfos[7] = &persistence.ActivityInfo{ScheduleID: 7, StartedID: 8}
+	s.mockExecutionMgr.On("GetWorkflowExecution", s.newGetWorkflowExecutionRequest()).Return(resp, nil).Times(3)
+
+	for scheduleID, expected := range map[int64]bool{5: false, 7: true, 9: true} {
+		expired, err := s.scavenger.isExpired(persistence.TaskListTypeActivity,
+			s.newTask(11, s.now.Add(-48*time.Hour), scheduleID))
+		s.NoError(err)
+		s.Equal(expected, expired, "schedule id %v", scheduleID)
+	}
+}
+
+func (s *workflowSuite) TestIsExpired_Decision() {
+	resp := s.newGetWorkflowExecutionResponse(persistence.WorkflowStateRunning, 5)
+	resp.State.ExecutionInfo.DecisionScheduleID = 7
+	resp.State.ExecutionInfo.DecisionStartedID = common.EmptyEventID
+	s.mockExecutionMgr.On("GetWorkflowExecution", s.newGetWorkflowExecutionRequest()).Return(resp, nil).Twice()
+
+	for scheduleID, expected := range map[int64]bool{5: true, 7: false} {
+		expired, err := s.scavenger.isExpired(persistence.TaskListTypeDecision,
+			s.newTask(11, s.now.Add(-48*time.Hour), scheduleID))
+		s.NoError(err)
+		s.Equal(expected, expired, "schedule id %v", scheduleID)
+	}
+}
+
+func (s *workflowSuite) TestCompleteTasksLessThan_Batches() {
+	info := s.newTaskListInfo(10, s.now)
+	s.mockCompleteTasksLessThan(11, taskBatchSize, taskBatchSize)
+	s.mockCompleteTasksLessThan(11, 500, 200)
+
+	deleted, err := s.scavenger.completeTasksLessThan(info, 11, taskBatchSize+500)
+	s.NoError(err)
+	s.Equal(taskBatchSize+200, deleted)
+}
+
+func (s *workflowSuite) TestScavenge_Pages() {
+	s.mockTaskMgr.On("ListTaskList", &persistence.ListTaskListRequest{PageSize: taskListPageSize}).
+		Return(&persistence.ListTaskListResponse{
+			Items:         []*persistence.TaskListInfo{s.newTaskListInfo(10, s.now)},
+			NextPageToken: []byte("next-page"),
+		}, nil).Once()
+	s.mockTaskMgr.On("ListTaskList", &persistence.ListTaskListRequest{
+		PageSize:  taskListPageSize,
+		PageToken: []byte("next-page"),
+	}).Return(&persistence.ListTaskListResponse{
+		Items: []*persistence.TaskListInfo{s.newTaskListInfo(20, s.now)},
+	}, nil).Once()
+	s.mockCompleteTasksLessThan(11, math.MaxInt32, 0)
+	s.mockGetTasks(10)
+	s.mockTaskMgr.On("CompleteTasksLessThan", &persistence.CompleteTasksLessThanRequest{
+		DomainID:     testDomainID,
+		TaskListName: testTaskList,
+		TaskType:     persistence.TaskListTypeActivity,
+		TaskID:       21,
+		Limit:        taskBatchSize,
+	}).Return(0, errors.New("persistence failure")).Once()
+
+	progress := scavengeProgress{}
+	s.NoError(s.scavenger.scavenge(&progress))
+	s.Equal(ScavengeSummary{TaskListsScanned: 2, Failed: 1}, progress.Summary)
+	s.Equal([]byte("next-page"), progress.PageToken)
+}
+
+func (s *workflowSuite) newTaskListInfo(ackLevel int64, lastUpdated time.Time) *persistence.TaskListInfo {
+	return &persistence.TaskListInfo{
+		DomainID:    testDomainID,
+		Name:        testTaskList,
+		TaskType:    persistence.TaskListTypeActivity,
+		RangeID:     5,
+		AckLevel:    ackLevel,
+		LastUpdated: lastUpdated,
+	}
+}
+
+func (s *workflowSuite) newTask(taskID int64, createdTime time.Time, scheduleID int64) *persistence.TaskInfo {
+	return &persistence.TaskInfo{
+		DomainID:    testDomainID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		TaskID:      taskID,
+		ScheduleID:  scheduleID,
+		CreatedTime: createdTime,
+	}
+}
+
+func (s *workflowSuite) newGetWorkflowExecutionRequest() *persistence.GetWorkflowExecutionRequest {
+	return &persistence.GetWorkflowExecutionRequest{
+		DomainID: testDomainID,
+		Execution: gen.WorkflowExecution{
+			WorkflowId: common.StringPtr(testWorkflowID),
+			RunId:      common.StringPtr(testRunID),
+		},
+	}
+}
+
+// newGetWorkflowExecutionResponse returns the mutable state of a workflow with a scheduled activity which was not
+// started yet and no outstanding decision
+func (s *workflowSuite) newGetWorkflowExecutionResponse(state int,
+	activityScheduleID int64) *persistence.GetWorkflowExecutionResponse {
+	return &persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				DomainID:           testDomainID,
+				WorkflowID:         testWorkflowID,
+				RunID:              testRunID,
+				State:              state,
+				DecisionScheduleID: common.EmptyEventID,
+				DecisionStartedID:  common.EmptyEventID,
+			},
+			ActivityInfos: map[int64]*persistence.ActivityInfo{
+				activityScheduleID: {ScheduleID: activityScheduleID, StartedID: common.EmptyEventID},
+			},
+		},
+	}
+}
+
+func (s *workflowSuite) mockCompleteTasksLessThan(taskID int64, limit int, deleted int) {
+	if limit > taskBatchSize {
+		limit = taskBatchSize
+	}
+	s.mockTaskMgr.On("CompleteTasksLessThan", &persistence.CompleteTasksLessThanRequest{
+		DomainID:     testDomainID,
+		TaskListName: testTaskList,
+		TaskType:     persistence.TaskListTypeActivity,
+		TaskID:       taskID,
+		Limit:        limit,
+	}).Return(deleted, nil).Once()
+}
+
+func (s *workflowSuite) mockGetTasks(readLevel int64, tasks ...*persistence.TaskInfo) {
+	s.mockTaskMgr.On("GetTasks", &persistence.GetTasksRequest{
+		DomainID:     testDomainID,
+		TaskList:     testTaskList,
+		TaskType:     persistence.TaskListTypeActivity,
+		ReadLevel:    readLevel,
+		MaxReadLevel: math.MaxInt64,
+		BatchSize:    taskBatchSize,
+	}).Return(&persistence.GetTasksResponse{Tasks: tasks}, nil).Once()
+}
@@ -35,8 +35,14 @@ import (
 	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/uber/cadence/service/worker/domaindeleter"
+	"github.com/uber/cadence/service/worker/indexer"
 	"github.com/uber/cadence/service/worker/replicator"
+	"github.com/uber/cadence/service/worker/scanner"
+	"github.com/uber/cadence/service/worker/scavenger"
 	"github.com/uber/cadence/service/worker/sysworkflow"
+	"github.com/uber/cadence/service/worker/verifier"
 	"go.uber.org/cadence/.gen/go/shared"
 )
 
@@ -65,6 +71,24 @@ type (
 	Config struct {
 		ReplicationCfg *replicator.Config
 		SysWorkflowCfg *sysworkflow.Config
+		IndexerCfg     *indexer.Config
+		VerifierCfg    *verifier.Config
+		ScannerCfg     *scanner.Config
+		ScavengerCfg   *scavenger.Config
+		EnableBatcher  dynamicconfig.BoolPropertyFn
+		// EnableDomainDeleter is whether the worker runs the workflows which delete deprecated domains
+		EnableDomainDeleter dynamicconfig.BoolPropertyFn
+		// EnableIndexer is whether the visibility messages published to kafka are indexed into the search backend
+		EnableIndexer dynamicconfig.BoolPropertyFn
+		// EnableReplicationVerifier is whether the worker periodically verifies the workflows of the global domains
+		// are consistent across clusters
+		EnableReplicationVerifier dynamicconfig.BoolPropertyFn
+		// EnableExecutionScanner is whether the worker periodically scans the persisted workflows for corrupted
+		// or orphaned records, the reports of the scans are written to the default archival bucket
+		EnableExecutionScanner dynamicconfig.BoolPropertyFn
+		// EnableTaskListScavenger is whether the worker periodically deletes the expired tasks and the idle task
+		// lists without backlog
+		EnableTaskListScavenger dynamicconfig.BoolPropertyFn
 	}
 )
 
@@ -88,6 +112,36 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 			ReplicationTaskMaxRetry:    dc.GetIntProperty(dynamicconfig.WorkerReplicationTaskMaxRetry, 50),
 		},
 		SysWorkflowCfg: &sysworkflow.Config{},
+		IndexerCfg: &indexer.Config{
+			IndexerConcurrency:   dc.GetIntProperty(dynamicconfig.WorkerIndexerConcurrency, 10),
+			IndexerBatchSize:     dc.GetIntProperty(dynamicconfig.WorkerIndexerBatchSize, 100),
+			IndexerFlushInterval: dc.GetDurationProperty(dynamicconfig.WorkerIndexerFlushInterval, time.Second),
+		},
+		VerifierCfg: &verifier.Config{
+			VerifierInterval:   dc.GetDurationProperty(dynamicconfig.WorkerReplicationVerifierInterval, time.Hour),
+			VerifierSampleSize: dc.GetIntProperty(dynamicconfig.WorkerReplicationVerifierSampleSize, 100),
+			VerifierRepair:     dc.GetBoolProperty(dynamicconfig.WorkerReplicationVerifierRepair, false),
+		},
+		ScannerCfg: &scanner.Config{
+			ScanInterval:        dc.GetDurationProperty(dynamicconfig.WorkerExecutionScannerInterval, 24*time.Hour),
+			ScanConcurrency:     dc.GetIntProperty(dynamicconfig.WorkerExecutionScannerConcurrency, 4),
+			FixEnabled:          dc.GetBoolProperty(dynamicconfig.WorkerExecutionScannerFixEnabled, false),
+			HistoryBranchMinAge: dc.GetDurationProperty(dynamicconfig.WorkerExecutionScannerBranchMinAge, 24*time.Hour),
+			DeleteExecutionsWithoutHistory: dc.GetBoolProperty(
+				dynamicconfig.WorkerExecutionScannerDeleteExecutionsWithoutHistory, false),
+		},
+		ScavengerCfg: &scavenger.Config{
+			ScavengeInterval:  dc.GetDurationProperty(dynamicconfig.WorkerTaskListScavengerInterval, 24*time.Hour),
+			TaskMaxAge:        dc.GetDurationProperty(dynamicconfig.WorkerTaskListScavengerTaskMaxAge, 30*24*time.Hour),
+			TaskListIdleTime:  dc.GetDurationProperty(dynamicconfig.WorkerTaskListScavengerIdleTime, 7*24*time.Hour),
+			PersistenceMaxRPS: dc.GetIntProperty(dynamicconfig.WorkerTaskListScavengerMaxRPS, 100),
+		},
+		EnableBatcher:             dc.GetBoolProperty(dynamicconfig.WorkerEnableBatcher, true),
+		EnableDomainDeleter:       dc.GetBoolProperty(dynamicconfig.WorkerEnableDomainDeleter, true),
+		EnableIndexer:             dc.GetBoolProperty(dynamicconfig.EnableVisibilityToKafka, dynamicconfig.DefaultEnableVisibilityToKafka),
+		EnableReplicationVerifier: dc.GetBoolProperty(dynamicconfig.WorkerEnableReplicationVerifier, false),
+		EnableExecutionScanner:    dc.GetBoolProperty(dynamicconfig.WorkerEnableExecutionScanner, false),
+		EnableTaskListScavenger:   dc.GetBoolProperty(dynamicconfig.WorkerEnableTaskListScavenger, false),
 	}
 }
 
@@ -114,11 +168,34 @@ func (s *Service) Start() {
 	s.domainCache.Start()
 
 	if params.ClusterMetadata.IsGlobalDomainEnabled() {
-		s.startReplicator(params, base, log)
+		s.startReplicator(params, base, log, pFactory)
+		if s.config.EnableReplicationVerifier() {
+			s.startReplicationVerifier(params, base, log)
+		}
 	}
 
 	if params.ClusterMetadata.IsArchivalEnabled() {
-		s.startSysWorker(base, log, params.MetricScope)
+		s.startSysWorker(base, log, params.MetricScope, pFactory)
+	}
+
+	if s.config.EnableBatcher() {
+		s.startBatcher(base, log, params.MetricScope)
+	}
+
+	if s.config.EnableDomainDeleter() {
+		s.startDomainDeleter(base, log, params.MetricScope, pFactory)
+	}
+
+	if s.config.EnableExecutionScanner() && params.BlobstoreClient != nil {
+		s.startExecutionScanner(params, base, log, params.MetricScope, pFactory)
+	}
+
+	if s.config.EnableTaskListScavenger() {
+		s.startTaskListScavenger(params, base, log, params.MetricScope, pFactory)
+	}
+
+	if s.config.EnableIndexer() && params.SearchClient != nil {
+		s.startIndexer(params, log)
 	}
 
 	log.Infof("%v started", common.WorkerServiceName)
@@ -135,30 +212,152 @@ func (s *Service) Stop() {
 	s.params.Logger.Infof("%v stopped", common.WorkerServiceName)
 }
 
-func (s *Service) startReplicator(params *service.BootstrapParams, base service.Service, log bark.Logger) {
+func (s *Service) startReplicator(params *service.BootstrapParams, base service.Service, log bark.Logger,
+	pFactory persistencefactory.Factory) {
 
 	replicator := replicator.NewReplicator(params.ClusterMetadata, s.metadataV2Mgr, s.domainCache, base.GetClientBean(),
-		s.config.ReplicationCfg, params.MessagingClient, log, s.metricsClient)
+		s.config.ReplicationCfg, params.MessagingClient, log, s.metricsClient, pFactory, params.PersistenceConfig.NumHistoryShards)
 	if err := replicator.Start(); err != nil {
 		replicator.Stop()
 		log.Fatalf("Fail to start replicator: %v", err)
 	}
 }
 
-func (s *Service) startSysWorker(base service.Service, log bark.Logger, scope tally.Scope) {
+func (s *Service) startReplicationVerifier(params *service.BootstrapParams, base service.Service, log bark.Logger) {
+	currentCluster := params.ClusterMetadata.GetCurrentClusterName()
+	replicationVerifier := verifier.NewVerifier(currentCluster, s.metadataV2Mgr, s.newFrontendClient(base, log),
+		base.GetClientBean().GetRemoteAdminClient(currentCluster), s.config.VerifierCfg, log, s.metricsClient)
+	if err := replicationVerifier.Start(); err != nil {
+		replicationVerifier.Stop()
+		log.Fatalf("failed to start replication verifier: %v", err)
+	}
+}
+
+func (s *Service) startSysWorker(base service.Service, log bark.Logger, scope tally.Scope, pFactory persistencefactory.Factory) {
+	historyManager, err := pFactory.NewHistoryManager()
+	if err != nil {
+		log.Fatalf("failed to create history manager: %v", err)
+	}
+	historyV2Manager, err := pFactory.NewHistoryV2Manager()
+	if err != nil {
+		log.Fatalf("failed to create history v2 manager: %v", err)
+	}
+
+	frontendClient := s.newFrontendClient(base, log)
+	sysWorker := sysworkflow.NewSysWorker(frontendClient, scope, s.params.BlobstoreClient, historyManager, historyV2Manager)
+	if err := sysWorker.Start(); err != nil {
+		sysWorker.Stop()
+		log.Fatalf("failed to start sysworker: %v", err)
+	}
+}
+
+func (s *Service) startBatcher(base service.Service, log bark.Logger, scope tally.Scope) {
+	batchWorker := batcher.New(s.newFrontendClient(base, log), scope)
+	if err := batchWorker.Start(); err != nil {
+		batchWorker.Stop()
+		log.Fatalf("failed to start batcher: %v", err)
+	}
+}
+
+func (s *Service) startDomainDeleter(base service.Service, log bark.Logger, scope tally.Scope, pFactory persistencefactory.Factory) {
+	metadataManager, err := pFactory.NewMetadataManager(persistencefactory.MetadataV1V2)
+	if err != nil {
+		log.Fatalf("failed to create metadata manager: %v", err)
+	}
+	visibilityManager, err := pFactory.NewVisibilityManager(false)
+	if err != nil {
+		log.Fatalf("failed to create visibility manager: %v", err)
+	}
+	taskManager, err := pFactory.NewTaskManager()
+	if err != nil {
+		log.Fatalf("failed to create task manager: %v", err)
+	}
+	historyManager, err := pFactory.NewHistoryManager()
+	if err != nil {
+		log.Fatalf("failed to create history manager: %v", err)
+	}
+	historyV2Manager, err := pFactory.NewHistoryV2Manager()
+	if err != nil {
+		log.Fatalf("failed to create history v2 manager: %v", err)
+	}
+
+	domainDeleter := domaindeleter.New(&domaindeleter.BootstrapParams{
+		FrontendClient:        s.newFrontendClient(base, log),
+		NumberOfHistoryShards: s.params.PersistenceConfig.NumHistoryShards,
+		MetadataMgr:           metadataManager,
+		VisibilityMgr:         visibilityManager,
+		TaskMgr:               taskManager,
+		HistoryMgr:            historyManager,
+		HistoryV2Mgr:          historyV2Manager,
+		ExecutionMgrFactory:   pFactory,
+	}, scope)
+	if err := domainDeleter.Start(); err != nil {
+		domainDeleter.Stop()
+		log.Fatalf("failed to start domain deleter: %v", err)
+	}
+}
+
+func (s *Service) startExecutionScanner(params *service.BootstrapParams, base service.Service, log bark.Logger,
+	scope tally.Scope, pFactory persistencefactory.Factory) {
+
+	historyV2Manager, err := pFactory.NewHistoryV2Manager()
+	if err != nil {
+		log.Fatalf("failed to create history v2 manager: %v", err)
+	}
+
+	executionScanner := scanner.New(&scanner.BootstrapParams{
+		FrontendClient:        s.newFrontendClient(base, log),
+		NumberOfHistoryShards: params.PersistenceConfig.NumHistoryShards,
+		ExecutionMgrFactory:   pFactory,
+		HistoryV2Mgr:          historyV2Manager,
+		BlobstoreClient:       params.BlobstoreClient,
+		Bucket:                params.ClusterMetadata.GetDefaultArchivalBucket(),
+		Config:                s.config.ScannerCfg,
+	}, scope)
+	if err := executionScanner.Start(); err != nil {
+		executionScanner.Stop()
+		log.Fatalf("failed to start execution scanner: %v", err)
+	}
+}
+
+func (s *Service) startTaskListScavenger(params *service.BootstrapParams, base service.Service, log bark.Logger,
+	scope tally.Scope, pFactory persistencefactory.Factory) {
+
+	taskManager, err := pFactory.NewTaskManager()
+	if err != nil {
+		log.Fatalf("failed to create task manager: %v", err)
+	}
+
+	taskListScavenger := scavenger.New(&scavenger.BootstrapParams{
+		FrontendClient:        s.newFrontendClient(base, log),
+		TaskMgr:               taskManager,
+		NumberOfHistoryShards: params.PersistenceConfig.NumHistoryShards,
+		ExecutionMgrFactory:   pFactory,
+		Config:                s.config.ScavengerCfg,
+	}, scope)
+	if err := taskListScavenger.Start(); err != nil {
+		taskListScavenger.Stop()
+		log.Fatalf("failed to start task list scavenger: %v", err)
+	}
+}
 
+func (s *Service) startIndexer(params *service.BootstrapParams, log bark.Logger) {
+	visibilityIndexer := indexer.NewIndexer(params.MessagingClient, params.SearchClient, s.config.IndexerCfg, log, s.metricsClient)
+	if err := visibilityIndexer.Start(); err != nil {
+		visibilityIndexer.Stop()
+		log.Fatalf("failed to start indexer: %v", err)
+	}
+}
+
+// newFrontendClient returns a retryable frontend client once the frontend is reachable
+func (s *Service) newFrontendClient(base service.Service, log bark.Logger) frontend.Client {
 	frontendClient := frontend.NewRetryableClient(
 		base.GetClientBean().GetFrontendClient(),
 		common.CreateFrontendServiceRetryPolicy(),
 		common.IsWhitelistServiceTransientError,
 	)
-
 	s.waitForFrontendStart(frontendClient, log)
-	sysWorker := sysworkflow.NewSysWorker(frontendClient, scope, s.params.BlobstoreClient)
-	if err := sysWorker.Start(); err != nil {
-		sysWorker.Stop()
-		log.Fatalf("failed to start sysworker: %v", err)
-	}
+	return frontendClient
 }
 
 func (s *Service) waitForFrontendStart(frontendClient frontend.Client, log bark.Logger) {
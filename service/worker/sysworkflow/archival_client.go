@@ -38,6 +38,10 @@ type (
 		WorkflowID string
 		RunID      string
 		Bucket     string
+		// EventStoreVersion, BranchToken and NextEventID locate the history of the closed run
+		EventStoreVersion int32
+		BranchToken       []byte
+		NextEventID       int64
 	}
 
 	// BackfillRequest is request to Backfill
@@ -59,6 +59,10 @@ const (
 	WorkflowStartToCloseTimeout = time.Hour * 24 * 30
 	// DecisionTaskStartToCloseTimeout is the time for decision to finish
 	DecisionTaskStartToCloseTimeout = time.Minute
+	// ArchivalActivityStartToCloseTimeout is the time for the archival activity to upload the whole history
+	ArchivalActivityStartToCloseTimeout = time.Hour
+	// ArchivalActivityHeartbeatTimeout is the time for the archival activity to upload a page of the history
+	ArchivalActivityHeartbeatTimeout = time.Minute
 	// DomainIDTag tag which identifies the domainID of an archived history
 	DomainIDTag = "domainID"
 	// WorkflowIDTag tag which identifies the workflowID of an archived history
@@ -82,4 +86,6 @@ type contextKey int
 const (
 	blobstoreClientKey contextKey = iota
 	frontendClientKey
+	historyManagerKey
+	historyV2ManagerKey
 )
@@ -0,0 +1,150 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sysworkflow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/blobstore"
+)
+
+const (
+	// HistoryBlobVersion is the version of the format history blobs are written in
+	HistoryBlobVersion = 1
+)
+
+type (
+	// HistoryBlobHeader describes the page of history stored in a history blob
+	HistoryBlobHeader struct {
+		Version      int
+		DomainName   string
+		DomainID     string
+		WorkflowID   string
+		RunID        string
+		PageNumber   int
+		IsLast       bool
+		FirstEventID int64
+		LastEventID  int64
+		EventCount   int
+		// Checksum is the crc32 checksum of the encoded body
+		Checksum uint32
+	}
+
+	// HistoryBlob is a page of an archived history
+	HistoryBlob struct {
+		Header *HistoryBlobHeader
+		Body   *shared.History
+	}
+
+	// encodedHistoryBlob is the form a history blob is stored in, the body is kept encoded so that
+	// the checksum can be verified against the exact bytes which were written
+	encodedHistoryBlob struct {
+		Header *HistoryBlobHeader
+		Body   json.RawMessage
+	}
+)
+
+// EncodeHistoryBlob encodes the blob and sets the version and checksum of its header
+func EncodeHistoryBlob(blob *HistoryBlob) ([]byte, error) {
+	body, err := json.Marshal(blob.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode history blob body: %v", err)
+	}
+	blob.Header.Version = HistoryBlobVersion
+	blob.Header.Checksum = crc32.ChecksumIEEE(body)
+	return json.Marshal(&encodedHistoryBlob{
+		Header: blob.Header,
+		Body:   body,
+	})
+}
+
+// DecodeHistoryBlob decodes the blob and verifies its version and checksum
+func DecodeHistoryBlob(data []byte) (*HistoryBlob, error) {
+	encoded := &encodedHistoryBlob{}
+	if err := json.Unmarshal(data, encoded); err != nil {
+		return nil, fmt.Errorf("failed to decode history blob: %v", err)
+	}
+	if encoded.Header == nil {
+		return nil, fmt.Errorf("history blob has no header")
+	}
+	if encoded.Header.Version != HistoryBlobVersion {
+		return nil, fmt.Errorf("unsupported history blob version: %v", encoded.Header.Version)
+	}
+	if checksum := crc32.ChecksumIEEE(encoded.Body); checksum != encoded.Header.Checksum {
+		return nil, fmt.Errorf("history blob checksum mismatch, expected %v, actual %v", encoded.Header.Checksum, checksum)
+	}
+	body := &shared.History{}
+	if err := json.Unmarshal(encoded.Body, body); err != nil {
+		return nil, fmt.Errorf("failed to decode history blob body: %v", err)
+	}
+	return &HistoryBlob{
+		Header: encoded.Header,
+		Body:   body,
+	}, nil
+}
+
+// UploadHistoryBlob writes the page of history described by the header of the blob to the bucket
+func UploadHistoryBlob(ctx context.Context, client blobstore.Client, bucket string, blob *HistoryBlob) error {
+	data, err := EncodeHistoryBlob(blob)
+	if err != nil {
+		return err
+	}
+	header := blob.Header
+	filename := HistoryBlobFilename(header.DomainID, header.WorkflowID, header.RunID, header.PageNumber)
+	return client.UploadBlob(ctx, bucket, filename, &blobstore.Blob{
+		Body:            bytes.NewReader(data),
+		CompressionType: blobstore.NoCompression,
+		Tags: map[string]string{
+			DomainIDTag:   header.DomainID,
+			WorkflowIDTag: header.WorkflowID,
+			RunIDTag:      header.RunID,
+		},
+	})
+}
+
+// DownloadHistoryBlob reads a page of an archived history from the bucket,
+// blobstore.ErrBlobNotExists is returned if the page was never archived
+func DownloadHistoryBlob(
+	ctx context.Context,
+	client blobstore.Client,
+	bucket string,
+	domainID string,
+	workflowID string,
+	runID string,
+	pageNumber int,
+) (*HistoryBlob, error) {
+	filename := HistoryBlobFilename(domainID, workflowID, runID, pageNumber)
+	blob, err := client.DownloadBlob(ctx, bucket, filename)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(blob.Body)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeHistoryBlob(data)
+}
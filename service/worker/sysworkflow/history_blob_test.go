@@ -0,0 +1,99 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sysworkflow
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type historyBlobSuite struct {
+	suite.Suite
+}
+
+func TestHistoryBlobSuite(t *testing.T) {
+	suite.Run(t, new(historyBlobSuite))
+}
+
+func (s *historyBlobSuite) TestEncodeDecode() {
+	blob := s.newHistoryBlob()
+	data, err := EncodeHistoryBlob(blob)
+	s.NoError(err)
+
+	decoded, err := DecodeHistoryBlob(data)
+	s.NoError(err)
+	s.Equal(HistoryBlobVersion, decoded.Header.Version)
+	s.Equal(blob.Header, decoded.Header)
+	s.Equal(blob.Body, decoded.Body)
+}
+
+func (s *historyBlobSuite) TestDecode_ChecksumMismatch() {
+	data, err := EncodeHistoryBlob(s.newHistoryBlob())
+	s.NoError(err)
+
+	data = bytes.Replace(data, []byte("test-identity"), []byte("fake-identity"), 1)
+	_, err = DecodeHistoryBlob(data)
+	s.Error(err)
+}
+
+func (s *historyBlobSuite) TestDecode_UnsupportedVersion() {
+	data, err := EncodeHistoryBlob(s.newHistoryBlob())
+	s.NoError(err)
+
+	data = bytes.Replace(data, []byte(`"Version":1`), []byte(`"Version":2`), 1)
+	_, err = DecodeHistoryBlob(data)
+	s.Error(err)
+}
+
+func (s *historyBlobSuite) newHistoryBlob() *HistoryBlob {
+	return &HistoryBlob{
+		Header: &HistoryBlobHeader{
+			DomainName:   "test-domain",
+			DomainID:     "test-domain-id",
+			WorkflowID:   "test-workflow-id",
+			RunID:        "test-run-id",
+			PageNumber:   0,
+			IsLast:       true,
+			FirstEventID: 1,
+			LastEventID:  2,
+			EventCount:   2,
+		},
+		Body: &shared.History{
+			Events: []*shared.HistoryEvent{
+				{
+					EventId:   common.Int64Ptr(1),
+					EventType: shared.EventTypeWorkflowExecutionStarted.Ptr(),
+					WorkflowExecutionStartedEventAttributes: &shared.WorkflowExecutionStartedEventAttributes{
+						Identity: common.StringPtr("test-identity"),
+					},
+				},
+				{
+					EventId:   common.Int64Ptr(2),
+					EventType: shared.EventTypeDecisionTaskScheduled.Ptr(),
+				},
+			},
+		},
+	}
+}
@@ -21,21 +21,23 @@
 package sysworkflow
 
 import (
-	"bytes"
 	"context"
 	"errors"
-	"fmt"
 	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/persistence"
 	"go.uber.org/cadence"
 	"go.uber.org/cadence/activity"
 	"go.uber.org/cadence/workflow"
 	"go.uber.org/zap"
-	"io/ioutil"
 	"time"
 )
 
+var errHistoryNotComplete = errors.New("history of the closed run is not complete yet")
+
 // SystemWorkflow is the system workflow code
 func SystemWorkflow(ctx workflow.Context) error {
 	id := workflow.GetInfo(ctx).WorkflowExecution.ID
@@ -92,8 +94,11 @@ func selectSystemTask(scope tally.Scope, signal signal, ctx workflow.Context, lo
 	actCtx := workflow.WithActivityOptions(ctx, ao)
 	switch signal.RequestType {
 	case archivalRequest:
+		// uploading a long history takes a while, the heartbeat after each page detects a stuck activity
+		archivalCtx := workflow.WithStartToCloseTimeout(actCtx, ArchivalActivityStartToCloseTimeout)
+		archivalCtx = workflow.WithHeartbeatTimeout(archivalCtx, ArchivalActivityHeartbeatTimeout)
 		if err := workflow.ExecuteActivity(
-			actCtx,
+			archivalCtx,
 			ArchivalActivityFnName,
 			*signal.ArchiveRequest,
 		).Get(ctx, nil); err != nil {
@@ -126,42 +131,104 @@ func ArchivalActivity(ctx context.Context, request ArchiveRequest) error {
 	logger.Info("called archival activity")
 
 	blobstoreClient := ctx.Value(blobstoreClientKey).(blobstore.Client)
+	historyManager := ctx.Value(historyManagerKey).(persistence.HistoryManager)
+	historyV2Manager := ctx.Value(historyV2ManagerKey).(persistence.HistoryV2Manager)
+
+	var nextPageToken []byte
+	for pageNumber := 0; ; pageNumber++ {
+		history, token, err := readHistoryPage(request, historyManager, historyV2Manager, nextPageToken)
+		if err != nil {
+			logger.Error("archival failed, could not read history", zap.Int("page-number", pageNumber), zap.Error(err))
+			return err
+		}
+		nextPageToken = token
+
+		header := &HistoryBlobHeader{
+			DomainName: request.DomainName,
+			DomainID:   request.DomainID,
+			WorkflowID: request.WorkflowID,
+			RunID:      request.RunID,
+			PageNumber: pageNumber,
+			IsLast:     len(nextPageToken) == 0,
+			EventCount: len(history.Events),
+		}
+		if len(history.Events) > 0 {
+			header.FirstEventID = history.Events[0].GetEventId()
+			header.LastEventID = history.Events[len(history.Events)-1].GetEventId()
+		}
+		// archival is started before the close of the run is persisted, so the history
+		// is only archived once it has all events up to the close event
+		if header.IsLast && header.LastEventID != request.NextEventID-1 {
+			logger.Error("archival failed, history is not complete yet",
+				zap.Int64("last-event-id", header.LastEventID), zap.Int64("next-event-id", request.NextEventID))
+			return errHistoryNotComplete
+		}
 
-	// TODO: the rest of this method is temporary (follow diff will do history archives and delete from cassandra)
-	body := fmt.Sprintf("DomainID: %v\n WorkflowID: %v\n RunID: %v", request.DomainID, request.WorkflowID, request.RunID)
-	blobFilename := HistoryBlobFilename(request.DomainID, request.WorkflowID, request.RunID)
-	blob := blobstore.Blob{
-		Body:            bytes.NewReader([]byte(body)),
-		CompressionType: blobstore.NoCompression,
-		Tags: map[string]string{
-			DomainIDTag:   request.DomainID,
-			WorkflowIDTag: request.WorkflowID,
-			RunIDTag:      request.RunID,
-		},
-	}
-	if err := blobstoreClient.UploadBlob(ctx, request.Bucket, blobFilename, &blob); err != nil {
-		logger.Error("archival failed, could not upload blob", zap.String("blobname", blobFilename), zap.Error(err))
-		return err
-	}
+		blob := &HistoryBlob{
+			Header: header,
+			Body:   history,
+		}
+		if err := UploadHistoryBlob(ctx, blobstoreClient, request.Bucket, blob); err != nil {
+			logger.Error("archival failed, could not upload blob", zap.Int("page-number", pageNumber), zap.Error(err))
+			return err
+		}
+		activity.RecordHeartbeat(ctx, pageNumber)
 
-	// TODO: only downloading blob to show that blobstore works, final impl will not download blobs after upload
-	downloadedBlob, err := blobstoreClient.DownloadBlob(ctx, request.Bucket, blobFilename)
-	if err != nil {
-		logger.Error("archival failed, could not download blob", zap.String("blobname", blobFilename), zap.Error(err))
-		return err
+		if header.IsLast {
+			logger.Info("uploaded history", zap.Int("page-count", pageNumber+1))
+			break
+		}
 	}
 
-	bytes, err := ioutil.ReadAll(downloadedBlob.Body)
-	if err != nil {
-		logger.Error("archival failed, could not read body of downloaded blob", zap.String("blobname", blobFilename), zap.Error(err))
-		return err
+	// the history stays in the primary store until the retention timer of the run deletes it
+	logger.Info("archival successful")
+	return nil
+}
+
+// readHistoryPage reads the next page of the history of the closed run from the primary store
+func readHistoryPage(
+	request ArchiveRequest,
+	historyManager persistence.HistoryManager,
+	historyV2Manager persistence.HistoryV2Manager,
+	nextPageToken []byte,
+) (*shared.History, []byte, error) {
+
+	var batches []*shared.History
+	if request.EventStoreVersion == persistence.EventStoreVersionV2 {
+		response, err := historyV2Manager.ReadHistoryBranchByBatch(&persistence.ReadHistoryBranchRequest{
+			BranchToken:   request.BranchToken,
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    request.NextEventID,
+			PageSize:      historyPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		batches, nextPageToken = response.History, response.NextPageToken
+	} else {
+		response, err := historyManager.GetWorkflowExecutionHistoryByBatch(&persistence.GetWorkflowExecutionHistoryRequest{
+			DomainID: request.DomainID,
+			Execution: shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(request.WorkflowID),
+				RunId:      common.StringPtr(request.RunID),
+			},
+			FirstEventID:  common.FirstEventID,
+			NextEventID:   request.NextEventID,
+			PageSize:      historyPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		batches, nextPageToken = response.History, response.NextPageToken
 	}
-	if len(downloadedBlob.Tags) != len(blob.Tags) {
-		logger.Error("archival failed, did not read correct blob back", zap.String("blobname", blobFilename))
-		return errors.New("downloaded blob is not valid")
+
+	history := &shared.History{}
+	for _, batch := range batches {
+		history.Events = append(history.Events, batch.Events...)
 	}
-	logger.Info("archival successful", zap.String("blobname", blobFilename), zap.Int("body-size", len(bytes)))
-	return nil
+	return history, nextPageToken, nil
 }
 
 // BackfillActivity is the backfill activity code
@@ -25,6 +25,7 @@ import (
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/persistence"
 	"go.uber.org/cadence/activity"
 	"go.uber.org/cadence/worker"
 	"go.uber.org/cadence/workflow"
@@ -47,10 +48,18 @@ func init() {
 }
 
 // NewSysWorker returns a new SysWorker
-func NewSysWorker(frontendClient frontend.Client, scope tally.Scope, blobstoreClient blobstore.Client) *SysWorker {
+func NewSysWorker(
+	frontendClient frontend.Client,
+	scope tally.Scope,
+	blobstoreClient blobstore.Client,
+	historyManager persistence.HistoryManager,
+	historyV2Manager persistence.HistoryV2Manager,
+) *SysWorker {
 	logger, _ := zap.NewProduction()
 	actCtx := context.WithValue(context.Background(), blobstoreClientKey, blobstoreClient)
 	actCtx = context.WithValue(actCtx, frontendClientKey, frontendClient)
+	actCtx = context.WithValue(actCtx, historyManagerKey, historyManager)
+	actCtx = context.WithValue(actCtx, historyV2ManagerKey, historyV2Manager)
 	wo := worker.Options{
 		Logger:                    logger,
 		MetricsScope:              scope.SubScope(SystemWorkflowScope),
@@ -28,11 +28,13 @@ import (
 
 const (
 	historyBlobFilenameExt = ".history"
+	// historyPageSize is the number of batches of events archived in a single history blob
+	historyPageSize = 250
 )
 
-// HistoryBlobFilename constructs name of history file from domainID, workflowID and runID
-func HistoryBlobFilename(domainID string, workflowID string, runID string) string {
+// HistoryBlobFilename constructs name of history file from domainID, workflowID, runID and the page of the history
+func HistoryBlobFilename(domainID string, workflowID string, runID string, pageNumber int) string {
 	hashInput := strings.Join([]string{domainID, workflowID, runID}, "")
 	hash := farm.Fingerprint64([]byte(hashInput))
-	return fmt.Sprintf("%v%v", hash, historyBlobFilenameExt)
+	return fmt.Sprintf("%v_%v%v", hash, pageNumber, historyBlobFilenameExt)
 }
@@ -0,0 +1,239 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package verifier
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/admin"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	adminClient "github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/cadence/.gen/go/shared"
+)
+
+type (
+	// Verifier periodically samples the open workflows of the global domains replicated to the current cluster
+	// and verifies through the admin API that their copies in all the clusters of the domain are consistent
+	Verifier struct {
+		currentCluster string
+		metadataMgr    persistence.MetadataManager
+		frontendClient frontend.Client
+		adminClient    adminClient.Client
+		config         *Config
+		logger         bark.Logger
+		metricsClient  metrics.Client
+		isStarted      int32
+		isStopped      int32
+		shutdownWG     sync.WaitGroup
+		shutdownCh     chan struct{}
+	}
+
+	// Config contains all the replication verifier config for worker
+	Config struct {
+		VerifierInterval   dynamicconfig.DurationPropertyFn
+		VerifierSampleSize dynamicconfig.IntPropertyFn
+		VerifierRepair     dynamicconfig.BoolPropertyFn
+	}
+)
+
+const (
+	listDomainsPageSize = 100
+	verifyTimeout       = 2 * time.Minute
+)
+
+// NewVerifier creates a new replication verifier, the admin client has to point at the frontend of the current cluster
+func NewVerifier(currentCluster string, metadataMgr persistence.MetadataManager, frontendClient frontend.Client,
+	adminClient adminClient.Client, config *Config, logger bark.Logger, metricsClient metrics.Client) *Verifier {
+	return &Verifier{
+		currentCluster: currentCluster,
+		metadataMgr:    metadataMgr,
+		frontendClient: frontendClient,
+		adminClient:    adminClient,
+		config:         config,
+		logger: logger.WithFields(bark.Fields{
+			logging.TagWorkflowComponent: logging.TagValueReplicationVerifierComponent,
+		}),
+		metricsClient: metricsClient,
+		shutdownCh:    make(chan struct{}),
+	}
+}
+
+// Start is called to start the verifier
+func (v *Verifier) Start() error {
+	if !atomic.CompareAndSwapInt32(&v.isStarted, 0, 1) {
+		return nil
+	}
+
+	v.shutdownWG.Add(1)
+	go v.verifyLoop()
+
+	v.logger.Info("Replication verifier started.")
+	return nil
+}
+
+// Stop is called to stop the verifier, the run in progress is abandoned
+func (v *Verifier) Stop() {
+	if !atomic.CompareAndSwapInt32(&v.isStopped, 0, 1) {
+		return
+	}
+
+	if atomic.LoadInt32(&v.isStarted) == 1 {
+		close(v.shutdownCh)
+	}
+
+	if success := common.AwaitWaitGroup(&v.shutdownWG, time.Minute); !success {
+		v.logger.Warn("Replication verifier timed out on shutdown.")
+	}
+	v.logger.Info("Replication verifier stopped.")
+}
+
+func (v *Verifier) verifyLoop() {
+	defer v.shutdownWG.Done()
+
+	timer := time.NewTimer(v.config.VerifierInterval())
+	defer timer.Stop()
+	for {
+		select {
+		case <-v.shutdownCh:
+			return
+		case <-timer.C:
+			v.verifyDomains()
+			timer.Reset(v.config.VerifierInterval())
+		}
+	}
+}
+
+// verifyDomains verifies a sample of the open workflows of every global domain replicated to the current cluster
+func (v *Verifier) verifyDomains() {
+	var pageToken []byte
+	for {
+		resp, err := v.metadataMgr.ListDomains(&persistence.ListDomainsRequest{
+			PageSize:      listDomainsPageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			v.metricsClient.IncCounter(metrics.ReplicationVerifierScope, metrics.VerifierFailures)
+			v.logger.WithField(logging.TagErr, err).Error("Failed to list domains.")
+			return
+		}
+		for _, domain := range resp.Domains {
+			if v.isShuttingDown() {
+				return
+			}
+			if v.shouldVerify(domain) {
+				v.verifyDomain(domain.Info)
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func (v *Verifier) shouldVerify(domain *persistence.GetDomainResponse) bool {
+	if !domain.IsGlobalDomain || domain.Info.Status != persistence.DomainStatusRegistered {
+		return false
+	}
+	for _, cluster := range domain.ReplicationConfig.Clusters {
+		if cluster.ClusterName == v.currentCluster {
+			return len(domain.ReplicationConfig.Clusters) > 1
+		}
+	}
+	return false
+}
+
+// verifyDomain verifies the most recently started open workflows of a domain
+func (v *Verifier) verifyDomain(info *persistence.DomainInfo) {
+	scope := v.metricsClient.Tagged(map[string]string{metrics.DomainTagName: info.Name})
+	logger := v.logger.WithField(logging.TagDomainID, info.ID)
+
+	ctx, cancel := context.WithTimeout(context.Background(), verifyTimeout)
+	resp, err := v.frontendClient.ListOpenWorkflowExecutions(ctx, &shared.ListOpenWorkflowExecutionsRequest{
+		Domain:          common.StringPtr(info.Name),
+		MaximumPageSize: common.Int32Ptr(int32(v.config.VerifierSampleSize())),
+		StartTimeFilter: &shared.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(0),
+			LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+		},
+	})
+	cancel()
+	if err != nil {
+		scope.IncCounter(metrics.ReplicationVerifierScope, metrics.VerifierFailures)
+		logger.WithField(logging.TagErr, err).Warn("Failed to list open workflows.")
+		return
+	}
+
+	for _, execution := range resp.Executions {
+		if v.isShuttingDown() {
+			return
+		}
+		v.verifyWorkflow(info.Name, &gen.WorkflowExecution{
+			WorkflowId: execution.Execution.WorkflowId,
+			RunId:      execution.Execution.RunId,
+		}, scope, logger)
+	}
+}
+
+func (v *Verifier) verifyWorkflow(domain string, execution *gen.WorkflowExecution, scope metrics.Client,
+	logger bark.Logger) {
+
+	logger = logger.WithFields(bark.Fields{
+		logging.TagWorkflowExecutionID: execution.GetWorkflowId(),
+		logging.TagWorkflowRunID:       execution.GetRunId(),
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), verifyTimeout)
+	resp, err := v.adminClient.VerifyWorkflowExecution(ctx, &admin.VerifyWorkflowExecutionRequest{
+		Domain:    common.StringPtr(domain),
+		Execution: execution,
+		Repair:    common.BoolPtr(v.config.VerifierRepair()),
+	})
+	cancel()
+	if err != nil {
+		scope.IncCounter(metrics.ReplicationVerifierScope, metrics.VerifierFailures)
+		logger.WithField(logging.TagErr, err).Warn("Failed to verify workflow.")
+		return
+	}
+
+	scope.IncCounter(metrics.ReplicationVerifierScope, metrics.VerifierWorkflowsVerified)
+	if len(resp.Divergences) > 0 {
+		scope.IncCounter(metrics.ReplicationVerifierScope, metrics.VerifierWorkflowsDiverged)
+		logger.WithField("divergences", resp.Divergences).Warn("Workflow diverged across clusters.")
+	}
+	if resp.GetRepaired() {
+		scope.IncCounter(metrics.ReplicationVerifierScope, metrics.VerifierWorkflowsRepaired)
+		logger.Info("Workflow repaired from the active cluster.")
+	}
+}
+
+func (v *Verifier) isShuttingDown() bool {
+	return atomic.LoadInt32(&v.isStopped) == 1
+}
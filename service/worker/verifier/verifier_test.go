@@ -0,0 +1,135 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package verifier
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/cadence/.gen/go/shared"
+)
+
+type verifierSuite struct {
+	suite.Suite
+	*require.Assertions
+
+	metadataMgr    *mocks.MetadataManager
+	frontendClient *mocks.FrontendClient
+	adminClient    *mocks.AdminClient
+	verifier       *Verifier
+}
+
+func TestVerifierSuite(t *testing.T) {
+	s := new(verifierSuite)
+	suite.Run(t, s)
+}
+
+func (s *verifierSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.metadataMgr = &mocks.MetadataManager{}
+	s.frontendClient = &mocks.FrontendClient{}
+	s.adminClient = &mocks.AdminClient{}
+	config := &Config{
+		VerifierInterval:   dynamicconfig.GetDurationPropertyFn(time.Hour),
+		VerifierSampleSize: dynamicconfig.GetIntPropertyFn(10),
+		VerifierRepair:     dynamicconfig.GetBoolPropertyFn(true),
+	}
+	s.verifier = NewVerifier("active", s.metadataMgr, s.frontendClient, s.adminClient, config,
+		bark.NewNopLogger(), metrics.NewClient(tally.NoopScope, metrics.Worker))
+}
+
+func (s *verifierSuite) TearDownTest() {
+	s.metadataMgr.AssertExpectations(s.T())
+	s.frontendClient.AssertExpectations(s.T())
+	s.adminClient.AssertExpectations(s.T())
+}
+
+func (s *verifierSuite) newDomain(name string, isGlobal bool, clusters ...string) *persistence.GetDomainResponse {
+	replicationConfig := &persistence.DomainReplicationConfig{ActiveClusterName: "active"}
+	for _, cluster := range clusters {
+		replicationConfig.Clusters = append(replicationConfig.Clusters, &persistence.ClusterReplicationConfig{ClusterName: cluster})
+	}
+	return &persistence.GetDomainResponse{
+		Info:              &persistence.DomainInfo{ID: name + "-id", Name: name, Status: persistence.DomainStatusRegistered},
+		ReplicationConfig: replicationConfig,
+		IsGlobalDomain:    isGlobal,
+	}
+}
+
+func (s *verifierSuite) TestVerifyDomains() {
+	s.metadataMgr.On("ListDomains", &persistence.ListDomainsRequest{PageSize: listDomainsPageSize}).Return(&persistence.ListDomainsResponse{
+		Domains: []*persistence.GetDomainResponse{
+			s.newDomain("local", false, "active"),
+			s.newDomain("single-cluster", true, "active"),
+			s.newDomain("other-clusters", true, "standby", "other"),
+			s.newDomain("replicated", true, "active", "standby"),
+		},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+	s.metadataMgr.On("ListDomains", &persistence.ListDomainsRequest{
+		PageSize:      listDomainsPageSize,
+		NextPageToken: []byte("token"),
+	}).Return(&persistence.ListDomainsResponse{}, nil).Once()
+
+	s.frontendClient.On("ListOpenWorkflowExecutions", mock.Anything, mock.MatchedBy(func(request *shared.ListOpenWorkflowExecutionsRequest) bool {
+		return request.GetDomain() == "replicated" && request.GetMaximumPageSize() == 10
+	})).Return(&shared.ListOpenWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{
+			{Execution: &shared.WorkflowExecution{WorkflowId: common.StringPtr("wid-1"), RunId: common.StringPtr("rid-1")}},
+			{Execution: &shared.WorkflowExecution{WorkflowId: common.StringPtr("wid-2"), RunId: common.StringPtr("rid-2")}},
+		},
+	}, nil).Once()
+
+	s.adminClient.On("VerifyWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *admin.VerifyWorkflowExecutionRequest) bool {
+		return request.GetDomain() == "replicated" && request.Execution.GetWorkflowId() == "wid-1" && request.GetRepair()
+	})).Return(&admin.VerifyWorkflowExecutionResponse{
+		Divergences: []string{"cluster standby: next event ID 8, active cluster has 10"},
+		Repaired:    common.BoolPtr(true),
+	}, nil).Once()
+	s.adminClient.On("VerifyWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *admin.VerifyWorkflowExecutionRequest) bool {
+		return request.Execution.GetWorkflowId() == "wid-2" && request.Execution.GetRunId() == "rid-2"
+	})).Return(nil, errors.New("some random error")).Once()
+
+	s.verifier.verifyDomains()
+}
+
+func (s *verifierSuite) TestVerifyDomains_ListDomainsFailed() {
+	s.metadataMgr.On("ListDomains", mock.Anything).Return(nil, errors.New("some random error")).Once()
+	s.verifier.verifyDomains()
+}
+
+func (s *verifierSuite) TestStartStop() {
+	s.NoError(s.verifier.Start())
+	s.verifier.Stop()
+	s.True(s.verifier.isShuttingDown())
+}
@@ -20,20 +20,19 @@
 
 package cli
 
-import "github.com/urfave/cli"
+import (
+	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/urfave/cli"
+)
 
 func newAdminWorkflowCommands() []cli.Command {
 	return []cli.Command{
 		{
 			Name:    "show",
 			Aliases: []string{"show"},
-			Usage:   "show workflow history from database",
+			Usage:   "show raw workflow history batches",
 			Flags: []cli.Flag{
 				// v1 history events
-				cli.StringFlag{
-					Name:  FlagDomainID,
-					Usage: "DomainID",
-				},
 				cli.StringFlag{
 					Name:  FlagWorkflowIDWithAlias,
 					Usage: "WorkflowID",
@@ -51,28 +50,6 @@ func newAdminWorkflowCommands() []cli.Command {
 					Name:  FlagBranchID,
 					Usage: "BranchID",
 				},
-
-				// for cassandra connection
-				cli.StringFlag{
-					Name:  FlagAddress,
-					Usage: "cassandra host address",
-				},
-				cli.IntFlag{
-					Name:  FlagPort,
-					Usage: "cassandra port for the host (default is 9042)",
-				},
-				cli.StringFlag{
-					Name:  FlagUsername,
-					Usage: "cassandra username",
-				},
-				cli.StringFlag{
-					Name:  FlagPassword,
-					Usage: "cassandra password",
-				},
-				cli.StringFlag{
-					Name:  FlagKeyspace,
-					Usage: "cassandra keyspace",
-				},
 			},
 			Action: func(c *cli.Context) {
 				AdminShowWorkflow(c)
@@ -109,36 +86,6 @@ func newAdminWorkflowCommands() []cli.Command {
 					Name:  FlagRunIDWithAlias,
 					Usage: "RunID",
 				},
-				cli.StringFlag{
-					Name:  FlagDomainID,
-					Usage: "DomainID",
-				},
-				cli.IntFlag{
-					Name:  FlagShardID,
-					Usage: "ShardID",
-				},
-
-				// for cassandra connection
-				cli.StringFlag{
-					Name:  FlagAddress,
-					Usage: "cassandra host address",
-				},
-				cli.IntFlag{
-					Name:  FlagPort,
-					Usage: "cassandra port for the host (default is 9042)",
-				},
-				cli.StringFlag{
-					Name:  FlagUsername,
-					Usage: "cassandra username",
-				},
-				cli.StringFlag{
-					Name:  FlagPassword,
-					Usage: "cassandra password",
-				},
-				cli.StringFlag{
-					Name:  FlagKeyspace,
-					Usage: "cassandra keyspace",
-				},
 			},
 			Action: func(c *cli.Context) {
 				AdminDeleteWorkflow(c)
@@ -211,28 +158,6 @@ func newAdminDomainCommands() []cli.Command {
 					Name:  FlagDomainID,
 					Usage: "Domain ID(uuid)",
 				},
-
-				// for cassandra connection
-				cli.StringFlag{
-					Name:  FlagAddress,
-					Usage: "cassandra host address",
-				},
-				cli.IntFlag{
-					Name:  FlagPort,
-					Usage: "cassandra port for the host (default is 9042)",
-				},
-				cli.StringFlag{
-					Name:  FlagUsername,
-					Usage: "cassandra username",
-				},
-				cli.StringFlag{
-					Name:  FlagPassword,
-					Usage: "cassandra password",
-				},
-				cli.StringFlag{
-					Name:  FlagKeyspace,
-					Usage: "cassandra keyspace",
-				},
 			},
 			Action: func(c *cli.Context) {
 				AdminGetDomainIDOrName(c)
@@ -349,6 +274,14 @@ func newAdminDomainCommands() []cli.Command {
 				AdminDescribeDomain(c)
 			},
 		},
+		{
+			Name:    "delete",
+			Aliases: []string{"del"},
+			Usage:   "Delete a deprecated domain together with all its workflow executions and task lists",
+			Action: func(c *cli.Context) {
+				AdminDeleteDomain(c)
+			},
+		},
 	}
 }
 
@@ -556,3 +489,188 @@ clusters:
 		},
 	}
 }
+
+func newAdminBatchCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "start",
+			Aliases: []string{"s"},
+			Usage:   "Start a batch operation on the open workflows of the domain which match all the given filters",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagBatchTypeWithAlias,
+					Usage: "Operation to run on the workflows, valid values are: {terminate, cancel, signal}",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for the batch operation",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowTypeWithAlias,
+					Usage: "Only operate on workflows of this type",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowIDPrefixWithAlias,
+					Usage: "Only operate on workflows whose workflow id starts with this prefix",
+				},
+				cli.StringFlag{
+					Name:  FlagEarliestTimeWithAlias,
+					Usage: "Only operate on workflows started after this time, supported formats are '2006-01-02T15:04:05Z07:00' and raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagLatestTimeWithAlias,
+					Usage: "Only operate on workflows started before this time, supported formats are '2006-01-02T15:04:05Z07:00' and raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagNameWithAlias,
+					Usage: "SignalName, required for signal operations",
+				},
+				cli.StringFlag{
+					Name:  FlagInputWithAlias,
+					Usage: "Input for the signal, in JSON format.",
+				},
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "Input for the signal from JSON file.",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Value: batcher.DefaultRPS,
+					Usage: "Max number of workflows operated on per second",
+				},
+				cli.IntFlag{
+					Name:  FlagConcurrencyWithAlias,
+					Value: batcher.DefaultConcurrency,
+					Usage: "Max number of workflows operated on at the same time",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminStartBatch(c)
+			},
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe the status and progress of a batch operation",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagJobIDWithAlias,
+					Usage: "Batch job id",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeBatch(c)
+			},
+		},
+		{
+			Name:    "cancel",
+			Aliases: []string{"c"},
+			Usage:   "Cancel a batch operation, the workflows already operated on are not affected",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagJobIDWithAlias,
+					Usage: "Batch job id",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminCancelBatch(c)
+			},
+		},
+	}
+}
+
+func newAdminDLQCommands() []cli.Command {
+	dlqFlags := func(extra ...cli.Flag) []cli.Flag {
+		return append([]cli.Flag{
+			cli.StringFlag{
+				Name:  FlagSourceClusterWithAlias,
+				Usage: "Cluster the replication tasks were received from",
+			},
+			cli.IntFlag{
+				Name:  FlagShardIDWithAlias,
+				Usage: "ShardId of the dead letter queue, or the first shard of the range when upper_shard_id is set",
+			},
+			cli.IntFlag{
+				Name:  FlagUpperShardIDWithAlias,
+				Usage: "Last ShardId of the range of dead letter queues, inclusive",
+			},
+			cli.StringFlag{
+				Name:  FlagWorkflowIDWithAlias,
+				Usage: "Only operate on the replication tasks of this workflow, the global domain option filters by domain",
+			},
+		}, extra...)
+	}
+
+	return []cli.Command{
+		{
+			Name:    "read",
+			Aliases: []string{"r"},
+			Usage:   "Print the replication tasks in the dead letter queues",
+			Flags: dlqFlags(
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: defaultDLQPageSize,
+					Usage: "Number of replication tasks read from the dead letter queue per request",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminReadDLQMessages(c)
+			},
+		},
+		{
+			Name:    "merge",
+			Aliases: []string{"m"},
+			Usage:   "Apply the replication tasks in the dead letter queues and remove the applied ones",
+			Flags: dlqFlags(
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: defaultDLQPageSize,
+					Usage: "Number of replication tasks applied per request",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminMergeDLQMessages(c)
+			},
+		},
+		{
+			Name:    "purge",
+			Aliases: []string{"p"},
+			Usage:   "Remove the replication tasks in the dead letter queues without applying them",
+			Flags:   dlqFlags(),
+			Action: func(c *cli.Context) {
+				AdminPurgeDLQMessages(c)
+			},
+		},
+	}
+}
+
+func newAdminClusterCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "verify",
+			Aliases: []string{"v"},
+			Usage:   "Compare the workflows of a global domain across its clusters and report where they diverge",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID, the open workflows of the domain are verified if not set",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, the current run is verified if not set",
+				},
+				cli.IntFlag{
+					Name:  FlagSampleSizeWithAlias,
+					Usage: "Only verify this many of the most recently started open workflows, all of them are verified if not set",
+				},
+				cli.BoolFlag{
+					Name:  FlagRepair,
+					Usage: "Re-replicate the history missing in the current cluster from the active cluster",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminVerifyCluster(c)
+			},
+		},
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pborman/uuid"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/urfave/cli"
+)
+
+// batchJobStatus is the output of describing a batch job
+type batchJobStatus struct {
+	JobID    string
+	Status   string
+	Params   *batcher.BatchParams      `json:",omitempty"`
+	Progress *batcher.HeartBeatDetails `json:",omitempty"`
+}
+
+// AdminStartBatch starts a batch operation on the workflows of the domain
+func AdminStartBatch(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	batchType := getRequiredOption(c, FlagBatchType)
+	reason := getRequiredOption(c, FlagReason)
+
+	request := &admin.StartBatchRequest{
+		Domain:            common.StringPtr(domain),
+		BatchType:         common.StringPtr(batchType),
+		Reason:            common.StringPtr(reason),
+		WorkflowType:      common.StringPtr(c.String(FlagWorkflowType)),
+		WorkflowIdPrefix:  common.StringPtr(c.String(FlagWorkflowIDPrefix)),
+		StartTimeEarliest: common.Int64Ptr(parseTime(c.String(FlagEarliestTime), 0)),
+		StartTimeLatest:   common.Int64Ptr(parseTime(c.String(FlagLatestTime), 0)),
+		Rps:               common.Int32Ptr(int32(c.Int(FlagRPS))),
+		Concurrency:       common.Int32Ptr(int32(c.Int(FlagConcurrency))),
+	}
+	switch batchType {
+	case batcher.BatchTypeTerminate, batcher.BatchTypeCancel:
+	case batcher.BatchTypeSignal:
+		request.SignalName = common.StringPtr(getRequiredOption(c, FlagName))
+		request.SignalInput = common.StringPtr(processJSONInput(c))
+	default:
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid, valid values are: {terminate, cancel, signal}.", FlagBatchType), nil)
+	}
+
+	// the batch is started by the admin API, which authorizes the caller against the domain of the workflows
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := adminClient.StartBatch(ctx, request)
+	if err != nil {
+		ErrorAndExit("Failed to start batch job.", err)
+	} else {
+		fmt.Printf("Started batch job, job id: %v, run id: %v\n", resp.GetJobId(), resp.GetRunId())
+	}
+}
+
+// AdminDescribeBatch prints the status and progress of a batch job
+func AdminDescribeBatch(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	jobID := getRequiredOption(c, FlagJobID)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := frontendClient.DescribeWorkflowExecution(ctx, &shared.DescribeWorkflowExecutionRequest{
+		Domain: common.StringPtr(batcher.Domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(jobID),
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Describe batch job failed.", err)
+	}
+
+	output := &batchJobStatus{
+		JobID:  jobID,
+		Status: "Running",
+	}
+	info := resp.WorkflowExecutionInfo
+	switch {
+	case info.CloseStatus == nil:
+		// the progress of a running job is recorded in the heartbeats of its activity
+		for _, activity := range resp.PendingActivities {
+			progress := &batcher.HeartBeatDetails{}
+			if err := json.Unmarshal(activity.HeartbeatDetails, progress); err == nil {
+				output.Progress = progress
+			}
+		}
+	case info.GetCloseStatus() == shared.WorkflowExecutionCloseStatusCompleted:
+		output.Status = info.GetCloseStatus().String()
+		output.Progress = getBatchResult(c, jobID, info.Execution.GetRunId())
+	default:
+		output.Status = info.GetCloseStatus().String()
+	}
+	prettyPrintJSONObject(output)
+}
+
+// getBatchResult returns the progress a completed batch job returned as its result
+func getBatchResult(c *cli.Context, jobID, runID string) *batcher.HeartBeatDetails {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, &shared.GetWorkflowExecutionHistoryRequest{
+		Domain: common.StringPtr(batcher.Domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(jobID),
+			RunId:      common.StringPtr(runID),
+		},
+		HistoryEventFilterType: shared.HistoryEventFilterTypeCloseEvent.Ptr(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to get the result of batch job.", err)
+	}
+	for _, event := range resp.History.Events {
+		if attributes := event.WorkflowExecutionCompletedEventAttributes; attributes != nil {
+			result := &batcher.HeartBeatDetails{}
+			if err := json.Unmarshal(attributes.Result, result); err != nil {
+				ErrorAndExit("Failed to decode the result of batch job.", err)
+			}
+			return result
+		}
+	}
+	return nil
+}
+
+// AdminCancelBatch cancels a batch job
+func AdminCancelBatch(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	jobID := getRequiredOption(c, FlagJobID)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	err := frontendClient.RequestCancelWorkflowExecution(ctx, &shared.RequestCancelWorkflowExecutionRequest{
+		Domain: common.StringPtr(batcher.Domain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(jobID),
+		},
+		Identity:  common.StringPtr(getCliIdentity()),
+		RequestId: common.StringPtr(uuid.New()),
+	})
+	if err != nil {
+		ErrorAndExit("Cancel batch job failed.", err)
+	} else {
+		fmt.Println("Cancel batch job succeeded.")
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/admin"
+	serverAdmin "github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+// AdminVerifyCluster compares a workflow, or the open workflows of a global domain, across the clusters of the domain
+func AdminVerifyCluster(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	repair := c.Bool(FlagRepair)
+
+	if c.IsSet(FlagWorkflowID) {
+		resp := verifyWorkflowExecution(adminClient, domain, &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(c.String(FlagWorkflowID)),
+			RunId:      common.StringPtr(c.String(FlagRunID)),
+		}, repair)
+		prettyPrintJSONObject(resp)
+		return
+	}
+
+	frontendClient := cFactory.ServerFrontendClient(c)
+	sampleSize := c.Int(FlagSampleSize)
+	pageSize := defaultPageSizeForList
+	if sampleSize > 0 && sampleSize < pageSize {
+		pageSize = sampleSize
+	}
+	latestTime := time.Now().UnixNano()
+
+	verified, diverged, repaired := 0, 0, 0
+	var pageToken []byte
+	for {
+		executions, nextPageToken := listOpenWorkflow(frontendClient, domain, pageSize, 0, latestTime, "", "",
+			pageToken, defaultContextTimeoutForLongPoll)
+		for _, execution := range executions {
+			resp := verifyWorkflowExecution(adminClient, domain, execution.Execution, repair)
+			verified++
+			if len(resp.Divergences) > 0 {
+				diverged++
+				fmt.Printf("Workflow %v, run %v diverged:\n", execution.Execution.GetWorkflowId(), execution.Execution.GetRunId())
+				for _, divergence := range resp.Divergences {
+					fmt.Printf("  %v\n", divergence)
+				}
+			}
+			if resp.GetRepaired() {
+				repaired++
+			}
+			if verified == sampleSize {
+				break
+			}
+		}
+		if len(nextPageToken) == 0 || verified == sampleSize {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	fmt.Printf("Verified %v workflows, %v diverged, %v repaired.\n", verified, diverged, repaired)
+}
+
+func verifyWorkflowExecution(adminClient serverAdmin.Interface, domain string, execution *shared.WorkflowExecution,
+	repair bool) *admin.VerifyWorkflowExecutionResponse {
+
+	// describing the workflow in the other clusters and re-replicating its history can take a while
+	ctx, cancel := newContextForLongPoll(defaultContextTimeoutForLongPoll)
+	defer cancel()
+	resp, err := adminClient.VerifyWorkflowExecution(ctx, &admin.VerifyWorkflowExecutionRequest{
+		Domain:    common.StringPtr(domain),
+		Execution: execution,
+		Repair:    common.BoolPtr(repair),
+	})
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Operation VerifyWorkflowExecution failed for workflow %v, run %v.",
+			execution.GetWorkflowId(), execution.GetRunId()), err)
+	}
+	return resp
+}
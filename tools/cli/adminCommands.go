@@ -25,64 +25,70 @@ import (
 	"fmt"
 
 	"github.com/gocql/gocql"
-	"github.com/uber-common/bark"
 	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/persistence"
-	cassp "github.com/uber/cadence/common/persistence/cassandra"
 	"github.com/uber/cadence/tools/cassandra"
 	"github.com/urfave/cli"
 )
 
-const maxEventID = 9999
-
 // AdminShowWorkflow shows history
 func AdminShowWorkflow(c *cli.Context) {
-	domainID := c.String(FlagDomainID)
+	adminClient := cFactory.ServerAdminClient(c)
+
 	wid := c.String(FlagWorkflowID)
 	rid := c.String(FlagRunID)
 	tid := c.String(FlagTreeID)
 	bid := c.String(FlagBranchID)
 
-	session := connectToCassandra(c)
-	serializer := persistence.NewHistorySerializer()
-	var history []*persistence.DataBlob
+	var history []*shared.DataBlob
 	if len(wid) != 0 {
-		histV1 := cassp.NewHistoryPersistenceFromSession(session, bark.NewNopLogger())
-		resp, err := histV1.GetWorkflowExecutionHistory(&persistence.InternalGetWorkflowExecutionHistoryRequest{
-			LastEventBatchVersion: common.EmptyVersion,
-			DomainID:              domainID,
-			Execution: shared.WorkflowExecution{
-				WorkflowId: common.StringPtr(wid),
-				RunId:      common.StringPtr(rid),
-			},
-			FirstEventID: 1,
-			NextEventID:  maxEventID,
-			PageSize:     maxEventID,
-		})
-		if err != nil {
-			ErrorAndExit("GetWorkflowExecutionHistory err", err)
+		domain := getRequiredGlobalOption(c, FlagDomain)
+		var token []byte
+		for {
+			ctx, cancel := newContext()
+			resp, err := adminClient.GetWorkflowExecutionRawHistory(ctx, &admin.GetWorkflowExecutionRawHistoryRequest{
+				Domain: common.StringPtr(domain),
+				Execution: &shared.WorkflowExecution{
+					WorkflowId: common.StringPtr(wid),
+					RunId:      common.StringPtr(rid),
+				},
+				FirstEventId:  common.Int64Ptr(common.FirstEventID),
+				NextEventId:   common.Int64Ptr(common.EndEventID),
+				NextPageToken: token,
+			})
+			cancel()
+			if err != nil {
+				ErrorAndExit("GetWorkflowExecutionRawHistory err", err)
+			}
+			history = append(history, resp.HistoryBatches...)
+			token = resp.NextPageToken
+			if len(token) == 0 {
+				break
+			}
 		}
-
-		history = resp.History
-
 	} else if len(tid) != 0 {
-		histV2 := cassp.NewHistoryV2PersistenceFromSession(session, bark.NewNopLogger())
-
-		resp, err := histV2.ReadHistoryBranch(&persistence.InternalReadHistoryBranchRequest{
-			TreeID:    tid,
-			BranchID:  bid,
-			MinNodeID: 1,
-			MaxNodeID: maxEventID,
-			PageSize:  maxEventID,
-		})
-		if err != nil {
-			ErrorAndExit("ReadHistoryBranch err", err)
+		var token []byte
+		for {
+			ctx, cancel := newContext()
+			resp, err := adminClient.ReadHistoryBranch(ctx, &admin.ReadHistoryBranchRequest{
+				Domain:        common.StringPtr(c.GlobalString(FlagDomain)),
+				TreeId:        common.StringPtr(tid),
+				BranchId:      common.StringPtr(bid),
+				NextPageToken: token,
+			})
+			cancel()
+			if err != nil {
+				ErrorAndExit("ReadHistoryBranch err", err)
+			}
+			history = append(history, resp.HistoryBatches...)
+			token = resp.NextPageToken
+			if len(token) == 0 {
+				break
+			}
 		}
-
-		history = resp.History
 	} else {
 		ErrorAndExit("need to specify either WorkflowId/RunID for v1, or TreeID/BranchID for v2", nil)
 	}
@@ -90,11 +96,12 @@ func AdminShowWorkflow(c *cli.Context) {
 	if len(history) == 0 {
 		ErrorAndExit("no events", nil)
 	}
+	serializer := persistence.NewHistorySerializer()
 	totalSize := 0
 	for idx, b := range history {
 		totalSize += len(b.Data)
 		fmt.Printf("======== batch %v, blob len: %v ======\n", idx+1, len(b.Data))
-		historyBatch, err := serializer.DeserializeBatchEvents(b)
+		historyBatch, err := serializer.DeserializeBatchEvents(persistence.NewDataBlob(b.Data, common.EncodingTypeThriftRW))
 		if err != nil {
 			ErrorAndExit("DeserializeBatchEvents err", err)
 		}
@@ -152,56 +159,28 @@ func AdminDescribeWorkflow(c *cli.Context) {
 	}
 }
 
-// AdminDeleteWorkflow describe a new workflow execution for admin
+// AdminDeleteWorkflow deletes the mutable state of a workflow execution, and the current execution record if it points to it
 func AdminDeleteWorkflow(c *cli.Context) {
-	domainID := getRequiredOption(c, FlagDomainID)
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
 	wid := getRequiredOption(c, FlagWorkflowID)
 	rid := getRequiredOption(c, FlagRunID)
-	if !c.IsSet(FlagShardID) {
-		ErrorAndExit("shardID is required", nil)
-	}
-	shardID := c.Int(FlagShardID)
-
-	session := connectToCassandra(c)
 
-	var err error
-	permanentRunID := "30000000-0000-f000-f000-000000000001"
-	selectTmpl := "select execution from executions where shard_id = ? and type = 1 and domain_id = ? and workflow_id = ? and run_id = ? "
-	deleteTmpl := "delete from executions where shard_id = ? and type = 1 and domain_id = ? and workflow_id = ? and run_id = ? "
-
-	query := session.Query(selectTmpl, shardID, domainID, wid, permanentRunID)
-	_, err = readOneRow(query)
-	if err != nil {
-		fmt.Printf("readOneRow for permanentRunID, %v, skip \n", err)
-	} else {
-
-		query := session.Query(deleteTmpl, shardID, domainID, wid, permanentRunID)
-		err := query.Exec()
-		if err != nil {
-			ErrorAndExit("delete row failed", err)
-		}
-		fmt.Println("delete row successfully")
-	}
+	ctx, cancel := newContext()
+	defer cancel()
 
-	query = session.Query(selectTmpl, shardID, domainID, wid, rid)
-	_, err = readOneRow(query)
+	err := adminClient.DeleteWorkflowExecution(ctx, &admin.DeleteWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+	})
 	if err != nil {
-		fmt.Printf("readOneRow for rid %v, %v, skip \n", rid, err)
-	} else {
-
-		query := session.Query(deleteTmpl, shardID, domainID, wid, rid)
-		err := query.Exec()
-		if err != nil {
-			ErrorAndExit("delete row failed", err)
-		}
-		fmt.Println("delete row successfully")
+		ErrorAndExit("Delete workflow execution failed", err)
 	}
-}
-
-func readOneRow(query *gocql.Query) (map[string]interface{}, error) {
-	result := make(map[string]interface{})
-	err := query.MapScan(result)
-	return result, err
+	fmt.Println("delete workflow execution successfully")
 }
 
 func connectToCassandra(c *cli.Context) *gocql.Session {
@@ -229,46 +208,28 @@ func connectToCassandra(c *cli.Context) *gocql.Session {
 
 // AdminGetDomainIDOrName map domain
 func AdminGetDomainIDOrName(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
 	domainID := c.String(FlagDomainID)
 	domainName := c.String(FlagDomain)
 	if len(domainID) == 0 && len(domainName) == 0 {
 		ErrorAndExit("Need either domainName or domainID", nil)
 	}
 
-	session := connectToCassandra(c)
+	ctx, cancel := newContext()
+	defer cancel()
 
+	resp, err := adminClient.GetDomainIDOrName(ctx, &admin.GetDomainIDOrNameRequest{
+		Domain:   common.StringPtr(domainName),
+		DomainId: common.StringPtr(domainID),
+	})
+	if err != nil {
+		ErrorAndExit("GetDomainIDOrName err", err)
+	}
 	if len(domainID) > 0 {
-		tmpl := "select domain from domains where id = ? "
-		query := session.Query(tmpl, domainID)
-		res, err := readOneRow(query)
-		if err != nil {
-			ErrorAndExit("readOneRow", err)
-		}
-		domain := res["domain"].(map[string]interface{})
-		domainName := domain["name"].(string)
-		fmt.Printf("domainName for domainID %v is %v \n", domainID, domainName)
+		fmt.Printf("domainName for domainID %v is %v \n", domainID, resp.GetDomain())
 	} else {
-		tmpl := "select domain from domains_by_name where name = ?"
-		tmplV2 := "select domain from domains_by_name_v2 where domains_partition=0 and name = ?"
-
-		query := session.Query(tmpl, domainName)
-		res, err := readOneRow(query)
-		if err != nil {
-			fmt.Printf("v1 return error: %v , trying v2...\n", err)
-
-			query := session.Query(tmplV2, domainName)
-			res, err := readOneRow(query)
-			if err != nil {
-				ErrorAndExit("readOneRow for v2", err)
-			}
-			domain := res["domain"].(map[string]interface{})
-			domainID := domain["id"].(gocql.UUID).String()
-			fmt.Printf("domainID for domainName %v is %v \n", domainName, domainID)
-		} else {
-			domain := res["domain"].(map[string]interface{})
-			domainID := domain["id"].(gocql.UUID).String()
-			fmt.Printf("domainID for domainName %v is %v \n", domainName, domainID)
-		}
+		fmt.Printf("domainID for domainName %v is %v \n", domainName, resp.GetDomainId())
 	}
 }
 
@@ -0,0 +1,160 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+const (
+	defaultDLQPageSize = 100
+)
+
+// dlqMessage is the output of reading a replication task from a dead letter queue
+type dlqMessage struct {
+	ShardID int
+	TaskID  int64
+	Task    *replicator.ReplicationTask
+}
+
+// AdminReadDLQMessages prints the replication tasks in the dead letter queues of the shards
+func AdminReadDLQMessages(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	domain := c.GlobalString(FlagDomain)
+	workflowID := c.String(FlagWorkflowID)
+	pageSize := c.Int(FlagPageSize)
+
+	for _, shardID := range getDLQShardIDs(c) {
+		var pageToken []byte
+		for {
+			ctx, cancel := newContext()
+			resp, err := adminClient.ReadDLQMessages(ctx, &replicator.ReadDLQMessagesRequest{
+				SourceCluster:   common.StringPtr(sourceCluster),
+				ShardId:         common.Int32Ptr(int32(shardID)),
+				Domain:          common.StringPtr(domain),
+				WorkflowId:      common.StringPtr(workflowID),
+				MaximumPageSize: common.Int32Ptr(int32(pageSize)),
+				NextPageToken:   pageToken,
+			})
+			cancel()
+			if err != nil {
+				ErrorAndExit(fmt.Sprintf("Operation ReadDLQMessages failed for shard %v.", shardID), err)
+			}
+			for _, task := range resp.ReplicationTasks {
+				prettyPrintJSONObject(&dlqMessage{
+					ShardID: shardID,
+					TaskID:  task.GetTaskId(),
+					Task:    task.Task,
+				})
+			}
+			if len(resp.NextPageToken) == 0 {
+				break
+			}
+			pageToken = resp.NextPageToken
+		}
+	}
+}
+
+// AdminMergeDLQMessages applies the replication tasks in the dead letter queues of the shards
+func AdminMergeDLQMessages(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	domain := c.GlobalString(FlagDomain)
+	workflowID := c.String(FlagWorkflowID)
+	pageSize := c.Int(FlagPageSize)
+
+	for _, shardID := range getDLQShardIDs(c) {
+		merged := 0
+		var pageToken []byte
+		for {
+			// applying a task can take as long as resending the missing history from the source cluster
+			ctx, cancel := newContextForLongPoll(defaultContextTimeoutForLongPoll)
+			resp, err := adminClient.MergeDLQMessages(ctx, &replicator.MergeDLQMessagesRequest{
+				SourceCluster:   common.StringPtr(sourceCluster),
+				ShardId:         common.Int32Ptr(int32(shardID)),
+				Domain:          common.StringPtr(domain),
+				WorkflowId:      common.StringPtr(workflowID),
+				MaximumPageSize: common.Int32Ptr(int32(pageSize)),
+				NextPageToken:   pageToken,
+			})
+			cancel()
+			if err != nil {
+				ErrorAndExit(fmt.Sprintf("Operation MergeDLQMessages failed for shard %v after merging %v tasks.",
+					shardID, merged), err)
+			}
+			merged += int(resp.GetMergedCount())
+			if len(resp.NextPageToken) == 0 {
+				break
+			}
+			pageToken = resp.NextPageToken
+		}
+		fmt.Printf("Merged %v replication tasks of shard %v.\n", merged, shardID)
+	}
+}
+
+// AdminPurgeDLQMessages removes the replication tasks in the dead letter queues of the shards
+func AdminPurgeDLQMessages(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	domain := c.GlobalString(FlagDomain)
+	workflowID := c.String(FlagWorkflowID)
+
+	for _, shardID := range getDLQShardIDs(c) {
+		ctx, cancel := newContextForLongPoll(defaultContextTimeoutForLongPoll)
+		err := adminClient.PurgeDLQMessages(ctx, &replicator.PurgeDLQMessagesRequest{
+			SourceCluster: common.StringPtr(sourceCluster),
+			ShardId:       common.Int32Ptr(int32(shardID)),
+			Domain:        common.StringPtr(domain),
+			WorkflowId:    common.StringPtr(workflowID),
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Operation PurgeDLQMessages failed for shard %v.", shardID), err)
+		}
+		fmt.Printf("Purged replication tasks of shard %v.\n", shardID)
+	}
+}
+
+// getDLQShardIDs returns the shard given by the shard_id option, or the range of shards up to upper_shard_id
+func getDLQShardIDs(c *cli.Context) []int {
+	if !c.IsSet(FlagShardID) {
+		ErrorAndExit(fmt.Sprintf("Option %s is required", FlagShardID), nil)
+	}
+	lower := c.Int(FlagShardID)
+	upper := lower
+	if c.IsSet(FlagUpperShardID) {
+		upper = c.Int(FlagUpperShardID)
+	}
+	if upper < lower {
+		ErrorAndExit(fmt.Sprintf("Option %s cannot be less than %s", FlagUpperShardID, FlagShardID), nil)
+	}
+
+	var shardIDs []int
+	for shardID := lower; shardID <= upper; shardID++ {
+		shardIDs = append(shardIDs, shardID)
+	}
+	return shardIDs
+}
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/urfave/cli"
@@ -309,6 +310,25 @@ func AdminDescribeDomain(c *cli.Context) {
 	fmt.Printf(formatStr, descValues...)
 }
 
+// AdminDeleteDomain deletes a deprecated domain
+func AdminDeleteDomain(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := adminClient.DeleteDomain(ctx, &admin.DeleteDomainRequest{
+		Domain: common.StringPtr(domain),
+	})
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); !ok {
+			ErrorAndExit("Operation DeleteDomain failed.", err)
+		}
+		ErrorAndExit(fmt.Sprintf("Domain %s does not exist.", domain), err)
+	}
+	fmt.Printf("Deletion of domain %s started, workflowID: %s, runID: %s\n", domain, resp.GetWorkflowId(), resp.GetRunId())
+}
+
 func serverClustersToString(clusters []*shared.ClusterReplicationConfiguration) string {
 	var res string
 	for i, cluster := range clusters {
@@ -46,6 +46,26 @@ func NewCliApp() *cli.App {
 			Usage:  "cadence workflow domain",
 			EnvVar: "CADENCE_CLI_DOMAIN",
 		},
+		cli.StringFlag{
+			Name:   FlagTLSCaPath,
+			Usage:  "path to the PEM encoded CA bundle the certificate of the frontend is verified with, the connection is encrypted if set",
+			EnvVar: "CADENCE_CLI_TLS_CA",
+		},
+		cli.StringFlag{
+			Name:   FlagTLSCertPath,
+			Usage:  "path to the PEM encoded client certificate presented to the frontend",
+			EnvVar: "CADENCE_CLI_TLS_CERT",
+		},
+		cli.StringFlag{
+			Name:   FlagTLSKeyPath,
+			Usage:  "path to the PEM encoded private key of the client certificate",
+			EnvVar: "CADENCE_CLI_TLS_KEY",
+		},
+		cli.StringFlag{
+			Name:   FlagTLSServerName,
+			Usage:  "name the certificate of the frontend is verified against, the host of the address is used if empty",
+			EnvVar: "CADENCE_CLI_TLS_SERVER_NAME",
+		},
 	}
 	app.Commands = []cli.Command{
 		{
@@ -95,6 +115,23 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on domain",
 					Subcommands: newAdminDomainCommands(),
 				},
+				{
+					Name:        "batch",
+					Aliases:     []string{"bat"},
+					Usage:       "Run batch operation on the workflows of a domain",
+					Subcommands: newAdminBatchCommands(),
+				},
+				{
+					Name:        "dlq",
+					Usage:       "Run admin operation on the replication dead letter queues of the history shards",
+					Subcommands: newAdminDLQCommands(),
+				},
+				{
+					Name:        "cluster",
+					Aliases:     []string{"cl"},
+					Usage:       "Run admin operation on the clusters of the global domains",
+					Subcommands: newAdminClusterCommands(),
+				},
 			},
 		},
 	}
@@ -21,6 +21,9 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
+	"flag"
 	"strings"
 	"testing"
 	"time"
@@ -34,8 +37,11 @@ import (
 	serverAdminTest "github.com/uber/cadence/.gen/go/admin/adminservicetest"
 	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
 	serverFrontendTest "github.com/uber/cadence/.gen/go/cadence/workflowservicetest"
+	"github.com/uber/cadence/.gen/go/replicator"
 	serverShared "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/worker/batcher"
 	"github.com/urfave/cli"
 	clientFrontend "go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	clientFrontendTest "go.uber.org/cadence/.gen/go/cadence/workflowservicetest"
@@ -47,6 +53,7 @@ type cliAppSuite struct {
 	app                  *cli.App
 	mockCtrl             *gomock.Controller
 	clientFrontendClient *clientFrontendTest.MockClient
+	serverFrontendClient *serverFrontendTest.MockClient
 	serverAdminClient    *serverAdminTest.MockClient
 }
 
@@ -92,10 +99,11 @@ func (s *cliAppSuite) SetupTest() {
 	s.mockCtrl = gomock.NewController(s.T())
 
 	s.clientFrontendClient = clientFrontendTest.NewMockClient(s.mockCtrl)
+	s.serverFrontendClient = serverFrontendTest.NewMockClient(s.mockCtrl)
 	s.serverAdminClient = serverAdminTest.NewMockClient(s.mockCtrl)
 	SetFactory(&clientFactoryMock{
 		clientFrontendClient: s.clientFrontendClient,
-		serverFrontendClient: serverFrontendTest.NewMockClient(s.mockCtrl),
+		serverFrontendClient: s.serverFrontendClient,
 		serverAdminClient:    s.serverAdminClient,
 	})
 }
@@ -267,8 +275,8 @@ func (s *cliAppSuite) TestShowHistory_PrintDateTime() {
 }
 
 func (s *cliAppSuite) TestStartWorkflow() {
-	resp := &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
-	s.clientFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil).Times(2)
+	resp := &serverShared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
+	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil).Times(2)
 	// start with wid
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "start", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "-w", "wid", "wrp", "2"})
 	s.Nil(err)
@@ -278,17 +286,42 @@ func (s *cliAppSuite) TestStartWorkflow() {
 }
 
 func (s *cliAppSuite) TestStartWorkflow_Failed() {
-	resp := &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
-	s.clientFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, &shared.BadRequestError{"faked error"})
+	resp := &serverShared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
+	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, &serverShared.BadRequestError{"faked error"})
 	// start with wid
 	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "start", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "-w", "wid"})
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestStartWorkflow_WithSearchAttributes() {
+	resp := &serverShared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
+	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+		Do(func(_ interface{}, request *serverShared.StartWorkflowExecutionRequest) {
+			s.Equal(map[string][]byte{
+				"CustomKeywordField": []byte(`"keyword1"`),
+				"CustomIntField":     []byte(`5`),
+			}, request.SearchAttributes.IndexedFields)
+		}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "start", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60",
+		"-sa", `{"CustomKeywordField":"keyword1","CustomIntField":5}`})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestStartWorkflow_WithCron() {
+	resp := &serverShared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
+	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+		Do(func(_ interface{}, request *serverShared.StartWorkflowExecutionRequest) {
+			s.Equal("CRON_TZ=America/New_York 0 9 * * *", request.GetCronSchedule())
+		}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "start", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60",
+		"--cron", "CRON_TZ=America/New_York 0 9 * * *"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestRunWorkflow() {
-	resp := &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
+	resp := &serverShared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
 	history := getWorkflowExecutionHistoryResponse
-	s.clientFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil).Times(2)
+	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil).Times(2)
 	s.clientFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any(), callOptions...).Return(history, nil).Times(2)
 	// start with wid
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "run", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "-w", "wid", "wrp", "2"})
@@ -299,9 +332,9 @@ func (s *cliAppSuite) TestRunWorkflow() {
 }
 
 func (s *cliAppSuite) TestRunWorkflow_Failed() {
-	resp := &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
+	resp := &serverShared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
 	history := getWorkflowExecutionHistoryResponse
-	s.clientFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, &shared.BadRequestError{"faked error"})
+	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, &serverShared.BadRequestError{"faked error"})
 	s.clientFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any(), callOptions...).Return(history, nil)
 	// start with wid
 	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "run", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "-w", "wid"})
@@ -320,6 +353,23 @@ func (s *cliAppSuite) TestTerminateWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestTriggerCronWorkflow() {
+	s.serverFrontendClient.EXPECT().TriggerCronWorkflowExecution(gomock.Any(), gomock.Any()).
+		Do(func(_ interface{}, request *serverShared.TriggerCronWorkflowExecutionRequest) {
+			s.Equal(domainName, request.GetDomain())
+			s.Equal("wid", request.WorkflowExecution.GetWorkflowId())
+			s.Nil(request.WorkflowExecution.RunId)
+		}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "triggercron", "-w", "wid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestTriggerCronWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().TriggerCronWorkflowExecution(gomock.Any(), gomock.Any()).Return(&serverShared.BadRequestError{"faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "triggercron", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestCancelWorkflow() {
 	s.clientFrontendClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any(), callOptions...).Return(nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "cancel", "-w", "wid"})
@@ -372,16 +422,16 @@ func (s *cliAppSuite) TestQueryWorkflow_Failed() {
 }
 
 var (
-	closeStatus = shared.WorkflowExecutionCloseStatusCompleted
+	closeStatus = serverShared.WorkflowExecutionCloseStatusCompleted
 
-	listClosedWorkflowExecutionsResponse = &shared.ListClosedWorkflowExecutionsResponse{
-		Executions: []*shared.WorkflowExecutionInfo{
+	listClosedWorkflowExecutionsResponse = &serverShared.ListClosedWorkflowExecutionsResponse{
+		Executions: []*serverShared.WorkflowExecutionInfo{
 			{
-				Execution: &shared.WorkflowExecution{
+				Execution: &serverShared.WorkflowExecution{
 					WorkflowId: common.StringPtr("test-list-workflow-id"),
 					RunId:      common.StringPtr(uuid.New()),
 				},
-				Type: &shared.WorkflowType{
+				Type: &serverShared.WorkflowType{
 					Name: common.StringPtr("test-list-workflow-type"),
 				},
 				StartTime:     common.Int64Ptr(time.Now().UnixNano()),
@@ -392,14 +442,14 @@ var (
 		},
 	}
 
-	listOpenWorkflowExecutionsResponse = &shared.ListOpenWorkflowExecutionsResponse{
-		Executions: []*shared.WorkflowExecutionInfo{
+	listOpenWorkflowExecutionsResponse = &serverShared.ListOpenWorkflowExecutionsResponse{
+		Executions: []*serverShared.WorkflowExecutionInfo{
 			{
-				Execution: &shared.WorkflowExecution{
+				Execution: &serverShared.WorkflowExecution{
 					WorkflowId: common.StringPtr("test-list-open-workflow-id"),
 					RunId:      common.StringPtr(uuid.New()),
 				},
-				Type: &shared.WorkflowType{
+				Type: &serverShared.WorkflowType{
 					Name: common.StringPtr("test-list-open-workflow-type"),
 				},
 				StartTime:     common.Int64Ptr(time.Now().UnixNano()),
@@ -412,56 +462,90 @@ var (
 
 func (s *cliAppSuite) TestListWorkflow() {
 	resp := listClosedWorkflowExecutionsResponse
-	s.clientFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	s.serverFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "list"})
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestListWorkflow_WithQuery() {
+	resp := &serverShared.ListWorkflowExecutionsResponse{}
+	s.serverFrontendClient.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Do(func(_ interface{}, request *serverShared.ListWorkflowExecutionsRequest) {
+			s.Equal("WorkflowType = 'main.Workflow'", request.GetQuery())
+		}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "list", "-q", "WorkflowType = 'main.Workflow'"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestListWorkflow_WithWorkflowID() {
-	resp := &shared.ListClosedWorkflowExecutionsResponse{}
-	s.clientFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	resp := &serverShared.ListClosedWorkflowExecutionsResponse{}
+	s.serverFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "list", "-wid", "nothing"})
 	s.Nil(err)
 }
 
 func (s *cliAppSuite) TestListWorkflow_WithWorkflowType() {
-	resp := &shared.ListClosedWorkflowExecutionsResponse{}
-	s.clientFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	resp := &serverShared.ListClosedWorkflowExecutionsResponse{}
+	s.serverFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "list", "-wt", "no-type"})
 	s.Nil(err)
 }
 
 func (s *cliAppSuite) TestListWorkflow_PrintDateTime() {
 	resp := listClosedWorkflowExecutionsResponse
-	s.clientFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	s.serverFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "list", "-pdt"})
 	s.Nil(err)
 }
 
 func (s *cliAppSuite) TestListWorkflow_PrintRawTime() {
 	resp := listClosedWorkflowExecutionsResponse
-	s.clientFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	s.serverFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "list", "-prt"})
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestListWorkflow_PrintMemo() {
+	resp := &serverShared.ListClosedWorkflowExecutionsResponse{
+		Executions: []*serverShared.WorkflowExecutionInfo{
+			{
+				Execution: &serverShared.WorkflowExecution{
+					WorkflowId: common.StringPtr("test-list-workflow-id"),
+					RunId:      common.StringPtr(uuid.New()),
+				},
+				Type: &serverShared.WorkflowType{
+					Name: common.StringPtr("test-list-workflow-type"),
+				},
+				StartTime: common.Int64Ptr(time.Now().UnixNano()),
+				CloseTime: common.Int64Ptr(time.Now().Add(time.Hour).UnixNano()),
+				Memo: &serverShared.Memo{
+					Fields: map[string][]byte{"owner": []byte(`"test-owner"`)},
+				},
+			},
+		},
+	}
+	s.serverFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "list", "-pme"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestListWorkflow_Open() {
 	resp := listOpenWorkflowExecutionsResponse
-	s.clientFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	s.serverFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "list", "-op"})
 	s.Nil(err)
 }
 
 func (s *cliAppSuite) TestListWorkflow_Open_WithWorkflowID() {
-	resp := &shared.ListOpenWorkflowExecutionsResponse{}
-	s.clientFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	resp := &serverShared.ListOpenWorkflowExecutionsResponse{}
+	s.serverFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "list", "-op", "-wid", "nothing"})
 	s.Nil(err)
 }
 
 func (s *cliAppSuite) TestListWorkflow_Open_WithWorkflowType() {
-	resp := &shared.ListOpenWorkflowExecutionsResponse{}
-	s.clientFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	resp := &serverShared.ListOpenWorkflowExecutionsResponse{}
+	s.serverFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "list", "-op", "-wt", "no-type"})
 	s.Nil(err)
 }
@@ -493,6 +577,285 @@ func (s *cliAppSuite) TestAdminDescribeWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestAdminShowWorkflow() {
+	blob, err := persistence.NewHistorySerializer().SerializeBatchEvents([]*serverShared.HistoryEvent{
+		{EventId: common.Int64Ptr(1), EventType: serverShared.EventTypeWorkflowExecutionStarted.Ptr()},
+	}, common.EncodingTypeThriftRW)
+	s.NoError(err)
+	batch := &serverShared.DataBlob{EncodingType: serverShared.EncodingTypeThriftRW.Ptr(), Data: blob.Data}
+
+	gomock.InOrder(
+		s.serverAdminClient.EXPECT().GetWorkflowExecutionRawHistory(gomock.Any(), gomock.Any()).Do(
+			func(_ context.Context, request *admin.GetWorkflowExecutionRawHistoryRequest) {
+				s.Equal(domainName, request.GetDomain())
+				s.Nil(request.NextPageToken)
+			}).Return(&admin.GetWorkflowExecutionRawHistoryResponse{
+			HistoryBatches: []*serverShared.DataBlob{batch},
+			NextPageToken:  []byte("token"),
+		}, nil),
+		s.serverAdminClient.EXPECT().GetWorkflowExecutionRawHistory(gomock.Any(), gomock.Any()).Do(
+			func(_ context.Context, request *admin.GetWorkflowExecutionRawHistoryRequest) {
+				s.Equal([]byte("token"), request.NextPageToken)
+			}).Return(&admin.GetWorkflowExecutionRawHistoryResponse{
+			HistoryBatches: []*serverShared.DataBlob{batch},
+		}, nil),
+	)
+	err = s.app.Run([]string{"", "--do", domainName, "admin", "wf", "show", "-w", "test-wf-id", "-r", uuid.New()})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminShowWorkflow_Branch() {
+	treeID := uuid.New()
+	branchID := uuid.New()
+	blob, err := persistence.NewHistorySerializer().SerializeBatchEvents([]*serverShared.HistoryEvent{
+		{EventId: common.Int64Ptr(1), EventType: serverShared.EventTypeWorkflowExecutionStarted.Ptr()},
+	}, common.EncodingTypeThriftRW)
+	s.NoError(err)
+
+	s.serverAdminClient.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, request *admin.ReadHistoryBranchRequest) {
+			s.Equal(treeID, request.GetTreeId())
+			s.Equal(branchID, request.GetBranchId())
+		}).Return(&admin.ReadHistoryBranchResponse{
+		HistoryBatches: []*serverShared.DataBlob{{EncodingType: serverShared.EncodingTypeThriftRW.Ptr(), Data: blob.Data}},
+	}, nil)
+	err = s.app.Run([]string{"", "admin", "wf", "show", "--tree_id", treeID, "--branch_id", branchID})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDeleteWorkflow() {
+	runID := uuid.New()
+	s.serverAdminClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, request *admin.DeleteWorkflowExecutionRequest) {
+			s.Equal(domainName, request.GetDomain())
+			s.Equal("test-wf-id", request.Execution.GetWorkflowId())
+			s.Equal(runID, request.Execution.GetRunId())
+		}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "wf", "delete", "-w", "test-wf-id", "-r", runID})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDeleteWorkflow_Failed() {
+	s.serverAdminClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Return(&serverShared.BadRequestError{"faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "admin", "wf", "delete", "-w", "test-wf-id", "-r", uuid.New()})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminGetDomainIDOrName() {
+	domainID := uuid.New()
+	s.serverAdminClient.EXPECT().GetDomainIDOrName(gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, request *admin.GetDomainIDOrNameRequest) {
+			s.Equal(domainID, request.GetDomainId())
+		}).Return(&admin.GetDomainIDOrNameResponse{
+		Domain:   common.StringPtr(domainName),
+		DomainId: common.StringPtr(domainID),
+	}, nil)
+	err := s.app.Run([]string{"", "admin", "domain", "getdomainidorname", "--domain_id", domainID})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDeleteDomain() {
+	s.serverAdminClient.EXPECT().DeleteDomain(gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, request *admin.DeleteDomainRequest) {
+			s.Equal(domainName, request.GetDomain())
+		}).Return(&admin.DeleteDomainResponse{
+		WorkflowId: common.StringPtr("test-wf-id"),
+		RunId:      common.StringPtr(uuid.New()),
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "domain", "delete"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDeleteDomain_Failed() {
+	s.serverAdminClient.EXPECT().DeleteDomain(gomock.Any(), gomock.Any()).Return(nil, &serverShared.BadRequestError{"faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "admin", "domain", "delete"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminReadDLQMessages() {
+	s.serverAdminClient.EXPECT().ReadDLQMessages(gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, request *replicator.ReadDLQMessagesRequest) {
+			s.Equal("standby", request.GetSourceCluster())
+			s.Equal(int32(3), request.GetShardId())
+			s.Equal(domainName, request.GetDomain())
+			s.Nil(request.NextPageToken)
+		}).Return(&replicator.ReadDLQMessagesResponse{
+		ReplicationTasks: []*replicator.ReplicationDLQTask{
+			{TaskId: common.Int64Ptr(1), Task: &replicator.ReplicationTask{}},
+		},
+		NextPageToken: []byte("token"),
+	}, nil)
+	s.serverAdminClient.EXPECT().ReadDLQMessages(gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, request *replicator.ReadDLQMessagesRequest) {
+			s.Equal([]byte("token"), request.NextPageToken)
+		}).Return(&replicator.ReadDLQMessagesResponse{}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "dlq", "read", "--sc", "standby", "--sid", "3"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminMergeDLQMessages_ShardRange() {
+	var shardIDs []int32
+	s.serverAdminClient.EXPECT().MergeDLQMessages(gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, request *replicator.MergeDLQMessagesRequest) {
+			s.Equal("test-wf-id", request.GetWorkflowId())
+			shardIDs = append(shardIDs, request.GetShardId())
+		}).Return(&replicator.MergeDLQMessagesResponse{MergedCount: common.Int32Ptr(2)}, nil).Times(3)
+	err := s.app.Run([]string{"", "admin", "dlq", "merge", "--sc", "standby", "--sid", "1", "--usid", "3", "-w", "test-wf-id"})
+	s.Nil(err)
+	s.Equal([]int32{1, 2, 3}, shardIDs)
+}
+
+func (s *cliAppSuite) TestAdminMergeDLQMessages_Failed() {
+	s.serverAdminClient.EXPECT().MergeDLQMessages(gomock.Any(), gomock.Any()).Return(nil, &serverShared.BadRequestError{"faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "admin", "dlq", "merge", "--sc", "standby", "--sid", "1"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminPurgeDLQMessages() {
+	s.serverAdminClient.EXPECT().PurgeDLQMessages(gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, request *replicator.PurgeDLQMessagesRequest) {
+			s.Equal("standby", request.GetSourceCluster())
+			s.Equal(int32(0), request.GetShardId())
+		}).Return(nil)
+	err := s.app.Run([]string{"", "admin", "dlq", "purge", "--sc", "standby", "--sid", "0"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminPurgeDLQMessages_MissingShardID() {
+	errorCode := s.RunErrorExitCode([]string{"", "admin", "dlq", "purge", "--sc", "standby"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminVerifyCluster_Workflow() {
+	s.serverAdminClient.EXPECT().VerifyWorkflowExecution(gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, request *admin.VerifyWorkflowExecutionRequest) {
+			s.Equal(domainName, request.GetDomain())
+			s.Equal("test-wf-id", request.Execution.GetWorkflowId())
+			s.True(request.GetRepair())
+		}).Return(&admin.VerifyWorkflowExecutionResponse{
+		ActiveCluster: common.StringPtr("active"),
+		Divergences:   []string{"cluster standby: next event ID 8, active cluster has 10"},
+		Repaired:      common.BoolPtr(true),
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "cluster", "verify", "-w", "test-wf-id", "--repair"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminVerifyCluster_SampledWorkflows() {
+	s.serverFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, request *serverShared.ListOpenWorkflowExecutionsRequest) {
+			s.Equal(int32(1), request.GetMaximumPageSize())
+		}).Return(listOpenWorkflowExecutionsResponse, nil)
+	s.serverAdminClient.EXPECT().VerifyWorkflowExecution(gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, request *admin.VerifyWorkflowExecutionRequest) {
+			s.Equal(listOpenWorkflowExecutionsResponse.Executions[0].Execution, request.Execution)
+			s.False(request.GetRepair())
+		}).Return(&admin.VerifyWorkflowExecutionResponse{}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "cluster", "verify", "--ss", "1"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminVerifyCluster_Failed() {
+	s.serverAdminClient.EXPECT().VerifyWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &serverShared.BadRequestError{"faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "admin", "cluster", "verify", "-w", "test-wf-id"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminStartBatch() {
+	resp := &admin.StartBatchResponse{JobId: common.StringPtr("test-job-id"), RunId: common.StringPtr(uuid.New())}
+	s.serverAdminClient.EXPECT().StartBatch(gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, request *admin.StartBatchRequest) {
+			s.Equal(domainName, request.GetDomain())
+			s.Equal(batcher.BatchTypeSignal, request.GetBatchType())
+			s.Equal("test-signal", request.GetSignalName())
+			s.Equal("wid-", request.GetWorkflowIdPrefix())
+			s.Equal(int32(10), request.GetRps())
+		}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "batch", "start", "--bt", "signal", "--re", "test",
+		"--wip", "wid-", "-n", "test-signal", "-i", "1", "--rps", "10"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminStartBatch_Failed() {
+	s.serverAdminClient.EXPECT().StartBatch(gomock.Any(), gomock.Any()).Return(nil, &serverShared.BadRequestError{"faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "admin", "batch", "start", "--bt", "terminate", "--re", "test"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminDescribeBatch() {
+	progress, _ := json.Marshal(batcher.HeartBeatDetails{CurrentPage: 2, SuccessCount: 10})
+	resp := &serverShared.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &serverShared.WorkflowExecutionInfo{
+			Execution: &serverShared.WorkflowExecution{
+				WorkflowId: common.StringPtr("test-job-id"),
+				RunId:      common.StringPtr(uuid.New()),
+			},
+		},
+		PendingActivities: []*serverShared.PendingActivityInfo{
+			{HeartbeatDetails: progress},
+		},
+	}
+	s.serverFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "batch", "describe", "--jid", "test-job-id"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminCancelBatch() {
+	s.serverFrontendClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "batch", "cancel", "--jid", "test-job-id"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDescribeWorkflow() {
+	resp := &serverShared.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &serverShared.WorkflowExecutionInfo{
+			Execution: &serverShared.WorkflowExecution{
+				WorkflowId: common.StringPtr("test-wf-id"),
+				RunId:      common.StringPtr(uuid.New()),
+			},
+			StartTime: common.Int64Ptr(time.Now().UnixNano()),
+		},
+		PendingActivities: []*serverShared.PendingActivityInfo{
+			{
+				ActivityID:         common.StringPtr("test-activity-id"),
+				State:              serverShared.PendingActivityStateScheduled.Ptr(),
+				ScheduledTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+				Attempt:            common.Int32Ptr(2),
+				MaximumAttempts:    common.Int32Ptr(5),
+				LastFailureReason:  common.StringPtr("test-reason"),
+			},
+		},
+		PendingChildren: []*serverShared.PendingChildExecutionInfo{
+			{
+				WorkflowID:  common.StringPtr("test-child-wf-id"),
+				InitiatedID: common.Int64Ptr(5),
+			},
+		},
+		PendingDecision: &serverShared.PendingDecisionInfo{
+			State:              serverShared.PendingDecisionStateStarted.Ptr(),
+			ScheduledTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+			StartedTimestamp:   common.Int64Ptr(time.Now().UnixNano()),
+			Attempt:            common.Int64Ptr(1),
+		},
+	}
+	s.serverFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, request *serverShared.DescribeWorkflowExecutionRequest) {
+			s.Equal(domainName, request.GetDomain())
+			s.Equal("test-wf-id", request.Execution.GetWorkflowId())
+			s.Nil(request.Execution.RunId)
+		}).Return(resp, nil).Times(2)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "describe", "-w", "test-wf-id"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "--do", domainName, "workflow", "describe", "-w", "test-wf-id", "--prt"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDescribeWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &serverShared.BadRequestError{"faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "describe", "-w", "test-wf-id"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestDescribeTaskList() {
 	resp := describeTaskListResponse
 	s.clientFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
@@ -591,3 +954,21 @@ func (s *cliAppSuite) TestGetWorkflowIdReusePolicy_Failed_Negative() {
 	getWorkflowIDReusePolicy(-1)
 	s.Equal(1, errorCode)
 }
+
+func (s *cliAppSuite) TestNewTLSConfig() {
+	set := flag.NewFlagSet("test", 0)
+	set.String(FlagTLSCaPath, "", "")
+	set.String(FlagTLSCertPath, "", "")
+	set.String(FlagTLSKeyPath, "", "")
+	set.String(FlagTLSServerName, "", "")
+	c := cli.NewContext(s.app, set, nil)
+	s.False(newTLSConfig(c).Enabled)
+
+	s.NoError(set.Set(FlagTLSCaPath, "ca.pem"))
+	s.NoError(set.Set(FlagTLSServerName, "cadence-frontend"))
+	tlsConfig := newTLSConfig(c)
+	s.True(tlsConfig.Enabled)
+	s.Equal("ca.pem", tlsConfig.CAFile)
+	s.Equal("cadence-frontend", tlsConfig.ServerName)
+	s.NoError(tlsConfig.Validate())
+}
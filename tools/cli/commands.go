@@ -30,6 +30,7 @@ import (
 	"io/ioutil"
 	"os"
 	"runtime/debug"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -37,6 +38,8 @@ import (
 	"github.com/fatih/color"
 	"github.com/olekukonko/tablewriter"
 	"github.com/pborman/uuid"
+	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/urfave/cli"
 
@@ -54,6 +57,10 @@ const (
 	FlagKeyspace                          = "keyspace"
 	FlagAddress                           = "address"
 	FlagAddressWithAlias                  = FlagAddress + ", ad"
+	FlagTLSCaPath                         = "tls_ca_path"
+	FlagTLSCertPath                       = "tls_cert_path"
+	FlagTLSKeyPath                        = "tls_key_path"
+	FlagTLSServerName                     = "tls_server_name"
 	FlagHistoryAddress                    = "history_address"
 	FlagHistoryAddressWithAlias           = FlagHistoryAddress + ", had"
 	FlagDomainID                          = "domain_id"
@@ -119,6 +126,8 @@ const (
 	FlagPrintRawTimeWithAlias             = FlagPrintRawTime + ", prt"
 	FlagPrintDateTime                     = "print_datetime"
 	FlagPrintDateTimeWithAlias            = FlagPrintDateTime + ", pdt"
+	FlagPrintMemo                         = "print_memo"
+	FlagPrintMemoWithAlias                = FlagPrintMemo + ", pme"
 	FlagDescription                       = "description"
 	FlagDescriptionWithAlias              = FlagDescription + ", desc"
 	FlagOwnerEmail                        = "owner_email"
@@ -158,6 +167,27 @@ const (
 	FlagSkipErrorModeWithAlias     = FlagSkipErrorMode + ", serr"
 	FlagHeadersMode                = "headers"
 	FlagHeadersModeWithAlias       = FlagHeadersMode + ", he"
+	FlagListQuery                  = "query"
+	FlagListQueryWithAlias         = FlagListQuery + ", q"
+	FlagSearchAttributes           = "search_attr"
+	FlagSearchAttributesWithAlias  = FlagSearchAttributes + ", sa"
+	FlagBatchType                  = "batch_type"
+	FlagBatchTypeWithAlias         = FlagBatchType + ", bt"
+	FlagWorkflowIDPrefix           = "workflow_id_prefix"
+	FlagWorkflowIDPrefixWithAlias  = FlagWorkflowIDPrefix + ", wip"
+	FlagRPS                        = "rps"
+	FlagConcurrency                = "concurrency"
+	FlagConcurrencyWithAlias       = FlagConcurrency + ", conc"
+	FlagJobID                      = "job_id"
+	FlagJobIDWithAlias             = FlagJobID + ", jid"
+	FlagCronSchedule               = "cron"
+	FlagSourceCluster              = "source_cluster"
+	FlagSourceClusterWithAlias     = FlagSourceCluster + ", sc"
+	FlagUpperShardID               = "upper_shard_id"
+	FlagUpperShardIDWithAlias      = FlagUpperShardID + ", usid"
+	FlagSampleSize                 = "sample_size"
+	FlagSampleSizeWithAlias        = FlagSampleSize + ", ss"
+	FlagRepair                     = "repair"
 )
 
 const (
@@ -534,8 +564,25 @@ func showHistoryHelper(c *cli.Context, wid, rid string) {
 
 // StartWorkflow starts a new workflow execution
 func StartWorkflow(c *cli.Context) {
-	serviceClient := cFactory.ClientFrontendClient(c)
+	// using the server side client, the client side thrift interface doesn't have search attributes yet
+	serviceClient := cFactory.ServerFrontendClient(c)
+
+	startRequest := createStartWorkflowRequest(c)
+
+	tcCtx, cancel := newContext()
+	defer cancel()
 
+	resp, err := serviceClient.StartWorkflowExecution(tcCtx, startRequest)
+
+	if err != nil {
+		ErrorAndExit("Failed to create workflow.", err)
+	} else {
+		fmt.Printf("Started Workflow Id: %s, run Id: %s\n", startRequest.GetWorkflowId(), resp.GetRunId())
+	}
+}
+
+// createStartWorkflowRequest builds the request used by both the start and the run command
+func createStartWorkflowRequest(c *cli.Context) *shared.StartWorkflowExecutionRequest {
 	domain := getRequiredGlobalOption(c, FlagDomain)
 	taskList := getRequiredOption(c, FlagTaskList)
 	workflowType := getRequiredOption(c, FlagWorkflowType)
@@ -548,24 +595,26 @@ func StartWorkflow(c *cli.Context) {
 	if len(wid) == 0 {
 		wid = uuid.New()
 	}
-	reusePolicy := defaultWorkflowIDReusePolicy.Ptr()
+	reusePolicy := shared.WorkflowIdReusePolicy(defaultWorkflowIDReusePolicy).Ptr()
 	if c.IsSet(FlagWorkflowIDReusePolicy) {
-		reusePolicy = getWorkflowIDReusePolicy(c.Int(FlagWorkflowIDReusePolicy))
+		reusePolicy = shared.WorkflowIdReusePolicy(*getWorkflowIDReusePolicy(c.Int(FlagWorkflowIDReusePolicy))).Ptr()
+	}
+	cronSchedule := c.String(FlagCronSchedule)
+	if err := common.ValidateCronSchedule(cronSchedule); err != nil {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagCronSchedule), err)
 	}
 
 	input := processJSONInput(c)
+	searchAttributes := processSearchAttributes(c)
 
-	tcCtx, cancel := newContext()
-	defer cancel()
-
-	resp, err := serviceClient.StartWorkflowExecution(tcCtx, &s.StartWorkflowExecutionRequest{
+	return &shared.StartWorkflowExecutionRequest{
 		RequestId:  common.StringPtr(uuid.New()),
 		Domain:     common.StringPtr(domain),
 		WorkflowId: common.StringPtr(wid),
-		WorkflowType: &s.WorkflowType{
+		WorkflowType: &shared.WorkflowType{
 			Name: common.StringPtr(workflowType),
 		},
-		TaskList: &s.TaskList{
+		TaskList: &shared.TaskList{
 			Name: common.StringPtr(taskList),
 		},
 		Input:                               []byte(input),
@@ -573,37 +622,35 @@ func StartWorkflow(c *cli.Context) {
 		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(dt)),
 		Identity:                            common.StringPtr(getCliIdentity()),
 		WorkflowIdReusePolicy:               reusePolicy,
-	})
-
-	if err != nil {
-		ErrorAndExit("Failed to create workflow.", err)
-	} else {
-		fmt.Printf("Started Workflow Id: %s, run Id: %s\n", wid, resp.GetRunId())
+		CronSchedule:                        getPtrOrNilIfEmpty(cronSchedule),
+		SearchAttributes:                    searchAttributes,
 	}
 }
 
-// RunWorkflow starts a new workflow execution and print workflow progress and result
-func RunWorkflow(c *cli.Context) {
-	serviceClient := cFactory.ClientFrontendClient(c)
-
-	domain := getRequiredGlobalOption(c, FlagDomain)
-	taskList := getRequiredOption(c, FlagTaskList)
-	workflowType := getRequiredOption(c, FlagWorkflowType)
-	et := c.Int(FlagExecutionTimeout)
-	if et == 0 {
-		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagExecutionTimeout), nil)
+// processSearchAttributes parses the search attributes given as a JSON object, e.g.
+// '{"CustomKeywordField":"keyword1","CustomIntField":5}'; every value is kept in its JSON encoding
+func processSearchAttributes(c *cli.Context) *shared.SearchAttributes {
+	if !c.IsSet(FlagSearchAttributes) {
+		return nil
 	}
-	dt := c.Int(FlagDecisionTimeout)
-	wid := c.String(FlagWorkflowID)
-	if len(wid) == 0 {
-		wid = uuid.New()
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(c.String(FlagSearchAttributes)), &fields); err != nil {
+		ErrorAndExit(fmt.Sprintf("Option %s is not a valid JSON object.", FlagSearchAttributes), err)
 	}
-	reusePolicy := defaultWorkflowIDReusePolicy.Ptr()
-	if c.IsSet(FlagWorkflowIDReusePolicy) {
-		reusePolicy = getWorkflowIDReusePolicy(c.Int(FlagWorkflowIDReusePolicy))
+	indexedFields := make(map[string][]byte, len(fields))
+	for key, value := range fields {
+		indexedFields[key] = []byte(value)
 	}
+	return &shared.SearchAttributes{IndexedFields: indexedFields}
+}
 
-	input := processJSONInput(c)
+// RunWorkflow starts a new workflow execution and print workflow progress and result
+func RunWorkflow(c *cli.Context) {
+	// using the server side client, the client side thrift interface doesn't have search attributes yet
+	serviceClient := cFactory.ServerFrontendClient(c)
+
+	startRequest := createStartWorkflowRequest(c)
 
 	contextTimeout := defaultContextTimeoutForLongPoll
 	if c.IsSet(FlagContextTimeout) {
@@ -612,22 +659,7 @@ func RunWorkflow(c *cli.Context) {
 	tcCtx, cancel := newContextForLongPoll(contextTimeout)
 	defer cancel()
 
-	resp, err := serviceClient.StartWorkflowExecution(tcCtx, &s.StartWorkflowExecutionRequest{
-		RequestId:  common.StringPtr(uuid.New()),
-		Domain:     common.StringPtr(domain),
-		WorkflowId: common.StringPtr(wid),
-		WorkflowType: &s.WorkflowType{
-			Name: common.StringPtr(workflowType),
-		},
-		TaskList: &s.TaskList{
-			Name: common.StringPtr(taskList),
-		},
-		Input:                               []byte(input),
-		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(et)),
-		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(dt)),
-		Identity:                            common.StringPtr(getCliIdentity()),
-		WorkflowIdReusePolicy:               reusePolicy,
-	})
+	resp, err := serviceClient.StartWorkflowExecution(tcCtx, startRequest)
 
 	if err != nil {
 		ErrorAndExit("Failed to run workflow.", err)
@@ -637,19 +669,22 @@ func RunWorkflow(c *cli.Context) {
 	fmt.Println(colorMagenta("Running execution:"))
 	table := tablewriter.NewWriter(os.Stdout)
 	executionData := [][]string{
-		{"Workflow Id", wid},
+		{"Workflow Id", startRequest.GetWorkflowId()},
 		{"Run Id", resp.GetRunId()},
-		{"Type", workflowType},
-		{"Domain", domain},
-		{"Task List", taskList},
-		{"Args", truncate(input)}, // in case of large input
+		{"Type", startRequest.WorkflowType.GetName()},
+		{"Domain", startRequest.GetDomain()},
+		{"Task List", startRequest.TaskList.GetName()},
+		{"Args", truncate(string(startRequest.Input))}, // in case of large input
+	}
+	if cronSchedule := startRequest.GetCronSchedule(); cronSchedule != "" {
+		executionData = append(executionData, []string{"Cron Schedule", cronSchedule})
 	}
 	table.SetBorder(false)
 	table.SetColumnSeparator(":")
 	table.AppendBulk(executionData) // Add Bulk Data
 	table.Render()
 
-	printWorkflowProgress(c, wid, resp.GetRunId())
+	printWorkflowProgress(c, startRequest.GetWorkflowId(), resp.GetRunId())
 }
 
 // helper function to print workflow progress with time refresh every second
@@ -734,6 +769,66 @@ func TerminateWorkflow(c *cli.Context) {
 	}
 }
 
+// ResetWorkflow resets a workflow execution to the given decision finish event
+func ResetWorkflow(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := getRequiredOption(c, FlagReason)
+	eventID := c.Int64(FlagEventID)
+	if eventID <= 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s is required and must be a positive event id.", FlagEventID), nil)
+	}
+
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := frontendClient.ResetWorkflowExecution(ctx, &shared.ResetWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+		Reason:                common.StringPtr(reason),
+		DecisionFinishEventId: common.Int64Ptr(eventID),
+		RequestId:             common.StringPtr(uuid.New()),
+		Identity:              common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Reset workflow failed.", err)
+	} else {
+		fmt.Printf("Reset workflow succeeded, new run id: %v\n", resp.GetRunId())
+	}
+}
+
+// TriggerCronWorkflow starts the next run of a cron workflow without waiting for its cron schedule
+func TriggerCronWorkflow(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	err := frontendClient.TriggerCronWorkflowExecution(ctx, &shared.TriggerCronWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+		Identity: common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Trigger cron workflow failed.", err)
+	} else {
+		fmt.Println("Next cron run triggered.")
+	}
+}
+
 // CancelWorkflow cancels a workflow execution
 func CancelWorkflow(c *cli.Context) {
 	wfClient := getWorkflowClient(c)
@@ -834,7 +929,7 @@ func ListWorkflow(c *cli.Context) {
 	more := c.Bool(FlagMore)
 	pageSize := c.Int(FlagPageSize)
 
-	table := createTableForListWorkflow(false)
+	table := createTableForListWorkflow(false, c.Bool(FlagPrintMemo))
 	prepareTable := listWorkflow(c, table)
 
 	if !more { // default mode only show one page items
@@ -867,7 +962,7 @@ func ListWorkflow(c *cli.Context) {
 
 // ListAllWorkflow list all workflow executions based on filters
 func ListAllWorkflow(c *cli.Context) {
-	table := createTableForListWorkflow(true)
+	table := createTableForListWorkflow(true, c.Bool(FlagPrintMemo))
 	prepareTable := listWorkflow(c, table)
 	var resultSize int
 	var nextPageToken []byte
@@ -903,14 +998,22 @@ func DescribeWorkflowWithID(c *cli.Context) {
 }
 
 func describeWorkflowHelper(c *cli.Context, wid, rid string) {
-	wfClient := getWorkflowClient(c)
+	// using the server side client, the client side thrift interface doesn't have the pending children and decision yet
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
 
 	printRawTime := c.Bool(FlagPrintRawTime) // default show datetime instead of raw time
 
 	ctx, cancel := newContext()
 	defer cancel()
 
-	resp, err := wfClient.DescribeWorkflowExecution(ctx, wid, rid)
+	resp, err := frontendClient.DescribeWorkflowExecution(ctx, &shared.DescribeWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+	})
 	if err != nil {
 		ErrorAndExit("Describe workflow execution failed", err)
 	}
@@ -935,39 +1038,60 @@ func prettyPrintJSONObject(o interface{}) {
 
 // describeWorkflowExecutionResponse is used to print datetime instead of print raw time
 type describeWorkflowExecutionResponse struct {
-	ExecutionConfiguration *s.WorkflowExecutionConfiguration
+	ExecutionConfiguration *shared.WorkflowExecutionConfiguration
 	WorkflowExecutionInfo  workflowExecutionInfo
 	PendingActivities      []*pendingActivityInfo
+	PendingChildren        []*shared.PendingChildExecutionInfo
+	PendingDecision        *pendingDecisionInfo
 }
 
 // workflowExecutionInfo has same fields as shared.WorkflowExecutionInfo, but has datetime instead of raw time
 type workflowExecutionInfo struct {
-	Execution     *s.WorkflowExecution
-	Type          *s.WorkflowType
-	StartTime     *string // change from *int64
-	CloseTime     *string // change from *int64
-	CloseStatus   *s.WorkflowExecutionCloseStatus
-	HistoryLength *int64
+	Execution            *shared.WorkflowExecution
+	Type                 *shared.WorkflowType
+	StartTime            *string // change from *int64
+	CloseTime            *string // change from *int64
+	CloseStatus          *shared.WorkflowExecutionCloseStatus
+	HistoryLength        *int64
+	CronSchedule         *string
+	NextCronScheduleTime *string // change from *int64
 }
 
 // pendingActivityInfo has same fields as shared.PendingActivityInfo, but different field type for better display
 type pendingActivityInfo struct {
 	ActivityID             *string
-	ActivityType           *s.ActivityType
-	State                  *s.PendingActivityState
+	ActivityType           *shared.ActivityType
+	State                  *shared.PendingActivityState
+	ScheduledTimestamp     *string // change from *int64
+	LastStartedTimestamp   *string // change from *int64
 	HeartbeatDetails       *string // change from byte[]
 	LastHeartbeatTimestamp *string // change from *int64
+	Attempt                *int32
+	MaximumAttempts        *int32
+	ExpirationTimestamp    *string // change from *int64
+	LastFailureReason      *string
+	LastWorkerIdentity     *string
+}
+
+// pendingDecisionInfo has same fields as shared.PendingDecisionInfo, but has datetime instead of raw time
+type pendingDecisionInfo struct {
+	State              *shared.PendingDecisionState
+	ScheduledTimestamp *string // change from *int64
+	StartedTimestamp   *string // change from *int64
+	Attempt            *int64
 }
 
-func convertDescribeWorkflowExecutionResponse(resp *s.DescribeWorkflowExecutionResponse) *describeWorkflowExecutionResponse {
+func convertDescribeWorkflowExecutionResponse(resp *shared.DescribeWorkflowExecutionResponse) *describeWorkflowExecutionResponse {
 	info := resp.WorkflowExecutionInfo
 	executionInfo := workflowExecutionInfo{
-		Execution:     info.Execution,
-		Type:          info.Type,
-		StartTime:     common.StringPtr(convertTime(info.GetStartTime(), false)),
-		CloseTime:     common.StringPtr(convertTime(info.GetCloseTime(), false)),
-		CloseStatus:   info.CloseStatus,
-		HistoryLength: info.HistoryLength,
+		Execution:            info.Execution,
+		Type:                 info.Type,
+		StartTime:            common.StringPtr(convertTime(info.GetStartTime(), false)),
+		CloseTime:            common.StringPtr(convertTime(info.GetCloseTime(), false)),
+		CloseStatus:          info.CloseStatus,
+		HistoryLength:        info.HistoryLength,
+		CronSchedule:         info.CronSchedule,
+		NextCronScheduleTime: convertTimePtr(info.NextCronScheduleTime),
 	}
 	var pendingActs []*pendingActivityInfo
 	var tmpAct *pendingActivityInfo
@@ -976,33 +1100,71 @@ func convertDescribeWorkflowExecutionResponse(resp *s.DescribeWorkflowExecutionR
 			ActivityID:             pa.ActivityID,
 			ActivityType:           pa.ActivityType,
 			State:                  pa.State,
-			HeartbeatDetails:       common.StringPtr(string(pa.HeartbeatDetails)),
-			LastHeartbeatTimestamp: common.StringPtr(convertTime(pa.GetLastHeartbeatTimestamp(), false)),
+			ScheduledTimestamp:     convertTimePtr(pa.ScheduledTimestamp),
+			LastStartedTimestamp:   convertTimePtr(pa.LastStartedTimestamp),
+			LastHeartbeatTimestamp: convertTimePtr(pa.LastHeartbeatTimestamp),
+			Attempt:                pa.Attempt,
+			MaximumAttempts:        pa.MaximumAttempts,
+			ExpirationTimestamp:    convertTimePtr(pa.ExpirationTimestamp),
+			LastFailureReason:      pa.LastFailureReason,
+			LastWorkerIdentity:     pa.LastWorkerIdentity,
+		}
+		if pa.HeartbeatDetails != nil {
+			tmpAct.HeartbeatDetails = common.StringPtr(string(pa.HeartbeatDetails))
 		}
 		pendingActs = append(pendingActs, tmpAct)
 	}
 
+	var pendingDecision *pendingDecisionInfo
+	if pd := resp.PendingDecision; pd != nil {
+		pendingDecision = &pendingDecisionInfo{
+			State:              pd.State,
+			ScheduledTimestamp: convertTimePtr(pd.ScheduledTimestamp),
+			StartedTimestamp:   convertTimePtr(pd.StartedTimestamp),
+			Attempt:            pd.Attempt,
+		}
+	}
+
 	return &describeWorkflowExecutionResponse{
 		ExecutionConfiguration: resp.ExecutionConfiguration,
 		WorkflowExecutionInfo:  executionInfo,
 		PendingActivities:      pendingActs,
+		PendingChildren:        resp.PendingChildren,
+		PendingDecision:        pendingDecision,
+	}
+}
+
+// convertTimePtr returns the datetime of an optional unix nano time, or nil if the time is not set
+func convertTimePtr(unixNano *int64) *string {
+	if unixNano == nil {
+		return nil
 	}
+	return common.StringPtr(convertTime(*unixNano, false))
 }
 
-func createTableForListWorkflow(listAll bool) *tablewriter.Table {
+func createTableForListWorkflow(listAll, printMemo bool) *tablewriter.Table {
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetBorder(false)
 	table.SetColumnSeparator("|")
-	table.SetHeader([]string{"Workflow Type", "Workflow ID", "Run ID", "Start Time", "End Time"})
+	header := []string{"Workflow Type", "Workflow ID", "Run ID", "Start Time", "End Time"}
+	if printMemo {
+		header = append(header, "Memo")
+	}
+	table.SetHeader(header)
 	if !listAll { // color is only friendly to ANSI terminal
-		table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+		headerColor := make([]tablewriter.Colors, len(header))
+		for i := range headerColor {
+			headerColor[i] = tableHeaderBlue
+		}
+		table.SetHeaderColor(headerColor...)
 	}
 	table.SetHeaderLine(false)
 	return table
 }
 
 func listWorkflow(c *cli.Context, table *tablewriter.Table) func([]byte) ([]byte, int) {
-	wfClient := getWorkflowClient(c)
+	serviceClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
 
 	queryOpen := c.Bool(FlagOpen)
 	earliestTime := parseTime(c.String(FlagEarliestTime), 0)
@@ -1011,6 +1173,7 @@ func listWorkflow(c *cli.Context, table *tablewriter.Table) func([]byte) ([]byte
 	workflowType := c.String(FlagWorkflowType)
 	printRawTime := c.Bool(FlagPrintRawTime)
 	printDateTime := c.Bool(FlagPrintDateTime)
+	printMemo := c.Bool(FlagPrintMemo)
 	pageSize := c.Int(FlagPageSize)
 	if pageSize <= 0 {
 		pageSize = defaultPageSizeForList
@@ -1031,15 +1194,7 @@ func listWorkflow(c *cli.Context, table *tablewriter.Table) func([]byte) ([]byte
 		ErrorAndExit(optionErr, errors.New("you can filter on workflow_id or workflow_type, but not on both"))
 	}
 
-	prepareTable := func(next []byte) ([]byte, int) {
-		var result []*s.WorkflowExecutionInfo
-		var nextPageToken []byte
-		if queryOpen {
-			result, nextPageToken = listOpenWorkflow(wfClient, pageSize, earliestTime, latestTime, workflowID, workflowType, next, timeout)
-		} else {
-			result, nextPageToken = listClosedWorkflow(wfClient, pageSize, earliestTime, latestTime, workflowID, workflowType, workflowStatus, next, timeout)
-		}
-
+	appendRows := func(result []*shared.WorkflowExecutionInfo) {
 		for _, e := range result {
 			var startTime, closeTime string
 			if printRawTime {
@@ -1049,65 +1204,131 @@ func listWorkflow(c *cli.Context, table *tablewriter.Table) func([]byte) ([]byte
 				startTime = convertTime(e.GetStartTime(), !printDateTime)
 				closeTime = convertTime(e.GetCloseTime(), !printDateTime)
 			}
-			table.Append([]string{trimWorkflowType(e.Type.GetName()), e.Execution.GetWorkflowId(), e.Execution.GetRunId(), startTime, closeTime})
+			row := []string{trimWorkflowType(e.Type.GetName()), e.Execution.GetWorkflowId(), e.Execution.GetRunId(), startTime, closeTime}
+			if printMemo {
+				row = append(row, getPrintableMemo(e.Memo))
+			}
+			table.Append(row)
 		}
+	}
 
+	if c.IsSet(FlagListQuery) {
+		if c.IsSet(FlagOpen) || c.IsSet(FlagWorkflowStatus) || len(workflowID) > 0 || len(workflowType) > 0 ||
+			c.IsSet(FlagEarliestTime) || c.IsSet(FlagLatestTime) {
+			ErrorAndExit(optionErr, errors.New("you can either list with a query or with filters, but not both"))
+		}
+		query := c.String(FlagListQuery)
+
+		return func(next []byte) ([]byte, int) {
+			result, nextPageToken := listWorkflowByQuery(serviceClient, domain, query, pageSize, next, timeout)
+			appendRows(result)
+			return nextPageToken, len(result)
+		}
+	}
+
+	prepareTable := func(next []byte) ([]byte, int) {
+		var result []*shared.WorkflowExecutionInfo
+		var nextPageToken []byte
+		if queryOpen {
+			result, nextPageToken = listOpenWorkflow(serviceClient, domain, pageSize, earliestTime, latestTime, workflowID, workflowType, next, timeout)
+		} else {
+			result, nextPageToken = listClosedWorkflow(serviceClient, domain, pageSize, earliestTime, latestTime, workflowID, workflowType, workflowStatus, next, timeout)
+		}
+		appendRows(result)
 		return nextPageToken, len(result)
 	}
 	return prepareTable
 }
 
-func listOpenWorkflow(client client.Client, pageSize int, earliestTime, latestTime int64, workflowID, workflowType string,
-	nextPageToken []byte, timeout time.Duration) ([]*s.WorkflowExecutionInfo, []byte) {
+// getPrintableMemo renders the memo as key=value pairs sorted by key
+func getPrintableMemo(memo *shared.Memo) string {
+	if memo == nil || len(memo.Fields) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(memo.Fields))
+	for k := range memo.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%v=%v", k, string(memo.Fields[k])))
+	}
+	return strings.Join(pairs, " ")
+}
+
+func listWorkflowByQuery(client serverFrontend.Interface, domain, query string, pageSize int,
+	nextPageToken []byte, timeout time.Duration) ([]*shared.WorkflowExecutionInfo, []byte) {
+
+	request := &shared.ListWorkflowExecutionsRequest{
+		Domain:        common.StringPtr(domain),
+		PageSize:      common.Int32Ptr(int32(pageSize)),
+		NextPageToken: nextPageToken,
+		Query:         common.StringPtr(query),
+	}
+
+	ctx, cancel := newContextForLongPoll(timeout)
+	defer cancel()
+	response, err := client.ListWorkflowExecutions(ctx, request)
+	if err != nil {
+		ErrorAndExit("Failed to list workflow.", err)
+	}
+	return response.Executions, response.NextPageToken
+}
+
+func listOpenWorkflow(client serverFrontend.Interface, domain string, pageSize int, earliestTime, latestTime int64, workflowID, workflowType string,
+	nextPageToken []byte, timeout time.Duration) ([]*shared.WorkflowExecutionInfo, []byte) {
 
-	request := &s.ListOpenWorkflowExecutionsRequest{
+	request := &shared.ListOpenWorkflowExecutionsRequest{
+		Domain:          common.StringPtr(domain),
 		MaximumPageSize: common.Int32Ptr(int32(pageSize)),
 		NextPageToken:   nextPageToken,
-		StartTimeFilter: &s.StartTimeFilter{
+		StartTimeFilter: &shared.StartTimeFilter{
 			EarliestTime: common.Int64Ptr(earliestTime),
 			LatestTime:   common.Int64Ptr(latestTime),
 		},
 	}
 	if len(workflowID) > 0 {
-		request.ExecutionFilter = &s.WorkflowExecutionFilter{WorkflowId: common.StringPtr(workflowID)}
+		request.ExecutionFilter = &shared.WorkflowExecutionFilter{WorkflowId: common.StringPtr(workflowID)}
 	}
 	if len(workflowType) > 0 {
-		request.TypeFilter = &s.WorkflowTypeFilter{Name: common.StringPtr(workflowType)}
+		request.TypeFilter = &shared.WorkflowTypeFilter{Name: common.StringPtr(workflowType)}
 	}
 
 	ctx, cancel := newContextForLongPoll(timeout)
 	defer cancel()
-	response, err := client.ListOpenWorkflow(ctx, request)
+	response, err := client.ListOpenWorkflowExecutions(ctx, request)
 	if err != nil {
 		ErrorAndExit("Failed to list open workflow.", err)
 	}
 	return response.Executions, response.NextPageToken
 }
 
-func listClosedWorkflow(client client.Client, pageSize int, earliestTime, latestTime int64, workflowID, workflowType string,
-	workflowStatus s.WorkflowExecutionCloseStatus, nextPageToken []byte, timeout time.Duration) ([]*s.WorkflowExecutionInfo, []byte) {
+func listClosedWorkflow(client serverFrontend.Interface, domain string, pageSize int, earliestTime, latestTime int64, workflowID, workflowType string,
+	workflowStatus s.WorkflowExecutionCloseStatus, nextPageToken []byte, timeout time.Duration) ([]*shared.WorkflowExecutionInfo, []byte) {
 
-	request := &s.ListClosedWorkflowExecutionsRequest{
+	request := &shared.ListClosedWorkflowExecutionsRequest{
+		Domain:          common.StringPtr(domain),
 		MaximumPageSize: common.Int32Ptr(int32(pageSize)),
 		NextPageToken:   nextPageToken,
-		StartTimeFilter: &s.StartTimeFilter{
+		StartTimeFilter: &shared.StartTimeFilter{
 			EarliestTime: common.Int64Ptr(earliestTime),
 			LatestTime:   common.Int64Ptr(latestTime),
 		},
 	}
 	if len(workflowID) > 0 {
-		request.ExecutionFilter = &s.WorkflowExecutionFilter{WorkflowId: common.StringPtr(workflowID)}
+		request.ExecutionFilter = &shared.WorkflowExecutionFilter{WorkflowId: common.StringPtr(workflowID)}
 	}
 	if len(workflowType) > 0 {
-		request.TypeFilter = &s.WorkflowTypeFilter{Name: common.StringPtr(workflowType)}
+		request.TypeFilter = &shared.WorkflowTypeFilter{Name: common.StringPtr(workflowType)}
 	}
 	if workflowStatus != workflowStatusNotSet {
-		request.StatusFilter = &workflowStatus
+		request.StatusFilter = shared.WorkflowExecutionCloseStatus(workflowStatus).Ptr()
 	}
 
 	ctx, cancel := newContextForLongPoll(timeout)
 	defer cancel()
-	response, err := client.ListClosedWorkflow(ctx, request)
+	response, err := client.ListClosedWorkflowExecutions(ctx, request)
 	if err != nil {
 		ErrorAndExit("Failed to list closed workflow.", err)
 	}
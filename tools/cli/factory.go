@@ -21,12 +21,13 @@
 package cli
 
 import (
+	"github.com/uber-common/bark"
 	serverAdmin "github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	"github.com/uber/cadence/common/service/config"
 	"github.com/urfave/cli"
 	clientFrontend "go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/yarpc"
-	"go.uber.org/yarpc/transport/tchannel"
 	"go.uber.org/zap"
 )
 
@@ -88,7 +89,7 @@ func (b *clientFactory) ensureDispatcher(c *cli.Context) {
 		b.hostPort = addr
 	}
 
-	ch, err := tchannel.NewChannelTransport(tchannel.ServiceName(cadenceClientName), tchannel.ListenAddr("127.0.0.1:0"))
+	ch, err := newTLSConfig(c).NewChannelTransport(cadenceClientName, "127.0.0.1:0", bark.NewNopLogger())
 	if err != nil {
 		b.logger.Fatal("Failed to create transport channel", zap.Error(err))
 	}
@@ -105,3 +106,16 @@ func (b *clientFactory) ensureDispatcher(c *cli.Context) {
 		b.logger.Fatal("Failed to create outbound transport channel: %v", zap.Error(err))
 	}
 }
+
+// newTLSConfig returns the TLS config of the connection to the frontend, TLS is enabled if a CA bundle or a
+// client certificate is given
+func newTLSConfig(c *cli.Context) *config.TLS {
+	tlsConfig := &config.TLS{
+		CAFile:     c.GlobalString(FlagTLSCaPath),
+		CertFile:   c.GlobalString(FlagTLSCertPath),
+		KeyFile:    c.GlobalString(FlagTLSKeyPath),
+		ServerName: c.GlobalString(FlagTLSServerName),
+	}
+	tlsConfig.Enabled = tlsConfig.CAFile != "" || tlsConfig.CertFile != "" || tlsConfig.KeyFile != ""
+	return tlsConfig
+}
@@ -148,6 +148,17 @@ func newWorkflowCommands() []cli.Command {
 					Usage: "Optional input for the workflow from JSON file. If there are multiple JSON, concatenate them and separate by space or newline. " +
 						"Input from file will be overwrite by input from command line",
 				},
+				cli.StringFlag{
+					Name: FlagSearchAttributesWithAlias,
+					Usage: "Optional search attributes for the workflow, as a JSON object of search attribute keys to values, " +
+						"e.g. '{\"CustomKeywordField\":\"keyword1\",\"CustomIntField\":5}'",
+				},
+				cli.StringFlag{
+					Name: FlagCronSchedule,
+					Usage: "Optional cron schedule for the workflow, in the standard cron format of " +
+						"minute, hour, day of month, month and day of week, e.g. '0 9 * * *'. " +
+						"It is evaluated in UTC unless prefixed with a timezone, e.g. 'CRON_TZ=America/New_York 0 9 * * *'",
+				},
 			},
 			Action: func(c *cli.Context) {
 				StartWorkflow(c)
@@ -204,6 +215,12 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagMaxFieldLengthWithAlias,
 					Usage: "Maximum length for each attribute field",
 				},
+				cli.StringFlag{
+					Name: FlagCronSchedule,
+					Usage: "Optional cron schedule for the workflow, in the standard cron format of " +
+						"minute, hour, day of month, month and day of week, e.g. '0 9 * * *'. " +
+						"It is evaluated in UTC unless prefixed with a timezone, e.g. 'CRON_TZ=America/New_York 0 9 * * *'",
+				},
 			},
 			Action: func(c *cli.Context) {
 				RunWorkflow(c)
@@ -279,6 +296,50 @@ func newWorkflowCommands() []cli.Command {
 				TerminateWorkflow(c)
 			},
 		},
+		{
+			Name:    "reset",
+			Aliases: []string{"rs"},
+			Usage:   "reset the workflow, by terminating the current run and starting a new run from a past decision",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, default to the current run",
+				},
+				cli.Int64Flag{
+					Name:  FlagEventIDWithAlias,
+					Usage: "The eventID of a DecisionTaskCompleted/DecisionTaskFailed/DecisionTaskTimedOut event to reset to",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to reset the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ResetWorkflow(c)
+			},
+		},
+		{
+			Name:    "triggercron",
+			Aliases: []string{"tc"},
+			Usage:   "start the next run of a cron workflow now, instead of waiting for its cron schedule",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, default to the current run",
+				},
+			},
+			Action: func(c *cli.Context) {
+				TriggerCronWorkflow(c)
+			},
+		},
 		{
 			Name:        "list",
 			Aliases:     []string{"l"},
@@ -322,6 +383,10 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagPrintDateTimeWithAlias,
 					Usage: "Print full date time in '2006-01-02T15:04:05Z07:00' format",
 				},
+				cli.BoolFlag{
+					Name:  FlagPrintMemoWithAlias,
+					Usage: "Print memo",
+				},
 				cli.IntFlag{
 					Name:  FlagContextTimeoutWithAlias,
 					Value: 30,
@@ -331,6 +396,11 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagWorkflowStatusWithAlias,
 					Usage: "Closed workflow status [completed, failed, canceled, terminated, continueasnew, timedout]",
 				},
+				cli.StringFlag{
+					Name: FlagListQueryWithAlias,
+					Usage: "Optional SQL like query over open and closed workflow executions, cannot be used together with other filters, " +
+						"e.g. \"WorkflowType = 'main.Workflow' AND CloseStatus = 'failed'\"",
+				},
 			},
 			Action: func(c *cli.Context) {
 				ListWorkflow(c)
@@ -369,6 +439,10 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagPrintDateTimeWithAlias,
 					Usage: "Print full date time in '2006-01-02T15:04:05Z07:00' format",
 				},
+				cli.BoolFlag{
+					Name:  FlagPrintMemoWithAlias,
+					Usage: "Print memo",
+				},
 				cli.IntFlag{
 					Name:  FlagContextTimeoutWithAlias,
 					Value: 30,
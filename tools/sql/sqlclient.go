@@ -0,0 +1,330 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"bufio"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	// load the drivers supported by the tool
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+)
+
+type (
+	// SQLClient is the interface for implementations
+	// that provide a way to talk to a sql database
+	SQLClient interface {
+		// Exec executes a sql statement
+		Exec(stmt string) error
+		// ListTables lists the table names in the database
+		ListTables() ([]string, error)
+		// DropTable drops the given table
+		DropTable(name string) error
+		// CreateDatabase creates a database, if it doesn't exist
+		CreateDatabase(name string) error
+		// DropDatabase drops a database, if it exists
+		DropDatabase(name string) error
+		// CreateSchemaVersionTables sets up the schema version tables
+		CreateSchemaVersionTables() error
+		// ReadSchemaVersion returns the current schema version for the database
+		ReadSchemaVersion() (string, error)
+		// UpdateSchemaVersion updates the schema version for the database
+		UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error
+		// WriteSchemaUpdateLog adds an entry to the schema update history table
+		WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error
+		// Close gracefully closes the client object
+		Close()
+	}
+
+	sqlClient struct {
+		db       *sqlx.DB
+		driver   *driver
+		database string
+	}
+
+	// driver holds the parts of connecting to and managing
+	// a database which differ between the supported databases
+	driver struct {
+		defaultPort int
+		// maintenanceDatabase is the database connected to when no database is given
+		maintenanceDatabase                 string
+		dataSourceName                      func(cfg *BaseConfig, database string) string
+		databaseExistsQuery                 string
+		dropDatabaseQueries                 []string
+		listTablesQuery                     string
+		createSchemaVersionTableQuery       string
+		createSchemaUpdateHistoryTableQuery string
+		writeSchemaVersionQuery             string
+	}
+)
+
+var errGetSchemaVersion = errors.New("Failed to get current schema version from sql database")
+
+const (
+	mysqlDriverName    = "mysql"
+	postgresDriverName = "postgres"
+
+	newLineDelim = '\n'
+)
+
+const (
+	readSchemaVersionQuery        = `SELECT curr_version FROM schema_version WHERE db_name = ?`
+	writeSchemaUpdateHistoryQuery = `INSERT INTO schema_update_history(year, month, update_time, old_version, new_version, manifest_md5, description) VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	createSchemaVersionTableQueryTemplate = `CREATE TABLE schema_version(db_name VARCHAR(255) NOT NULL, ` +
+		`creation_time %[1]v, ` +
+		`curr_version VARCHAR(64), ` +
+		`min_compatible_version VARCHAR(64), ` +
+		`PRIMARY KEY (db_name));`
+
+	createSchemaUpdateHistoryTableQueryTemplate = `CREATE TABLE schema_update_history(` +
+		`year INT NOT NULL, ` +
+		`month INT NOT NULL, ` +
+		`update_time %[1]v NOT NULL, ` +
+		`description VARCHAR(255), ` +
+		`manifest_md5 VARCHAR(64), ` +
+		`new_version VARCHAR(64), ` +
+		`old_version VARCHAR(64), ` +
+		`PRIMARY KEY (year, month, update_time));`
+
+	writeSchemaVersionQueryPrefix = `INTO schema_version(db_name, creation_time, curr_version, min_compatible_version) VALUES (?, ?, ?, ?)`
+)
+
+var drivers = map[string]*driver{
+	mysqlDriverName: {
+		defaultPort:         3306,
+		maintenanceDatabase: "",
+		dataSourceName: func(cfg *BaseConfig, database string) string {
+			return fmt.Sprintf("%s:%s@tcp(%s)/%s?multiStatements=true&parseTime=true",
+				cfg.User, cfg.Password, net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)), database)
+		},
+		databaseExistsQuery:                 `SELECT schema_name FROM information_schema.schemata WHERE schema_name = ?`,
+		dropDatabaseQueries:                 []string{`DROP DATABASE IF EXISTS %[1]v`},
+		listTablesQuery:                     `SHOW TABLES`,
+		createSchemaVersionTableQuery:       fmt.Sprintf(createSchemaVersionTableQueryTemplate, "DATETIME(6)"),
+		createSchemaUpdateHistoryTableQuery: fmt.Sprintf(createSchemaUpdateHistoryTableQueryTemplate, "DATETIME(6)"),
+		writeSchemaVersionQuery:             `REPLACE ` + writeSchemaVersionQueryPrefix,
+	},
+	postgresDriverName: {
+		defaultPort:         5432,
+		maintenanceDatabase: "postgres",
+		dataSourceName: func(cfg *BaseConfig, database string) string {
+			dsn := url.URL{
+				Scheme:   "postgres",
+				User:     url.UserPassword(cfg.User, cfg.Password),
+				Host:     net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+				Path:     "/" + database,
+				RawQuery: "sslmode=disable",
+			}
+			return dsn.String()
+		},
+		databaseExistsQuery: `SELECT datname FROM pg_database WHERE datname = ?`,
+		dropDatabaseQueries: []string{
+			// a database cannot be dropped while there are connections to it
+			`SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = '%[1]v' AND pid <> pg_backend_pid()`,
+			`DROP DATABASE IF EXISTS %[1]v`,
+		},
+		listTablesQuery:                     `SELECT table_name FROM information_schema.tables WHERE table_schema = current_schema()`,
+		createSchemaVersionTableQuery:       fmt.Sprintf(createSchemaVersionTableQueryTemplate, "TIMESTAMP WITH TIME ZONE"),
+		createSchemaUpdateHistoryTableQuery: fmt.Sprintf(createSchemaUpdateHistoryTableQueryTemplate, "TIMESTAMP WITH TIME ZONE"),
+		writeSchemaVersionQuery: `INSERT ` + writeSchemaVersionQueryPrefix + ` ON CONFLICT (db_name) DO UPDATE SET ` +
+			`creation_time = excluded.creation_time, ` +
+			`curr_version = excluded.curr_version, ` +
+			`min_compatible_version = excluded.min_compatible_version`,
+	},
+}
+
+// newSQLClient returns a new instance of SQLClient connected to the given
+// database, or to the maintenance database of the driver if database is empty
+func newSQLClient(cfg *BaseConfig, database string) (SQLClient, error) {
+	d, ok := drivers[cfg.DriverName]
+	if !ok {
+		return nil, fmt.Errorf("unsupported sql driver: %v", cfg.DriverName)
+	}
+	if len(database) == 0 {
+		database = d.maintenanceDatabase
+	}
+	db, err := sqlx.Connect(cfg.DriverName, d.dataSourceName(cfg, database))
+	if err != nil {
+		return nil, err
+	}
+	return &sqlClient{
+		db:       db,
+		driver:   d,
+		database: database,
+	}, nil
+}
+
+// CreateDatabase creates a database if it doesn't exist
+func (client *sqlClient) CreateDatabase(name string) error {
+	var names []string
+	if err := client.db.Select(&names, client.db.Rebind(client.driver.databaseExistsQuery), name); err != nil {
+		return err
+	}
+	if len(names) > 0 {
+		return nil
+	}
+	return client.Exec(fmt.Sprintf("CREATE DATABASE %v", name))
+}
+
+// DropDatabase drops a database if it exists
+func (client *sqlClient) DropDatabase(name string) error {
+	for _, query := range client.driver.dropDatabaseQueries {
+		if err := client.Exec(fmt.Sprintf(query, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListTables lists the table names in the database
+func (client *sqlClient) ListTables() ([]string, error) {
+	var names []string
+	if err := client.db.Select(&names, client.driver.listTablesQuery); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// DropTable drops a given table from the database
+func (client *sqlClient) DropTable(name string) error {
+	return client.Exec(fmt.Sprintf("DROP TABLE %v", name))
+}
+
+// CreateSchemaVersionTables sets up the schema version tables
+func (client *sqlClient) CreateSchemaVersionTables() error {
+	if err := client.Exec(client.driver.createSchemaVersionTableQuery); err != nil {
+		return err
+	}
+	return client.Exec(client.driver.createSchemaUpdateHistoryTableQuery)
+}
+
+// ReadSchemaVersion returns the current schema version for the database
+func (client *sqlClient) ReadSchemaVersion() (string, error) {
+	var version string
+	err := client.db.Get(&version, client.db.Rebind(readSchemaVersionQuery), client.database)
+	if err == sql.ErrNoRows {
+		return "", errGetSchemaVersion
+	}
+	return version, err
+}
+
+// UpdateSchemaVersion updates the schema version for the database
+func (client *sqlClient) UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error {
+	_, err := client.db.Exec(client.db.Rebind(client.driver.writeSchemaVersionQuery),
+		client.database, time.Now().UTC(), newVersion, minCompatibleVersion)
+	return err
+}
+
+// WriteSchemaUpdateLog adds an entry to the schema update history table
+func (client *sqlClient) WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
+	now := time.Now().UTC()
+	_, err := client.db.Exec(client.db.Rebind(writeSchemaUpdateHistoryQuery),
+		now.Year(), int(now.Month()), now, oldVersion, newVersion, manifestMD5, desc)
+	return err
+}
+
+// Exec executes a sql statement
+func (client *sqlClient) Exec(stmt string) error {
+	_, err := client.db.Exec(stmt)
+	return err
+}
+
+// Close closes the sql client
+func (client *sqlClient) Close() {
+	if client.db != nil {
+		client.db.Close()
+	}
+}
+
+// ParseSQLFile takes a sql file path as input
+// and returns an array of sql statements on
+// success.
+func ParseSQLFile(filePath string) ([]string, error) {
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	reader := bufio.NewReader(f)
+
+	var line string
+	var currStmt []string
+	var stmts = make([]string, 0, 4)
+
+	for err == nil {
+
+		line, err = reader.ReadString(newLineDelim)
+		line = strings.TrimSpace(line)
+		if len(line) < 1 {
+			continue
+		}
+
+		// Filter out the comment lines, the
+		// only recognized comment line format
+		// is any line that starts with double dashes
+		tokens := strings.Split(line, "--")
+		if token := strings.TrimSpace(tokens[0]); len(token) > 0 {
+			currStmt = append(currStmt, token)
+			// semi-colon is the end of statement delim
+			if strings.HasSuffix(token, ";") {
+				stmts = append(stmts, strings.Join(currStmt, " "))
+				currStmt = nil
+			}
+		}
+	}
+
+	if err == io.EOF {
+		return stmts, nil
+	}
+
+	return nil, err
+}
+
+// dropAllTables deletes all tables in the
+// database without deleting the database
+func dropAllTables(client SQLClient) {
+	tables, err := client.ListTables()
+	if err != nil {
+		return
+	}
+	log.Printf("Dropping following tables: %v\n", tables)
+	for _, table := range tables {
+		err1 := client.DropTable(table)
+		if err1 != nil {
+			log.Printf("Error dropping table %v, err=%v\n", table, err1)
+		}
+	}
+}
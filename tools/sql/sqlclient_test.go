@@ -0,0 +1,92 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	SQLClientTestSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+	}
+)
+
+func TestSQLClientTestSuite(t *testing.T) {
+	suite.Run(t, new(SQLClientTestSuite))
+}
+
+func (s *SQLClientTestSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+}
+
+func (s *SQLClientTestSuite) TestParseSQLFile() {
+	rootDir, err := ioutil.TempDir("", "sqlClientTestDir")
+	s.Nil(err)
+	defer os.RemoveAll(rootDir)
+
+	sqlFile, err := ioutil.TempFile(rootDir, "parseSQLTest")
+	s.Nil(err)
+	defer os.Remove(sqlFile.Name())
+
+	sqlFile.WriteString(createTestSQLFileContent())
+	stmts, err := ParseSQLFile(sqlFile.Name())
+	s.Nil(err)
+	s.Equal(3, len(stmts), "wrong number of sql statements")
+	s.Equal("CREATE TABLE events ( domain_id BINARY(16) NOT NULL, workflow_id VARCHAR(255) NOT NULL, "+
+		"first_event_id BIGINT NOT NULL, data BLOB NOT NULL, PRIMARY KEY (domain_id, workflow_id, first_event_id) );",
+		rmspaceRegex.ReplaceAllString(stmts[0], " "))
+	s.Equal("INSERT INTO tasks (id) VALUES (1);", stmts[2])
+}
+
+func (s *SQLClientTestSuite) TestParseSQLFileNotFound() {
+	_, err := ParseSQLFile("/non/existent/file.sql")
+	s.NotNil(err)
+}
+
+func createTestSQLFileContent() string {
+	return `
+-- test sql file content
+
+CREATE TABLE events (
+  domain_id      BINARY(16) NOT NULL,
+  workflow_id    VARCHAR(255) NOT NULL,
+  -- This field stores the event id of first event in the batch.
+  first_event_id BIGINT NOT NULL,
+  data           BLOB NOT NULL, -- Batch of workflow execution history events as a blob
+  PRIMARY KEY (domain_id, workflow_id, first_event_id)
+);
+
+-- Stores activity or workflow tasks
+CREATE TABLE tasks (
+  id BIGINT NOT NULL,
+  PRIMARY KEY (id)
+);
+
+INSERT INTO tasks (id) VALUES (1);
+`
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	UpdateSchemaTestSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+		database string
+		client   SQLClient
+	}
+)
+
+const (
+	testUser     = "uber"
+	testPassword = "uber"
+)
+
+func TestUpdateSchemaTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateSchemaTestSuite))
+}
+
+func (s *UpdateSchemaTestSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+}
+
+func (s *UpdateSchemaTestSuite) SetupSuite() {
+	rand := rand.New(rand.NewSource(time.Now().UnixNano()))
+	s.database = fmt.Sprintf("update_schema_test_%v", rand.Int63())
+
+	client, err := newSQLClient(newTestBaseConfig(s.database), "")
+	if err != nil {
+		log.Fatalf("error creating SQLClient, err=%v", err)
+	}
+	if err := client.CreateDatabase(s.database); err != nil {
+		log.Fatalf("error creating database, err=%v", err)
+	}
+	client.Close()
+
+	client, err = newSQLClient(newTestBaseConfig(s.database), s.database)
+	if err != nil {
+		log.Fatalf("error creating SQLClient, err=%v", err)
+	}
+	s.client = client
+}
+
+func (s *UpdateSchemaTestSuite) TearDownSuite() {
+	s.client.Close()
+	client, err := newSQLClient(newTestBaseConfig(s.database), "")
+	s.Nil(err)
+	defer client.Close()
+	s.Nil(client.DropDatabase(s.database))
+}
+
+func (s *UpdateSchemaTestSuite) TestUpdateSchema() {
+
+	dir := "../../schema/mysql/v57/cadence/versioned"
+	s.Nil(s.runTool("setup-schema", "-v", "0.0"))
+	s.Nil(s.runTool("update-schema", "-d", dir))
+
+	expected, err := getExpectedVersion(dir)
+	s.Nil(err)
+	ver, err := s.client.ReadSchemaVersion()
+	s.Nil(err)
+	s.Equal(expected, ver)
+
+	tables, err := s.client.ListTables()
+	s.Nil(err)
+	s.Contains(tables, "schema_version")
+	s.Contains(tables, "schema_update_history")
+	s.Contains(tables, "executions")
+
+	dropAllTables(s.client)
+}
+
+func (s *UpdateSchemaTestSuite) TestDryrun() {
+
+	dir := "../../schema/mysql/v57/cadence/versioned"
+	s.Nil(s.runTool("setup-schema", "-v", "0.0"))
+	s.Nil(s.runTool("update-schema", "-d", dir, "-y"))
+
+	ver, err := s.client.ReadSchemaVersion()
+	s.Nil(err)
+	s.Equal("0.0", ver)
+
+	tables, err := s.client.ListTables()
+	s.Nil(err)
+	s.NotContains(tables, "executions")
+
+	dropAllTables(s.client)
+}
+
+func (s *UpdateSchemaTestSuite) TestReadManifest() {
+
+	tmpDir, err := ioutil.TempDir("", "update_schema_test")
+	s.Nil(err)
+	defer os.RemoveAll(tmpDir)
+
+	input := `{
+		"CurrVersion": "0.4",
+		"MinCompatibleVersion": "0.1",
+		"Description": "base version of schema",
+		"SchemaUpdateSqlFiles": ["base1.sql", "base2.sql", "base3.sql"]
+	}`
+	files := []string{"base1.sql", "base2.sql", "base3.sql"}
+	s.runReadManifestTest(tmpDir, input, "0.4", "0.1", "base version of schema", files, false)
+
+	errInputs := []string{
+		`{
+			"MinCompatibleVersion": "0.1",
+			"Description": "base",
+			"SchemaUpdateSqlFiles": ["base1.sql"]
+		 }`,
+		`{
+			"CurrVersion": "0.4",
+			"Description": "base version of schema",
+			"SchemaUpdateSqlFiles": ["base1.sql", "base2.sql", "base3.sql"]
+		 }`,
+		`{
+			"CurrVersion": "0.4",
+			"MinCompatibleVersion": "0.1",
+			"Description": "base version of schema",
+		 }`,
+		`{
+			"CurrVersion": "0.4",
+			"MinCompatibleVersion": "0.1",
+			"Description": "base version of schema",
+			"SchemaUpdateSqlFiles": []
+		 }`,
+	}
+
+	for _, in := range errInputs {
+		s.runReadManifestTest(tmpDir, in, "", "", "", nil, true)
+	}
+}
+
+func (s *UpdateSchemaTestSuite) TestReadSchemaDir() {
+
+	tmpDir, err := ioutil.TempDir("", "update_schema_test")
+	s.Nil(err)
+	defer os.RemoveAll(tmpDir)
+
+	subDirs := []string{"v0.5", "v1.5", "v2.5", "v3.5", "v10.2", "abc", "2.0", "3.0"}
+	for _, d := range subDirs {
+		os.Mkdir(tmpDir+"/"+d, os.FileMode(0444))
+	}
+
+	_, err = readSchemaDir(tmpDir, "11.0", "11.2")
+	s.NotNil(err)
+	_, err = readSchemaDir(tmpDir, "0.5", "10.3")
+	s.NotNil(err)
+
+	ans, err := readSchemaDir(tmpDir, "0.4", "10.2")
+	s.Nil(err)
+	s.Equal([]string{"v0.5", "v1.5", "v2.5", "v3.5", "v10.2"}, ans)
+
+	ans, err = readSchemaDir(tmpDir, "0.5", "3.5")
+	s.Nil(err)
+	s.Equal([]string{"v1.5", "v2.5", "v3.5"}, ans)
+}
+
+func (s *UpdateSchemaTestSuite) TestValidateSQLStmts() {
+	s.Nil(validateSQLStmts([]string{"CREATE TABLE foo (id INT);", "ALTER TABLE foo ADD bar INT;"}))
+	s.NotNil(validateSQLStmts([]string{"DROP TABLE foo;"}))
+}
+
+func (s *UpdateSchemaTestSuite) runTool(args ...string) error {
+	return RunTool(append([]string{
+		"./tool", "-u", testUser, "-pw", testPassword, "-db", s.database, "-q",
+	}, args...))
+}
+
+func (s *UpdateSchemaTestSuite) runReadManifestTest(dir, input, currVer, minVer, desc string,
+	files []string, isErr bool) {
+
+	file := dir + "/manifest.json"
+	err := ioutil.WriteFile(file, []byte(input), os.FileMode(0644))
+	s.Nil(err)
+
+	m, err := readManifest(dir)
+	if isErr {
+		s.NotNil(err)
+		return
+	}
+	s.Nil(err)
+	s.Equal(currVer, m.CurrVersion)
+	s.Equal(minVer, m.MinCompatibleVersion)
+	s.Equal(desc, m.Description)
+	s.True(len(m.md5) > 0)
+	s.Equal(files, m.SchemaUpdateSqlFiles)
+}
+
+func newTestBaseConfig(database string) *BaseConfig {
+	return &BaseConfig{
+		DriverName: mysqlDriverName,
+		Host:       "127.0.0.1",
+		Port:       drivers[mysqlDriverName].defaultPort,
+		User:       testUser,
+		Password:   testPassword,
+		Database:   database,
+	}
+}
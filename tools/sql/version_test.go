@@ -0,0 +1,171 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	VersionTestSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+	}
+)
+
+func TestVersionTestSuite(t *testing.T) {
+	suite.Run(t, new(VersionTestSuite))
+}
+
+func (s *VersionTestSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+}
+
+func (s *VersionTestSuite) TestParseVersion() {
+	s.execParseTest("", 0, 0, false)
+	s.execParseTest("0", 0, 0, false)
+	s.execParseTest("99", 99, 0, false)
+	s.execParseTest("0.0", 0, 0, false)
+	s.execParseTest("0.9", 0, 9, false)
+	s.execParseTest("0.10", 0, 10, false)
+	s.execParseTest("1.0", 1, 0, false)
+	s.execParseTest("999.999", 999, 999, false)
+	s.execParseTest("a.b", 0, 0, true)
+	s.execParseTest("1.5a", 0, 0, true)
+	s.execParseTest("golang", 0, 0, true)
+}
+
+func (s *VersionTestSuite) TestCmpVersion() {
+
+	s.Equal(0, cmpVersion("0", "0"))
+	s.Equal(0, cmpVersion("0.0", "0.0"))
+	s.Equal(0, cmpVersion("99.888", "99.888"))
+
+	s.True(cmpVersion("0.1", "0") > 0)
+	s.True(cmpVersion("0.10", "0.9") > 0)
+	s.True(cmpVersion("1.1", "0.9") > 0)
+
+	s.True(cmpVersion("0", "0.1") < 0)
+	s.True(cmpVersion("0.9", "0.10") < 0)
+	s.True(cmpVersion("0.9", "1.1") < 0)
+}
+
+func (s *VersionTestSuite) TestParseValidateVersion() {
+
+	inputs := []string{"0", "1000", "0.1", "0.9", "99.9", "100.8"}
+	for _, in := range inputs {
+		s.execParseValidateTest(in, in, false)
+		s.execParseValidateTest("v"+in, in, false)
+	}
+
+	errInputs := []string{"", "1.2a", "ab", "5.11a"}
+	for _, in := range errInputs {
+		s.execParseValidateTest(in, "", true)
+		s.execParseValidateTest("v"+in, "", true)
+	}
+}
+
+func (s *VersionTestSuite) TestGetExpectedVersion() {
+	flags := []struct {
+		dirs     []string
+		expected string
+		err      string
+	}{
+		{[]string{"1.0"}, "1.0", ""},
+		{[]string{"1.0", "2.0"}, "2.0", ""},
+		{[]string{"0.9", "0.10"}, "0.10", ""},
+		{[]string{"abc"}, "", "no valid schemas"},
+	}
+	for _, flag := range flags {
+		s.expectedVersionTest(flag.expected, flag.dirs, flag.err)
+	}
+}
+
+func (s *VersionTestSuite) TestGetExpectedVersionOfSchemaDirs() {
+	for _, dir := range []string{
+		"../../schema/mysql/v56/cadence/versioned",
+		"../../schema/mysql/v56/visibility/versioned",
+		"../../schema/mysql/v57/cadence/versioned",
+		"../../schema/mysql/v57/visibility/versioned",
+		"../../schema/postgres/cadence/versioned",
+		"../../schema/postgres/visibility/versioned",
+	} {
+		_, err := getExpectedVersion(dir)
+		s.NoError(err, dir)
+	}
+}
+
+func (s *VersionTestSuite) TestVerifyCompatibleVersionIgnoresOtherStores() {
+	cfg := config.Persistence{
+		DefaultStore:    "default",
+		VisibilityStore: "visibility",
+		DataStores: map[string]config.DataStore{
+			"default":    {Cassandra: &config.Cassandra{Hosts: "127.0.0.1"}},
+			"visibility": {Cassandra: &config.Cassandra{Hosts: "127.0.0.1"}},
+		},
+	}
+	s.NoError(VerifyCompatibleVersion(cfg, "../.."))
+}
+
+func (s *VersionTestSuite) execParseValidateTest(input string, output string, isErr bool) {
+	ver, err := parseValidateVersion(input)
+	if isErr {
+		s.NotNil(err)
+		return
+	}
+	s.Nil(err)
+	s.Equal(output, ver)
+}
+
+func (s *VersionTestSuite) execParseTest(input string, expMajor int, expMinor int, isErr bool) {
+	maj, min, err := parseVersion(input)
+	if isErr {
+		s.NotNil(err)
+		return
+	}
+	s.Nil(err)
+	s.Equal(expMajor, maj)
+	s.Equal(expMinor, min)
+}
+
+func (s *VersionTestSuite) expectedVersionTest(expected string, dirs []string, errStr string) {
+	tmpDir, err := ioutil.TempDir("", "version_test")
+	s.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	for _, dir := range dirs {
+		s.NoError(os.Mkdir(tmpDir+"/v"+dir, os.FileMode(0744)))
+	}
+	v, err := getExpectedVersion(tmpDir)
+	if len(errStr) == 0 {
+		s.NoError(err)
+		s.Equal(expected, v)
+	} else {
+		s.Error(err)
+		s.Contains(err.Error(), errStr)
+	}
+}